@@ -0,0 +1,397 @@
+package gurl
+
+import "errors"
+
+// ErrInvalidURL is returned by Build for a Builder made by FromURL from a nil
+// URL.
+var ErrInvalidURL = errors.New("gurl: invalid URL")
+
+// Builder chains edits to a URL and applies them when Build is called.
+//
+// A Builder is immutable: every method returns a new Builder and leaves the
+// receiver and its source URL untouched, so a partially built Builder can be
+// shared and extended in several directions. The first error met, whether
+// from parsing the source or from an edit, is kept and returned by Build.
+type Builder struct {
+	src *URL
+	ops []func(*URL) error
+	err error
+}
+
+// From returns a Builder for the URL string u.
+//
+// Parameters:
+//
+//	u: The URL to build from.
+//
+// Returns:
+//
+//	A Builder. An error parsing u is returned by Build.
+//
+// Example:
+//
+//	result, err := From("http://example.com/?a=0").Query("a", "1").Hash("t", "2").Path("/x").Scheme("https").Build()
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "https://example.com/x?a=1#?t=2"
+func From(u string) Builder {
+	parsedURL, err := Parse(u)
+	return Builder{src: parsedURL, err: err}
+}
+
+// FromURL returns a Builder for the parsed URL u. The edits are applied to a
+// copy of u, so u itself is never changed. If u is nil, Build returns
+// ErrInvalidURL.
+func FromURL(u *URL) Builder {
+	if u == nil {
+		return Builder{err: ErrInvalidURL}
+	}
+	return Builder{src: u.Clone()}
+}
+
+// with returns a copy of b with op appended. The ops slice is always copied
+// so that Builders derived from the same parent do not share edits.
+func (b Builder) with(op func(*URL) error) Builder {
+	ops := make([]func(*URL) error, len(b.ops), len(b.ops)+1)
+	copy(ops, b.ops)
+	b.ops = append(ops, op)
+	return b
+}
+
+// PreserveQuery makes the following query edits keep the order and encoding
+// of untouched parameters. See URL.PreserveQuery.
+func (b Builder) PreserveQuery() Builder {
+	return b.with(func(u *URL) error {
+		u.PreserveQuery(true)
+		return nil
+	})
+}
+
+// Query sets the query parameter param to value.
+func (b Builder) Query(param, value string) Builder {
+	return b.with(func(u *URL) error {
+		u.SetQueryParam(param, value)
+		return nil
+	})
+}
+
+// DelQuery deletes the query parameter param.
+func (b Builder) DelQuery(param string) Builder {
+	return b.with(func(u *URL) error {
+		u.DelQueryParam(param)
+		return nil
+	})
+}
+
+// AddQuery appends value to the query parameter param.
+func (b Builder) AddQuery(param, value string) Builder {
+	return b.with(func(u *URL) error {
+		u.AddQueryParam(param, value)
+		return nil
+	})
+}
+
+// DelQueryValue removes value from the query parameter param.
+func (b Builder) DelQueryValue(param, value string) Builder {
+	return b.with(func(u *URL) error {
+		u.DelQueryParamValue(param, value)
+		return nil
+	})
+}
+
+// QueryAt replaces the i-th value of the query parameter param.
+func (b Builder) QueryAt(param string, i int, value string) Builder {
+	return b.with(func(u *URL) error {
+		return u.SetQueryParamAt(param, i, value)
+	})
+}
+
+// EncodeQuery sets the query parameters of the fields of the struct src.
+// See EncodeQuery.
+func (b Builder) EncodeQuery(src interface{}) Builder {
+	return b.with(func(u *URL) error {
+		return u.EncodeQuery(src)
+	})
+}
+
+// NestedQuery replaces the query parameters under a path such as
+// "filter.status" with those of value. See SetNestedQueryParam.
+func (b Builder) NestedQuery(path string, value interface{}, opts ...NestedQueryOptions) Builder {
+	return b.with(func(u *URL) error {
+		return u.SetNestedQueryParam(path, value, opts...)
+	})
+}
+
+// StripTracking removes tracking parameters from the query and the
+// fragment. See StripTracking.
+func (b Builder) StripTracking(opts TrackingOptions) Builder {
+	return b.with(func(u *URL) error {
+		u.StripTracking(opts)
+		return nil
+	})
+}
+
+// Campaign tags the URL with the UTM parameters of c. See ApplyCampaign.
+func (b Builder) Campaign(c Campaign, opts ...CampaignOptions) Builder {
+	return b.with(func(u *URL) error {
+		return u.ApplyCampaign(c, opts...)
+	})
+}
+
+// Sign signs the URL with key. It should be the last edit of the chain, as
+// later edits would invalidate the signature. See Sign.
+func (b Builder) Sign(key SigningKey, opts SignOptions) Builder {
+	return b.with(func(u *URL) error {
+		return u.Sign(key, opts)
+	})
+}
+
+// PresignSigV4 presigns the URL with AWS Signature Version 4. It should be
+// the last edit of the chain. See PresignSigV4.
+func (b Builder) PresignSigV4(creds SigV4Credentials, opts PresignOptions) Builder {
+	return b.with(func(u *URL) error {
+		return u.PresignSigV4(creds, opts)
+	})
+}
+
+// Redact masks the credentials and secrets of the URL. See Redact.
+func (b Builder) Redact(policy RedactPolicy) Builder {
+	return b.with(func(u *URL) error {
+		u.Redact(policy)
+		return nil
+	})
+}
+
+// HashEncoding selects how the following hash edits escape parameters.
+func (b Builder) HashEncoding(enc Encoding) Builder {
+	return b.with(func(u *URL) error {
+		u.SetHashEncoding(enc)
+		return nil
+	})
+}
+
+// Hash sets the hash parameter param to value.
+func (b Builder) Hash(param, value string) Builder {
+	return b.with(func(u *URL) error {
+		u.SetHashParam(param, value)
+		return nil
+	})
+}
+
+// DelHash deletes the hash parameter param.
+func (b Builder) DelHash(param string) Builder {
+	return b.with(func(u *URL) error {
+		u.DelHashParam(param)
+		return nil
+	})
+}
+
+// AddHash appends value to the hash parameter param.
+func (b Builder) AddHash(param, value string) Builder {
+	return b.with(func(u *URL) error {
+		u.AddHashParam(param, value)
+		return nil
+	})
+}
+
+// DelHashValue removes value from the hash parameter param.
+func (b Builder) DelHashValue(param, value string) Builder {
+	return b.with(func(u *URL) error {
+		u.DelHashParamValue(param, value)
+		return nil
+	})
+}
+
+// HashAt replaces the i-th value of the hash parameter param.
+func (b Builder) HashAt(param string, i int, value string) Builder {
+	return b.with(func(u *URL) error {
+		return u.SetHashParamAt(param, i, value)
+	})
+}
+
+// EncodeHashQuery sets the hash parameters of the fields of the struct src.
+// See EncodeHashQuery.
+func (b Builder) EncodeHashQuery(src interface{}) Builder {
+	return b.with(func(u *URL) error {
+		return u.EncodeHashQuery(src)
+	})
+}
+
+// HashPath sets the route path of the fragment.
+func (b Builder) HashPath(newPath string) Builder {
+	return b.with(func(u *URL) error {
+		u.SetHashPath(newPath)
+		return nil
+	})
+}
+
+// JoinHashPath joins the elements onto the route path of the fragment.
+func (b Builder) JoinHashPath(elem ...string) Builder {
+	return b.with(func(u *URL) error {
+		u.JoinHashPath(elem...)
+		return nil
+	})
+}
+
+// Path sets the path.
+func (b Builder) Path(newPath string) Builder {
+	return b.with(func(u *URL) error {
+		u.SetPath(newPath)
+		return nil
+	})
+}
+
+// PathSegment replaces the i-th path segment. A negative i counts from the
+// end.
+func (b Builder) PathSegment(i int, value string) Builder {
+	return b.with(func(u *URL) error {
+		return u.SetPathSegment(i, value)
+	})
+}
+
+// InsertPathSegment inserts value before the i-th path segment.
+func (b Builder) InsertPathSegment(i int, value string) Builder {
+	return b.with(func(u *URL) error {
+		return u.InsertPathSegment(i, value)
+	})
+}
+
+// DelPathSegment deletes the i-th path segment.
+func (b Builder) DelPathSegment(i int) Builder {
+	return b.with(func(u *URL) error {
+		return u.DelPathSegment(i)
+	})
+}
+
+// AppendPath joins elements onto the path.
+func (b Builder) AppendPath(elem ...string) Builder {
+	return b.with(func(u *URL) error {
+		u.AppendPath(elem...)
+		return nil
+	})
+}
+
+// PopPath removes the last path segment.
+func (b Builder) PopPath() Builder {
+	return b.with(func(u *URL) error {
+		u.PopPath()
+		return nil
+	})
+}
+
+// Route sets the path built from a route and its parameters.
+func (b Builder) Route(r *Route, params map[string]string) Builder {
+	return b.with(func(u *URL) error {
+		path, err := r.Path(params)
+		if err != nil {
+			return err
+		}
+		u.SetPath(path)
+		return nil
+	})
+}
+
+// Host sets the host, including the port if any.
+func (b Builder) Host(newHost string) Builder {
+	return b.with(func(u *URL) error {
+		u.SetHost(newHost)
+		return nil
+	})
+}
+
+// Hostname sets the host while keeping the port.
+func (b Builder) Hostname(newHostname string) Builder {
+	return b.with(func(u *URL) error {
+		u.SetHostname(newHostname)
+		return nil
+	})
+}
+
+// HostnameAs converts newHostname to form and sets it while keeping the
+// port. See URL.SetHostnameAs.
+func (b Builder) HostnameAs(newHostname string, form HostnameForm) Builder {
+	return b.with(func(u *URL) error {
+		return u.SetHostnameAs(newHostname, form)
+	})
+}
+
+// Subdomain replaces the labels of the host before its registrable domain.
+func (b Builder) Subdomain(subdomain string) Builder {
+	return b.with(func(u *URL) error {
+		return u.SetSubdomain(subdomain)
+	})
+}
+
+// Port sets the port while keeping the hostname.
+func (b Builder) Port(port string) Builder {
+	return b.with(func(u *URL) error {
+		return u.SetPort(port)
+	})
+}
+
+// DelPort removes the port.
+func (b Builder) DelPort() Builder {
+	return b.with(func(u *URL) error {
+		u.DelPort()
+		return nil
+	})
+}
+
+// Userinfo sets the username and password. An empty password leaves the
+// password out, and an empty username and password remove the userinfo.
+func (b Builder) Userinfo(username, password string) Builder {
+	return b.with(func(u *URL) error {
+		u.SetUserinfo(username, password)
+		return nil
+	})
+}
+
+// DelUserinfo removes the username and password.
+func (b Builder) DelUserinfo() Builder {
+	return b.with(func(u *URL) error {
+		u.DelUserinfo()
+		return nil
+	})
+}
+
+// Scheme sets the protocol (scheme).
+func (b Builder) Scheme(newProtocol string) Builder {
+	return b.with(func(u *URL) error {
+		u.SetProtocol(newProtocol)
+		return nil
+	})
+}
+
+// Normalize applies the normalization steps selected by opts.
+func (b Builder) Normalize(opts NormalizeOptions) Builder {
+	return b.with(func(u *URL) error {
+		u.Normalize(opts)
+		return nil
+	})
+}
+
+// BuildURL applies the edits to a copy of the source URL and returns it, or
+// the first error met.
+func (b Builder) BuildURL() (*URL, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	u := b.src.Clone()
+	for _, op := range b.ops {
+		if err := op(u); err != nil {
+			return nil, err
+		}
+	}
+	return u, nil
+}
+
+// Build applies the edits to a copy of the source URL and returns the new URL
+// string, or the first error met.
+func (b Builder) Build() (string, error) {
+	u, err := b.BuildURL()
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
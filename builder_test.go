@@ -0,0 +1,128 @@
+package gurl
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+	result, err := From("http://example.com/?a=0&b=1#?t=1&s=2").
+		Query("a", "1").
+		DelQuery("b").
+		Hash("t", "2").
+		DelHash("s").
+		Path("/x").
+		Hostname("newhost.com").
+		Scheme("https").
+		Build()
+	want := "https://newhost.com/x?a=1#?t=2"
+	if err != nil || result != want {
+		t.Errorf("Builder was incorrect, got: %s, want: %s.", result, want)
+	}
+}
+
+func TestBuilderError(t *testing.T) {
+	result, err := From("http://[::1").Query("a", "1").Build()
+	if err == nil || result != "" {
+		t.Errorf("Builder was incorrect, got: %s, want: error.", result)
+	}
+}
+
+func TestBuilderNilURL(t *testing.T) {
+	result, err := FromURL(nil).Query("a", "1").Build()
+	if err != ErrInvalidURL || result != "" {
+		t.Errorf("Builder was incorrect, got: %s, %v, want: %v.", result, err, ErrInvalidURL)
+	}
+}
+
+func TestBuilderImmutable(t *testing.T) {
+	src, _ := Parse("http://example.com/?a=0")
+	base := FromURL(src).Query("a", "1")
+	first, _ := base.Path("/first").Build()
+	second, _ := base.Host("other.com").Build()
+	if first != "http://example.com/first?a=1" {
+		t.Errorf("Builder was incorrect, got: %s, want: %s.", first, "http://example.com/first?a=1")
+	}
+	if second != "http://other.com/?a=1" {
+		t.Errorf("Builder was incorrect, got: %s, want: %s.", second, "http://other.com/?a=1")
+	}
+	if src.String() != "http://example.com/?a=0" {
+		t.Errorf("Builder modified its source, got: %s, want: %s.", src, "http://example.com/?a=0")
+	}
+}
+
+func TestBuilderIndexError(t *testing.T) {
+	_, err := From("http://example.com/?tag=a").AddQuery("tag", "b").QueryAt("tag", 2, "c").Build()
+	if err != ErrIndexOutOfRange {
+		t.Errorf("Builder was incorrect, got: %v, want: %v.", err, ErrIndexOutOfRange)
+	}
+}
+
+func TestBuilderHostnameAs(t *testing.T) {
+	result, err := From("http://example.com:8080/").HostnameAs("bücher.example", HostnameASCII).Build()
+	want := "http://xn--bcher-kva.example:8080/"
+	if err != nil || result != want {
+		t.Errorf("Builder was incorrect, got: %s, want: %s.", result, want)
+	}
+}
+
+func TestBuilderSubdomain(t *testing.T) {
+	result, err := From("https://a.b.example.co.uk/").Subdomain("www").Build()
+	want := "https://www.example.co.uk/"
+	if err != nil || result != want {
+		t.Errorf("Builder was incorrect, got: %s, want: %s.", result, want)
+	}
+	if _, err := From("https://co.uk/").Subdomain("www").Build(); err != ErrNoRegistrableDomain {
+		t.Errorf("Builder was incorrect, got: %v, want: %v.", err, ErrNoRegistrableDomain)
+	}
+}
+
+func TestBuilderPathSegments(t *testing.T) {
+	result, err := From("http://example.com/users/42/").
+		InsertPathSegment(0, "v2").
+		PathSegment(-1, "43").
+		AppendPath("posts").
+		DelPathSegment(1).
+		PopPath().
+		Build()
+	want := "http://example.com/v2/43"
+	if err != nil || result != want {
+		t.Errorf("Builder was incorrect, got: %s, want: %s.", result, want)
+	}
+}
+
+func TestBuilderRoute(t *testing.T) {
+	route := MustCompileRoute("/users/{id:int}/posts/:slug?")
+	result, err := From("http://example.com/old?a=1").Route(route, map[string]string{"id": "42"}).Build()
+	want := "http://example.com/users/42/posts?a=1"
+	if err != nil || result != want {
+		t.Errorf("Builder was incorrect, got: %s, want: %s.", result, want)
+	}
+	if _, err := From("http://example.com/").Route(route, nil).Build(); !errors.Is(err, ErrMissingRouteParam) {
+		t.Errorf("Builder was incorrect, got: %v, want: %v.", err, ErrMissingRouteParam)
+	}
+}
+
+func TestBuilderEncodeQuery(t *testing.T) {
+	params := struct {
+		Page int    `url:"page"`
+		Tab  string `url:"tab"`
+	}{Page: 2, Tab: "posts"}
+	result, err := From("http://example.com/?a=1#/users").EncodeQuery(params).EncodeHashQuery(params).Build()
+	want := "http://example.com/?a=1&page=2&tab=posts#/users?page=2&tab=posts"
+	if err != nil || result != want {
+		t.Errorf("Builder was incorrect, got: %s, want: %s.", result, want)
+	}
+}
+
+func TestBuilderNestedQuery(t *testing.T) {
+	result, err := From("http://example.com/?page=1").
+		NestedQuery("filter.status", []string{"open"}).
+		NestedQuery("filter.owner", "me").
+		Query("page", "2").
+		Build()
+	want := "http://example.com/?filter%5Bowner%5D=me&filter%5Bstatus%5D%5B%5D=open&page=2"
+	if err != nil || result != want {
+		t.Errorf("Builder was incorrect, got: %s, want: %s.", result, want)
+	}
+}
@@ -0,0 +1,179 @@
+package gurl
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrMissingCampaignField is returned when a required field of a Campaign is
+// empty. It is wrapped in a *CampaignError.
+var ErrMissingCampaignField = errors.New("gurl: missing campaign field")
+
+// CampaignError describes why a Campaign was rejected.
+type CampaignError struct {
+	// Param is the parameter of the field, such as "utm_source".
+	Param string
+	// Err is ErrMissingCampaignField.
+	Err error
+}
+
+func (e *CampaignError) Error() string {
+	return e.Err.Error() + " " + strconv.Quote(e.Param)
+}
+
+func (e *CampaignError) Unwrap() error {
+	return e.Err
+}
+
+// Campaign holds the UTM parameters that tag a link for campaign tracking in
+// Google Analytics and compatible tools. Source, Medium and Name are
+// required; the others are optional.
+type Campaign struct {
+	// Source is the referrer, such as "google" or "newsletter".
+	Source string `url:"utm_source,omitempty"`
+	// Medium is the marketing medium, such as "cpc" or "email".
+	Medium string `url:"utm_medium,omitempty"`
+	// Name is the campaign, such as "spring_sale".
+	Name string `url:"utm_campaign,omitempty"`
+	// Term is the paid search keyword.
+	Term string `url:"utm_term,omitempty"`
+	// Content tells apart links that point to the same URL, such as in A/B
+	// tests.
+	Content string `url:"utm_content,omitempty"`
+	// ID is the campaign ID, used to import campaign data.
+	ID string `url:"utm_id,omitempty"`
+	// SourcePlatform is the platform directing traffic, such as "dv360", a
+	// GA4 parameter.
+	SourcePlatform string `url:"utm_source_platform,omitempty"`
+	// CreativeFormat is the type of creative, such as "display" or "video",
+	// a GA4 parameter.
+	CreativeFormat string `url:"utm_creative_format,omitempty"`
+	// MarketingTactic is the targeting criteria, such as "remarketing" or
+	// "prospecting", a GA4 parameter.
+	MarketingTactic string `url:"utm_marketing_tactic,omitempty"`
+}
+
+// CampaignOptions configures ApplyCampaign.
+type CampaignOptions struct {
+	// Fragment puts the parameters in the fragment, as hash parameters, for
+	// landing pages that route on the hash. ApplyCampaign then leaves the
+	// query untouched.
+	Fragment bool
+}
+
+// fields returns pointers to the fields of c.
+func (c *Campaign) fields() []*string {
+	return []*string{&c.Source, &c.Medium, &c.Name, &c.Term, &c.Content, &c.ID, &c.SourcePlatform, &c.CreativeFormat, &c.MarketingTactic}
+}
+
+// Normalize returns c with every value trimmed and lowercased, and runs of
+// spaces replaced with "_", so that "Spring Sale" and "spring_sale" are
+// reported as the same campaign.
+func (c Campaign) Normalize() Campaign {
+	for _, field := range c.fields() {
+		*field = strings.Join(strings.Fields(strings.ToLower(*field)), "_")
+	}
+	return c
+}
+
+// Validate returns a *CampaignError wrapping ErrMissingCampaignField if
+// Source, Medium or Name is empty.
+func (c Campaign) Validate() error {
+	switch {
+	case strings.TrimSpace(c.Source) == "":
+		return &CampaignError{Param: "utm_source", Err: ErrMissingCampaignField}
+	case strings.TrimSpace(c.Medium) == "":
+		return &CampaignError{Param: "utm_medium", Err: ErrMissingCampaignField}
+	case strings.TrimSpace(c.Name) == "":
+		return &CampaignError{Param: "utm_campaign", Err: ErrMissingCampaignField}
+	}
+	return nil
+}
+
+// ApplyCampaign validates and normalizes c, and sets its parameters in the
+// query, or in the hash parameters if opts has Fragment set. The UTM
+// parameters of empty fields are deleted.
+func (u *URL) ApplyCampaign(c Campaign, opts ...CampaignOptions) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	if len(opts) > 0 && opts[0].Fragment {
+		return u.EncodeHashQuery(c.Normalize())
+	}
+	return u.EncodeQuery(c.Normalize())
+}
+
+// ExtractCampaign returns the UTM parameters of the query, or of the hash
+// parameters if the query has none. The values are returned as they are,
+// without normalization.
+func (u *URL) ExtractCampaign() Campaign {
+	var c Campaign
+	// Campaign only has string fields, which cannot fail to decode.
+	_ = u.DecodeQuery(&c)
+	if c == (Campaign{}) {
+		_ = u.DecodeHashQuery(&c)
+	}
+	return c
+}
+
+// ApplyCampaign tags a URL with the UTM parameters of a campaign, and
+// returns the new URL. The values are normalized: lowercased, with spaces
+// replaced with "_".
+//
+// Parameters:
+//
+//	u: The URL to tag.
+//	c: The campaign. Source, Medium and Name are required.
+//	opts: Optional options, to put the parameters in the fragment.
+//
+// Returns:
+//
+//	A string containing the new URL, and an error if any occurred. A
+//	missing required field is reported as a *CampaignError wrapping
+//	ErrMissingCampaignField.
+//
+// Example:
+//
+//	result, err := ApplyCampaign("https://example.com/pricing", Campaign{Source: "Newsletter", Medium: "email", Name: "Spring Sale"})
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "https://example.com/pricing?utm_campaign=spring_sale&utm_medium=email&utm_source=newsletter"
+func ApplyCampaign(u string, c Campaign, opts ...CampaignOptions) (string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", err
+	}
+	if err := parsedURL.ApplyCampaign(c, opts...); err != nil {
+		return "", err
+	}
+	return parsedURL.String(), nil
+}
+
+// ExtractCampaign reads the UTM parameters of a URL, from its query, or from
+// its hash parameters if the query has none.
+//
+// Parameters:
+//
+//	u: The URL to read.
+//
+// Returns:
+//
+//	The campaign, empty if the URL has no UTM parameters, and an error if
+//	any occurred.
+//
+// Example:
+//
+//	c, err := ExtractCampaign("https://example.com/?utm_source=google&utm_medium=cpc&utm_campaign=brand&utm_term=shoes")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(c.Source, c.Medium, c.Name, c.Term) // Output: google cpc brand shoes
+func ExtractCampaign(u string) (Campaign, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return Campaign{}, err
+	}
+	return parsedURL.ExtractCampaign(), nil
+}
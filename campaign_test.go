@@ -0,0 +1,72 @@
+package gurl
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestApplyCampaign(t *testing.T) {
+	spring := Campaign{Source: "Newsletter", Medium: "email", Name: "  Spring  Sale ", Content: "Hero Banner"}
+	tests := []struct {
+		url    string
+		c      Campaign
+		opts   CampaignOptions
+		result string
+	}{
+		{"https://example.com/pricing", spring, CampaignOptions{}, "https://example.com/pricing?utm_campaign=spring_sale&utm_content=hero_banner&utm_medium=email&utm_source=newsletter"},
+		{"https://example.com/?plan=pro&utm_term=old", spring, CampaignOptions{}, "https://example.com/?plan=pro&utm_campaign=spring_sale&utm_content=hero_banner&utm_medium=email&utm_source=newsletter"},
+		{"https://example.com/app?plan=pro#/signup", spring, CampaignOptions{Fragment: true}, "https://example.com/app?plan=pro#/signup?utm_source=newsletter&utm_medium=email&utm_campaign=spring_sale&utm_content=hero_banner"},
+		{"https://example.com/", Campaign{Source: "dv360", Medium: "display", Name: "q3", SourcePlatform: "DV360", CreativeFormat: "Video", MarketingTactic: "Remarketing", ID: "abc.123"}, CampaignOptions{}, "https://example.com/?utm_campaign=q3&utm_creative_format=video&utm_id=abc.123&utm_marketing_tactic=remarketing&utm_medium=display&utm_source=dv360&utm_source_platform=dv360"},
+	}
+	for _, test := range tests {
+		result, err := ApplyCampaign(test.url, test.c, test.opts)
+		if err != nil || result != test.result {
+			t.Errorf("ApplyCampaign(%q) was incorrect, got: %s, %v, want: %s.", test.url, result, err, test.result)
+		}
+	}
+}
+
+func TestApplyCampaignError(t *testing.T) {
+	tests := []struct {
+		c     Campaign
+		param string
+	}{
+		{Campaign{Medium: "email", Name: "spring"}, "utm_source"},
+		{Campaign{Source: "newsletter", Medium: " ", Name: "spring"}, "utm_medium"},
+		{Campaign{Source: "newsletter", Medium: "email", ID: "42"}, "utm_campaign"},
+	}
+	for _, test := range tests {
+		_, err := ApplyCampaign("https://example.com/", test.c)
+		var campaignErr *CampaignError
+		if !errors.Is(err, ErrMissingCampaignField) || !errors.As(err, &campaignErr) || campaignErr.Param != test.param {
+			t.Errorf("ApplyCampaign(%+v) was incorrect, got: %v, want: an error about %s.", test.c, err, test.param)
+		}
+	}
+}
+
+func TestExtractCampaign(t *testing.T) {
+	tests := []struct {
+		url    string
+		result Campaign
+	}{
+		{"https://example.com/?utm_source=Google&utm_medium=cpc&utm_campaign=brand&utm_term=running+shoes&x=1", Campaign{Source: "Google", Medium: "cpc", Name: "brand", Term: "running shoes"}},
+		{"https://example.com/#/signup?utm_source=newsletter&utm_medium=email&utm_campaign=spring&utm_id=42", Campaign{Source: "newsletter", Medium: "email", Name: "spring", ID: "42"}},
+		{"https://example.com/?utm_source=a#?utm_source=b", Campaign{Source: "a"}},
+		{"https://example.com/?x=1", Campaign{}},
+	}
+	for _, test := range tests {
+		result, err := ExtractCampaign(test.url)
+		if err != nil || result != test.result {
+			t.Errorf("ExtractCampaign(%q) was incorrect, got: %+v, want: %+v.", test.url, result, test.result)
+		}
+	}
+}
+
+func TestCampaignBuilder(t *testing.T) {
+	result, err := From("https://example.com/?utm_source=x#/home").
+		Campaign(Campaign{Source: "ads", Medium: "cpc", Name: "brand"}, CampaignOptions{Fragment: true}).
+		Build()
+	if want := "https://example.com/?utm_source=x#/home?utm_source=ads&utm_medium=cpc&utm_campaign=brand"; err != nil || result != want {
+		t.Errorf("Builder.Campaign was incorrect, got: %s, %v, want: %s.", result, err, want)
+	}
+}
@@ -0,0 +1,251 @@
+package gurl
+
+import (
+	"net/url"
+	"path"
+	"strings"
+)
+
+// Encoding selects how hash parameter keys and values are escaped.
+type Encoding int
+
+const (
+	// EncodingForm follows the rules of url.Values: a space is written as
+	// "+", and a "+" is read as a space.
+	EncodingForm Encoding = iota
+	// EncodingRFC3986 writes a space as "%20" and reads "+" literally.
+	EncodingRFC3986
+)
+
+// escape escapes s so that it can be used as a hash parameter key or value.
+// Only the unreserved characters of RFC 3986 are left as they are.
+func (e Encoding) escape(s string) string {
+	escaped := url.QueryEscape(s)
+	if e == EncodingRFC3986 {
+		// QueryEscape writes a literal "+" as "%2B", so every "+" left is a space.
+		escaped = strings.ReplaceAll(escaped, "+", "%20")
+	}
+	return escaped
+}
+
+// unescape decodes s, or returns it unchanged if it is not validly escaped.
+func (e Encoding) unescape(s string) string {
+	var unescaped string
+	var err error
+	if e == EncodingRFC3986 {
+		unescaped, err = url.PathUnescape(s)
+	} else {
+		unescaped, err = url.QueryUnescape(s)
+	}
+	if err != nil {
+		return s
+	}
+	return unescaped
+}
+
+// Fragment is a URL fragment split the way single-page applications use it
+// for hash routing: an optional hashbang, a route path and ordered params.
+//
+// For example, "!/users/42?tab=posts&page=2" has Hashbang set, the Path
+// "/users/42" and the params tab=posts and page=2.
+type Fragment struct {
+	// Hashbang reports whether the fragment starts with "!", as in "#!/path".
+	Hashbang bool
+	// Path is the route, everything before the first "?". It is kept escaped,
+	// as it appears in the URL.
+	Path string
+	// Params are the parameters after the first "?", in order.
+	Params []FragmentParam
+	// Encoding is used to escape and unescape the keys and values of Params.
+	Encoding Encoding
+}
+
+// FragmentParam is one "key=value" parameter of a Fragment. Key and Value are
+// unescaped.
+type FragmentParam struct {
+	Key   string
+	Value string
+
+	// raw holds the original text of a parsed param, so that a param that is
+	// not changed is written back exactly as it was read.
+	raw    string
+	hasRaw bool
+}
+
+// ParseFragment parses an escaped fragment, without its leading "#", into a
+// Fragment. Params are unescaped with EncodingForm.
+//
+// Parameters:
+//
+//	fragment: The fragment to parse.
+//
+// Returns:
+//
+//	A Fragment.
+//
+// Example:
+//
+//	fra := ParseFragment("!/users/42?tab=posts")
+//	fmt.Println(fra.Hashbang, fra.Path, fra.Get("tab")) // Output: true /users/42 posts
+func ParseFragment(fragment string) Fragment {
+	return ParseFragmentEncoding(fragment, EncodingForm)
+}
+
+// ParseFragmentEncoding is like ParseFragment, but unescapes Params with enc.
+func ParseFragmentEncoding(fragment string, enc Encoding) Fragment {
+	fra := Fragment{Encoding: enc}
+	if strings.HasPrefix(fragment, "!") {
+		fra.Hashbang = true
+		fragment = fragment[1:]
+	}
+	fraPath, fraQuery := parseFragment(fragment)
+	fra.Path = fraPath
+	if fraQuery != "" {
+		for _, p := range strings.Split(fraQuery, "&") {
+			fra.Params = append(fra.Params, parseFragmentParam(p, enc))
+		}
+	}
+	return fra
+}
+
+func parseFragmentParam(raw string, enc Encoding) FragmentParam {
+	key, value, _ := strings.Cut(raw, "=")
+	return FragmentParam{Key: enc.unescape(key), Value: enc.unescape(value), raw: raw, hasRaw: true}
+}
+
+// format returns the escaped "key=value" text of p. A param read by
+// ParseFragment and not changed since is returned as it was read.
+func (p FragmentParam) format(enc Encoding) string {
+	if p.hasRaw {
+		if parsed := parseFragmentParam(p.raw, enc); parsed.Key == p.Key && parsed.Value == p.Value {
+			return p.raw
+		}
+	}
+	return enc.escape(p.Key) + "=" + enc.escape(p.Value)
+}
+
+// String reassembles the escaped fragment, without its leading "#".
+func (f *Fragment) String() string {
+	var b strings.Builder
+	if f.Hashbang {
+		b.WriteString("!")
+	}
+	b.WriteString(f.Path)
+	if len(f.Params) > 0 {
+		b.WriteString("?")
+		for i, p := range f.Params {
+			if i > 0 {
+				b.WriteString("&")
+			}
+			b.WriteString(p.format(f.Encoding))
+		}
+	}
+	return b.String()
+}
+
+// clone returns a copy of f that does not share its Params.
+func (f *Fragment) clone() Fragment {
+	fra := *f
+	fra.Params = append([]FragmentParam(nil), f.Params...)
+	return fra
+}
+
+// Get returns the first value of the param.
+func (f *Fragment) Get(param string) string {
+	for _, p := range f.Params {
+		if p.Key == param {
+			return p.Value
+		}
+	}
+	return ""
+}
+
+// GetAll returns all values of the param, in order.
+func (f *Fragment) GetAll(param string) []string {
+	var values []string
+	for _, p := range f.Params {
+		if p.Key == param {
+			values = append(values, p.Value)
+		}
+	}
+	return values
+}
+
+// Set replaces the first value of the param where it sits and drops its
+// other values. A missing param is appended.
+func (f *Fragment) Set(param, value string) {
+	var newParams []FragmentParam
+	paramExists := false
+	for _, p := range f.Params {
+		if p.Key != param {
+			newParams = append(newParams, p)
+		} else if !paramExists {
+			p.Value = value
+			newParams = append(newParams, p)
+			paramExists = true
+		}
+	}
+	if !paramExists {
+		newParams = append(newParams, FragmentParam{Key: param, Value: value})
+	}
+	f.Params = newParams
+}
+
+// Add appends a value to the param, keeping its existing values.
+func (f *Fragment) Add(param, value string) {
+	f.Params = append(f.Params, FragmentParam{Key: param, Value: value})
+}
+
+// Del deletes the param.
+func (f *Fragment) Del(param string) {
+	f.filter(func(p FragmentParam) bool {
+		return p.Key != param
+	})
+}
+
+// DelValue removes every occurrence of value from the param.
+func (f *Fragment) DelValue(param, value string) {
+	f.filter(func(p FragmentParam) bool {
+		return p.Key != param || p.Value != value
+	})
+}
+
+func (f *Fragment) filter(keep func(FragmentParam) bool) {
+	var newParams []FragmentParam
+	for _, p := range f.Params {
+		if keep(p) {
+			newParams = append(newParams, p)
+		}
+	}
+	f.Params = newParams
+}
+
+// SetAt replaces the i-th value of the param. It returns ErrIndexOutOfRange
+// if the param has no i-th value.
+func (f *Fragment) SetAt(param string, i int, value string) error {
+	n := 0
+	for j, p := range f.Params {
+		if p.Key != param {
+			continue
+		}
+		if n == i {
+			f.Params[j].Value = value
+			return nil
+		}
+		n++
+	}
+	return ErrIndexOutOfRange
+}
+
+// JoinPath joins the elements onto the route path, cleaning "." and ".."
+// segments. A trailing slash on the last element is kept.
+func (f *Fragment) JoinPath(elem ...string) {
+	if len(elem) == 0 {
+		return
+	}
+	joined := path.Join(append([]string{f.Path}, elem...)...)
+	if strings.HasSuffix(elem[len(elem)-1], "/") && !strings.HasSuffix(joined, "/") {
+		joined += "/"
+	}
+	f.Path = joined
+}
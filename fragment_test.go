@@ -0,0 +1,79 @@
+package gurl
+
+import (
+	"testing"
+)
+
+func TestParseFragment(t *testing.T) {
+	type FragmentTest struct {
+		fragment string
+		hashbang bool
+		path     string
+		params   int
+	}
+	tests := []FragmentTest{
+		{"", false, "", 0},
+		{"path", false, "path", 0},
+		{"?t1=1&t2=2", false, "", 2},
+		{"/users/42?tab=posts", false, "/users/42", 1},
+		{"!/users/42?tab=posts&x", true, "/users/42", 2},
+		{"p3=3&p4=4", false, "p3=3&p4=4", 0},
+	}
+	for _, test := range tests {
+		fra := ParseFragment(test.fragment)
+		if fra.Hashbang != test.hashbang || fra.Path != test.path || len(fra.Params) != test.params {
+			t.Errorf("ParseFragment was incorrect, got: %+v, for: %s.", fra, test.fragment)
+		}
+		if result := fra.String(); result != test.fragment {
+			t.Errorf("Fragment.String was incorrect, got: %s, want: %s.", result, test.fragment)
+		}
+	}
+}
+
+func TestFragmentEdit(t *testing.T) {
+	fra := ParseFragment("!/users?ssss&tab=posts&tag=a")
+	fra.Set("tab", "likes")
+	fra.Add("tag", "b")
+	fra.Del("ssss")
+	fra.JoinPath("42", "edit/")
+	want := "!/users/42/edit/?tab=likes&tag=a&tag=b"
+	if result := fra.String(); result != want {
+		t.Errorf("Fragment was incorrect, got: %s, want: %s.", result, want)
+	}
+	fra = Fragment{Path: "/home"}
+	fra.Set("a", "1")
+	if result := fra.String(); result != "/home?a=1" {
+		t.Errorf("Fragment was incorrect, got: %s, want: %s.", result, "/home?a=1")
+	}
+}
+
+func TestFragmentEncoding(t *testing.T) {
+	type EncodingTest struct {
+		encoding Encoding
+		value    string
+		result   string
+	}
+	tests := []EncodingTest{
+		{EncodingForm, "a b", "?q=a+b"},
+		{EncodingRFC3986, "a b", "?q=a%20b"},
+		{EncodingForm, "a&b=c#d+e", "?q=a%26b%3Dc%23d%2Be"},
+		{EncodingRFC3986, "a&b=c#d+e", "?q=a%26b%3Dc%23d%2Be"},
+		{EncodingForm, "中", "?q=%E4%B8%AD"},
+	}
+	for _, test := range tests {
+		fra := Fragment{Encoding: test.encoding}
+		fra.Set("q", test.value)
+		result := fra.String()
+		if result != test.result {
+			t.Errorf("Fragment.String was incorrect, got: %s, want: %s.", result, test.result)
+		}
+		parsed := ParseFragmentEncoding(result, test.encoding)
+		if value := parsed.Get("q"); value != test.value {
+			t.Errorf("Fragment.Get was incorrect, got: %s, want: %s.", value, test.value)
+		}
+	}
+	fra := ParseFragmentEncoding("?q=a+b", EncodingRFC3986)
+	if value := fra.Get("q"); value != "a+b" {
+		t.Errorf("Fragment.Get was incorrect, got: %s, want: %s.", value, "a+b")
+	}
+}
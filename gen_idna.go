@@ -0,0 +1,303 @@
+//go:build ignore
+
+// This program generates idna_tables.go from the Unicode Character Database.
+//
+// Usage:
+//
+//	go run gen_idna.go -ucd /path/to/ucd -version 17.0.0
+//
+// The directory must hold IdnaMappingTable.txt, UnicodeData.txt,
+// DerivedNormalizationProps.txt and DerivedJoiningType.txt, in the formats
+// published at https://www.unicode.org/Public/.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	ucdDir  = flag.String("ucd", "ucd", "directory holding the Unicode data files")
+	version = flag.String("version", "17.0.0", "Unicode version of the data files")
+	output  = flag.String("output", "idna_tables.go", "file to write")
+)
+
+type mapping struct {
+	lo, hi  rune
+	status  string
+	mapping string
+}
+
+type valueRange struct {
+	lo, hi rune
+	value  string
+}
+
+// bidiClasses are the bidi classes the Bidi Rule tells apart. Every other
+// class is written as bidiOther; bidiL is the default and is not written.
+var bidiClasses = map[string]string{
+	"R": "bidiR", "AL": "bidiAL", "AN": "bidiAN", "EN": "bidiEN",
+	"ES": "bidiES", "CS": "bidiCS", "ET": "bidiET", "ON": "bidiON",
+	"BN": "bidiBN", "NSM": "bidiNSM",
+}
+
+var joiningTypes = map[string]string{
+	"L": "joiningL", "D": "joiningD", "T": "joiningT", "R": "joiningR",
+}
+
+var statuses = map[string]string{
+	"valid":                  "idnaValid",
+	"ignored":                "idnaIgnored",
+	"mapped":                 "idnaMapped",
+	"deviation":              "idnaDeviation",
+	"disallowed":             "idnaDisallowed",
+	"disallowed_STD3_valid":  "idnaValid",
+	"disallowed_STD3_mapped": "idnaMapped",
+}
+
+func main() {
+	flag.Parse()
+
+	mappings := readMappings()
+	ccc, bidi, marks, decomps := readUnicodeData()
+	exclusions := readExclusions()
+	joining := readJoiningTypes()
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen_idna.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package gurl\n\n")
+	fmt.Fprintf(&buf, "// idnaUnicodeVersion is the Unicode version the tables were generated from.\n")
+	fmt.Fprintf(&buf, "const idnaUnicodeVersion = %q\n\n", *version)
+
+	fmt.Fprintf(&buf, "var idnaMappingTable = []idnaMapping{\n")
+	for _, m := range mappings {
+		fmt.Fprintf(&buf, "\t{0x%04X, 0x%04X, %s, %+q},\n", m.lo, m.hi, m.status, m.mapping)
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	writeRanges(&buf, "cccTable", ccc)
+	writeRanges(&buf, "bidiTable", bidi)
+	writeRanges(&buf, "joiningTable", joining)
+	writeRanges(&buf, "markTable", marks)
+
+	// Decompositions are written fully expanded, so decomposing a rune is a
+	// single lookup.
+	var runes []rune
+	for r := range decomps {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+	fmt.Fprintf(&buf, "var decompositionTable = []decomposition{\n")
+	for _, r := range runes {
+		fmt.Fprintf(&buf, "\t{0x%04X, %+q},\n", r, string(expand(r, decomps)))
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	// Only two-rune decompositions of runes that are not excluded are
+	// recomposed.
+	var pairs []composition
+	for _, r := range runes {
+		d := decomps[r]
+		if len(d) != 2 || exclusions[r] {
+			continue
+		}
+		pairs = append(pairs, composition{d[0], d[1], r})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].a != pairs[j].a {
+			return pairs[i].a < pairs[j].a
+		}
+		return pairs[i].b < pairs[j].b
+	})
+	fmt.Fprintf(&buf, "var compositionTable = []composition{\n")
+	for _, p := range pairs {
+		fmt.Fprintf(&buf, "\t{0x%04X, 0x%04X, 0x%04X},\n", p.a, p.b, p.c)
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type composition struct {
+	a, b, c rune
+}
+
+func expand(r rune, decomps map[rune][]rune) []rune {
+	d, ok := decomps[r]
+	if !ok {
+		return []rune{r}
+	}
+	var out []rune
+	for _, c := range d {
+		out = append(out, expand(c, decomps)...)
+	}
+	return out
+}
+
+func writeRanges(buf *bytes.Buffer, name string, ranges []valueRange) {
+	fmt.Fprintf(buf, "var %s = []runeRange{\n", name)
+	for _, r := range ranges {
+		fmt.Fprintf(buf, "\t{0x%04X, 0x%04X, %s},\n", r.lo, r.hi, r.value)
+	}
+	fmt.Fprintf(buf, "}\n\n")
+}
+
+// addRange appends the range lo..hi with value to ranges, merging it into
+// the last range when they touch and share the value.
+func addRange(ranges []valueRange, lo, hi rune, value string) []valueRange {
+	if n := len(ranges); n > 0 && ranges[n-1].hi+1 == lo && ranges[n-1].value == value {
+		ranges[n-1].hi = hi
+		return ranges
+	}
+	return append(ranges, valueRange{lo, hi, value})
+}
+
+// eachLine calls f with the fields of every data line in the file name.
+func eachLine(name string, f func(fields []string)) {
+	file, err := os.Open(filepath.Join(*ucdDir, name))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		f(fields)
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseRune(s string) rune {
+	r, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(r)
+}
+
+func parseRange(s string) (rune, rune) {
+	lo, hi, ok := strings.Cut(s, "..")
+	if !ok {
+		r := parseRune(s)
+		return r, r
+	}
+	return parseRune(lo), parseRune(hi)
+}
+
+func parseRunes(s string) []rune {
+	var runes []rune
+	for _, f := range strings.Fields(s) {
+		runes = append(runes, parseRune(f))
+	}
+	return runes
+}
+
+func readMappings() []mapping {
+	var mappings []mapping
+	eachLine("IdnaMappingTable.txt", func(fields []string) {
+		lo, hi := parseRange(fields[0])
+		status, ok := statuses[fields[1]]
+		if !ok {
+			log.Fatalf("unknown IDNA status %q", fields[1])
+		}
+		m := mapping{lo: lo, hi: hi, status: status}
+		if len(fields) > 2 && (status == "idnaMapped" || status == "idnaDeviation") {
+			m.mapping = string(parseRunes(fields[2]))
+		}
+		if n := len(mappings); n > 0 && m.mapping == "" && m.status != "idnaMapped" &&
+			mappings[n-1].hi+1 == m.lo && mappings[n-1].status == m.status && mappings[n-1].mapping == "" {
+			mappings[n-1].hi = m.hi
+			return
+		}
+		mappings = append(mappings, m)
+	})
+	return mappings
+}
+
+func readUnicodeData() (ccc, bidi, marks []valueRange, decomps map[rune][]rune) {
+	decomps = make(map[rune][]rune)
+	var first rune
+	eachLine("UnicodeData.txt", func(fields []string) {
+		lo := parseRune(fields[0])
+		hi := lo
+		switch {
+		case strings.HasSuffix(fields[1], ", First>"):
+			first = lo
+			return
+		case strings.HasSuffix(fields[1], ", Last>"):
+			lo = first
+		}
+
+		if fields[3] != "0" {
+			ccc = addRange(ccc, lo, hi, fields[3])
+		}
+		if class, ok := bidiClasses[fields[4]]; ok {
+			bidi = addRange(bidi, lo, hi, class)
+		} else if fields[4] != "L" {
+			bidi = addRange(bidi, lo, hi, "bidiOther")
+		}
+		if strings.HasPrefix(fields[2], "M") {
+			marks = addRange(marks, lo, hi, "1")
+		}
+		if d := fields[5]; d != "" && !strings.HasPrefix(d, "<") {
+			decomps[lo] = parseRunes(d)
+		}
+	})
+	return ccc, bidi, marks, decomps
+}
+
+func readExclusions() map[rune]bool {
+	exclusions := make(map[rune]bool)
+	eachLine("DerivedNormalizationProps.txt", func(fields []string) {
+		if fields[1] != "Full_Composition_Exclusion" {
+			return
+		}
+		lo, hi := parseRange(fields[0])
+		for r := lo; r <= hi; r++ {
+			exclusions[r] = true
+		}
+	})
+	return exclusions
+}
+
+func readJoiningTypes() []valueRange {
+	var ranges []valueRange
+	eachLine("DerivedJoiningType.txt", func(fields []string) {
+		value, ok := joiningTypes[fields[1]]
+		if !ok {
+			return
+		}
+		lo, hi := parseRange(fields[0])
+		ranges = addRange(ranges, lo, hi, value)
+	})
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].lo < ranges[j].lo })
+	return ranges
+}
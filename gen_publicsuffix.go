@@ -0,0 +1,85 @@
+//go:build ignore
+
+// This program refreshes publicsuffix.dat, the snapshot of the Public Suffix
+// List embedded in the package, from a local copy of the list.
+//
+// Usage:
+//
+//	go run gen_publicsuffix.go -input /path/to/public_suffix_list.dat
+//
+// The list is published at https://publicsuffix.org/list/public_suffix_list.dat.
+// The license header, the section markers and the rules are kept; other
+// comments and blank lines are dropped.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+var (
+	input  = flag.String("input", "public_suffix_list.dat", "the Public Suffix List file to read")
+	output = flag.String("output", "publicsuffix.dat", "file to write")
+)
+
+var markers = []string{
+	"// ===BEGIN ICANN DOMAINS===",
+	"// ===END ICANN DOMAINS===",
+	"// ===BEGIN PRIVATE DOMAINS===",
+	"// ===END PRIVATE DOMAINS===",
+}
+
+func main() {
+	flag.Parse()
+
+	file, err := os.Open(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	var buf bytes.Buffer
+	header := true
+	next, rules := 0, 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case header && strings.HasPrefix(line, "//") && !strings.HasPrefix(line, "// ==="):
+			buf.WriteString(line + "\n")
+			continue
+		case header:
+			header = false
+			buf.WriteString("\n")
+		}
+
+		switch {
+		case next < len(markers) && line == markers[next]:
+			buf.WriteString(line + "\n")
+			next++
+		case line == "" || strings.HasPrefix(line, "//"):
+		case next != 1 && next != 3:
+			log.Fatalf("rule %q is outside the ICANN and private sections", line)
+		default:
+			// Rules end at the first whitespace.
+			buf.WriteString(strings.Fields(line)[0] + "\n")
+			rules++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	if next != len(markers) {
+		log.Fatalf("missing section marker %q", markers[next])
+	}
+
+	if err := os.WriteFile(*output, buf.Bytes(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("wrote %d rules to %s\n", rules, *output)
+}
@@ -0,0 +1,635 @@
+package gurl
+
+//go:generate go run gen_idna.go -ucd ucd -version 17.0.0
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrUnicodeHostnameForm is returned when a hostname is set with
+// HostnameUnicode, since net/url percent-encodes a Unicode host when it
+// serializes the URL.
+var ErrUnicodeHostnameForm = errors.New("gurl: a Unicode hostname cannot be set in a URL")
+
+// IDNAError is returned when a domain name fails UTS #46 processing.
+// It wraps ErrInvalidHostname.
+type IDNAError struct {
+	// Code names the UTS #46 step or rule that failed, in the form used by
+	// the Unicode IDNA test data, such as "V6" or "B1".
+	Code string
+	// Label is the label that failed.
+	Label string
+}
+
+func (e *IDNAError) Error() string {
+	return fmt.Sprintf("gurl: invalid domain label %q (%s)", e.Label, e.Code)
+}
+
+func (e *IDNAError) Unwrap() error {
+	return ErrInvalidHostname
+}
+
+// IDNAProfile selects the UTS #46 processing options of ToASCII and
+// ToUnicode. The zero value applies only the checks that cannot be turned
+// off.
+type IDNAProfile struct {
+	// Transitional maps deviation characters, such as "ß" to "ss", in
+	// ToASCII. ToUnicode never does.
+	Transitional bool
+	// CheckHyphens rejects labels that start or end with a hyphen, or that
+	// have hyphens in the third and fourth positions.
+	CheckHyphens bool
+	// CheckBidi applies the Bidi Rule of RFC 5893 to right-to-left domain
+	// names.
+	CheckBidi bool
+	// CheckJoiners applies the ContextJ rules of RFC 5892 to zero width
+	// joiners and non-joiners.
+	CheckJoiners bool
+	// UseSTD3ASCIIRules only allows the ASCII letters, digits and hyphen.
+	UseSTD3ASCIIRules bool
+	// VerifyDNSLength rejects empty labels, including a trailing dot, labels
+	// longer than 63 bytes and names longer than 253 bytes in ToASCII.
+	VerifyDNSLength bool
+}
+
+var (
+	// IDNALookup is the profile used by browsers to look up host names, as
+	// defined by the WHATWG URL Standard. It is used by ToASCII, ToUnicode
+	// and the hostname forms.
+	IDNALookup = IDNAProfile{CheckBidi: true, CheckJoiners: true}
+	// IDNAStrict turns on every check and only accepts names that can be
+	// registered in DNS.
+	IDNAStrict = IDNAProfile{
+		CheckHyphens:      true,
+		CheckBidi:         true,
+		CheckJoiners:      true,
+		UseSTD3ASCIIRules: true,
+		VerifyDNSLength:   true,
+	}
+)
+
+// ToASCII converts a domain name to its ASCII form, encoding Unicode labels
+// with Punycode, using the IDNALookup profile.
+//
+// Parameters:
+//
+//	domain: The domain name to convert.
+//
+// Returns:
+//
+//	A string containing the ASCII domain name, and an error if the domain
+//	name is not valid.
+//
+// Example:
+//
+//	result, err := ToASCII("München.de")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "xn--mnchen-3ya.de"
+func ToASCII(domain string) (string, error) {
+	return IDNALookup.ToASCII(domain)
+}
+
+// ToUnicode converts a domain name to its Unicode form, decoding Punycode
+// labels, using the IDNALookup profile.
+//
+// Parameters:
+//
+//	domain: The domain name to convert.
+//
+// Returns:
+//
+//	A string containing the Unicode domain name, and an error if the domain
+//	name is not valid. The converted name is returned even with an error.
+//
+// Example:
+//
+//	result, err := ToUnicode("xn--mnchen-3ya.de")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "münchen.de"
+func ToUnicode(domain string) (string, error) {
+	return IDNALookup.ToUnicode(domain)
+}
+
+// HostnameForm selects the form a hostname is converted to by GetHostname
+// and SetHostname. SetHostname only accepts HostnameAsIs and HostnameASCII.
+type HostnameForm int
+
+const (
+	// HostnameAsIs leaves the hostname unchanged.
+	HostnameAsIs HostnameForm = iota
+	// HostnameASCII converts the hostname with ToASCII, as in
+	// "xn--mnchen-3ya.de".
+	HostnameASCII
+	// HostnameUnicode converts the hostname with ToUnicode, as in
+	// "münchen.de". It is only for reading a hostname: a URL string would
+	// hold it percent-encoded, so SetHostname returns ErrUnicodeHostnameForm.
+	HostnameUnicode
+)
+
+// convertHostname converts hostname to form. IP addresses and empty
+// hostnames are not converted.
+func convertHostname(hostname string, form HostnameForm) (string, error) {
+	if hostname == "" || isIPLiteral(hostname) {
+		return hostname, nil
+	}
+	switch form {
+	case HostnameASCII:
+		return ToASCII(hostname)
+	case HostnameUnicode:
+		return ToUnicode(hostname)
+	}
+	return hostname, nil
+}
+
+// ToASCII converts a domain name to its ASCII form with the profile p.
+func (p IDNAProfile) ToASCII(domain string) (string, error) {
+	labels, err := p.process(domain, p.Transitional)
+	for i, label := range labels {
+		if isASCII(label) {
+			continue
+		}
+		encoded, perr := EncodePunycode(label)
+		if perr != nil {
+			if err == nil {
+				err = &IDNAError{Code: "A3", Label: label}
+			}
+			continue
+		}
+		labels[i] = "xn--" + encoded
+	}
+	if p.VerifyDNSLength && err == nil {
+		err = verifyDNSLength(labels)
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(labels, "."), nil
+}
+
+// ToUnicode converts a domain name to its Unicode form with the profile p.
+// The converted name is returned even with an error.
+func (p IDNAProfile) ToUnicode(domain string) (string, error) {
+	labels, err := p.process(domain, false)
+	if err != nil {
+		return strings.Join(labels, "."), err
+	}
+	return strings.Join(labels, "."), nil
+}
+
+// process maps, normalizes and validates domain, and returns its labels
+// with Punycode labels decoded, along with the first error found.
+func (p IDNAProfile) process(domain string, transitional bool) ([]string, error) {
+	var err *IDNAError
+	fail := func(code, label string) {
+		if err == nil {
+			err = &IDNAError{Code: code, Label: label}
+		}
+	}
+
+	var b strings.Builder
+	for _, r := range domain {
+		m := lookupIDNA(r)
+		switch {
+		case m.status == idnaIgnored:
+		case m.status == idnaMapped && transitional:
+			// A mapping can produce a deviation, such as "ẞ" to "ß",
+			// which transitional processing maps again.
+			for _, r := range m.mapping {
+				if d := lookupIDNA(r); d.status == idnaDeviation {
+					b.WriteString(d.mapping)
+				} else {
+					b.WriteRune(r)
+				}
+			}
+		case m.status == idnaMapped, m.status == idnaDeviation && transitional:
+			b.WriteString(m.mapping)
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	labels := strings.Split(nfc(b.String()), ".")
+	for i, label := range labels {
+		labelTransitional := transitional
+		if strings.HasPrefix(label, "xn--") {
+			if !isASCII(label) {
+				fail("P4", label)
+				continue
+			}
+			decoded, perr := DecodePunycode(label[4:])
+			if perr != nil {
+				fail("P4", label)
+				continue
+			}
+			if decoded == "" || isASCII(decoded) {
+				fail("P4", label)
+			}
+			labels[i] = decoded
+			labelTransitional = false
+		}
+		if code := p.validateLabel(labels[i], labelTransitional); code != "" {
+			fail(code, labels[i])
+		}
+	}
+
+	if p.CheckBidi && isBidiDomain(labels) {
+		for _, label := range labels {
+			if label == "" {
+				continue
+			}
+			if code := checkBidiLabel(label); code != "" {
+				fail(code, label)
+			}
+		}
+	}
+
+	if err != nil {
+		return labels, err
+	}
+	return labels, nil
+}
+
+// validateLabel checks label against the validity criteria of UTS #46,
+// section 4.1, and returns the code of the first one that fails, or "".
+func (p IDNAProfile) validateLabel(label string, transitional bool) string {
+	if label == "" {
+		return ""
+	}
+	if nfc(label) != label {
+		return "V1"
+	}
+	if p.CheckHyphens {
+		if len(label) >= 4 && label[2:4] == "--" {
+			return "V2"
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return "V3"
+		}
+	} else if strings.HasPrefix(label, "xn--") {
+		return "V4"
+	}
+	if strings.ContainsRune(label, '.') {
+		return "V5"
+	}
+	first, _ := utf8.DecodeRuneInString(label)
+	if lookupRange(markTable, first) != 0 {
+		return "V6"
+	}
+
+	runes := []rune(label)
+	for _, r := range runes {
+		switch lookupIDNA(r).status {
+		case idnaValid:
+		case idnaDeviation:
+			if transitional {
+				return "V7"
+			}
+		default:
+			return "V7"
+		}
+		if p.UseSTD3ASCIIRules && r < utf8.RuneSelf && !isLDH(r) {
+			return "U1"
+		}
+	}
+	if p.CheckJoiners {
+		return checkJoiners(runes)
+	}
+	return ""
+}
+
+// isLDH reports whether r is a lowercase ASCII letter, a digit or a hyphen.
+func isLDH(r rune) bool {
+	return 'a' <= r && r <= 'z' || '0' <= r && r <= '9' || r == '-'
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// verifyDNSLength checks the label and name lengths of the ASCII labels of a
+// domain name. Like every other label, a trailing root label must not be
+// empty.
+func verifyDNSLength(labels []string) error {
+	total := len(labels) - 1
+	for _, label := range labels {
+		if label == "" || len(label) > 63 {
+			return &IDNAError{Code: "A4_2", Label: label}
+		}
+		total += len(label)
+	}
+	if total > 253 {
+		return &IDNAError{Code: "A4_1", Label: strings.Join(labels, ".")}
+	}
+	return nil
+}
+
+// The joining types of RFC 5892, Appendix A.1. Other runes are non-joining.
+const (
+	joiningNone = iota
+	joiningL
+	joiningD
+	joiningT
+	joiningR
+)
+
+// cccVirama is the canonical combining class of a virama.
+const cccVirama = 9
+
+// checkJoiners applies the ContextJ rules of RFC 5892, Appendix A.1 and
+// A.2, and returns "C1" or "C2" if one fails, or "".
+func checkJoiners(runes []rune) string {
+	for i, r := range runes {
+		switch r {
+		case '\u200C':
+			if i > 0 && combiningClass(runes[i-1]) == cccVirama {
+				continue
+			}
+			if !joinsWith(runes[:i], true) || !joinsWith(runes[i+1:], false) {
+				return "C1"
+			}
+		case '\u200D':
+			if i == 0 || combiningClass(runes[i-1]) != cccVirama {
+				return "C2"
+			}
+		}
+	}
+	return ""
+}
+
+// joinsWith reports whether the runes next to a zero width non-joiner join
+// with it: skipping transparent runes, the nearest rune before it must join
+// on the left, and the nearest rune after it on the right.
+func joinsWith(runes []rune, before bool) bool {
+	for i := range runes {
+		r := runes[i]
+		if before {
+			r = runes[len(runes)-1-i]
+		}
+		switch lookupRange(joiningTable, r) {
+		case joiningT:
+			continue
+		case joiningD:
+			return true
+		case joiningL:
+			return before
+		case joiningR:
+			return !before
+		}
+		return false
+	}
+	return false
+}
+
+// The bidi classes used by the Bidi Rule. Other classes are bidiOther.
+const (
+	bidiL = iota
+	bidiR
+	bidiAL
+	bidiAN
+	bidiEN
+	bidiES
+	bidiCS
+	bidiET
+	bidiON
+	bidiBN
+	bidiNSM
+	bidiOther
+)
+
+// isBidiDomain reports whether any label has a right-to-left rune or an
+// Arabic digit.
+func isBidiDomain(labels []string) bool {
+	for _, label := range labels {
+		for _, r := range label {
+			switch lookupRange(bidiTable, r) {
+			case bidiR, bidiAL, bidiAN:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkBidiLabel applies the six conditions of the Bidi Rule, RFC 5893,
+// section 2, to a non-empty label, and returns "B1" to "B6" for the first
+// one that fails, or "".
+func checkBidiLabel(label string) string {
+	var classes []uint8
+	for _, r := range label {
+		classes = append(classes, lookupRange(bidiTable, r))
+	}
+	last := len(classes) - 1
+	for last > 0 && classes[last] == bidiNSM {
+		last--
+	}
+
+	switch classes[0] {
+	case bidiR, bidiAL:
+		hasEN, hasAN := false, false
+		for _, c := range classes {
+			switch c {
+			case bidiR, bidiAL, bidiES, bidiCS, bidiET, bidiON, bidiBN, bidiNSM:
+			case bidiEN:
+				hasEN = true
+			case bidiAN:
+				hasAN = true
+			default:
+				return "B2"
+			}
+		}
+		switch classes[last] {
+		case bidiR, bidiAL, bidiEN, bidiAN:
+		default:
+			return "B3"
+		}
+		if hasEN && hasAN {
+			return "B4"
+		}
+	case bidiL:
+		for _, c := range classes {
+			switch c {
+			case bidiL, bidiEN, bidiES, bidiCS, bidiET, bidiON, bidiBN, bidiNSM:
+			default:
+				return "B5"
+			}
+		}
+		switch classes[last] {
+		case bidiL, bidiEN:
+		default:
+			return "B6"
+		}
+	default:
+		return "B1"
+	}
+	return ""
+}
+
+// The status of a rune in the IDNA mapping table.
+type idnaStatus uint8
+
+const (
+	idnaValid idnaStatus = iota
+	idnaIgnored
+	idnaMapped
+	idnaDeviation
+	idnaDisallowed
+)
+
+// idnaMapping is a range of runes in the IDNA mapping table. Mapped and
+// deviation runes are replaced by mapping.
+type idnaMapping struct {
+	lo, hi  rune
+	status  idnaStatus
+	mapping string
+}
+
+// runeRange gives a property value to the runes lo to hi.
+type runeRange struct {
+	lo, hi rune
+	value  uint8
+}
+
+// decomposition is the full canonical decomposition d of the rune r.
+type decomposition struct {
+	r rune
+	d string
+}
+
+// composition is the primary composite c of the runes a and b.
+type composition struct {
+	a, b, c rune
+}
+
+var disallowedMapping = idnaMapping{status: idnaDisallowed}
+
+// lookupIDNA returns the entry of r in the IDNA mapping table.
+func lookupIDNA(r rune) *idnaMapping {
+	i := sort.Search(len(idnaMappingTable), func(i int) bool {
+		return idnaMappingTable[i].hi >= r
+	})
+	if i == len(idnaMappingTable) || idnaMappingTable[i].lo > r {
+		return &disallowedMapping
+	}
+	return &idnaMappingTable[i]
+}
+
+// lookupRange returns the value table gives to r, or 0 if it has none.
+func lookupRange(table []runeRange, r rune) uint8 {
+	i := sort.Search(len(table), func(i int) bool {
+		return table[i].hi >= r
+	})
+	if i == len(table) || table[i].lo > r {
+		return 0
+	}
+	return table[i].value
+}
+
+func combiningClass(r rune) uint8 {
+	return lookupRange(cccTable, r)
+}
+
+// Hangul syllable constants from the Unicode Standard, section 3.12.
+const (
+	hangulSBase  = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// nfc returns s in Unicode Normalization Form C.
+func nfc(s string) string {
+	if isASCII(s) {
+		return s
+	}
+
+	// Decompose.
+	var runes []rune
+	for _, r := range s {
+		if r >= hangulSBase && r < hangulSBase+hangulSCount {
+			i := r - hangulSBase
+			runes = append(runes, hangulLBase+i/hangulNCount, hangulVBase+i%hangulNCount/hangulTCount)
+			if t := i % hangulTCount; t != 0 {
+				runes = append(runes, hangulTBase+t)
+			}
+			continue
+		}
+		i := sort.Search(len(decompositionTable), func(i int) bool {
+			return decompositionTable[i].r >= r
+		})
+		if i < len(decompositionTable) && decompositionTable[i].r == r {
+			runes = append(runes, []rune(decompositionTable[i].d)...)
+			continue
+		}
+		runes = append(runes, r)
+	}
+
+	// Put combining marks in canonical order.
+	for i := 1; i < len(runes); i++ {
+		ccc := combiningClass(runes[i])
+		if ccc == 0 {
+			continue
+		}
+		for j := i; j > 0 && combiningClass(runes[j-1]) > ccc; j-- {
+			runes[j-1], runes[j] = runes[j], runes[j-1]
+		}
+	}
+
+	// Compose each rune with the last starter, unless a rune in between
+	// blocks it.
+	out := runes[:1]
+	starter := 0
+	lastCCC := int(combiningClass(runes[0]))
+	if lastCCC != 0 {
+		lastCCC = 256
+	}
+	for _, r := range runes[1:] {
+		ccc := int(combiningClass(r))
+		if c, ok := compose(out[starter], r); ok && (lastCCC < ccc || lastCCC == 0) {
+			out[starter] = c
+			continue
+		}
+		if ccc == 0 {
+			starter = len(out)
+		}
+		lastCCC = ccc
+		out = append(out, r)
+	}
+	return string(out)
+}
+
+// compose returns the primary composite of a and b, if there is one.
+func compose(a, b rune) (rune, bool) {
+	if a >= hangulLBase && a < hangulLBase+hangulLCount && b >= hangulVBase && b < hangulVBase+hangulVCount {
+		return hangulSBase + ((a-hangulLBase)*hangulVCount+b-hangulVBase)*hangulTCount, true
+	}
+	if a >= hangulSBase && a < hangulSBase+hangulSCount && (a-hangulSBase)%hangulTCount == 0 &&
+		b > hangulTBase && b < hangulTBase+hangulTCount {
+		return a + b - hangulTBase, true
+	}
+	i := sort.Search(len(compositionTable), func(i int) bool {
+		c := compositionTable[i]
+		return c.a > a || c.a == a && c.b >= b
+	})
+	if i < len(compositionTable) && compositionTable[i].a == a && compositionTable[i].b == b {
+		return compositionTable[i].c, true
+	}
+	return 0, false
+}
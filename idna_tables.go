@@ -0,0 +1,13582 @@
+// Code generated by gen_idna.go; DO NOT EDIT.
+
+package gurl
+
+// idnaUnicodeVersion is the Unicode version the tables were generated from.
+const idnaUnicodeVersion = "17.0.0"
+
+var idnaMappingTable = []idnaMapping{
+	{0x0000, 0x0040, idnaValid, ""},
+	{0x0041, 0x0041, idnaMapped, "a"},
+	{0x0042, 0x0042, idnaMapped, "b"},
+	{0x0043, 0x0043, idnaMapped, "c"},
+	{0x0044, 0x0044, idnaMapped, "d"},
+	{0x0045, 0x0045, idnaMapped, "e"},
+	{0x0046, 0x0046, idnaMapped, "f"},
+	{0x0047, 0x0047, idnaMapped, "g"},
+	{0x0048, 0x0048, idnaMapped, "h"},
+	{0x0049, 0x0049, idnaMapped, "i"},
+	{0x004A, 0x004A, idnaMapped, "j"},
+	{0x004B, 0x004B, idnaMapped, "k"},
+	{0x004C, 0x004C, idnaMapped, "l"},
+	{0x004D, 0x004D, idnaMapped, "m"},
+	{0x004E, 0x004E, idnaMapped, "n"},
+	{0x004F, 0x004F, idnaMapped, "o"},
+	{0x0050, 0x0050, idnaMapped, "p"},
+	{0x0051, 0x0051, idnaMapped, "q"},
+	{0x0052, 0x0052, idnaMapped, "r"},
+	{0x0053, 0x0053, idnaMapped, "s"},
+	{0x0054, 0x0054, idnaMapped, "t"},
+	{0x0055, 0x0055, idnaMapped, "u"},
+	{0x0056, 0x0056, idnaMapped, "v"},
+	{0x0057, 0x0057, idnaMapped, "w"},
+	{0x0058, 0x0058, idnaMapped, "x"},
+	{0x0059, 0x0059, idnaMapped, "y"},
+	{0x005A, 0x005A, idnaMapped, "z"},
+	{0x005B, 0x007F, idnaValid, ""},
+	{0x0080, 0x009F, idnaDisallowed, ""},
+	{0x00A0, 0x00A0, idnaMapped, " "},
+	{0x00A1, 0x00A7, idnaValid, ""},
+	{0x00A8, 0x00A8, idnaMapped, " \u0308"},
+	{0x00A9, 0x00A9, idnaValid, ""},
+	{0x00AA, 0x00AA, idnaMapped, "a"},
+	{0x00AB, 0x00AC, idnaValid, ""},
+	{0x00AD, 0x00AD, idnaIgnored, ""},
+	{0x00AE, 0x00AE, idnaValid, ""},
+	{0x00AF, 0x00AF, idnaMapped, " \u0304"},
+	{0x00B0, 0x00B1, idnaValid, ""},
+	{0x00B2, 0x00B2, idnaMapped, "2"},
+	{0x00B3, 0x00B3, idnaMapped, "3"},
+	{0x00B4, 0x00B4, idnaMapped, " \u0301"},
+	{0x00B5, 0x00B5, idnaMapped, "\u03bc"},
+	{0x00B6, 0x00B7, idnaValid, ""},
+	{0x00B8, 0x00B8, idnaMapped, " \u0327"},
+	{0x00B9, 0x00B9, idnaMapped, "1"},
+	{0x00BA, 0x00BA, idnaMapped, "o"},
+	{0x00BB, 0x00BB, idnaValid, ""},
+	{0x00BC, 0x00BC, idnaMapped, "1\u20444"},
+	{0x00BD, 0x00BD, idnaMapped, "1\u20442"},
+	{0x00BE, 0x00BE, idnaMapped, "3\u20444"},
+	{0x00BF, 0x00BF, idnaValid, ""},
+	{0x00C0, 0x00C0, idnaMapped, "\u00e0"},
+	{0x00C1, 0x00C1, idnaMapped, "\u00e1"},
+	{0x00C2, 0x00C2, idnaMapped, "\u00e2"},
+	{0x00C3, 0x00C3, idnaMapped, "\u00e3"},
+	{0x00C4, 0x00C4, idnaMapped, "\u00e4"},
+	{0x00C5, 0x00C5, idnaMapped, "\u00e5"},
+	{0x00C6, 0x00C6, idnaMapped, "\u00e6"},
+	{0x00C7, 0x00C7, idnaMapped, "\u00e7"},
+	{0x00C8, 0x00C8, idnaMapped, "\u00e8"},
+	{0x00C9, 0x00C9, idnaMapped, "\u00e9"},
+	{0x00CA, 0x00CA, idnaMapped, "\u00ea"},
+	{0x00CB, 0x00CB, idnaMapped, "\u00eb"},
+	{0x00CC, 0x00CC, idnaMapped, "\u00ec"},
+	{0x00CD, 0x00CD, idnaMapped, "\u00ed"},
+	{0x00CE, 0x00CE, idnaMapped, "\u00ee"},
+	{0x00CF, 0x00CF, idnaMapped, "\u00ef"},
+	{0x00D0, 0x00D0, idnaMapped, "\u00f0"},
+	{0x00D1, 0x00D1, idnaMapped, "\u00f1"},
+	{0x00D2, 0x00D2, idnaMapped, "\u00f2"},
+	{0x00D3, 0x00D3, idnaMapped, "\u00f3"},
+	{0x00D4, 0x00D4, idnaMapped, "\u00f4"},
+	{0x00D5, 0x00D5, idnaMapped, "\u00f5"},
+	{0x00D6, 0x00D6, idnaMapped, "\u00f6"},
+	{0x00D7, 0x00D7, idnaValid, ""},
+	{0x00D8, 0x00D8, idnaMapped, "\u00f8"},
+	{0x00D9, 0x00D9, idnaMapped, "\u00f9"},
+	{0x00DA, 0x00DA, idnaMapped, "\u00fa"},
+	{0x00DB, 0x00DB, idnaMapped, "\u00fb"},
+	{0x00DC, 0x00DC, idnaMapped, "\u00fc"},
+	{0x00DD, 0x00DD, idnaMapped, "\u00fd"},
+	{0x00DE, 0x00DE, idnaMapped, "\u00fe"},
+	{0x00DF, 0x00DF, idnaDeviation, "ss"},
+	{0x00E0, 0x00FF, idnaValid, ""},
+	{0x0100, 0x0100, idnaMapped, "\u0101"},
+	{0x0101, 0x0101, idnaValid, ""},
+	{0x0102, 0x0102, idnaMapped, "\u0103"},
+	{0x0103, 0x0103, idnaValid, ""},
+	{0x0104, 0x0104, idnaMapped, "\u0105"},
+	{0x0105, 0x0105, idnaValid, ""},
+	{0x0106, 0x0106, idnaMapped, "\u0107"},
+	{0x0107, 0x0107, idnaValid, ""},
+	{0x0108, 0x0108, idnaMapped, "\u0109"},
+	{0x0109, 0x0109, idnaValid, ""},
+	{0x010A, 0x010A, idnaMapped, "\u010b"},
+	{0x010B, 0x010B, idnaValid, ""},
+	{0x010C, 0x010C, idnaMapped, "\u010d"},
+	{0x010D, 0x010D, idnaValid, ""},
+	{0x010E, 0x010E, idnaMapped, "\u010f"},
+	{0x010F, 0x010F, idnaValid, ""},
+	{0x0110, 0x0110, idnaMapped, "\u0111"},
+	{0x0111, 0x0111, idnaValid, ""},
+	{0x0112, 0x0112, idnaMapped, "\u0113"},
+	{0x0113, 0x0113, idnaValid, ""},
+	{0x0114, 0x0114, idnaMapped, "\u0115"},
+	{0x0115, 0x0115, idnaValid, ""},
+	{0x0116, 0x0116, idnaMapped, "\u0117"},
+	{0x0117, 0x0117, idnaValid, ""},
+	{0x0118, 0x0118, idnaMapped, "\u0119"},
+	{0x0119, 0x0119, idnaValid, ""},
+	{0x011A, 0x011A, idnaMapped, "\u011b"},
+	{0x011B, 0x011B, idnaValid, ""},
+	{0x011C, 0x011C, idnaMapped, "\u011d"},
+	{0x011D, 0x011D, idnaValid, ""},
+	{0x011E, 0x011E, idnaMapped, "\u011f"},
+	{0x011F, 0x011F, idnaValid, ""},
+	{0x0120, 0x0120, idnaMapped, "\u0121"},
+	{0x0121, 0x0121, idnaValid, ""},
+	{0x0122, 0x0122, idnaMapped, "\u0123"},
+	{0x0123, 0x0123, idnaValid, ""},
+	{0x0124, 0x0124, idnaMapped, "\u0125"},
+	{0x0125, 0x0125, idnaValid, ""},
+	{0x0126, 0x0126, idnaMapped, "\u0127"},
+	{0x0127, 0x0127, idnaValid, ""},
+	{0x0128, 0x0128, idnaMapped, "\u0129"},
+	{0x0129, 0x0129, idnaValid, ""},
+	{0x012A, 0x012A, idnaMapped, "\u012b"},
+	{0x012B, 0x012B, idnaValid, ""},
+	{0x012C, 0x012C, idnaMapped, "\u012d"},
+	{0x012D, 0x012D, idnaValid, ""},
+	{0x012E, 0x012E, idnaMapped, "\u012f"},
+	{0x012F, 0x012F, idnaValid, ""},
+	{0x0130, 0x0130, idnaMapped, "i\u0307"},
+	{0x0131, 0x0131, idnaValid, ""},
+	{0x0132, 0x0132, idnaMapped, "ij"},
+	{0x0133, 0x0133, idnaMapped, "ij"},
+	{0x0134, 0x0134, idnaMapped, "\u0135"},
+	{0x0135, 0x0135, idnaValid, ""},
+	{0x0136, 0x0136, idnaMapped, "\u0137"},
+	{0x0137, 0x0138, idnaValid, ""},
+	{0x0139, 0x0139, idnaMapped, "\u013a"},
+	{0x013A, 0x013A, idnaValid, ""},
+	{0x013B, 0x013B, idnaMapped, "\u013c"},
+	{0x013C, 0x013C, idnaValid, ""},
+	{0x013D, 0x013D, idnaMapped, "\u013e"},
+	{0x013E, 0x013E, idnaValid, ""},
+	{0x013F, 0x013F, idnaMapped, "l\u00b7"},
+	{0x0140, 0x0140, idnaMapped, "l\u00b7"},
+	{0x0141, 0x0141, idnaMapped, "\u0142"},
+	{0x0142, 0x0142, idnaValid, ""},
+	{0x0143, 0x0143, idnaMapped, "\u0144"},
+	{0x0144, 0x0144, idnaValid, ""},
+	{0x0145, 0x0145, idnaMapped, "\u0146"},
+	{0x0146, 0x0146, idnaValid, ""},
+	{0x0147, 0x0147, idnaMapped, "\u0148"},
+	{0x0148, 0x0148, idnaValid, ""},
+	{0x0149, 0x0149, idnaMapped, "\u02bcn"},
+	{0x014A, 0x014A, idnaMapped, "\u014b"},
+	{0x014B, 0x014B, idnaValid, ""},
+	{0x014C, 0x014C, idnaMapped, "\u014d"},
+	{0x014D, 0x014D, idnaValid, ""},
+	{0x014E, 0x014E, idnaMapped, "\u014f"},
+	{0x014F, 0x014F, idnaValid, ""},
+	{0x0150, 0x0150, idnaMapped, "\u0151"},
+	{0x0151, 0x0151, idnaValid, ""},
+	{0x0152, 0x0152, idnaMapped, "\u0153"},
+	{0x0153, 0x0153, idnaValid, ""},
+	{0x0154, 0x0154, idnaMapped, "\u0155"},
+	{0x0155, 0x0155, idnaValid, ""},
+	{0x0156, 0x0156, idnaMapped, "\u0157"},
+	{0x0157, 0x0157, idnaValid, ""},
+	{0x0158, 0x0158, idnaMapped, "\u0159"},
+	{0x0159, 0x0159, idnaValid, ""},
+	{0x015A, 0x015A, idnaMapped, "\u015b"},
+	{0x015B, 0x015B, idnaValid, ""},
+	{0x015C, 0x015C, idnaMapped, "\u015d"},
+	{0x015D, 0x015D, idnaValid, ""},
+	{0x015E, 0x015E, idnaMapped, "\u015f"},
+	{0x015F, 0x015F, idnaValid, ""},
+	{0x0160, 0x0160, idnaMapped, "\u0161"},
+	{0x0161, 0x0161, idnaValid, ""},
+	{0x0162, 0x0162, idnaMapped, "\u0163"},
+	{0x0163, 0x0163, idnaValid, ""},
+	{0x0164, 0x0164, idnaMapped, "\u0165"},
+	{0x0165, 0x0165, idnaValid, ""},
+	{0x0166, 0x0166, idnaMapped, "\u0167"},
+	{0x0167, 0x0167, idnaValid, ""},
+	{0x0168, 0x0168, idnaMapped, "\u0169"},
+	{0x0169, 0x0169, idnaValid, ""},
+	{0x016A, 0x016A, idnaMapped, "\u016b"},
+	{0x016B, 0x016B, idnaValid, ""},
+	{0x016C, 0x016C, idnaMapped, "\u016d"},
+	{0x016D, 0x016D, idnaValid, ""},
+	{0x016E, 0x016E, idnaMapped, "\u016f"},
+	{0x016F, 0x016F, idnaValid, ""},
+	{0x0170, 0x0170, idnaMapped, "\u0171"},
+	{0x0171, 0x0171, idnaValid, ""},
+	{0x0172, 0x0172, idnaMapped, "\u0173"},
+	{0x0173, 0x0173, idnaValid, ""},
+	{0x0174, 0x0174, idnaMapped, "\u0175"},
+	{0x0175, 0x0175, idnaValid, ""},
+	{0x0176, 0x0176, idnaMapped, "\u0177"},
+	{0x0177, 0x0177, idnaValid, ""},
+	{0x0178, 0x0178, idnaMapped, "\u00ff"},
+	{0x0179, 0x0179, idnaMapped, "\u017a"},
+	{0x017A, 0x017A, idnaValid, ""},
+	{0x017B, 0x017B, idnaMapped, "\u017c"},
+	{0x017C, 0x017C, idnaValid, ""},
+	{0x017D, 0x017D, idnaMapped, "\u017e"},
+	{0x017E, 0x017E, idnaValid, ""},
+	{0x017F, 0x017F, idnaMapped, "s"},
+	{0x0180, 0x0180, idnaValid, ""},
+	{0x0181, 0x0181, idnaMapped, "\u0253"},
+	{0x0182, 0x0182, idnaMapped, "\u0183"},
+	{0x0183, 0x0183, idnaValid, ""},
+	{0x0184, 0x0184, idnaMapped, "\u0185"},
+	{0x0185, 0x0185, idnaValid, ""},
+	{0x0186, 0x0186, idnaMapped, "\u0254"},
+	{0x0187, 0x0187, idnaMapped, "\u0188"},
+	{0x0188, 0x0188, idnaValid, ""},
+	{0x0189, 0x0189, idnaMapped, "\u0256"},
+	{0x018A, 0x018A, idnaMapped, "\u0257"},
+	{0x018B, 0x018B, idnaMapped, "\u018c"},
+	{0x018C, 0x018D, idnaValid, ""},
+	{0x018E, 0x018E, idnaMapped, "\u01dd"},
+	{0x018F, 0x018F, idnaMapped, "\u0259"},
+	{0x0190, 0x0190, idnaMapped, "\u025b"},
+	{0x0191, 0x0191, idnaMapped, "\u0192"},
+	{0x0192, 0x0192, idnaValid, ""},
+	{0x0193, 0x0193, idnaMapped, "\u0260"},
+	{0x0194, 0x0194, idnaMapped, "\u0263"},
+	{0x0195, 0x0195, idnaValid, ""},
+	{0x0196, 0x0196, idnaMapped, "\u0269"},
+	{0x0197, 0x0197, idnaMapped, "\u0268"},
+	{0x0198, 0x0198, idnaMapped, "\u0199"},
+	{0x0199, 0x019B, idnaValid, ""},
+	{0x019C, 0x019C, idnaMapped, "\u026f"},
+	{0x019D, 0x019D, idnaMapped, "\u0272"},
+	{0x019E, 0x019E, idnaValid, ""},
+	{0x019F, 0x019F, idnaMapped, "\u0275"},
+	{0x01A0, 0x01A0, idnaMapped, "\u01a1"},
+	{0x01A1, 0x01A1, idnaValid, ""},
+	{0x01A2, 0x01A2, idnaMapped, "\u01a3"},
+	{0x01A3, 0x01A3, idnaValid, ""},
+	{0x01A4, 0x01A4, idnaMapped, "\u01a5"},
+	{0x01A5, 0x01A5, idnaValid, ""},
+	{0x01A6, 0x01A6, idnaMapped, "\u0280"},
+	{0x01A7, 0x01A7, idnaMapped, "\u01a8"},
+	{0x01A8, 0x01A8, idnaValid, ""},
+	{0x01A9, 0x01A9, idnaMapped, "\u0283"},
+	{0x01AA, 0x01AB, idnaValid, ""},
+	{0x01AC, 0x01AC, idnaMapped, "\u01ad"},
+	{0x01AD, 0x01AD, idnaValid, ""},
+	{0x01AE, 0x01AE, idnaMapped, "\u0288"},
+	{0x01AF, 0x01AF, idnaMapped, "\u01b0"},
+	{0x01B0, 0x01B0, idnaValid, ""},
+	{0x01B1, 0x01B1, idnaMapped, "\u028a"},
+	{0x01B2, 0x01B2, idnaMapped, "\u028b"},
+	{0x01B3, 0x01B3, idnaMapped, "\u01b4"},
+	{0x01B4, 0x01B4, idnaValid, ""},
+	{0x01B5, 0x01B5, idnaMapped, "\u01b6"},
+	{0x01B6, 0x01B6, idnaValid, ""},
+	{0x01B7, 0x01B7, idnaMapped, "\u0292"},
+	{0x01B8, 0x01B8, idnaMapped, "\u01b9"},
+	{0x01B9, 0x01BB, idnaValid, ""},
+	{0x01BC, 0x01BC, idnaMapped, "\u01bd"},
+	{0x01BD, 0x01C3, idnaValid, ""},
+	{0x01C4, 0x01C4, idnaMapped, "d\u017e"},
+	{0x01C5, 0x01C5, idnaMapped, "d\u017e"},
+	{0x01C6, 0x01C6, idnaMapped, "d\u017e"},
+	{0x01C7, 0x01C7, idnaMapped, "lj"},
+	{0x01C8, 0x01C8, idnaMapped, "lj"},
+	{0x01C9, 0x01C9, idnaMapped, "lj"},
+	{0x01CA, 0x01CA, idnaMapped, "nj"},
+	{0x01CB, 0x01CB, idnaMapped, "nj"},
+	{0x01CC, 0x01CC, idnaMapped, "nj"},
+	{0x01CD, 0x01CD, idnaMapped, "\u01ce"},
+	{0x01CE, 0x01CE, idnaValid, ""},
+	{0x01CF, 0x01CF, idnaMapped, "\u01d0"},
+	{0x01D0, 0x01D0, idnaValid, ""},
+	{0x01D1, 0x01D1, idnaMapped, "\u01d2"},
+	{0x01D2, 0x01D2, idnaValid, ""},
+	{0x01D3, 0x01D3, idnaMapped, "\u01d4"},
+	{0x01D4, 0x01D4, idnaValid, ""},
+	{0x01D5, 0x01D5, idnaMapped, "\u01d6"},
+	{0x01D6, 0x01D6, idnaValid, ""},
+	{0x01D7, 0x01D7, idnaMapped, "\u01d8"},
+	{0x01D8, 0x01D8, idnaValid, ""},
+	{0x01D9, 0x01D9, idnaMapped, "\u01da"},
+	{0x01DA, 0x01DA, idnaValid, ""},
+	{0x01DB, 0x01DB, idnaMapped, "\u01dc"},
+	{0x01DC, 0x01DD, idnaValid, ""},
+	{0x01DE, 0x01DE, idnaMapped, "\u01df"},
+	{0x01DF, 0x01DF, idnaValid, ""},
+	{0x01E0, 0x01E0, idnaMapped, "\u01e1"},
+	{0x01E1, 0x01E1, idnaValid, ""},
+	{0x01E2, 0x01E2, idnaMapped, "\u01e3"},
+	{0x01E3, 0x01E3, idnaValid, ""},
+	{0x01E4, 0x01E4, idnaMapped, "\u01e5"},
+	{0x01E5, 0x01E5, idnaValid, ""},
+	{0x01E6, 0x01E6, idnaMapped, "\u01e7"},
+	{0x01E7, 0x01E7, idnaValid, ""},
+	{0x01E8, 0x01E8, idnaMapped, "\u01e9"},
+	{0x01E9, 0x01E9, idnaValid, ""},
+	{0x01EA, 0x01EA, idnaMapped, "\u01eb"},
+	{0x01EB, 0x01EB, idnaValid, ""},
+	{0x01EC, 0x01EC, idnaMapped, "\u01ed"},
+	{0x01ED, 0x01ED, idnaValid, ""},
+	{0x01EE, 0x01EE, idnaMapped, "\u01ef"},
+	{0x01EF, 0x01F0, idnaValid, ""},
+	{0x01F1, 0x01F1, idnaMapped, "dz"},
+	{0x01F2, 0x01F2, idnaMapped, "dz"},
+	{0x01F3, 0x01F3, idnaMapped, "dz"},
+	{0x01F4, 0x01F4, idnaMapped, "\u01f5"},
+	{0x01F5, 0x01F5, idnaValid, ""},
+	{0x01F6, 0x01F6, idnaMapped, "\u0195"},
+	{0x01F7, 0x01F7, idnaMapped, "\u01bf"},
+	{0x01F8, 0x01F8, idnaMapped, "\u01f9"},
+	{0x01F9, 0x01F9, idnaValid, ""},
+	{0x01FA, 0x01FA, idnaMapped, "\u01fb"},
+	{0x01FB, 0x01FB, idnaValid, ""},
+	{0x01FC, 0x01FC, idnaMapped, "\u01fd"},
+	{0x01FD, 0x01FD, idnaValid, ""},
+	{0x01FE, 0x01FE, idnaMapped, "\u01ff"},
+	{0x01FF, 0x01FF, idnaValid, ""},
+	{0x0200, 0x0200, idnaMapped, "\u0201"},
+	{0x0201, 0x0201, idnaValid, ""},
+	{0x0202, 0x0202, idnaMapped, "\u0203"},
+	{0x0203, 0x0203, idnaValid, ""},
+	{0x0204, 0x0204, idnaMapped, "\u0205"},
+	{0x0205, 0x0205, idnaValid, ""},
+	{0x0206, 0x0206, idnaMapped, "\u0207"},
+	{0x0207, 0x0207, idnaValid, ""},
+	{0x0208, 0x0208, idnaMapped, "\u0209"},
+	{0x0209, 0x0209, idnaValid, ""},
+	{0x020A, 0x020A, idnaMapped, "\u020b"},
+	{0x020B, 0x020B, idnaValid, ""},
+	{0x020C, 0x020C, idnaMapped, "\u020d"},
+	{0x020D, 0x020D, idnaValid, ""},
+	{0x020E, 0x020E, idnaMapped, "\u020f"},
+	{0x020F, 0x020F, idnaValid, ""},
+	{0x0210, 0x0210, idnaMapped, "\u0211"},
+	{0x0211, 0x0211, idnaValid, ""},
+	{0x0212, 0x0212, idnaMapped, "\u0213"},
+	{0x0213, 0x0213, idnaValid, ""},
+	{0x0214, 0x0214, idnaMapped, "\u0215"},
+	{0x0215, 0x0215, idnaValid, ""},
+	{0x0216, 0x0216, idnaMapped, "\u0217"},
+	{0x0217, 0x0217, idnaValid, ""},
+	{0x0218, 0x0218, idnaMapped, "\u0219"},
+	{0x0219, 0x0219, idnaValid, ""},
+	{0x021A, 0x021A, idnaMapped, "\u021b"},
+	{0x021B, 0x021B, idnaValid, ""},
+	{0x021C, 0x021C, idnaMapped, "\u021d"},
+	{0x021D, 0x021D, idnaValid, ""},
+	{0x021E, 0x021E, idnaMapped, "\u021f"},
+	{0x021F, 0x021F, idnaValid, ""},
+	{0x0220, 0x0220, idnaMapped, "\u019e"},
+	{0x0221, 0x0221, idnaValid, ""},
+	{0x0222, 0x0222, idnaMapped, "\u0223"},
+	{0x0223, 0x0223, idnaValid, ""},
+	{0x0224, 0x0224, idnaMapped, "\u0225"},
+	{0x0225, 0x0225, idnaValid, ""},
+	{0x0226, 0x0226, idnaMapped, "\u0227"},
+	{0x0227, 0x0227, idnaValid, ""},
+	{0x0228, 0x0228, idnaMapped, "\u0229"},
+	{0x0229, 0x0229, idnaValid, ""},
+	{0x022A, 0x022A, idnaMapped, "\u022b"},
+	{0x022B, 0x022B, idnaValid, ""},
+	{0x022C, 0x022C, idnaMapped, "\u022d"},
+	{0x022D, 0x022D, idnaValid, ""},
+	{0x022E, 0x022E, idnaMapped, "\u022f"},
+	{0x022F, 0x022F, idnaValid, ""},
+	{0x0230, 0x0230, idnaMapped, "\u0231"},
+	{0x0231, 0x0231, idnaValid, ""},
+	{0x0232, 0x0232, idnaMapped, "\u0233"},
+	{0x0233, 0x0239, idnaValid, ""},
+	{0x023A, 0x023A, idnaMapped, "\u2c65"},
+	{0x023B, 0x023B, idnaMapped, "\u023c"},
+	{0x023C, 0x023C, idnaValid, ""},
+	{0x023D, 0x023D, idnaMapped, "\u019a"},
+	{0x023E, 0x023E, idnaMapped, "\u2c66"},
+	{0x023F, 0x0240, idnaValid, ""},
+	{0x0241, 0x0241, idnaMapped, "\u0242"},
+	{0x0242, 0x0242, idnaValid, ""},
+	{0x0243, 0x0243, idnaMapped, "\u0180"},
+	{0x0244, 0x0244, idnaMapped, "\u0289"},
+	{0x0245, 0x0245, idnaMapped, "\u028c"},
+	{0x0246, 0x0246, idnaMapped, "\u0247"},
+	{0x0247, 0x0247, idnaValid, ""},
+	{0x0248, 0x0248, idnaMapped, "\u0249"},
+	{0x0249, 0x0249, idnaValid, ""},
+	{0x024A, 0x024A, idnaMapped, "\u024b"},
+	{0x024B, 0x024B, idnaValid, ""},
+	{0x024C, 0x024C, idnaMapped, "\u024d"},
+	{0x024D, 0x024D, idnaValid, ""},
+	{0x024E, 0x024E, idnaMapped, "\u024f"},
+	{0x024F, 0x02AF, idnaValid, ""},
+	{0x02B0, 0x02B0, idnaMapped, "h"},
+	{0x02B1, 0x02B1, idnaMapped, "\u0266"},
+	{0x02B2, 0x02B2, idnaMapped, "j"},
+	{0x02B3, 0x02B3, idnaMapped, "r"},
+	{0x02B4, 0x02B4, idnaMapped, "\u0279"},
+	{0x02B5, 0x02B5, idnaMapped, "\u027b"},
+	{0x02B6, 0x02B6, idnaMapped, "\u0281"},
+	{0x02B7, 0x02B7, idnaMapped, "w"},
+	{0x02B8, 0x02B8, idnaMapped, "y"},
+	{0x02B9, 0x02D7, idnaValid, ""},
+	{0x02D8, 0x02D8, idnaMapped, " \u0306"},
+	{0x02D9, 0x02D9, idnaMapped, " \u0307"},
+	{0x02DA, 0x02DA, idnaMapped, " \u030a"},
+	{0x02DB, 0x02DB, idnaMapped, " \u0328"},
+	{0x02DC, 0x02DC, idnaMapped, " \u0303"},
+	{0x02DD, 0x02DD, idnaMapped, " \u030b"},
+	{0x02DE, 0x02DF, idnaValid, ""},
+	{0x02E0, 0x02E0, idnaMapped, "\u0263"},
+	{0x02E1, 0x02E1, idnaMapped, "l"},
+	{0x02E2, 0x02E2, idnaMapped, "s"},
+	{0x02E3, 0x02E3, idnaMapped, "x"},
+	{0x02E4, 0x02E4, idnaMapped, "\u0295"},
+	{0x02E5, 0x033F, idnaValid, ""},
+	{0x0340, 0x0340, idnaMapped, "\u0300"},
+	{0x0341, 0x0341, idnaMapped, "\u0301"},
+	{0x0342, 0x0342, idnaValid, ""},
+	{0x0343, 0x0343, idnaMapped, "\u0313"},
+	{0x0344, 0x0344, idnaMapped, "\u0308\u0301"},
+	{0x0345, 0x0345, idnaMapped, "\u03b9"},
+	{0x0346, 0x034E, idnaValid, ""},
+	{0x034F, 0x034F, idnaIgnored, ""},
+	{0x0350, 0x036F, idnaValid, ""},
+	{0x0370, 0x0370, idnaMapped, "\u0371"},
+	{0x0371, 0x0371, idnaValid, ""},
+	{0x0372, 0x0372, idnaMapped, "\u0373"},
+	{0x0373, 0x0373, idnaValid, ""},
+	{0x0374, 0x0374, idnaMapped, "\u02b9"},
+	{0x0375, 0x0375, idnaValid, ""},
+	{0x0376, 0x0376, idnaMapped, "\u0377"},
+	{0x0377, 0x0377, idnaValid, ""},
+	{0x0378, 0x0379, idnaDisallowed, ""},
+	{0x037A, 0x037A, idnaMapped, " \u03b9"},
+	{0x037B, 0x037D, idnaValid, ""},
+	{0x037E, 0x037E, idnaMapped, ";"},
+	{0x037F, 0x037F, idnaMapped, "\u03f3"},
+	{0x0380, 0x0383, idnaDisallowed, ""},
+	{0x0384, 0x0384, idnaMapped, " \u0301"},
+	{0x0385, 0x0385, idnaMapped, " \u0308\u0301"},
+	{0x0386, 0x0386, idnaMapped, "\u03ac"},
+	{0x0387, 0x0387, idnaMapped, "\u00b7"},
+	{0x0388, 0x0388, idnaMapped, "\u03ad"},
+	{0x0389, 0x0389, idnaMapped, "\u03ae"},
+	{0x038A, 0x038A, idnaMapped, "\u03af"},
+	{0x038B, 0x038B, idnaDisallowed, ""},
+	{0x038C, 0x038C, idnaMapped, "\u03cc"},
+	{0x038D, 0x038D, idnaDisallowed, ""},
+	{0x038E, 0x038E, idnaMapped, "\u03cd"},
+	{0x038F, 0x038F, idnaMapped, "\u03ce"},
+	{0x0390, 0x0390, idnaValid, ""},
+	{0x0391, 0x0391, idnaMapped, "\u03b1"},
+	{0x0392, 0x0392, idnaMapped, "\u03b2"},
+	{0x0393, 0x0393, idnaMapped, "\u03b3"},
+	{0x0394, 0x0394, idnaMapped, "\u03b4"},
+	{0x0395, 0x0395, idnaMapped, "\u03b5"},
+	{0x0396, 0x0396, idnaMapped, "\u03b6"},
+	{0x0397, 0x0397, idnaMapped, "\u03b7"},
+	{0x0398, 0x0398, idnaMapped, "\u03b8"},
+	{0x0399, 0x0399, idnaMapped, "\u03b9"},
+	{0x039A, 0x039A, idnaMapped, "\u03ba"},
+	{0x039B, 0x039B, idnaMapped, "\u03bb"},
+	{0x039C, 0x039C, idnaMapped, "\u03bc"},
+	{0x039D, 0x039D, idnaMapped, "\u03bd"},
+	{0x039E, 0x039E, idnaMapped, "\u03be"},
+	{0x039F, 0x039F, idnaMapped, "\u03bf"},
+	{0x03A0, 0x03A0, idnaMapped, "\u03c0"},
+	{0x03A1, 0x03A1, idnaMapped, "\u03c1"},
+	{0x03A2, 0x03A2, idnaDisallowed, ""},
+	{0x03A3, 0x03A3, idnaMapped, "\u03c3"},
+	{0x03A4, 0x03A4, idnaMapped, "\u03c4"},
+	{0x03A5, 0x03A5, idnaMapped, "\u03c5"},
+	{0x03A6, 0x03A6, idnaMapped, "\u03c6"},
+	{0x03A7, 0x03A7, idnaMapped, "\u03c7"},
+	{0x03A8, 0x03A8, idnaMapped, "\u03c8"},
+	{0x03A9, 0x03A9, idnaMapped, "\u03c9"},
+	{0x03AA, 0x03AA, idnaMapped, "\u03ca"},
+	{0x03AB, 0x03AB, idnaMapped, "\u03cb"},
+	{0x03AC, 0x03C1, idnaValid, ""},
+	{0x03C2, 0x03C2, idnaDeviation, "\u03c3"},
+	{0x03C3, 0x03CE, idnaValid, ""},
+	{0x03CF, 0x03CF, idnaMapped, "\u03d7"},
+	{0x03D0, 0x03D0, idnaMapped, "\u03b2"},
+	{0x03D1, 0x03D1, idnaMapped, "\u03b8"},
+	{0x03D2, 0x03D2, idnaMapped, "\u03c5"},
+	{0x03D3, 0x03D3, idnaMapped, "\u03cd"},
+	{0x03D4, 0x03D4, idnaMapped, "\u03cb"},
+	{0x03D5, 0x03D5, idnaMapped, "\u03c6"},
+	{0x03D6, 0x03D6, idnaMapped, "\u03c0"},
+	{0x03D7, 0x03D7, idnaValid, ""},
+	{0x03D8, 0x03D8, idnaMapped, "\u03d9"},
+	{0x03D9, 0x03D9, idnaValid, ""},
+	{0x03DA, 0x03DA, idnaMapped, "\u03db"},
+	{0x03DB, 0x03DB, idnaValid, ""},
+	{0x03DC, 0x03DC, idnaMapped, "\u03dd"},
+	{0x03DD, 0x03DD, idnaValid, ""},
+	{0x03DE, 0x03DE, idnaMapped, "\u03df"},
+	{0x03DF, 0x03DF, idnaValid, ""},
+	{0x03E0, 0x03E0, idnaMapped, "\u03e1"},
+	{0x03E1, 0x03E1, idnaValid, ""},
+	{0x03E2, 0x03E2, idnaMapped, "\u03e3"},
+	{0x03E3, 0x03E3, idnaValid, ""},
+	{0x03E4, 0x03E4, idnaMapped, "\u03e5"},
+	{0x03E5, 0x03E5, idnaValid, ""},
+	{0x03E6, 0x03E6, idnaMapped, "\u03e7"},
+	{0x03E7, 0x03E7, idnaValid, ""},
+	{0x03E8, 0x03E8, idnaMapped, "\u03e9"},
+	{0x03E9, 0x03E9, idnaValid, ""},
+	{0x03EA, 0x03EA, idnaMapped, "\u03eb"},
+	{0x03EB, 0x03EB, idnaValid, ""},
+	{0x03EC, 0x03EC, idnaMapped, "\u03ed"},
+	{0x03ED, 0x03ED, idnaValid, ""},
+	{0x03EE, 0x03EE, idnaMapped, "\u03ef"},
+	{0x03EF, 0x03EF, idnaValid, ""},
+	{0x03F0, 0x03F0, idnaMapped, "\u03ba"},
+	{0x03F1, 0x03F1, idnaMapped, "\u03c1"},
+	{0x03F2, 0x03F2, idnaMapped, "\u03c3"},
+	{0x03F3, 0x03F3, idnaValid, ""},
+	{0x03F4, 0x03F4, idnaMapped, "\u03b8"},
+	{0x03F5, 0x03F5, idnaMapped, "\u03b5"},
+	{0x03F6, 0x03F6, idnaValid, ""},
+	{0x03F7, 0x03F7, idnaMapped, "\u03f8"},
+	{0x03F8, 0x03F8, idnaValid, ""},
+	{0x03F9, 0x03F9, idnaMapped, "\u03c3"},
+	{0x03FA, 0x03FA, idnaMapped, "\u03fb"},
+	{0x03FB, 0x03FC, idnaValid, ""},
+	{0x03FD, 0x03FD, idnaMapped, "\u037b"},
+	{0x03FE, 0x03FE, idnaMapped, "\u037c"},
+	{0x03FF, 0x03FF, idnaMapped, "\u037d"},
+	{0x0400, 0x0400, idnaMapped, "\u0450"},
+	{0x0401, 0x0401, idnaMapped, "\u0451"},
+	{0x0402, 0x0402, idnaMapped, "\u0452"},
+	{0x0403, 0x0403, idnaMapped, "\u0453"},
+	{0x0404, 0x0404, idnaMapped, "\u0454"},
+	{0x0405, 0x0405, idnaMapped, "\u0455"},
+	{0x0406, 0x0406, idnaMapped, "\u0456"},
+	{0x0407, 0x0407, idnaMapped, "\u0457"},
+	{0x0408, 0x0408, idnaMapped, "\u0458"},
+	{0x0409, 0x0409, idnaMapped, "\u0459"},
+	{0x040A, 0x040A, idnaMapped, "\u045a"},
+	{0x040B, 0x040B, idnaMapped, "\u045b"},
+	{0x040C, 0x040C, idnaMapped, "\u045c"},
+	{0x040D, 0x040D, idnaMapped, "\u045d"},
+	{0x040E, 0x040E, idnaMapped, "\u045e"},
+	{0x040F, 0x040F, idnaMapped, "\u045f"},
+	{0x0410, 0x0410, idnaMapped, "\u0430"},
+	{0x0411, 0x0411, idnaMapped, "\u0431"},
+	{0x0412, 0x0412, idnaMapped, "\u0432"},
+	{0x0413, 0x0413, idnaMapped, "\u0433"},
+	{0x0414, 0x0414, idnaMapped, "\u0434"},
+	{0x0415, 0x0415, idnaMapped, "\u0435"},
+	{0x0416, 0x0416, idnaMapped, "\u0436"},
+	{0x0417, 0x0417, idnaMapped, "\u0437"},
+	{0x0418, 0x0418, idnaMapped, "\u0438"},
+	{0x0419, 0x0419, idnaMapped, "\u0439"},
+	{0x041A, 0x041A, idnaMapped, "\u043a"},
+	{0x041B, 0x041B, idnaMapped, "\u043b"},
+	{0x041C, 0x041C, idnaMapped, "\u043c"},
+	{0x041D, 0x041D, idnaMapped, "\u043d"},
+	{0x041E, 0x041E, idnaMapped, "\u043e"},
+	{0x041F, 0x041F, idnaMapped, "\u043f"},
+	{0x0420, 0x0420, idnaMapped, "\u0440"},
+	{0x0421, 0x0421, idnaMapped, "\u0441"},
+	{0x0422, 0x0422, idnaMapped, "\u0442"},
+	{0x0423, 0x0423, idnaMapped, "\u0443"},
+	{0x0424, 0x0424, idnaMapped, "\u0444"},
+	{0x0425, 0x0425, idnaMapped, "\u0445"},
+	{0x0426, 0x0426, idnaMapped, "\u0446"},
+	{0x0427, 0x0427, idnaMapped, "\u0447"},
+	{0x0428, 0x0428, idnaMapped, "\u0448"},
+	{0x0429, 0x0429, idnaMapped, "\u0449"},
+	{0x042A, 0x042A, idnaMapped, "\u044a"},
+	{0x042B, 0x042B, idnaMapped, "\u044b"},
+	{0x042C, 0x042C, idnaMapped, "\u044c"},
+	{0x042D, 0x042D, idnaMapped, "\u044d"},
+	{0x042E, 0x042E, idnaMapped, "\u044e"},
+	{0x042F, 0x042F, idnaMapped, "\u044f"},
+	{0x0430, 0x045F, idnaValid, ""},
+	{0x0460, 0x0460, idnaMapped, "\u0461"},
+	{0x0461, 0x0461, idnaValid, ""},
+	{0x0462, 0x0462, idnaMapped, "\u0463"},
+	{0x0463, 0x0463, idnaValid, ""},
+	{0x0464, 0x0464, idnaMapped, "\u0465"},
+	{0x0465, 0x0465, idnaValid, ""},
+	{0x0466, 0x0466, idnaMapped, "\u0467"},
+	{0x0467, 0x0467, idnaValid, ""},
+	{0x0468, 0x0468, idnaMapped, "\u0469"},
+	{0x0469, 0x0469, idnaValid, ""},
+	{0x046A, 0x046A, idnaMapped, "\u046b"},
+	{0x046B, 0x046B, idnaValid, ""},
+	{0x046C, 0x046C, idnaMapped, "\u046d"},
+	{0x046D, 0x046D, idnaValid, ""},
+	{0x046E, 0x046E, idnaMapped, "\u046f"},
+	{0x046F, 0x046F, idnaValid, ""},
+	{0x0470, 0x0470, idnaMapped, "\u0471"},
+	{0x0471, 0x0471, idnaValid, ""},
+	{0x0472, 0x0472, idnaMapped, "\u0473"},
+	{0x0473, 0x0473, idnaValid, ""},
+	{0x0474, 0x0474, idnaMapped, "\u0475"},
+	{0x0475, 0x0475, idnaValid, ""},
+	{0x0476, 0x0476, idnaMapped, "\u0477"},
+	{0x0477, 0x0477, idnaValid, ""},
+	{0x0478, 0x0478, idnaMapped, "\u0479"},
+	{0x0479, 0x0479, idnaValid, ""},
+	{0x047A, 0x047A, idnaMapped, "\u047b"},
+	{0x047B, 0x047B, idnaValid, ""},
+	{0x047C, 0x047C, idnaMapped, "\u047d"},
+	{0x047D, 0x047D, idnaValid, ""},
+	{0x047E, 0x047E, idnaMapped, "\u047f"},
+	{0x047F, 0x047F, idnaValid, ""},
+	{0x0480, 0x0480, idnaMapped, "\u0481"},
+	{0x0481, 0x0489, idnaValid, ""},
+	{0x048A, 0x048A, idnaMapped, "\u048b"},
+	{0x048B, 0x048B, idnaValid, ""},
+	{0x048C, 0x048C, idnaMapped, "\u048d"},
+	{0x048D, 0x048D, idnaValid, ""},
+	{0x048E, 0x048E, idnaMapped, "\u048f"},
+	{0x048F, 0x048F, idnaValid, ""},
+	{0x0490, 0x0490, idnaMapped, "\u0491"},
+	{0x0491, 0x0491, idnaValid, ""},
+	{0x0492, 0x0492, idnaMapped, "\u0493"},
+	{0x0493, 0x0493, idnaValid, ""},
+	{0x0494, 0x0494, idnaMapped, "\u0495"},
+	{0x0495, 0x0495, idnaValid, ""},
+	{0x0496, 0x0496, idnaMapped, "\u0497"},
+	{0x0497, 0x0497, idnaValid, ""},
+	{0x0498, 0x0498, idnaMapped, "\u0499"},
+	{0x0499, 0x0499, idnaValid, ""},
+	{0x049A, 0x049A, idnaMapped, "\u049b"},
+	{0x049B, 0x049B, idnaValid, ""},
+	{0x049C, 0x049C, idnaMapped, "\u049d"},
+	{0x049D, 0x049D, idnaValid, ""},
+	{0x049E, 0x049E, idnaMapped, "\u049f"},
+	{0x049F, 0x049F, idnaValid, ""},
+	{0x04A0, 0x04A0, idnaMapped, "\u04a1"},
+	{0x04A1, 0x04A1, idnaValid, ""},
+	{0x04A2, 0x04A2, idnaMapped, "\u04a3"},
+	{0x04A3, 0x04A3, idnaValid, ""},
+	{0x04A4, 0x04A4, idnaMapped, "\u04a5"},
+	{0x04A5, 0x04A5, idnaValid, ""},
+	{0x04A6, 0x04A6, idnaMapped, "\u04a7"},
+	{0x04A7, 0x04A7, idnaValid, ""},
+	{0x04A8, 0x04A8, idnaMapped, "\u04a9"},
+	{0x04A9, 0x04A9, idnaValid, ""},
+	{0x04AA, 0x04AA, idnaMapped, "\u04ab"},
+	{0x04AB, 0x04AB, idnaValid, ""},
+	{0x04AC, 0x04AC, idnaMapped, "\u04ad"},
+	{0x04AD, 0x04AD, idnaValid, ""},
+	{0x04AE, 0x04AE, idnaMapped, "\u04af"},
+	{0x04AF, 0x04AF, idnaValid, ""},
+	{0x04B0, 0x04B0, idnaMapped, "\u04b1"},
+	{0x04B1, 0x04B1, idnaValid, ""},
+	{0x04B2, 0x04B2, idnaMapped, "\u04b3"},
+	{0x04B3, 0x04B3, idnaValid, ""},
+	{0x04B4, 0x04B4, idnaMapped, "\u04b5"},
+	{0x04B5, 0x04B5, idnaValid, ""},
+	{0x04B6, 0x04B6, idnaMapped, "\u04b7"},
+	{0x04B7, 0x04B7, idnaValid, ""},
+	{0x04B8, 0x04B8, idnaMapped, "\u04b9"},
+	{0x04B9, 0x04B9, idnaValid, ""},
+	{0x04BA, 0x04BA, idnaMapped, "\u04bb"},
+	{0x04BB, 0x04BB, idnaValid, ""},
+	{0x04BC, 0x04BC, idnaMapped, "\u04bd"},
+	{0x04BD, 0x04BD, idnaValid, ""},
+	{0x04BE, 0x04BE, idnaMapped, "\u04bf"},
+	{0x04BF, 0x04BF, idnaValid, ""},
+	{0x04C0, 0x04C0, idnaMapped, "\u04cf"},
+	{0x04C1, 0x04C1, idnaMapped, "\u04c2"},
+	{0x04C2, 0x04C2, idnaValid, ""},
+	{0x04C3, 0x04C3, idnaMapped, "\u04c4"},
+	{0x04C4, 0x04C4, idnaValid, ""},
+	{0x04C5, 0x04C5, idnaMapped, "\u04c6"},
+	{0x04C6, 0x04C6, idnaValid, ""},
+	{0x04C7, 0x04C7, idnaMapped, "\u04c8"},
+	{0x04C8, 0x04C8, idnaValid, ""},
+	{0x04C9, 0x04C9, idnaMapped, "\u04ca"},
+	{0x04CA, 0x04CA, idnaValid, ""},
+	{0x04CB, 0x04CB, idnaMapped, "\u04cc"},
+	{0x04CC, 0x04CC, idnaValid, ""},
+	{0x04CD, 0x04CD, idnaMapped, "\u04ce"},
+	{0x04CE, 0x04CF, idnaValid, ""},
+	{0x04D0, 0x04D0, idnaMapped, "\u04d1"},
+	{0x04D1, 0x04D1, idnaValid, ""},
+	{0x04D2, 0x04D2, idnaMapped, "\u04d3"},
+	{0x04D3, 0x04D3, idnaValid, ""},
+	{0x04D4, 0x04D4, idnaMapped, "\u04d5"},
+	{0x04D5, 0x04D5, idnaValid, ""},
+	{0x04D6, 0x04D6, idnaMapped, "\u04d7"},
+	{0x04D7, 0x04D7, idnaValid, ""},
+	{0x04D8, 0x04D8, idnaMapped, "\u04d9"},
+	{0x04D9, 0x04D9, idnaValid, ""},
+	{0x04DA, 0x04DA, idnaMapped, "\u04db"},
+	{0x04DB, 0x04DB, idnaValid, ""},
+	{0x04DC, 0x04DC, idnaMapped, "\u04dd"},
+	{0x04DD, 0x04DD, idnaValid, ""},
+	{0x04DE, 0x04DE, idnaMapped, "\u04df"},
+	{0x04DF, 0x04DF, idnaValid, ""},
+	{0x04E0, 0x04E0, idnaMapped, "\u04e1"},
+	{0x04E1, 0x04E1, idnaValid, ""},
+	{0x04E2, 0x04E2, idnaMapped, "\u04e3"},
+	{0x04E3, 0x04E3, idnaValid, ""},
+	{0x04E4, 0x04E4, idnaMapped, "\u04e5"},
+	{0x04E5, 0x04E5, idnaValid, ""},
+	{0x04E6, 0x04E6, idnaMapped, "\u04e7"},
+	{0x04E7, 0x04E7, idnaValid, ""},
+	{0x04E8, 0x04E8, idnaMapped, "\u04e9"},
+	{0x04E9, 0x04E9, idnaValid, ""},
+	{0x04EA, 0x04EA, idnaMapped, "\u04eb"},
+	{0x04EB, 0x04EB, idnaValid, ""},
+	{0x04EC, 0x04EC, idnaMapped, "\u04ed"},
+	{0x04ED, 0x04ED, idnaValid, ""},
+	{0x04EE, 0x04EE, idnaMapped, "\u04ef"},
+	{0x04EF, 0x04EF, idnaValid, ""},
+	{0x04F0, 0x04F0, idnaMapped, "\u04f1"},
+	{0x04F1, 0x04F1, idnaValid, ""},
+	{0x04F2, 0x04F2, idnaMapped, "\u04f3"},
+	{0x04F3, 0x04F3, idnaValid, ""},
+	{0x04F4, 0x04F4, idnaMapped, "\u04f5"},
+	{0x04F5, 0x04F5, idnaValid, ""},
+	{0x04F6, 0x04F6, idnaMapped, "\u04f7"},
+	{0x04F7, 0x04F7, idnaValid, ""},
+	{0x04F8, 0x04F8, idnaMapped, "\u04f9"},
+	{0x04F9, 0x04F9, idnaValid, ""},
+	{0x04FA, 0x04FA, idnaMapped, "\u04fb"},
+	{0x04FB, 0x04FB, idnaValid, ""},
+	{0x04FC, 0x04FC, idnaMapped, "\u04fd"},
+	{0x04FD, 0x04FD, idnaValid, ""},
+	{0x04FE, 0x04FE, idnaMapped, "\u04ff"},
+	{0x04FF, 0x04FF, idnaValid, ""},
+	{0x0500, 0x0500, idnaMapped, "\u0501"},
+	{0x0501, 0x0501, idnaValid, ""},
+	{0x0502, 0x0502, idnaMapped, "\u0503"},
+	{0x0503, 0x0503, idnaValid, ""},
+	{0x0504, 0x0504, idnaMapped, "\u0505"},
+	{0x0505, 0x0505, idnaValid, ""},
+	{0x0506, 0x0506, idnaMapped, "\u0507"},
+	{0x0507, 0x0507, idnaValid, ""},
+	{0x0508, 0x0508, idnaMapped, "\u0509"},
+	{0x0509, 0x0509, idnaValid, ""},
+	{0x050A, 0x050A, idnaMapped, "\u050b"},
+	{0x050B, 0x050B, idnaValid, ""},
+	{0x050C, 0x050C, idnaMapped, "\u050d"},
+	{0x050D, 0x050D, idnaValid, ""},
+	{0x050E, 0x050E, idnaMapped, "\u050f"},
+	{0x050F, 0x050F, idnaValid, ""},
+	{0x0510, 0x0510, idnaMapped, "\u0511"},
+	{0x0511, 0x0511, idnaValid, ""},
+	{0x0512, 0x0512, idnaMapped, "\u0513"},
+	{0x0513, 0x0513, idnaValid, ""},
+	{0x0514, 0x0514, idnaMapped, "\u0515"},
+	{0x0515, 0x0515, idnaValid, ""},
+	{0x0516, 0x0516, idnaMapped, "\u0517"},
+	{0x0517, 0x0517, idnaValid, ""},
+	{0x0518, 0x0518, idnaMapped, "\u0519"},
+	{0x0519, 0x0519, idnaValid, ""},
+	{0x051A, 0x051A, idnaMapped, "\u051b"},
+	{0x051B, 0x051B, idnaValid, ""},
+	{0x051C, 0x051C, idnaMapped, "\u051d"},
+	{0x051D, 0x051D, idnaValid, ""},
+	{0x051E, 0x051E, idnaMapped, "\u051f"},
+	{0x051F, 0x051F, idnaValid, ""},
+	{0x0520, 0x0520, idnaMapped, "\u0521"},
+	{0x0521, 0x0521, idnaValid, ""},
+	{0x0522, 0x0522, idnaMapped, "\u0523"},
+	{0x0523, 0x0523, idnaValid, ""},
+	{0x0524, 0x0524, idnaMapped, "\u0525"},
+	{0x0525, 0x0525, idnaValid, ""},
+	{0x0526, 0x0526, idnaMapped, "\u0527"},
+	{0x0527, 0x0527, idnaValid, ""},
+	{0x0528, 0x0528, idnaMapped, "\u0529"},
+	{0x0529, 0x0529, idnaValid, ""},
+	{0x052A, 0x052A, idnaMapped, "\u052b"},
+	{0x052B, 0x052B, idnaValid, ""},
+	{0x052C, 0x052C, idnaMapped, "\u052d"},
+	{0x052D, 0x052D, idnaValid, ""},
+	{0x052E, 0x052E, idnaMapped, "\u052f"},
+	{0x052F, 0x052F, idnaValid, ""},
+	{0x0530, 0x0530, idnaDisallowed, ""},
+	{0x0531, 0x0531, idnaMapped, "\u0561"},
+	{0x0532, 0x0532, idnaMapped, "\u0562"},
+	{0x0533, 0x0533, idnaMapped, "\u0563"},
+	{0x0534, 0x0534, idnaMapped, "\u0564"},
+	{0x0535, 0x0535, idnaMapped, "\u0565"},
+	{0x0536, 0x0536, idnaMapped, "\u0566"},
+	{0x0537, 0x0537, idnaMapped, "\u0567"},
+	{0x0538, 0x0538, idnaMapped, "\u0568"},
+	{0x0539, 0x0539, idnaMapped, "\u0569"},
+	{0x053A, 0x053A, idnaMapped, "\u056a"},
+	{0x053B, 0x053B, idnaMapped, "\u056b"},
+	{0x053C, 0x053C, idnaMapped, "\u056c"},
+	{0x053D, 0x053D, idnaMapped, "\u056d"},
+	{0x053E, 0x053E, idnaMapped, "\u056e"},
+	{0x053F, 0x053F, idnaMapped, "\u056f"},
+	{0x0540, 0x0540, idnaMapped, "\u0570"},
+	{0x0541, 0x0541, idnaMapped, "\u0571"},
+	{0x0542, 0x0542, idnaMapped, "\u0572"},
+	{0x0543, 0x0543, idnaMapped, "\u0573"},
+	{0x0544, 0x0544, idnaMapped, "\u0574"},
+	{0x0545, 0x0545, idnaMapped, "\u0575"},
+	{0x0546, 0x0546, idnaMapped, "\u0576"},
+	{0x0547, 0x0547, idnaMapped, "\u0577"},
+	{0x0548, 0x0548, idnaMapped, "\u0578"},
+	{0x0549, 0x0549, idnaMapped, "\u0579"},
+	{0x054A, 0x054A, idnaMapped, "\u057a"},
+	{0x054B, 0x054B, idnaMapped, "\u057b"},
+	{0x054C, 0x054C, idnaMapped, "\u057c"},
+	{0x054D, 0x054D, idnaMapped, "\u057d"},
+	{0x054E, 0x054E, idnaMapped, "\u057e"},
+	{0x054F, 0x054F, idnaMapped, "\u057f"},
+	{0x0550, 0x0550, idnaMapped, "\u0580"},
+	{0x0551, 0x0551, idnaMapped, "\u0581"},
+	{0x0552, 0x0552, idnaMapped, "\u0582"},
+	{0x0553, 0x0553, idnaMapped, "\u0583"},
+	{0x0554, 0x0554, idnaMapped, "\u0584"},
+	{0x0555, 0x0555, idnaMapped, "\u0585"},
+	{0x0556, 0x0556, idnaMapped, "\u0586"},
+	{0x0557, 0x0558, idnaDisallowed, ""},
+	{0x0559, 0x0586, idnaValid, ""},
+	{0x0587, 0x0587, idnaMapped, "\u0565\u0582"},
+	{0x0588, 0x058A, idnaValid, ""},
+	{0x058B, 0x058C, idnaDisallowed, ""},
+	{0x058D, 0x058F, idnaValid, ""},
+	{0x0590, 0x0590, idnaDisallowed, ""},
+	{0x0591, 0x05C7, idnaValid, ""},
+	{0x05C8, 0x05CF, idnaDisallowed, ""},
+	{0x05D0, 0x05EA, idnaValid, ""},
+	{0x05EB, 0x05EE, idnaDisallowed, ""},
+	{0x05EF, 0x05F4, idnaValid, ""},
+	{0x05F5, 0x0605, idnaDisallowed, ""},
+	{0x0606, 0x061B, idnaValid, ""},
+	{0x061C, 0x061C, idnaDisallowed, ""},
+	{0x061D, 0x0674, idnaValid, ""},
+	{0x0675, 0x0675, idnaMapped, "\u0627\u0674"},
+	{0x0676, 0x0676, idnaMapped, "\u0648\u0674"},
+	{0x0677, 0x0677, idnaMapped, "\u06c7\u0674"},
+	{0x0678, 0x0678, idnaMapped, "\u064a\u0674"},
+	{0x0679, 0x06DC, idnaValid, ""},
+	{0x06DD, 0x06DD, idnaDisallowed, ""},
+	{0x06DE, 0x070D, idnaValid, ""},
+	{0x070E, 0x070F, idnaDisallowed, ""},
+	{0x0710, 0x074A, idnaValid, ""},
+	{0x074B, 0x074C, idnaDisallowed, ""},
+	{0x074D, 0x07B1, idnaValid, ""},
+	{0x07B2, 0x07BF, idnaDisallowed, ""},
+	{0x07C0, 0x07FA, idnaValid, ""},
+	{0x07FB, 0x07FC, idnaDisallowed, ""},
+	{0x07FD, 0x082D, idnaValid, ""},
+	{0x082E, 0x082F, idnaDisallowed, ""},
+	{0x0830, 0x083E, idnaValid, ""},
+	{0x083F, 0x083F, idnaDisallowed, ""},
+	{0x0840, 0x085B, idnaValid, ""},
+	{0x085C, 0x085D, idnaDisallowed, ""},
+	{0x085E, 0x085E, idnaValid, ""},
+	{0x085F, 0x085F, idnaDisallowed, ""},
+	{0x0860, 0x086A, idnaValid, ""},
+	{0x086B, 0x086F, idnaDisallowed, ""},
+	{0x0870, 0x088F, idnaValid, ""},
+	{0x0890, 0x0896, idnaDisallowed, ""},
+	{0x0897, 0x08E1, idnaValid, ""},
+	{0x08E2, 0x08E2, idnaDisallowed, ""},
+	{0x08E3, 0x0957, idnaValid, ""},
+	{0x0958, 0x0958, idnaMapped, "\u0915\u093c"},
+	{0x0959, 0x0959, idnaMapped, "\u0916\u093c"},
+	{0x095A, 0x095A, idnaMapped, "\u0917\u093c"},
+	{0x095B, 0x095B, idnaMapped, "\u091c\u093c"},
+	{0x095C, 0x095C, idnaMapped, "\u0921\u093c"},
+	{0x095D, 0x095D, idnaMapped, "\u0922\u093c"},
+	{0x095E, 0x095E, idnaMapped, "\u092b\u093c"},
+	{0x095F, 0x095F, idnaMapped, "\u092f\u093c"},
+	{0x0960, 0x0983, idnaValid, ""},
+	{0x0984, 0x0984, idnaDisallowed, ""},
+	{0x0985, 0x098C, idnaValid, ""},
+	{0x098D, 0x098E, idnaDisallowed, ""},
+	{0x098F, 0x0990, idnaValid, ""},
+	{0x0991, 0x0992, idnaDisallowed, ""},
+	{0x0993, 0x09A8, idnaValid, ""},
+	{0x09A9, 0x09A9, idnaDisallowed, ""},
+	{0x09AA, 0x09B0, idnaValid, ""},
+	{0x09B1, 0x09B1, idnaDisallowed, ""},
+	{0x09B2, 0x09B2, idnaValid, ""},
+	{0x09B3, 0x09B5, idnaDisallowed, ""},
+	{0x09B6, 0x09B9, idnaValid, ""},
+	{0x09BA, 0x09BB, idnaDisallowed, ""},
+	{0x09BC, 0x09C4, idnaValid, ""},
+	{0x09C5, 0x09C6, idnaDisallowed, ""},
+	{0x09C7, 0x09C8, idnaValid, ""},
+	{0x09C9, 0x09CA, idnaDisallowed, ""},
+	{0x09CB, 0x09CE, idnaValid, ""},
+	{0x09CF, 0x09D6, idnaDisallowed, ""},
+	{0x09D7, 0x09D7, idnaValid, ""},
+	{0x09D8, 0x09DB, idnaDisallowed, ""},
+	{0x09DC, 0x09DC, idnaMapped, "\u09a1\u09bc"},
+	{0x09DD, 0x09DD, idnaMapped, "\u09a2\u09bc"},
+	{0x09DE, 0x09DE, idnaDisallowed, ""},
+	{0x09DF, 0x09DF, idnaMapped, "\u09af\u09bc"},
+	{0x09E0, 0x09E3, idnaValid, ""},
+	{0x09E4, 0x09E5, idnaDisallowed, ""},
+	{0x09E6, 0x09FE, idnaValid, ""},
+	{0x09FF, 0x0A00, idnaDisallowed, ""},
+	{0x0A01, 0x0A03, idnaValid, ""},
+	{0x0A04, 0x0A04, idnaDisallowed, ""},
+	{0x0A05, 0x0A0A, idnaValid, ""},
+	{0x0A0B, 0x0A0E, idnaDisallowed, ""},
+	{0x0A0F, 0x0A10, idnaValid, ""},
+	{0x0A11, 0x0A12, idnaDisallowed, ""},
+	{0x0A13, 0x0A28, idnaValid, ""},
+	{0x0A29, 0x0A29, idnaDisallowed, ""},
+	{0x0A2A, 0x0A30, idnaValid, ""},
+	{0x0A31, 0x0A31, idnaDisallowed, ""},
+	{0x0A32, 0x0A32, idnaValid, ""},
+	{0x0A33, 0x0A33, idnaMapped, "\u0a32\u0a3c"},
+	{0x0A34, 0x0A34, idnaDisallowed, ""},
+	{0x0A35, 0x0A35, idnaValid, ""},
+	{0x0A36, 0x0A36, idnaMapped, "\u0a38\u0a3c"},
+	{0x0A37, 0x0A37, idnaDisallowed, ""},
+	{0x0A38, 0x0A39, idnaValid, ""},
+	{0x0A3A, 0x0A3B, idnaDisallowed, ""},
+	{0x0A3C, 0x0A3C, idnaValid, ""},
+	{0x0A3D, 0x0A3D, idnaDisallowed, ""},
+	{0x0A3E, 0x0A42, idnaValid, ""},
+	{0x0A43, 0x0A46, idnaDisallowed, ""},
+	{0x0A47, 0x0A48, idnaValid, ""},
+	{0x0A49, 0x0A4A, idnaDisallowed, ""},
+	{0x0A4B, 0x0A4D, idnaValid, ""},
+	{0x0A4E, 0x0A50, idnaDisallowed, ""},
+	{0x0A51, 0x0A51, idnaValid, ""},
+	{0x0A52, 0x0A58, idnaDisallowed, ""},
+	{0x0A59, 0x0A59, idnaMapped, "\u0a16\u0a3c"},
+	{0x0A5A, 0x0A5A, idnaMapped, "\u0a17\u0a3c"},
+	{0x0A5B, 0x0A5B, idnaMapped, "\u0a1c\u0a3c"},
+	{0x0A5C, 0x0A5C, idnaValid, ""},
+	{0x0A5D, 0x0A5D, idnaDisallowed, ""},
+	{0x0A5E, 0x0A5E, idnaMapped, "\u0a2b\u0a3c"},
+	{0x0A5F, 0x0A65, idnaDisallowed, ""},
+	{0x0A66, 0x0A76, idnaValid, ""},
+	{0x0A77, 0x0A80, idnaDisallowed, ""},
+	{0x0A81, 0x0A83, idnaValid, ""},
+	{0x0A84, 0x0A84, idnaDisallowed, ""},
+	{0x0A85, 0x0A8D, idnaValid, ""},
+	{0x0A8E, 0x0A8E, idnaDisallowed, ""},
+	{0x0A8F, 0x0A91, idnaValid, ""},
+	{0x0A92, 0x0A92, idnaDisallowed, ""},
+	{0x0A93, 0x0AA8, idnaValid, ""},
+	{0x0AA9, 0x0AA9, idnaDisallowed, ""},
+	{0x0AAA, 0x0AB0, idnaValid, ""},
+	{0x0AB1, 0x0AB1, idnaDisallowed, ""},
+	{0x0AB2, 0x0AB3, idnaValid, ""},
+	{0x0AB4, 0x0AB4, idnaDisallowed, ""},
+	{0x0AB5, 0x0AB9, idnaValid, ""},
+	{0x0ABA, 0x0ABB, idnaDisallowed, ""},
+	{0x0ABC, 0x0AC5, idnaValid, ""},
+	{0x0AC6, 0x0AC6, idnaDisallowed, ""},
+	{0x0AC7, 0x0AC9, idnaValid, ""},
+	{0x0ACA, 0x0ACA, idnaDisallowed, ""},
+	{0x0ACB, 0x0ACD, idnaValid, ""},
+	{0x0ACE, 0x0ACF, idnaDisallowed, ""},
+	{0x0AD0, 0x0AD0, idnaValid, ""},
+	{0x0AD1, 0x0ADF, idnaDisallowed, ""},
+	{0x0AE0, 0x0AE3, idnaValid, ""},
+	{0x0AE4, 0x0AE5, idnaDisallowed, ""},
+	{0x0AE6, 0x0AF1, idnaValid, ""},
+	{0x0AF2, 0x0AF8, idnaDisallowed, ""},
+	{0x0AF9, 0x0AFF, idnaValid, ""},
+	{0x0B00, 0x0B00, idnaDisallowed, ""},
+	{0x0B01, 0x0B03, idnaValid, ""},
+	{0x0B04, 0x0B04, idnaDisallowed, ""},
+	{0x0B05, 0x0B0C, idnaValid, ""},
+	{0x0B0D, 0x0B0E, idnaDisallowed, ""},
+	{0x0B0F, 0x0B10, idnaValid, ""},
+	{0x0B11, 0x0B12, idnaDisallowed, ""},
+	{0x0B13, 0x0B28, idnaValid, ""},
+	{0x0B29, 0x0B29, idnaDisallowed, ""},
+	{0x0B2A, 0x0B30, idnaValid, ""},
+	{0x0B31, 0x0B31, idnaDisallowed, ""},
+	{0x0B32, 0x0B33, idnaValid, ""},
+	{0x0B34, 0x0B34, idnaDisallowed, ""},
+	{0x0B35, 0x0B39, idnaValid, ""},
+	{0x0B3A, 0x0B3B, idnaDisallowed, ""},
+	{0x0B3C, 0x0B44, idnaValid, ""},
+	{0x0B45, 0x0B46, idnaDisallowed, ""},
+	{0x0B47, 0x0B48, idnaValid, ""},
+	{0x0B49, 0x0B4A, idnaDisallowed, ""},
+	{0x0B4B, 0x0B4D, idnaValid, ""},
+	{0x0B4E, 0x0B54, idnaDisallowed, ""},
+	{0x0B55, 0x0B57, idnaValid, ""},
+	{0x0B58, 0x0B5B, idnaDisallowed, ""},
+	{0x0B5C, 0x0B5C, idnaMapped, "\u0b21\u0b3c"},
+	{0x0B5D, 0x0B5D, idnaMapped, "\u0b22\u0b3c"},
+	{0x0B5E, 0x0B5E, idnaDisallowed, ""},
+	{0x0B5F, 0x0B63, idnaValid, ""},
+	{0x0B64, 0x0B65, idnaDisallowed, ""},
+	{0x0B66, 0x0B77, idnaValid, ""},
+	{0x0B78, 0x0B81, idnaDisallowed, ""},
+	{0x0B82, 0x0B83, idnaValid, ""},
+	{0x0B84, 0x0B84, idnaDisallowed, ""},
+	{0x0B85, 0x0B8A, idnaValid, ""},
+	{0x0B8B, 0x0B8D, idnaDisallowed, ""},
+	{0x0B8E, 0x0B90, idnaValid, ""},
+	{0x0B91, 0x0B91, idnaDisallowed, ""},
+	{0x0B92, 0x0B95, idnaValid, ""},
+	{0x0B96, 0x0B98, idnaDisallowed, ""},
+	{0x0B99, 0x0B9A, idnaValid, ""},
+	{0x0B9B, 0x0B9B, idnaDisallowed, ""},
+	{0x0B9C, 0x0B9C, idnaValid, ""},
+	{0x0B9D, 0x0B9D, idnaDisallowed, ""},
+	{0x0B9E, 0x0B9F, idnaValid, ""},
+	{0x0BA0, 0x0BA2, idnaDisallowed, ""},
+	{0x0BA3, 0x0BA4, idnaValid, ""},
+	{0x0BA5, 0x0BA7, idnaDisallowed, ""},
+	{0x0BA8, 0x0BAA, idnaValid, ""},
+	{0x0BAB, 0x0BAD, idnaDisallowed, ""},
+	{0x0BAE, 0x0BB9, idnaValid, ""},
+	{0x0BBA, 0x0BBD, idnaDisallowed, ""},
+	{0x0BBE, 0x0BC2, idnaValid, ""},
+	{0x0BC3, 0x0BC5, idnaDisallowed, ""},
+	{0x0BC6, 0x0BC8, idnaValid, ""},
+	{0x0BC9, 0x0BC9, idnaDisallowed, ""},
+	{0x0BCA, 0x0BCD, idnaValid, ""},
+	{0x0BCE, 0x0BCF, idnaDisallowed, ""},
+	{0x0BD0, 0x0BD0, idnaValid, ""},
+	{0x0BD1, 0x0BD6, idnaDisallowed, ""},
+	{0x0BD7, 0x0BD7, idnaValid, ""},
+	{0x0BD8, 0x0BE5, idnaDisallowed, ""},
+	{0x0BE6, 0x0BFA, idnaValid, ""},
+	{0x0BFB, 0x0BFF, idnaDisallowed, ""},
+	{0x0C00, 0x0C0C, idnaValid, ""},
+	{0x0C0D, 0x0C0D, idnaDisallowed, ""},
+	{0x0C0E, 0x0C10, idnaValid, ""},
+	{0x0C11, 0x0C11, idnaDisallowed, ""},
+	{0x0C12, 0x0C28, idnaValid, ""},
+	{0x0C29, 0x0C29, idnaDisallowed, ""},
+	{0x0C2A, 0x0C39, idnaValid, ""},
+	{0x0C3A, 0x0C3B, idnaDisallowed, ""},
+	{0x0C3C, 0x0C44, idnaValid, ""},
+	{0x0C45, 0x0C45, idnaDisallowed, ""},
+	{0x0C46, 0x0C48, idnaValid, ""},
+	{0x0C49, 0x0C49, idnaDisallowed, ""},
+	{0x0C4A, 0x0C4D, idnaValid, ""},
+	{0x0C4E, 0x0C54, idnaDisallowed, ""},
+	{0x0C55, 0x0C56, idnaValid, ""},
+	{0x0C57, 0x0C57, idnaDisallowed, ""},
+	{0x0C58, 0x0C5A, idnaValid, ""},
+	{0x0C5B, 0x0C5B, idnaDisallowed, ""},
+	{0x0C5C, 0x0C5D, idnaValid, ""},
+	{0x0C5E, 0x0C5F, idnaDisallowed, ""},
+	{0x0C60, 0x0C63, idnaValid, ""},
+	{0x0C64, 0x0C65, idnaDisallowed, ""},
+	{0x0C66, 0x0C6F, idnaValid, ""},
+	{0x0C70, 0x0C76, idnaDisallowed, ""},
+	{0x0C77, 0x0C8C, idnaValid, ""},
+	{0x0C8D, 0x0C8D, idnaDisallowed, ""},
+	{0x0C8E, 0x0C90, idnaValid, ""},
+	{0x0C91, 0x0C91, idnaDisallowed, ""},
+	{0x0C92, 0x0CA8, idnaValid, ""},
+	{0x0CA9, 0x0CA9, idnaDisallowed, ""},
+	{0x0CAA, 0x0CB3, idnaValid, ""},
+	{0x0CB4, 0x0CB4, idnaDisallowed, ""},
+	{0x0CB5, 0x0CB9, idnaValid, ""},
+	{0x0CBA, 0x0CBB, idnaDisallowed, ""},
+	{0x0CBC, 0x0CC4, idnaValid, ""},
+	{0x0CC5, 0x0CC5, idnaDisallowed, ""},
+	{0x0CC6, 0x0CC8, idnaValid, ""},
+	{0x0CC9, 0x0CC9, idnaDisallowed, ""},
+	{0x0CCA, 0x0CCD, idnaValid, ""},
+	{0x0CCE, 0x0CD4, idnaDisallowed, ""},
+	{0x0CD5, 0x0CD6, idnaValid, ""},
+	{0x0CD7, 0x0CDB, idnaDisallowed, ""},
+	{0x0CDC, 0x0CDE, idnaValid, ""},
+	{0x0CDF, 0x0CDF, idnaDisallowed, ""},
+	{0x0CE0, 0x0CE3, idnaValid, ""},
+	{0x0CE4, 0x0CE5, idnaDisallowed, ""},
+	{0x0CE6, 0x0CEF, idnaValid, ""},
+	{0x0CF0, 0x0CF0, idnaDisallowed, ""},
+	{0x0CF1, 0x0CF3, idnaValid, ""},
+	{0x0CF4, 0x0CFF, idnaDisallowed, ""},
+	{0x0D00, 0x0D0C, idnaValid, ""},
+	{0x0D0D, 0x0D0D, idnaDisallowed, ""},
+	{0x0D0E, 0x0D10, idnaValid, ""},
+	{0x0D11, 0x0D11, idnaDisallowed, ""},
+	{0x0D12, 0x0D44, idnaValid, ""},
+	{0x0D45, 0x0D45, idnaDisallowed, ""},
+	{0x0D46, 0x0D48, idnaValid, ""},
+	{0x0D49, 0x0D49, idnaDisallowed, ""},
+	{0x0D4A, 0x0D4F, idnaValid, ""},
+	{0x0D50, 0x0D53, idnaDisallowed, ""},
+	{0x0D54, 0x0D63, idnaValid, ""},
+	{0x0D64, 0x0D65, idnaDisallowed, ""},
+	{0x0D66, 0x0D7F, idnaValid, ""},
+	{0x0D80, 0x0D80, idnaDisallowed, ""},
+	{0x0D81, 0x0D83, idnaValid, ""},
+	{0x0D84, 0x0D84, idnaDisallowed, ""},
+	{0x0D85, 0x0D96, idnaValid, ""},
+	{0x0D97, 0x0D99, idnaDisallowed, ""},
+	{0x0D9A, 0x0DB1, idnaValid, ""},
+	{0x0DB2, 0x0DB2, idnaDisallowed, ""},
+	{0x0DB3, 0x0DBB, idnaValid, ""},
+	{0x0DBC, 0x0DBC, idnaDisallowed, ""},
+	{0x0DBD, 0x0DBD, idnaValid, ""},
+	{0x0DBE, 0x0DBF, idnaDisallowed, ""},
+	{0x0DC0, 0x0DC6, idnaValid, ""},
+	{0x0DC7, 0x0DC9, idnaDisallowed, ""},
+	{0x0DCA, 0x0DCA, idnaValid, ""},
+	{0x0DCB, 0x0DCE, idnaDisallowed, ""},
+	{0x0DCF, 0x0DD4, idnaValid, ""},
+	{0x0DD5, 0x0DD5, idnaDisallowed, ""},
+	{0x0DD6, 0x0DD6, idnaValid, ""},
+	{0x0DD7, 0x0DD7, idnaDisallowed, ""},
+	{0x0DD8, 0x0DDF, idnaValid, ""},
+	{0x0DE0, 0x0DE5, idnaDisallowed, ""},
+	{0x0DE6, 0x0DEF, idnaValid, ""},
+	{0x0DF0, 0x0DF1, idnaDisallowed, ""},
+	{0x0DF2, 0x0DF4, idnaValid, ""},
+	{0x0DF5, 0x0E00, idnaDisallowed, ""},
+	{0x0E01, 0x0E32, idnaValid, ""},
+	{0x0E33, 0x0E33, idnaMapped, "\u0e4d\u0e32"},
+	{0x0E34, 0x0E3A, idnaValid, ""},
+	{0x0E3B, 0x0E3E, idnaDisallowed, ""},
+	{0x0E3F, 0x0E5B, idnaValid, ""},
+	{0x0E5C, 0x0E80, idnaDisallowed, ""},
+	{0x0E81, 0x0E82, idnaValid, ""},
+	{0x0E83, 0x0E83, idnaDisallowed, ""},
+	{0x0E84, 0x0E84, idnaValid, ""},
+	{0x0E85, 0x0E85, idnaDisallowed, ""},
+	{0x0E86, 0x0E8A, idnaValid, ""},
+	{0x0E8B, 0x0E8B, idnaDisallowed, ""},
+	{0x0E8C, 0x0EA3, idnaValid, ""},
+	{0x0EA4, 0x0EA4, idnaDisallowed, ""},
+	{0x0EA5, 0x0EA5, idnaValid, ""},
+	{0x0EA6, 0x0EA6, idnaDisallowed, ""},
+	{0x0EA7, 0x0EB2, idnaValid, ""},
+	{0x0EB3, 0x0EB3, idnaMapped, "\u0ecd\u0eb2"},
+	{0x0EB4, 0x0EBD, idnaValid, ""},
+	{0x0EBE, 0x0EBF, idnaDisallowed, ""},
+	{0x0EC0, 0x0EC4, idnaValid, ""},
+	{0x0EC5, 0x0EC5, idnaDisallowed, ""},
+	{0x0EC6, 0x0EC6, idnaValid, ""},
+	{0x0EC7, 0x0EC7, idnaDisallowed, ""},
+	{0x0EC8, 0x0ECE, idnaValid, ""},
+	{0x0ECF, 0x0ECF, idnaDisallowed, ""},
+	{0x0ED0, 0x0ED9, idnaValid, ""},
+	{0x0EDA, 0x0EDB, idnaDisallowed, ""},
+	{0x0EDC, 0x0EDC, idnaMapped, "\u0eab\u0e99"},
+	{0x0EDD, 0x0EDD, idnaMapped, "\u0eab\u0ea1"},
+	{0x0EDE, 0x0EDF, idnaValid, ""},
+	{0x0EE0, 0x0EFF, idnaDisallowed, ""},
+	{0x0F00, 0x0F0B, idnaValid, ""},
+	{0x0F0C, 0x0F0C, idnaMapped, "\u0f0b"},
+	{0x0F0D, 0x0F42, idnaValid, ""},
+	{0x0F43, 0x0F43, idnaMapped, "\u0f42\u0fb7"},
+	{0x0F44, 0x0F47, idnaValid, ""},
+	{0x0F48, 0x0F48, idnaDisallowed, ""},
+	{0x0F49, 0x0F4C, idnaValid, ""},
+	{0x0F4D, 0x0F4D, idnaMapped, "\u0f4c\u0fb7"},
+	{0x0F4E, 0x0F51, idnaValid, ""},
+	{0x0F52, 0x0F52, idnaMapped, "\u0f51\u0fb7"},
+	{0x0F53, 0x0F56, idnaValid, ""},
+	{0x0F57, 0x0F57, idnaMapped, "\u0f56\u0fb7"},
+	{0x0F58, 0x0F5B, idnaValid, ""},
+	{0x0F5C, 0x0F5C, idnaMapped, "\u0f5b\u0fb7"},
+	{0x0F5D, 0x0F68, idnaValid, ""},
+	{0x0F69, 0x0F69, idnaMapped, "\u0f40\u0fb5"},
+	{0x0F6A, 0x0F6C, idnaValid, ""},
+	{0x0F6D, 0x0F70, idnaDisallowed, ""},
+	{0x0F71, 0x0F72, idnaValid, ""},
+	{0x0F73, 0x0F73, idnaMapped, "\u0f71\u0f72"},
+	{0x0F74, 0x0F74, idnaValid, ""},
+	{0x0F75, 0x0F75, idnaMapped, "\u0f71\u0f74"},
+	{0x0F76, 0x0F76, idnaMapped, "\u0fb2\u0f80"},
+	{0x0F77, 0x0F77, idnaMapped, "\u0fb2\u0f71\u0f80"},
+	{0x0F78, 0x0F78, idnaMapped, "\u0fb3\u0f80"},
+	{0x0F79, 0x0F79, idnaMapped, "\u0fb3\u0f71\u0f80"},
+	{0x0F7A, 0x0F80, idnaValid, ""},
+	{0x0F81, 0x0F81, idnaMapped, "\u0f71\u0f80"},
+	{0x0F82, 0x0F92, idnaValid, ""},
+	{0x0F93, 0x0F93, idnaMapped, "\u0f92\u0fb7"},
+	{0x0F94, 0x0F97, idnaValid, ""},
+	{0x0F98, 0x0F98, idnaDisallowed, ""},
+	{0x0F99, 0x0F9C, idnaValid, ""},
+	{0x0F9D, 0x0F9D, idnaMapped, "\u0f9c\u0fb7"},
+	{0x0F9E, 0x0FA1, idnaValid, ""},
+	{0x0FA2, 0x0FA2, idnaMapped, "\u0fa1\u0fb7"},
+	{0x0FA3, 0x0FA6, idnaValid, ""},
+	{0x0FA7, 0x0FA7, idnaMapped, "\u0fa6\u0fb7"},
+	{0x0FA8, 0x0FAB, idnaValid, ""},
+	{0x0FAC, 0x0FAC, idnaMapped, "\u0fab\u0fb7"},
+	{0x0FAD, 0x0FB8, idnaValid, ""},
+	{0x0FB9, 0x0FB9, idnaMapped, "\u0f90\u0fb5"},
+	{0x0FBA, 0x0FBC, idnaValid, ""},
+	{0x0FBD, 0x0FBD, idnaDisallowed, ""},
+	{0x0FBE, 0x0FCC, idnaValid, ""},
+	{0x0FCD, 0x0FCD, idnaDisallowed, ""},
+	{0x0FCE, 0x0FDA, idnaValid, ""},
+	{0x0FDB, 0x0FFF, idnaDisallowed, ""},
+	{0x1000, 0x109F, idnaValid, ""},
+	{0x10A0, 0x10A0, idnaMapped, "\u2d00"},
+	{0x10A1, 0x10A1, idnaMapped, "\u2d01"},
+	{0x10A2, 0x10A2, idnaMapped, "\u2d02"},
+	{0x10A3, 0x10A3, idnaMapped, "\u2d03"},
+	{0x10A4, 0x10A4, idnaMapped, "\u2d04"},
+	{0x10A5, 0x10A5, idnaMapped, "\u2d05"},
+	{0x10A6, 0x10A6, idnaMapped, "\u2d06"},
+	{0x10A7, 0x10A7, idnaMapped, "\u2d07"},
+	{0x10A8, 0x10A8, idnaMapped, "\u2d08"},
+	{0x10A9, 0x10A9, idnaMapped, "\u2d09"},
+	{0x10AA, 0x10AA, idnaMapped, "\u2d0a"},
+	{0x10AB, 0x10AB, idnaMapped, "\u2d0b"},
+	{0x10AC, 0x10AC, idnaMapped, "\u2d0c"},
+	{0x10AD, 0x10AD, idnaMapped, "\u2d0d"},
+	{0x10AE, 0x10AE, idnaMapped, "\u2d0e"},
+	{0x10AF, 0x10AF, idnaMapped, "\u2d0f"},
+	{0x10B0, 0x10B0, idnaMapped, "\u2d10"},
+	{0x10B1, 0x10B1, idnaMapped, "\u2d11"},
+	{0x10B2, 0x10B2, idnaMapped, "\u2d12"},
+	{0x10B3, 0x10B3, idnaMapped, "\u2d13"},
+	{0x10B4, 0x10B4, idnaMapped, "\u2d14"},
+	{0x10B5, 0x10B5, idnaMapped, "\u2d15"},
+	{0x10B6, 0x10B6, idnaMapped, "\u2d16"},
+	{0x10B7, 0x10B7, idnaMapped, "\u2d17"},
+	{0x10B8, 0x10B8, idnaMapped, "\u2d18"},
+	{0x10B9, 0x10B9, idnaMapped, "\u2d19"},
+	{0x10BA, 0x10BA, idnaMapped, "\u2d1a"},
+	{0x10BB, 0x10BB, idnaMapped, "\u2d1b"},
+	{0x10BC, 0x10BC, idnaMapped, "\u2d1c"},
+	{0x10BD, 0x10BD, idnaMapped, "\u2d1d"},
+	{0x10BE, 0x10BE, idnaMapped, "\u2d1e"},
+	{0x10BF, 0x10BF, idnaMapped, "\u2d1f"},
+	{0x10C0, 0x10C0, idnaMapped, "\u2d20"},
+	{0x10C1, 0x10C1, idnaMapped, "\u2d21"},
+	{0x10C2, 0x10C2, idnaMapped, "\u2d22"},
+	{0x10C3, 0x10C3, idnaMapped, "\u2d23"},
+	{0x10C4, 0x10C4, idnaMapped, "\u2d24"},
+	{0x10C5, 0x10C5, idnaMapped, "\u2d25"},
+	{0x10C6, 0x10C6, idnaDisallowed, ""},
+	{0x10C7, 0x10C7, idnaMapped, "\u2d27"},
+	{0x10C8, 0x10CC, idnaDisallowed, ""},
+	{0x10CD, 0x10CD, idnaMapped, "\u2d2d"},
+	{0x10CE, 0x10CF, idnaDisallowed, ""},
+	{0x10D0, 0x10FB, idnaValid, ""},
+	{0x10FC, 0x10FC, idnaMapped, "\u10dc"},
+	{0x10FD, 0x115E, idnaValid, ""},
+	{0x115F, 0x1160, idnaIgnored, ""},
+	{0x1161, 0x1248, idnaValid, ""},
+	{0x1249, 0x1249, idnaDisallowed, ""},
+	{0x124A, 0x124D, idnaValid, ""},
+	{0x124E, 0x124F, idnaDisallowed, ""},
+	{0x1250, 0x1256, idnaValid, ""},
+	{0x1257, 0x1257, idnaDisallowed, ""},
+	{0x1258, 0x1258, idnaValid, ""},
+	{0x1259, 0x1259, idnaDisallowed, ""},
+	{0x125A, 0x125D, idnaValid, ""},
+	{0x125E, 0x125F, idnaDisallowed, ""},
+	{0x1260, 0x1288, idnaValid, ""},
+	{0x1289, 0x1289, idnaDisallowed, ""},
+	{0x128A, 0x128D, idnaValid, ""},
+	{0x128E, 0x128F, idnaDisallowed, ""},
+	{0x1290, 0x12B0, idnaValid, ""},
+	{0x12B1, 0x12B1, idnaDisallowed, ""},
+	{0x12B2, 0x12B5, idnaValid, ""},
+	{0x12B6, 0x12B7, idnaDisallowed, ""},
+	{0x12B8, 0x12BE, idnaValid, ""},
+	{0x12BF, 0x12BF, idnaDisallowed, ""},
+	{0x12C0, 0x12C0, idnaValid, ""},
+	{0x12C1, 0x12C1, idnaDisallowed, ""},
+	{0x12C2, 0x12C5, idnaValid, ""},
+	{0x12C6, 0x12C7, idnaDisallowed, ""},
+	{0x12C8, 0x12D6, idnaValid, ""},
+	{0x12D7, 0x12D7, idnaDisallowed, ""},
+	{0x12D8, 0x1310, idnaValid, ""},
+	{0x1311, 0x1311, idnaDisallowed, ""},
+	{0x1312, 0x1315, idnaValid, ""},
+	{0x1316, 0x1317, idnaDisallowed, ""},
+	{0x1318, 0x135A, idnaValid, ""},
+	{0x135B, 0x135C, idnaDisallowed, ""},
+	{0x135D, 0x137C, idnaValid, ""},
+	{0x137D, 0x137F, idnaDisallowed, ""},
+	{0x1380, 0x1399, idnaValid, ""},
+	{0x139A, 0x139F, idnaDisallowed, ""},
+	{0x13A0, 0x13F5, idnaValid, ""},
+	{0x13F6, 0x13F7, idnaDisallowed, ""},
+	{0x13F8, 0x13F8, idnaMapped, "\u13f0"},
+	{0x13F9, 0x13F9, idnaMapped, "\u13f1"},
+	{0x13FA, 0x13FA, idnaMapped, "\u13f2"},
+	{0x13FB, 0x13FB, idnaMapped, "\u13f3"},
+	{0x13FC, 0x13FC, idnaMapped, "\u13f4"},
+	{0x13FD, 0x13FD, idnaMapped, "\u13f5"},
+	{0x13FE, 0x13FF, idnaDisallowed, ""},
+	{0x1400, 0x167F, idnaValid, ""},
+	{0x1680, 0x1680, idnaDisallowed, ""},
+	{0x1681, 0x169C, idnaValid, ""},
+	{0x169D, 0x169F, idnaDisallowed, ""},
+	{0x16A0, 0x16F8, idnaValid, ""},
+	{0x16F9, 0x16FF, idnaDisallowed, ""},
+	{0x1700, 0x1715, idnaValid, ""},
+	{0x1716, 0x171E, idnaDisallowed, ""},
+	{0x171F, 0x1736, idnaValid, ""},
+	{0x1737, 0x173F, idnaDisallowed, ""},
+	{0x1740, 0x1753, idnaValid, ""},
+	{0x1754, 0x175F, idnaDisallowed, ""},
+	{0x1760, 0x176C, idnaValid, ""},
+	{0x176D, 0x176D, idnaDisallowed, ""},
+	{0x176E, 0x1770, idnaValid, ""},
+	{0x1771, 0x1771, idnaDisallowed, ""},
+	{0x1772, 0x1773, idnaValid, ""},
+	{0x1774, 0x177F, idnaDisallowed, ""},
+	{0x1780, 0x17B3, idnaValid, ""},
+	{0x17B4, 0x17B5, idnaIgnored, ""},
+	{0x17B6, 0x17DD, idnaValid, ""},
+	{0x17DE, 0x17DF, idnaDisallowed, ""},
+	{0x17E0, 0x17E9, idnaValid, ""},
+	{0x17EA, 0x17EF, idnaDisallowed, ""},
+	{0x17F0, 0x17F9, idnaValid, ""},
+	{0x17FA, 0x17FF, idnaDisallowed, ""},
+	{0x1800, 0x180A, idnaValid, ""},
+	{0x180B, 0x180F, idnaIgnored, ""},
+	{0x1810, 0x1819, idnaValid, ""},
+	{0x181A, 0x181F, idnaDisallowed, ""},
+	{0x1820, 0x1878, idnaValid, ""},
+	{0x1879, 0x187F, idnaDisallowed, ""},
+	{0x1880, 0x18AA, idnaValid, ""},
+	{0x18AB, 0x18AF, idnaDisallowed, ""},
+	{0x18B0, 0x18F5, idnaValid, ""},
+	{0x18F6, 0x18FF, idnaDisallowed, ""},
+	{0x1900, 0x191E, idnaValid, ""},
+	{0x191F, 0x191F, idnaDisallowed, ""},
+	{0x1920, 0x192B, idnaValid, ""},
+	{0x192C, 0x192F, idnaDisallowed, ""},
+	{0x1930, 0x193B, idnaValid, ""},
+	{0x193C, 0x193F, idnaDisallowed, ""},
+	{0x1940, 0x1940, idnaValid, ""},
+	{0x1941, 0x1943, idnaDisallowed, ""},
+	{0x1944, 0x196D, idnaValid, ""},
+	{0x196E, 0x196F, idnaDisallowed, ""},
+	{0x1970, 0x1974, idnaValid, ""},
+	{0x1975, 0x197F, idnaDisallowed, ""},
+	{0x1980, 0x19AB, idnaValid, ""},
+	{0x19AC, 0x19AF, idnaDisallowed, ""},
+	{0x19B0, 0x19C9, idnaValid, ""},
+	{0x19CA, 0x19CF, idnaDisallowed, ""},
+	{0x19D0, 0x19DA, idnaValid, ""},
+	{0x19DB, 0x19DD, idnaDisallowed, ""},
+	{0x19DE, 0x1A1B, idnaValid, ""},
+	{0x1A1C, 0x1A1D, idnaDisallowed, ""},
+	{0x1A1E, 0x1A5E, idnaValid, ""},
+	{0x1A5F, 0x1A5F, idnaDisallowed, ""},
+	{0x1A60, 0x1A7C, idnaValid, ""},
+	{0x1A7D, 0x1A7E, idnaDisallowed, ""},
+	{0x1A7F, 0x1A89, idnaValid, ""},
+	{0x1A8A, 0x1A8F, idnaDisallowed, ""},
+	{0x1A90, 0x1A99, idnaValid, ""},
+	{0x1A9A, 0x1A9F, idnaDisallowed, ""},
+	{0x1AA0, 0x1AAD, idnaValid, ""},
+	{0x1AAE, 0x1AAF, idnaDisallowed, ""},
+	{0x1AB0, 0x1ADD, idnaValid, ""},
+	{0x1ADE, 0x1ADF, idnaDisallowed, ""},
+	{0x1AE0, 0x1AEB, idnaValid, ""},
+	{0x1AEC, 0x1AFF, idnaDisallowed, ""},
+	{0x1B00, 0x1B4C, idnaValid, ""},
+	{0x1B4D, 0x1B4D, idnaDisallowed, ""},
+	{0x1B4E, 0x1BF3, idnaValid, ""},
+	{0x1BF4, 0x1BFB, idnaDisallowed, ""},
+	{0x1BFC, 0x1C37, idnaValid, ""},
+	{0x1C38, 0x1C3A, idnaDisallowed, ""},
+	{0x1C3B, 0x1C49, idnaValid, ""},
+	{0x1C4A, 0x1C4C, idnaDisallowed, ""},
+	{0x1C4D, 0x1C7F, idnaValid, ""},
+	{0x1C80, 0x1C80, idnaMapped, "\u0432"},
+	{0x1C81, 0x1C81, idnaMapped, "\u0434"},
+	{0x1C82, 0x1C82, idnaMapped, "\u043e"},
+	{0x1C83, 0x1C83, idnaMapped, "\u0441"},
+	{0x1C84, 0x1C84, idnaMapped, "\u0442"},
+	{0x1C85, 0x1C85, idnaMapped, "\u0442"},
+	{0x1C86, 0x1C86, idnaMapped, "\u044a"},
+	{0x1C87, 0x1C87, idnaMapped, "\u0463"},
+	{0x1C88, 0x1C88, idnaMapped, "\ua64b"},
+	{0x1C89, 0x1C89, idnaMapped, "\u1c8a"},
+	{0x1C8A, 0x1C8A, idnaValid, ""},
+	{0x1C8B, 0x1C8F, idnaDisallowed, ""},
+	{0x1C90, 0x1C90, idnaMapped, "\u10d0"},
+	{0x1C91, 0x1C91, idnaMapped, "\u10d1"},
+	{0x1C92, 0x1C92, idnaMapped, "\u10d2"},
+	{0x1C93, 0x1C93, idnaMapped, "\u10d3"},
+	{0x1C94, 0x1C94, idnaMapped, "\u10d4"},
+	{0x1C95, 0x1C95, idnaMapped, "\u10d5"},
+	{0x1C96, 0x1C96, idnaMapped, "\u10d6"},
+	{0x1C97, 0x1C97, idnaMapped, "\u10d7"},
+	{0x1C98, 0x1C98, idnaMapped, "\u10d8"},
+	{0x1C99, 0x1C99, idnaMapped, "\u10d9"},
+	{0x1C9A, 0x1C9A, idnaMapped, "\u10da"},
+	{0x1C9B, 0x1C9B, idnaMapped, "\u10db"},
+	{0x1C9C, 0x1C9C, idnaMapped, "\u10dc"},
+	{0x1C9D, 0x1C9D, idnaMapped, "\u10dd"},
+	{0x1C9E, 0x1C9E, idnaMapped, "\u10de"},
+	{0x1C9F, 0x1C9F, idnaMapped, "\u10df"},
+	{0x1CA0, 0x1CA0, idnaMapped, "\u10e0"},
+	{0x1CA1, 0x1CA1, idnaMapped, "\u10e1"},
+	{0x1CA2, 0x1CA2, idnaMapped, "\u10e2"},
+	{0x1CA3, 0x1CA3, idnaMapped, "\u10e3"},
+	{0x1CA4, 0x1CA4, idnaMapped, "\u10e4"},
+	{0x1CA5, 0x1CA5, idnaMapped, "\u10e5"},
+	{0x1CA6, 0x1CA6, idnaMapped, "\u10e6"},
+	{0x1CA7, 0x1CA7, idnaMapped, "\u10e7"},
+	{0x1CA8, 0x1CA8, idnaMapped, "\u10e8"},
+	{0x1CA9, 0x1CA9, idnaMapped, "\u10e9"},
+	{0x1CAA, 0x1CAA, idnaMapped, "\u10ea"},
+	{0x1CAB, 0x1CAB, idnaMapped, "\u10eb"},
+	{0x1CAC, 0x1CAC, idnaMapped, "\u10ec"},
+	{0x1CAD, 0x1CAD, idnaMapped, "\u10ed"},
+	{0x1CAE, 0x1CAE, idnaMapped, "\u10ee"},
+	{0x1CAF, 0x1CAF, idnaMapped, "\u10ef"},
+	{0x1CB0, 0x1CB0, idnaMapped, "\u10f0"},
+	{0x1CB1, 0x1CB1, idnaMapped, "\u10f1"},
+	{0x1CB2, 0x1CB2, idnaMapped, "\u10f2"},
+	{0x1CB3, 0x1CB3, idnaMapped, "\u10f3"},
+	{0x1CB4, 0x1CB4, idnaMapped, "\u10f4"},
+	{0x1CB5, 0x1CB5, idnaMapped, "\u10f5"},
+	{0x1CB6, 0x1CB6, idnaMapped, "\u10f6"},
+	{0x1CB7, 0x1CB7, idnaMapped, "\u10f7"},
+	{0x1CB8, 0x1CB8, idnaMapped, "\u10f8"},
+	{0x1CB9, 0x1CB9, idnaMapped, "\u10f9"},
+	{0x1CBA, 0x1CBA, idnaMapped, "\u10fa"},
+	{0x1CBB, 0x1CBC, idnaDisallowed, ""},
+	{0x1CBD, 0x1CBD, idnaMapped, "\u10fd"},
+	{0x1CBE, 0x1CBE, idnaMapped, "\u10fe"},
+	{0x1CBF, 0x1CBF, idnaMapped, "\u10ff"},
+	{0x1CC0, 0x1CC7, idnaValid, ""},
+	{0x1CC8, 0x1CCF, idnaDisallowed, ""},
+	{0x1CD0, 0x1CFA, idnaValid, ""},
+	{0x1CFB, 0x1CFF, idnaDisallowed, ""},
+	{0x1D00, 0x1D2B, idnaValid, ""},
+	{0x1D2C, 0x1D2C, idnaMapped, "a"},
+	{0x1D2D, 0x1D2D, idnaMapped, "\u00e6"},
+	{0x1D2E, 0x1D2E, idnaMapped, "b"},
+	{0x1D2F, 0x1D2F, idnaValid, ""},
+	{0x1D30, 0x1D30, idnaMapped, "d"},
+	{0x1D31, 0x1D31, idnaMapped, "e"},
+	{0x1D32, 0x1D32, idnaMapped, "\u01dd"},
+	{0x1D33, 0x1D33, idnaMapped, "g"},
+	{0x1D34, 0x1D34, idnaMapped, "h"},
+	{0x1D35, 0x1D35, idnaMapped, "i"},
+	{0x1D36, 0x1D36, idnaMapped, "j"},
+	{0x1D37, 0x1D37, idnaMapped, "k"},
+	{0x1D38, 0x1D38, idnaMapped, "l"},
+	{0x1D39, 0x1D39, idnaMapped, "m"},
+	{0x1D3A, 0x1D3A, idnaMapped, "n"},
+	{0x1D3B, 0x1D3B, idnaValid, ""},
+	{0x1D3C, 0x1D3C, idnaMapped, "o"},
+	{0x1D3D, 0x1D3D, idnaMapped, "\u0223"},
+	{0x1D3E, 0x1D3E, idnaMapped, "p"},
+	{0x1D3F, 0x1D3F, idnaMapped, "r"},
+	{0x1D40, 0x1D40, idnaMapped, "t"},
+	{0x1D41, 0x1D41, idnaMapped, "u"},
+	{0x1D42, 0x1D42, idnaMapped, "w"},
+	{0x1D43, 0x1D43, idnaMapped, "a"},
+	{0x1D44, 0x1D44, idnaMapped, "\u0250"},
+	{0x1D45, 0x1D45, idnaMapped, "\u0251"},
+	{0x1D46, 0x1D46, idnaMapped, "\u1d02"},
+	{0x1D47, 0x1D47, idnaMapped, "b"},
+	{0x1D48, 0x1D48, idnaMapped, "d"},
+	{0x1D49, 0x1D49, idnaMapped, "e"},
+	{0x1D4A, 0x1D4A, idnaMapped, "\u0259"},
+	{0x1D4B, 0x1D4B, idnaMapped, "\u025b"},
+	{0x1D4C, 0x1D4C, idnaMapped, "\u025c"},
+	{0x1D4D, 0x1D4D, idnaMapped, "g"},
+	{0x1D4E, 0x1D4E, idnaValid, ""},
+	{0x1D4F, 0x1D4F, idnaMapped, "k"},
+	{0x1D50, 0x1D50, idnaMapped, "m"},
+	{0x1D51, 0x1D51, idnaMapped, "\u014b"},
+	{0x1D52, 0x1D52, idnaMapped, "o"},
+	{0x1D53, 0x1D53, idnaMapped, "\u0254"},
+	{0x1D54, 0x1D54, idnaMapped, "\u1d16"},
+	{0x1D55, 0x1D55, idnaMapped, "\u1d17"},
+	{0x1D56, 0x1D56, idnaMapped, "p"},
+	{0x1D57, 0x1D57, idnaMapped, "t"},
+	{0x1D58, 0x1D58, idnaMapped, "u"},
+	{0x1D59, 0x1D59, idnaMapped, "\u1d1d"},
+	{0x1D5A, 0x1D5A, idnaMapped, "\u026f"},
+	{0x1D5B, 0x1D5B, idnaMapped, "v"},
+	{0x1D5C, 0x1D5C, idnaMapped, "\u1d25"},
+	{0x1D5D, 0x1D5D, idnaMapped, "\u03b2"},
+	{0x1D5E, 0x1D5E, idnaMapped, "\u03b3"},
+	{0x1D5F, 0x1D5F, idnaMapped, "\u03b4"},
+	{0x1D60, 0x1D60, idnaMapped, "\u03c6"},
+	{0x1D61, 0x1D61, idnaMapped, "\u03c7"},
+	{0x1D62, 0x1D62, idnaMapped, "i"},
+	{0x1D63, 0x1D63, idnaMapped, "r"},
+	{0x1D64, 0x1D64, idnaMapped, "u"},
+	{0x1D65, 0x1D65, idnaMapped, "v"},
+	{0x1D66, 0x1D66, idnaMapped, "\u03b2"},
+	{0x1D67, 0x1D67, idnaMapped, "\u03b3"},
+	{0x1D68, 0x1D68, idnaMapped, "\u03c1"},
+	{0x1D69, 0x1D69, idnaMapped, "\u03c6"},
+	{0x1D6A, 0x1D6A, idnaMapped, "\u03c7"},
+	{0x1D6B, 0x1D77, idnaValid, ""},
+	{0x1D78, 0x1D78, idnaMapped, "\u043d"},
+	{0x1D79, 0x1D9A, idnaValid, ""},
+	{0x1D9B, 0x1D9B, idnaMapped, "\u0252"},
+	{0x1D9C, 0x1D9C, idnaMapped, "c"},
+	{0x1D9D, 0x1D9D, idnaMapped, "\u0255"},
+	{0x1D9E, 0x1D9E, idnaMapped, "\u00f0"},
+	{0x1D9F, 0x1D9F, idnaMapped, "\u025c"},
+	{0x1DA0, 0x1DA0, idnaMapped, "f"},
+	{0x1DA1, 0x1DA1, idnaMapped, "\u025f"},
+	{0x1DA2, 0x1DA2, idnaMapped, "\u0261"},
+	{0x1DA3, 0x1DA3, idnaMapped, "\u0265"},
+	{0x1DA4, 0x1DA4, idnaMapped, "\u0268"},
+	{0x1DA5, 0x1DA5, idnaMapped, "\u0269"},
+	{0x1DA6, 0x1DA6, idnaMapped, "\u026a"},
+	{0x1DA7, 0x1DA7, idnaMapped, "\u1d7b"},
+	{0x1DA8, 0x1DA8, idnaMapped, "\u029d"},
+	{0x1DA9, 0x1DA9, idnaMapped, "\u026d"},
+	{0x1DAA, 0x1DAA, idnaMapped, "\u1d85"},
+	{0x1DAB, 0x1DAB, idnaMapped, "\u029f"},
+	{0x1DAC, 0x1DAC, idnaMapped, "\u0271"},
+	{0x1DAD, 0x1DAD, idnaMapped, "\u0270"},
+	{0x1DAE, 0x1DAE, idnaMapped, "\u0272"},
+	{0x1DAF, 0x1DAF, idnaMapped, "\u0273"},
+	{0x1DB0, 0x1DB0, idnaMapped, "\u0274"},
+	{0x1DB1, 0x1DB1, idnaMapped, "\u0275"},
+	{0x1DB2, 0x1DB2, idnaMapped, "\u0278"},
+	{0x1DB3, 0x1DB3, idnaMapped, "\u0282"},
+	{0x1DB4, 0x1DB4, idnaMapped, "\u0283"},
+	{0x1DB5, 0x1DB5, idnaMapped, "\u01ab"},
+	{0x1DB6, 0x1DB6, idnaMapped, "\u0289"},
+	{0x1DB7, 0x1DB7, idnaMapped, "\u028a"},
+	{0x1DB8, 0x1DB8, idnaMapped, "\u1d1c"},
+	{0x1DB9, 0x1DB9, idnaMapped, "\u028b"},
+	{0x1DBA, 0x1DBA, idnaMapped, "\u028c"},
+	{0x1DBB, 0x1DBB, idnaMapped, "z"},
+	{0x1DBC, 0x1DBC, idnaMapped, "\u0290"},
+	{0x1DBD, 0x1DBD, idnaMapped, "\u0291"},
+	{0x1DBE, 0x1DBE, idnaMapped, "\u0292"},
+	{0x1DBF, 0x1DBF, idnaMapped, "\u03b8"},
+	{0x1DC0, 0x1DFF, idnaValid, ""},
+	{0x1E00, 0x1E00, idnaMapped, "\u1e01"},
+	{0x1E01, 0x1E01, idnaValid, ""},
+	{0x1E02, 0x1E02, idnaMapped, "\u1e03"},
+	{0x1E03, 0x1E03, idnaValid, ""},
+	{0x1E04, 0x1E04, idnaMapped, "\u1e05"},
+	{0x1E05, 0x1E05, idnaValid, ""},
+	{0x1E06, 0x1E06, idnaMapped, "\u1e07"},
+	{0x1E07, 0x1E07, idnaValid, ""},
+	{0x1E08, 0x1E08, idnaMapped, "\u1e09"},
+	{0x1E09, 0x1E09, idnaValid, ""},
+	{0x1E0A, 0x1E0A, idnaMapped, "\u1e0b"},
+	{0x1E0B, 0x1E0B, idnaValid, ""},
+	{0x1E0C, 0x1E0C, idnaMapped, "\u1e0d"},
+	{0x1E0D, 0x1E0D, idnaValid, ""},
+	{0x1E0E, 0x1E0E, idnaMapped, "\u1e0f"},
+	{0x1E0F, 0x1E0F, idnaValid, ""},
+	{0x1E10, 0x1E10, idnaMapped, "\u1e11"},
+	{0x1E11, 0x1E11, idnaValid, ""},
+	{0x1E12, 0x1E12, idnaMapped, "\u1e13"},
+	{0x1E13, 0x1E13, idnaValid, ""},
+	{0x1E14, 0x1E14, idnaMapped, "\u1e15"},
+	{0x1E15, 0x1E15, idnaValid, ""},
+	{0x1E16, 0x1E16, idnaMapped, "\u1e17"},
+	{0x1E17, 0x1E17, idnaValid, ""},
+	{0x1E18, 0x1E18, idnaMapped, "\u1e19"},
+	{0x1E19, 0x1E19, idnaValid, ""},
+	{0x1E1A, 0x1E1A, idnaMapped, "\u1e1b"},
+	{0x1E1B, 0x1E1B, idnaValid, ""},
+	{0x1E1C, 0x1E1C, idnaMapped, "\u1e1d"},
+	{0x1E1D, 0x1E1D, idnaValid, ""},
+	{0x1E1E, 0x1E1E, idnaMapped, "\u1e1f"},
+	{0x1E1F, 0x1E1F, idnaValid, ""},
+	{0x1E20, 0x1E20, idnaMapped, "\u1e21"},
+	{0x1E21, 0x1E21, idnaValid, ""},
+	{0x1E22, 0x1E22, idnaMapped, "\u1e23"},
+	{0x1E23, 0x1E23, idnaValid, ""},
+	{0x1E24, 0x1E24, idnaMapped, "\u1e25"},
+	{0x1E25, 0x1E25, idnaValid, ""},
+	{0x1E26, 0x1E26, idnaMapped, "\u1e27"},
+	{0x1E27, 0x1E27, idnaValid, ""},
+	{0x1E28, 0x1E28, idnaMapped, "\u1e29"},
+	{0x1E29, 0x1E29, idnaValid, ""},
+	{0x1E2A, 0x1E2A, idnaMapped, "\u1e2b"},
+	{0x1E2B, 0x1E2B, idnaValid, ""},
+	{0x1E2C, 0x1E2C, idnaMapped, "\u1e2d"},
+	{0x1E2D, 0x1E2D, idnaValid, ""},
+	{0x1E2E, 0x1E2E, idnaMapped, "\u1e2f"},
+	{0x1E2F, 0x1E2F, idnaValid, ""},
+	{0x1E30, 0x1E30, idnaMapped, "\u1e31"},
+	{0x1E31, 0x1E31, idnaValid, ""},
+	{0x1E32, 0x1E32, idnaMapped, "\u1e33"},
+	{0x1E33, 0x1E33, idnaValid, ""},
+	{0x1E34, 0x1E34, idnaMapped, "\u1e35"},
+	{0x1E35, 0x1E35, idnaValid, ""},
+	{0x1E36, 0x1E36, idnaMapped, "\u1e37"},
+	{0x1E37, 0x1E37, idnaValid, ""},
+	{0x1E38, 0x1E38, idnaMapped, "\u1e39"},
+	{0x1E39, 0x1E39, idnaValid, ""},
+	{0x1E3A, 0x1E3A, idnaMapped, "\u1e3b"},
+	{0x1E3B, 0x1E3B, idnaValid, ""},
+	{0x1E3C, 0x1E3C, idnaMapped, "\u1e3d"},
+	{0x1E3D, 0x1E3D, idnaValid, ""},
+	{0x1E3E, 0x1E3E, idnaMapped, "\u1e3f"},
+	{0x1E3F, 0x1E3F, idnaValid, ""},
+	{0x1E40, 0x1E40, idnaMapped, "\u1e41"},
+	{0x1E41, 0x1E41, idnaValid, ""},
+	{0x1E42, 0x1E42, idnaMapped, "\u1e43"},
+	{0x1E43, 0x1E43, idnaValid, ""},
+	{0x1E44, 0x1E44, idnaMapped, "\u1e45"},
+	{0x1E45, 0x1E45, idnaValid, ""},
+	{0x1E46, 0x1E46, idnaMapped, "\u1e47"},
+	{0x1E47, 0x1E47, idnaValid, ""},
+	{0x1E48, 0x1E48, idnaMapped, "\u1e49"},
+	{0x1E49, 0x1E49, idnaValid, ""},
+	{0x1E4A, 0x1E4A, idnaMapped, "\u1e4b"},
+	{0x1E4B, 0x1E4B, idnaValid, ""},
+	{0x1E4C, 0x1E4C, idnaMapped, "\u1e4d"},
+	{0x1E4D, 0x1E4D, idnaValid, ""},
+	{0x1E4E, 0x1E4E, idnaMapped, "\u1e4f"},
+	{0x1E4F, 0x1E4F, idnaValid, ""},
+	{0x1E50, 0x1E50, idnaMapped, "\u1e51"},
+	{0x1E51, 0x1E51, idnaValid, ""},
+	{0x1E52, 0x1E52, idnaMapped, "\u1e53"},
+	{0x1E53, 0x1E53, idnaValid, ""},
+	{0x1E54, 0x1E54, idnaMapped, "\u1e55"},
+	{0x1E55, 0x1E55, idnaValid, ""},
+	{0x1E56, 0x1E56, idnaMapped, "\u1e57"},
+	{0x1E57, 0x1E57, idnaValid, ""},
+	{0x1E58, 0x1E58, idnaMapped, "\u1e59"},
+	{0x1E59, 0x1E59, idnaValid, ""},
+	{0x1E5A, 0x1E5A, idnaMapped, "\u1e5b"},
+	{0x1E5B, 0x1E5B, idnaValid, ""},
+	{0x1E5C, 0x1E5C, idnaMapped, "\u1e5d"},
+	{0x1E5D, 0x1E5D, idnaValid, ""},
+	{0x1E5E, 0x1E5E, idnaMapped, "\u1e5f"},
+	{0x1E5F, 0x1E5F, idnaValid, ""},
+	{0x1E60, 0x1E60, idnaMapped, "\u1e61"},
+	{0x1E61, 0x1E61, idnaValid, ""},
+	{0x1E62, 0x1E62, idnaMapped, "\u1e63"},
+	{0x1E63, 0x1E63, idnaValid, ""},
+	{0x1E64, 0x1E64, idnaMapped, "\u1e65"},
+	{0x1E65, 0x1E65, idnaValid, ""},
+	{0x1E66, 0x1E66, idnaMapped, "\u1e67"},
+	{0x1E67, 0x1E67, idnaValid, ""},
+	{0x1E68, 0x1E68, idnaMapped, "\u1e69"},
+	{0x1E69, 0x1E69, idnaValid, ""},
+	{0x1E6A, 0x1E6A, idnaMapped, "\u1e6b"},
+	{0x1E6B, 0x1E6B, idnaValid, ""},
+	{0x1E6C, 0x1E6C, idnaMapped, "\u1e6d"},
+	{0x1E6D, 0x1E6D, idnaValid, ""},
+	{0x1E6E, 0x1E6E, idnaMapped, "\u1e6f"},
+	{0x1E6F, 0x1E6F, idnaValid, ""},
+	{0x1E70, 0x1E70, idnaMapped, "\u1e71"},
+	{0x1E71, 0x1E71, idnaValid, ""},
+	{0x1E72, 0x1E72, idnaMapped, "\u1e73"},
+	{0x1E73, 0x1E73, idnaValid, ""},
+	{0x1E74, 0x1E74, idnaMapped, "\u1e75"},
+	{0x1E75, 0x1E75, idnaValid, ""},
+	{0x1E76, 0x1E76, idnaMapped, "\u1e77"},
+	{0x1E77, 0x1E77, idnaValid, ""},
+	{0x1E78, 0x1E78, idnaMapped, "\u1e79"},
+	{0x1E79, 0x1E79, idnaValid, ""},
+	{0x1E7A, 0x1E7A, idnaMapped, "\u1e7b"},
+	{0x1E7B, 0x1E7B, idnaValid, ""},
+	{0x1E7C, 0x1E7C, idnaMapped, "\u1e7d"},
+	{0x1E7D, 0x1E7D, idnaValid, ""},
+	{0x1E7E, 0x1E7E, idnaMapped, "\u1e7f"},
+	{0x1E7F, 0x1E7F, idnaValid, ""},
+	{0x1E80, 0x1E80, idnaMapped, "\u1e81"},
+	{0x1E81, 0x1E81, idnaValid, ""},
+	{0x1E82, 0x1E82, idnaMapped, "\u1e83"},
+	{0x1E83, 0x1E83, idnaValid, ""},
+	{0x1E84, 0x1E84, idnaMapped, "\u1e85"},
+	{0x1E85, 0x1E85, idnaValid, ""},
+	{0x1E86, 0x1E86, idnaMapped, "\u1e87"},
+	{0x1E87, 0x1E87, idnaValid, ""},
+	{0x1E88, 0x1E88, idnaMapped, "\u1e89"},
+	{0x1E89, 0x1E89, idnaValid, ""},
+	{0x1E8A, 0x1E8A, idnaMapped, "\u1e8b"},
+	{0x1E8B, 0x1E8B, idnaValid, ""},
+	{0x1E8C, 0x1E8C, idnaMapped, "\u1e8d"},
+	{0x1E8D, 0x1E8D, idnaValid, ""},
+	{0x1E8E, 0x1E8E, idnaMapped, "\u1e8f"},
+	{0x1E8F, 0x1E8F, idnaValid, ""},
+	{0x1E90, 0x1E90, idnaMapped, "\u1e91"},
+	{0x1E91, 0x1E91, idnaValid, ""},
+	{0x1E92, 0x1E92, idnaMapped, "\u1e93"},
+	{0x1E93, 0x1E93, idnaValid, ""},
+	{0x1E94, 0x1E94, idnaMapped, "\u1e95"},
+	{0x1E95, 0x1E99, idnaValid, ""},
+	{0x1E9A, 0x1E9A, idnaMapped, "a\u02be"},
+	{0x1E9B, 0x1E9B, idnaMapped, "\u1e61"},
+	{0x1E9C, 0x1E9D, idnaValid, ""},
+	{0x1E9E, 0x1E9E, idnaMapped, "\u00df"},
+	{0x1E9F, 0x1E9F, idnaValid, ""},
+	{0x1EA0, 0x1EA0, idnaMapped, "\u1ea1"},
+	{0x1EA1, 0x1EA1, idnaValid, ""},
+	{0x1EA2, 0x1EA2, idnaMapped, "\u1ea3"},
+	{0x1EA3, 0x1EA3, idnaValid, ""},
+	{0x1EA4, 0x1EA4, idnaMapped, "\u1ea5"},
+	{0x1EA5, 0x1EA5, idnaValid, ""},
+	{0x1EA6, 0x1EA6, idnaMapped, "\u1ea7"},
+	{0x1EA7, 0x1EA7, idnaValid, ""},
+	{0x1EA8, 0x1EA8, idnaMapped, "\u1ea9"},
+	{0x1EA9, 0x1EA9, idnaValid, ""},
+	{0x1EAA, 0x1EAA, idnaMapped, "\u1eab"},
+	{0x1EAB, 0x1EAB, idnaValid, ""},
+	{0x1EAC, 0x1EAC, idnaMapped, "\u1ead"},
+	{0x1EAD, 0x1EAD, idnaValid, ""},
+	{0x1EAE, 0x1EAE, idnaMapped, "\u1eaf"},
+	{0x1EAF, 0x1EAF, idnaValid, ""},
+	{0x1EB0, 0x1EB0, idnaMapped, "\u1eb1"},
+	{0x1EB1, 0x1EB1, idnaValid, ""},
+	{0x1EB2, 0x1EB2, idnaMapped, "\u1eb3"},
+	{0x1EB3, 0x1EB3, idnaValid, ""},
+	{0x1EB4, 0x1EB4, idnaMapped, "\u1eb5"},
+	{0x1EB5, 0x1EB5, idnaValid, ""},
+	{0x1EB6, 0x1EB6, idnaMapped, "\u1eb7"},
+	{0x1EB7, 0x1EB7, idnaValid, ""},
+	{0x1EB8, 0x1EB8, idnaMapped, "\u1eb9"},
+	{0x1EB9, 0x1EB9, idnaValid, ""},
+	{0x1EBA, 0x1EBA, idnaMapped, "\u1ebb"},
+	{0x1EBB, 0x1EBB, idnaValid, ""},
+	{0x1EBC, 0x1EBC, idnaMapped, "\u1ebd"},
+	{0x1EBD, 0x1EBD, idnaValid, ""},
+	{0x1EBE, 0x1EBE, idnaMapped, "\u1ebf"},
+	{0x1EBF, 0x1EBF, idnaValid, ""},
+	{0x1EC0, 0x1EC0, idnaMapped, "\u1ec1"},
+	{0x1EC1, 0x1EC1, idnaValid, ""},
+	{0x1EC2, 0x1EC2, idnaMapped, "\u1ec3"},
+	{0x1EC3, 0x1EC3, idnaValid, ""},
+	{0x1EC4, 0x1EC4, idnaMapped, "\u1ec5"},
+	{0x1EC5, 0x1EC5, idnaValid, ""},
+	{0x1EC6, 0x1EC6, idnaMapped, "\u1ec7"},
+	{0x1EC7, 0x1EC7, idnaValid, ""},
+	{0x1EC8, 0x1EC8, idnaMapped, "\u1ec9"},
+	{0x1EC9, 0x1EC9, idnaValid, ""},
+	{0x1ECA, 0x1ECA, idnaMapped, "\u1ecb"},
+	{0x1ECB, 0x1ECB, idnaValid, ""},
+	{0x1ECC, 0x1ECC, idnaMapped, "\u1ecd"},
+	{0x1ECD, 0x1ECD, idnaValid, ""},
+	{0x1ECE, 0x1ECE, idnaMapped, "\u1ecf"},
+	{0x1ECF, 0x1ECF, idnaValid, ""},
+	{0x1ED0, 0x1ED0, idnaMapped, "\u1ed1"},
+	{0x1ED1, 0x1ED1, idnaValid, ""},
+	{0x1ED2, 0x1ED2, idnaMapped, "\u1ed3"},
+	{0x1ED3, 0x1ED3, idnaValid, ""},
+	{0x1ED4, 0x1ED4, idnaMapped, "\u1ed5"},
+	{0x1ED5, 0x1ED5, idnaValid, ""},
+	{0x1ED6, 0x1ED6, idnaMapped, "\u1ed7"},
+	{0x1ED7, 0x1ED7, idnaValid, ""},
+	{0x1ED8, 0x1ED8, idnaMapped, "\u1ed9"},
+	{0x1ED9, 0x1ED9, idnaValid, ""},
+	{0x1EDA, 0x1EDA, idnaMapped, "\u1edb"},
+	{0x1EDB, 0x1EDB, idnaValid, ""},
+	{0x1EDC, 0x1EDC, idnaMapped, "\u1edd"},
+	{0x1EDD, 0x1EDD, idnaValid, ""},
+	{0x1EDE, 0x1EDE, idnaMapped, "\u1edf"},
+	{0x1EDF, 0x1EDF, idnaValid, ""},
+	{0x1EE0, 0x1EE0, idnaMapped, "\u1ee1"},
+	{0x1EE1, 0x1EE1, idnaValid, ""},
+	{0x1EE2, 0x1EE2, idnaMapped, "\u1ee3"},
+	{0x1EE3, 0x1EE3, idnaValid, ""},
+	{0x1EE4, 0x1EE4, idnaMapped, "\u1ee5"},
+	{0x1EE5, 0x1EE5, idnaValid, ""},
+	{0x1EE6, 0x1EE6, idnaMapped, "\u1ee7"},
+	{0x1EE7, 0x1EE7, idnaValid, ""},
+	{0x1EE8, 0x1EE8, idnaMapped, "\u1ee9"},
+	{0x1EE9, 0x1EE9, idnaValid, ""},
+	{0x1EEA, 0x1EEA, idnaMapped, "\u1eeb"},
+	{0x1EEB, 0x1EEB, idnaValid, ""},
+	{0x1EEC, 0x1EEC, idnaMapped, "\u1eed"},
+	{0x1EED, 0x1EED, idnaValid, ""},
+	{0x1EEE, 0x1EEE, idnaMapped, "\u1eef"},
+	{0x1EEF, 0x1EEF, idnaValid, ""},
+	{0x1EF0, 0x1EF0, idnaMapped, "\u1ef1"},
+	{0x1EF1, 0x1EF1, idnaValid, ""},
+	{0x1EF2, 0x1EF2, idnaMapped, "\u1ef3"},
+	{0x1EF3, 0x1EF3, idnaValid, ""},
+	{0x1EF4, 0x1EF4, idnaMapped, "\u1ef5"},
+	{0x1EF5, 0x1EF5, idnaValid, ""},
+	{0x1EF6, 0x1EF6, idnaMapped, "\u1ef7"},
+	{0x1EF7, 0x1EF7, idnaValid, ""},
+	{0x1EF8, 0x1EF8, idnaMapped, "\u1ef9"},
+	{0x1EF9, 0x1EF9, idnaValid, ""},
+	{0x1EFA, 0x1EFA, idnaMapped, "\u1efb"},
+	{0x1EFB, 0x1EFB, idnaValid, ""},
+	{0x1EFC, 0x1EFC, idnaMapped, "\u1efd"},
+	{0x1EFD, 0x1EFD, idnaValid, ""},
+	{0x1EFE, 0x1EFE, idnaMapped, "\u1eff"},
+	{0x1EFF, 0x1F07, idnaValid, ""},
+	{0x1F08, 0x1F08, idnaMapped, "\u1f00"},
+	{0x1F09, 0x1F09, idnaMapped, "\u1f01"},
+	{0x1F0A, 0x1F0A, idnaMapped, "\u1f02"},
+	{0x1F0B, 0x1F0B, idnaMapped, "\u1f03"},
+	{0x1F0C, 0x1F0C, idnaMapped, "\u1f04"},
+	{0x1F0D, 0x1F0D, idnaMapped, "\u1f05"},
+	{0x1F0E, 0x1F0E, idnaMapped, "\u1f06"},
+	{0x1F0F, 0x1F0F, idnaMapped, "\u1f07"},
+	{0x1F10, 0x1F15, idnaValid, ""},
+	{0x1F16, 0x1F17, idnaDisallowed, ""},
+	{0x1F18, 0x1F18, idnaMapped, "\u1f10"},
+	{0x1F19, 0x1F19, idnaMapped, "\u1f11"},
+	{0x1F1A, 0x1F1A, idnaMapped, "\u1f12"},
+	{0x1F1B, 0x1F1B, idnaMapped, "\u1f13"},
+	{0x1F1C, 0x1F1C, idnaMapped, "\u1f14"},
+	{0x1F1D, 0x1F1D, idnaMapped, "\u1f15"},
+	{0x1F1E, 0x1F1F, idnaDisallowed, ""},
+	{0x1F20, 0x1F27, idnaValid, ""},
+	{0x1F28, 0x1F28, idnaMapped, "\u1f20"},
+	{0x1F29, 0x1F29, idnaMapped, "\u1f21"},
+	{0x1F2A, 0x1F2A, idnaMapped, "\u1f22"},
+	{0x1F2B, 0x1F2B, idnaMapped, "\u1f23"},
+	{0x1F2C, 0x1F2C, idnaMapped, "\u1f24"},
+	{0x1F2D, 0x1F2D, idnaMapped, "\u1f25"},
+	{0x1F2E, 0x1F2E, idnaMapped, "\u1f26"},
+	{0x1F2F, 0x1F2F, idnaMapped, "\u1f27"},
+	{0x1F30, 0x1F37, idnaValid, ""},
+	{0x1F38, 0x1F38, idnaMapped, "\u1f30"},
+	{0x1F39, 0x1F39, idnaMapped, "\u1f31"},
+	{0x1F3A, 0x1F3A, idnaMapped, "\u1f32"},
+	{0x1F3B, 0x1F3B, idnaMapped, "\u1f33"},
+	{0x1F3C, 0x1F3C, idnaMapped, "\u1f34"},
+	{0x1F3D, 0x1F3D, idnaMapped, "\u1f35"},
+	{0x1F3E, 0x1F3E, idnaMapped, "\u1f36"},
+	{0x1F3F, 0x1F3F, idnaMapped, "\u1f37"},
+	{0x1F40, 0x1F45, idnaValid, ""},
+	{0x1F46, 0x1F47, idnaDisallowed, ""},
+	{0x1F48, 0x1F48, idnaMapped, "\u1f40"},
+	{0x1F49, 0x1F49, idnaMapped, "\u1f41"},
+	{0x1F4A, 0x1F4A, idnaMapped, "\u1f42"},
+	{0x1F4B, 0x1F4B, idnaMapped, "\u1f43"},
+	{0x1F4C, 0x1F4C, idnaMapped, "\u1f44"},
+	{0x1F4D, 0x1F4D, idnaMapped, "\u1f45"},
+	{0x1F4E, 0x1F4F, idnaDisallowed, ""},
+	{0x1F50, 0x1F57, idnaValid, ""},
+	{0x1F58, 0x1F58, idnaDisallowed, ""},
+	{0x1F59, 0x1F59, idnaMapped, "\u1f51"},
+	{0x1F5A, 0x1F5A, idnaDisallowed, ""},
+	{0x1F5B, 0x1F5B, idnaMapped, "\u1f53"},
+	{0x1F5C, 0x1F5C, idnaDisallowed, ""},
+	{0x1F5D, 0x1F5D, idnaMapped, "\u1f55"},
+	{0x1F5E, 0x1F5E, idnaDisallowed, ""},
+	{0x1F5F, 0x1F5F, idnaMapped, "\u1f57"},
+	{0x1F60, 0x1F67, idnaValid, ""},
+	{0x1F68, 0x1F68, idnaMapped, "\u1f60"},
+	{0x1F69, 0x1F69, idnaMapped, "\u1f61"},
+	{0x1F6A, 0x1F6A, idnaMapped, "\u1f62"},
+	{0x1F6B, 0x1F6B, idnaMapped, "\u1f63"},
+	{0x1F6C, 0x1F6C, idnaMapped, "\u1f64"},
+	{0x1F6D, 0x1F6D, idnaMapped, "\u1f65"},
+	{0x1F6E, 0x1F6E, idnaMapped, "\u1f66"},
+	{0x1F6F, 0x1F6F, idnaMapped, "\u1f67"},
+	{0x1F70, 0x1F70, idnaValid, ""},
+	{0x1F71, 0x1F71, idnaMapped, "\u03ac"},
+	{0x1F72, 0x1F72, idnaValid, ""},
+	{0x1F73, 0x1F73, idnaMapped, "\u03ad"},
+	{0x1F74, 0x1F74, idnaValid, ""},
+	{0x1F75, 0x1F75, idnaMapped, "\u03ae"},
+	{0x1F76, 0x1F76, idnaValid, ""},
+	{0x1F77, 0x1F77, idnaMapped, "\u03af"},
+	{0x1F78, 0x1F78, idnaValid, ""},
+	{0x1F79, 0x1F79, idnaMapped, "\u03cc"},
+	{0x1F7A, 0x1F7A, idnaValid, ""},
+	{0x1F7B, 0x1F7B, idnaMapped, "\u03cd"},
+	{0x1F7C, 0x1F7C, idnaValid, ""},
+	{0x1F7D, 0x1F7D, idnaMapped, "\u03ce"},
+	{0x1F7E, 0x1F7F, idnaDisallowed, ""},
+	{0x1F80, 0x1F80, idnaMapped, "\u1f00\u03b9"},
+	{0x1F81, 0x1F81, idnaMapped, "\u1f01\u03b9"},
+	{0x1F82, 0x1F82, idnaMapped, "\u1f02\u03b9"},
+	{0x1F83, 0x1F83, idnaMapped, "\u1f03\u03b9"},
+	{0x1F84, 0x1F84, idnaMapped, "\u1f04\u03b9"},
+	{0x1F85, 0x1F85, idnaMapped, "\u1f05\u03b9"},
+	{0x1F86, 0x1F86, idnaMapped, "\u1f06\u03b9"},
+	{0x1F87, 0x1F87, idnaMapped, "\u1f07\u03b9"},
+	{0x1F88, 0x1F88, idnaMapped, "\u1f00\u03b9"},
+	{0x1F89, 0x1F89, idnaMapped, "\u1f01\u03b9"},
+	{0x1F8A, 0x1F8A, idnaMapped, "\u1f02\u03b9"},
+	{0x1F8B, 0x1F8B, idnaMapped, "\u1f03\u03b9"},
+	{0x1F8C, 0x1F8C, idnaMapped, "\u1f04\u03b9"},
+	{0x1F8D, 0x1F8D, idnaMapped, "\u1f05\u03b9"},
+	{0x1F8E, 0x1F8E, idnaMapped, "\u1f06\u03b9"},
+	{0x1F8F, 0x1F8F, idnaMapped, "\u1f07\u03b9"},
+	{0x1F90, 0x1F90, idnaMapped, "\u1f20\u03b9"},
+	{0x1F91, 0x1F91, idnaMapped, "\u1f21\u03b9"},
+	{0x1F92, 0x1F92, idnaMapped, "\u1f22\u03b9"},
+	{0x1F93, 0x1F93, idnaMapped, "\u1f23\u03b9"},
+	{0x1F94, 0x1F94, idnaMapped, "\u1f24\u03b9"},
+	{0x1F95, 0x1F95, idnaMapped, "\u1f25\u03b9"},
+	{0x1F96, 0x1F96, idnaMapped, "\u1f26\u03b9"},
+	{0x1F97, 0x1F97, idnaMapped, "\u1f27\u03b9"},
+	{0x1F98, 0x1F98, idnaMapped, "\u1f20\u03b9"},
+	{0x1F99, 0x1F99, idnaMapped, "\u1f21\u03b9"},
+	{0x1F9A, 0x1F9A, idnaMapped, "\u1f22\u03b9"},
+	{0x1F9B, 0x1F9B, idnaMapped, "\u1f23\u03b9"},
+	{0x1F9C, 0x1F9C, idnaMapped, "\u1f24\u03b9"},
+	{0x1F9D, 0x1F9D, idnaMapped, "\u1f25\u03b9"},
+	{0x1F9E, 0x1F9E, idnaMapped, "\u1f26\u03b9"},
+	{0x1F9F, 0x1F9F, idnaMapped, "\u1f27\u03b9"},
+	{0x1FA0, 0x1FA0, idnaMapped, "\u1f60\u03b9"},
+	{0x1FA1, 0x1FA1, idnaMapped, "\u1f61\u03b9"},
+	{0x1FA2, 0x1FA2, idnaMapped, "\u1f62\u03b9"},
+	{0x1FA3, 0x1FA3, idnaMapped, "\u1f63\u03b9"},
+	{0x1FA4, 0x1FA4, idnaMapped, "\u1f64\u03b9"},
+	{0x1FA5, 0x1FA5, idnaMapped, "\u1f65\u03b9"},
+	{0x1FA6, 0x1FA6, idnaMapped, "\u1f66\u03b9"},
+	{0x1FA7, 0x1FA7, idnaMapped, "\u1f67\u03b9"},
+	{0x1FA8, 0x1FA8, idnaMapped, "\u1f60\u03b9"},
+	{0x1FA9, 0x1FA9, idnaMapped, "\u1f61\u03b9"},
+	{0x1FAA, 0x1FAA, idnaMapped, "\u1f62\u03b9"},
+	{0x1FAB, 0x1FAB, idnaMapped, "\u1f63\u03b9"},
+	{0x1FAC, 0x1FAC, idnaMapped, "\u1f64\u03b9"},
+	{0x1FAD, 0x1FAD, idnaMapped, "\u1f65\u03b9"},
+	{0x1FAE, 0x1FAE, idnaMapped, "\u1f66\u03b9"},
+	{0x1FAF, 0x1FAF, idnaMapped, "\u1f67\u03b9"},
+	{0x1FB0, 0x1FB1, idnaValid, ""},
+	{0x1FB2, 0x1FB2, idnaMapped, "\u1f70\u03b9"},
+	{0x1FB3, 0x1FB3, idnaMapped, "\u03b1\u03b9"},
+	{0x1FB4, 0x1FB4, idnaMapped, "\u03ac\u03b9"},
+	{0x1FB5, 0x1FB5, idnaDisallowed, ""},
+	{0x1FB6, 0x1FB6, idnaValid, ""},
+	{0x1FB7, 0x1FB7, idnaMapped, "\u1fb6\u03b9"},
+	{0x1FB8, 0x1FB8, idnaMapped, "\u1fb0"},
+	{0x1FB9, 0x1FB9, idnaMapped, "\u1fb1"},
+	{0x1FBA, 0x1FBA, idnaMapped, "\u1f70"},
+	{0x1FBB, 0x1FBB, idnaMapped, "\u03ac"},
+	{0x1FBC, 0x1FBC, idnaMapped, "\u03b1\u03b9"},
+	{0x1FBD, 0x1FBD, idnaMapped, " \u0313"},
+	{0x1FBE, 0x1FBE, idnaMapped, "\u03b9"},
+	{0x1FBF, 0x1FBF, idnaMapped, " \u0313"},
+	{0x1FC0, 0x1FC0, idnaMapped, " \u0342"},
+	{0x1FC1, 0x1FC1, idnaMapped, " \u0308\u0342"},
+	{0x1FC2, 0x1FC2, idnaMapped, "\u1f74\u03b9"},
+	{0x1FC3, 0x1FC3, idnaMapped, "\u03b7\u03b9"},
+	{0x1FC4, 0x1FC4, idnaMapped, "\u03ae\u03b9"},
+	{0x1FC5, 0x1FC5, idnaDisallowed, ""},
+	{0x1FC6, 0x1FC6, idnaValid, ""},
+	{0x1FC7, 0x1FC7, idnaMapped, "\u1fc6\u03b9"},
+	{0x1FC8, 0x1FC8, idnaMapped, "\u1f72"},
+	{0x1FC9, 0x1FC9, idnaMapped, "\u03ad"},
+	{0x1FCA, 0x1FCA, idnaMapped, "\u1f74"},
+	{0x1FCB, 0x1FCB, idnaMapped, "\u03ae"},
+	{0x1FCC, 0x1FCC, idnaMapped, "\u03b7\u03b9"},
+	{0x1FCD, 0x1FCD, idnaMapped, " \u0313\u0300"},
+	{0x1FCE, 0x1FCE, idnaMapped, " \u0313\u0301"},
+	{0x1FCF, 0x1FCF, idnaMapped, " \u0313\u0342"},
+	{0x1FD0, 0x1FD2, idnaValid, ""},
+	{0x1FD3, 0x1FD3, idnaMapped, "\u0390"},
+	{0x1FD4, 0x1FD5, idnaDisallowed, ""},
+	{0x1FD6, 0x1FD7, idnaValid, ""},
+	{0x1FD8, 0x1FD8, idnaMapped, "\u1fd0"},
+	{0x1FD9, 0x1FD9, idnaMapped, "\u1fd1"},
+	{0x1FDA, 0x1FDA, idnaMapped, "\u1f76"},
+	{0x1FDB, 0x1FDB, idnaMapped, "\u03af"},
+	{0x1FDC, 0x1FDC, idnaDisallowed, ""},
+	{0x1FDD, 0x1FDD, idnaMapped, " \u0314\u0300"},
+	{0x1FDE, 0x1FDE, idnaMapped, " \u0314\u0301"},
+	{0x1FDF, 0x1FDF, idnaMapped, " \u0314\u0342"},
+	{0x1FE0, 0x1FE2, idnaValid, ""},
+	{0x1FE3, 0x1FE3, idnaMapped, "\u03b0"},
+	{0x1FE4, 0x1FE7, idnaValid, ""},
+	{0x1FE8, 0x1FE8, idnaMapped, "\u1fe0"},
+	{0x1FE9, 0x1FE9, idnaMapped, "\u1fe1"},
+	{0x1FEA, 0x1FEA, idnaMapped, "\u1f7a"},
+	{0x1FEB, 0x1FEB, idnaMapped, "\u03cd"},
+	{0x1FEC, 0x1FEC, idnaMapped, "\u1fe5"},
+	{0x1FED, 0x1FED, idnaMapped, " \u0308\u0300"},
+	{0x1FEE, 0x1FEE, idnaMapped, " \u0308\u0301"},
+	{0x1FEF, 0x1FEF, idnaMapped, "`"},
+	{0x1FF0, 0x1FF1, idnaDisallowed, ""},
+	{0x1FF2, 0x1FF2, idnaMapped, "\u1f7c\u03b9"},
+	{0x1FF3, 0x1FF3, idnaMapped, "\u03c9\u03b9"},
+	{0x1FF4, 0x1FF4, idnaMapped, "\u03ce\u03b9"},
+	{0x1FF5, 0x1FF5, idnaDisallowed, ""},
+	{0x1FF6, 0x1FF6, idnaValid, ""},
+	{0x1FF7, 0x1FF7, idnaMapped, "\u1ff6\u03b9"},
+	{0x1FF8, 0x1FF8, idnaMapped, "\u1f78"},
+	{0x1FF9, 0x1FF9, idnaMapped, "\u03cc"},
+	{0x1FFA, 0x1FFA, idnaMapped, "\u1f7c"},
+	{0x1FFB, 0x1FFB, idnaMapped, "\u03ce"},
+	{0x1FFC, 0x1FFC, idnaMapped, "\u03c9\u03b9"},
+	{0x1FFD, 0x1FFD, idnaMapped, " \u0301"},
+	{0x1FFE, 0x1FFE, idnaMapped, " \u0314"},
+	{0x1FFF, 0x1FFF, idnaDisallowed, ""},
+	{0x2000, 0x2000, idnaMapped, " "},
+	{0x2001, 0x2001, idnaMapped, " "},
+	{0x2002, 0x2002, idnaMapped, " "},
+	{0x2003, 0x2003, idnaMapped, " "},
+	{0x2004, 0x2004, idnaMapped, " "},
+	{0x2005, 0x2005, idnaMapped, " "},
+	{0x2006, 0x2006, idnaMapped, " "},
+	{0x2007, 0x2007, idnaMapped, " "},
+	{0x2008, 0x2008, idnaMapped, " "},
+	{0x2009, 0x2009, idnaMapped, " "},
+	{0x200A, 0x200A, idnaMapped, " "},
+	{0x200B, 0x200B, idnaIgnored, ""},
+	{0x200C, 0x200D, idnaDeviation, ""},
+	{0x200E, 0x200F, idnaDisallowed, ""},
+	{0x2010, 0x2010, idnaValid, ""},
+	{0x2011, 0x2011, idnaMapped, "\u2010"},
+	{0x2012, 0x2016, idnaValid, ""},
+	{0x2017, 0x2017, idnaMapped, " \u0333"},
+	{0x2018, 0x2023, idnaValid, ""},
+	{0x2024, 0x2026, idnaDisallowed, ""},
+	{0x2027, 0x2027, idnaValid, ""},
+	{0x2028, 0x202E, idnaDisallowed, ""},
+	{0x202F, 0x202F, idnaMapped, " "},
+	{0x2030, 0x2032, idnaValid, ""},
+	{0x2033, 0x2033, idnaMapped, "\u2032\u2032"},
+	{0x2034, 0x2034, idnaMapped, "\u2032\u2032\u2032"},
+	{0x2035, 0x2035, idnaValid, ""},
+	{0x2036, 0x2036, idnaMapped, "\u2035\u2035"},
+	{0x2037, 0x2037, idnaMapped, "\u2035\u2035\u2035"},
+	{0x2038, 0x203B, idnaValid, ""},
+	{0x203C, 0x203C, idnaMapped, "!!"},
+	{0x203D, 0x203D, idnaValid, ""},
+	{0x203E, 0x203E, idnaMapped, " \u0305"},
+	{0x203F, 0x2046, idnaValid, ""},
+	{0x2047, 0x2047, idnaMapped, "??"},
+	{0x2048, 0x2048, idnaMapped, "?!"},
+	{0x2049, 0x2049, idnaMapped, "!?"},
+	{0x204A, 0x2056, idnaValid, ""},
+	{0x2057, 0x2057, idnaMapped, "\u2032\u2032\u2032\u2032"},
+	{0x2058, 0x205E, idnaValid, ""},
+	{0x205F, 0x205F, idnaMapped, " "},
+	{0x2060, 0x2064, idnaIgnored, ""},
+	{0x2065, 0x2069, idnaDisallowed, ""},
+	{0x206A, 0x206F, idnaIgnored, ""},
+	{0x2070, 0x2070, idnaMapped, "0"},
+	{0x2071, 0x2071, idnaMapped, "i"},
+	{0x2072, 0x2073, idnaDisallowed, ""},
+	{0x2074, 0x2074, idnaMapped, "4"},
+	{0x2075, 0x2075, idnaMapped, "5"},
+	{0x2076, 0x2076, idnaMapped, "6"},
+	{0x2077, 0x2077, idnaMapped, "7"},
+	{0x2078, 0x2078, idnaMapped, "8"},
+	{0x2079, 0x2079, idnaMapped, "9"},
+	{0x207A, 0x207A, idnaMapped, "+"},
+	{0x207B, 0x207B, idnaMapped, "\u2212"},
+	{0x207C, 0x207C, idnaMapped, "="},
+	{0x207D, 0x207D, idnaMapped, "("},
+	{0x207E, 0x207E, idnaMapped, ")"},
+	{0x207F, 0x207F, idnaMapped, "n"},
+	{0x2080, 0x2080, idnaMapped, "0"},
+	{0x2081, 0x2081, idnaMapped, "1"},
+	{0x2082, 0x2082, idnaMapped, "2"},
+	{0x2083, 0x2083, idnaMapped, "3"},
+	{0x2084, 0x2084, idnaMapped, "4"},
+	{0x2085, 0x2085, idnaMapped, "5"},
+	{0x2086, 0x2086, idnaMapped, "6"},
+	{0x2087, 0x2087, idnaMapped, "7"},
+	{0x2088, 0x2088, idnaMapped, "8"},
+	{0x2089, 0x2089, idnaMapped, "9"},
+	{0x208A, 0x208A, idnaMapped, "+"},
+	{0x208B, 0x208B, idnaMapped, "\u2212"},
+	{0x208C, 0x208C, idnaMapped, "="},
+	{0x208D, 0x208D, idnaMapped, "("},
+	{0x208E, 0x208E, idnaMapped, ")"},
+	{0x208F, 0x208F, idnaDisallowed, ""},
+	{0x2090, 0x2090, idnaMapped, "a"},
+	{0x2091, 0x2091, idnaMapped, "e"},
+	{0x2092, 0x2092, idnaMapped, "o"},
+	{0x2093, 0x2093, idnaMapped, "x"},
+	{0x2094, 0x2094, idnaMapped, "\u0259"},
+	{0x2095, 0x2095, idnaMapped, "h"},
+	{0x2096, 0x2096, idnaMapped, "k"},
+	{0x2097, 0x2097, idnaMapped, "l"},
+	{0x2098, 0x2098, idnaMapped, "m"},
+	{0x2099, 0x2099, idnaMapped, "n"},
+	{0x209A, 0x209A, idnaMapped, "p"},
+	{0x209B, 0x209B, idnaMapped, "s"},
+	{0x209C, 0x209C, idnaMapped, "t"},
+	{0x209D, 0x209F, idnaDisallowed, ""},
+	{0x20A0, 0x20A7, idnaValid, ""},
+	{0x20A8, 0x20A8, idnaMapped, "rs"},
+	{0x20A9, 0x20C1, idnaValid, ""},
+	{0x20C2, 0x20CF, idnaDisallowed, ""},
+	{0x20D0, 0x20F0, idnaValid, ""},
+	{0x20F1, 0x20FF, idnaDisallowed, ""},
+	{0x2100, 0x2100, idnaMapped, "a/c"},
+	{0x2101, 0x2101, idnaMapped, "a/s"},
+	{0x2102, 0x2102, idnaMapped, "c"},
+	{0x2103, 0x2103, idnaMapped, "\u00b0c"},
+	{0x2104, 0x2104, idnaValid, ""},
+	{0x2105, 0x2105, idnaMapped, "c/o"},
+	{0x2106, 0x2106, idnaMapped, "c/u"},
+	{0x2107, 0x2107, idnaMapped, "\u025b"},
+	{0x2108, 0x2108, idnaValid, ""},
+	{0x2109, 0x2109, idnaMapped, "\u00b0f"},
+	{0x210A, 0x210A, idnaMapped, "g"},
+	{0x210B, 0x210B, idnaMapped, "h"},
+	{0x210C, 0x210C, idnaMapped, "h"},
+	{0x210D, 0x210D, idnaMapped, "h"},
+	{0x210E, 0x210E, idnaMapped, "h"},
+	{0x210F, 0x210F, idnaMapped, "\u0127"},
+	{0x2110, 0x2110, idnaMapped, "i"},
+	{0x2111, 0x2111, idnaMapped, "i"},
+	{0x2112, 0x2112, idnaMapped, "l"},
+	{0x2113, 0x2113, idnaMapped, "l"},
+	{0x2114, 0x2114, idnaValid, ""},
+	{0x2115, 0x2115, idnaMapped, "n"},
+	{0x2116, 0x2116, idnaMapped, "no"},
+	{0x2117, 0x2118, idnaValid, ""},
+	{0x2119, 0x2119, idnaMapped, "p"},
+	{0x211A, 0x211A, idnaMapped, "q"},
+	{0x211B, 0x211B, idnaMapped, "r"},
+	{0x211C, 0x211C, idnaMapped, "r"},
+	{0x211D, 0x211D, idnaMapped, "r"},
+	{0x211E, 0x211F, idnaValid, ""},
+	{0x2120, 0x2120, idnaMapped, "sm"},
+	{0x2121, 0x2121, idnaMapped, "tel"},
+	{0x2122, 0x2122, idnaMapped, "tm"},
+	{0x2123, 0x2123, idnaValid, ""},
+	{0x2124, 0x2124, idnaMapped, "z"},
+	{0x2125, 0x2125, idnaValid, ""},
+	{0x2126, 0x2126, idnaMapped, "\u03c9"},
+	{0x2127, 0x2127, idnaValid, ""},
+	{0x2128, 0x2128, idnaMapped, "z"},
+	{0x2129, 0x2129, idnaValid, ""},
+	{0x212A, 0x212A, idnaMapped, "k"},
+	{0x212B, 0x212B, idnaMapped, "\u00e5"},
+	{0x212C, 0x212C, idnaMapped, "b"},
+	{0x212D, 0x212D, idnaMapped, "c"},
+	{0x212E, 0x212E, idnaValid, ""},
+	{0x212F, 0x212F, idnaMapped, "e"},
+	{0x2130, 0x2130, idnaMapped, "e"},
+	{0x2131, 0x2131, idnaMapped, "f"},
+	{0x2132, 0x2132, idnaMapped, "\u214e"},
+	{0x2133, 0x2133, idnaMapped, "m"},
+	{0x2134, 0x2134, idnaMapped, "o"},
+	{0x2135, 0x2135, idnaMapped, "\u05d0"},
+	{0x2136, 0x2136, idnaMapped, "\u05d1"},
+	{0x2137, 0x2137, idnaMapped, "\u05d2"},
+	{0x2138, 0x2138, idnaMapped, "\u05d3"},
+	{0x2139, 0x2139, idnaMapped, "i"},
+	{0x213A, 0x213A, idnaValid, ""},
+	{0x213B, 0x213B, idnaMapped, "fax"},
+	{0x213C, 0x213C, idnaMapped, "\u03c0"},
+	{0x213D, 0x213D, idnaMapped, "\u03b3"},
+	{0x213E, 0x213E, idnaMapped, "\u03b3"},
+	{0x213F, 0x213F, idnaMapped, "\u03c0"},
+	{0x2140, 0x2140, idnaMapped, "\u2211"},
+	{0x2141, 0x2144, idnaValid, ""},
+	{0x2145, 0x2145, idnaMapped, "d"},
+	{0x2146, 0x2146, idnaMapped, "d"},
+	{0x2147, 0x2147, idnaMapped, "e"},
+	{0x2148, 0x2148, idnaMapped, "i"},
+	{0x2149, 0x2149, idnaMapped, "j"},
+	{0x214A, 0x214F, idnaValid, ""},
+	{0x2150, 0x2150, idnaMapped, "1\u20447"},
+	{0x2151, 0x2151, idnaMapped, "1\u20449"},
+	{0x2152, 0x2152, idnaMapped, "1\u204410"},
+	{0x2153, 0x2153, idnaMapped, "1\u20443"},
+	{0x2154, 0x2154, idnaMapped, "2\u20443"},
+	{0x2155, 0x2155, idnaMapped, "1\u20445"},
+	{0x2156, 0x2156, idnaMapped, "2\u20445"},
+	{0x2157, 0x2157, idnaMapped, "3\u20445"},
+	{0x2158, 0x2158, idnaMapped, "4\u20445"},
+	{0x2159, 0x2159, idnaMapped, "1\u20446"},
+	{0x215A, 0x215A, idnaMapped, "5\u20446"},
+	{0x215B, 0x215B, idnaMapped, "1\u20448"},
+	{0x215C, 0x215C, idnaMapped, "3\u20448"},
+	{0x215D, 0x215D, idnaMapped, "5\u20448"},
+	{0x215E, 0x215E, idnaMapped, "7\u20448"},
+	{0x215F, 0x215F, idnaMapped, "1\u2044"},
+	{0x2160, 0x2160, idnaMapped, "i"},
+	{0x2161, 0x2161, idnaMapped, "ii"},
+	{0x2162, 0x2162, idnaMapped, "iii"},
+	{0x2163, 0x2163, idnaMapped, "iv"},
+	{0x2164, 0x2164, idnaMapped, "v"},
+	{0x2165, 0x2165, idnaMapped, "vi"},
+	{0x2166, 0x2166, idnaMapped, "vii"},
+	{0x2167, 0x2167, idnaMapped, "viii"},
+	{0x2168, 0x2168, idnaMapped, "ix"},
+	{0x2169, 0x2169, idnaMapped, "x"},
+	{0x216A, 0x216A, idnaMapped, "xi"},
+	{0x216B, 0x216B, idnaMapped, "xii"},
+	{0x216C, 0x216C, idnaMapped, "l"},
+	{0x216D, 0x216D, idnaMapped, "c"},
+	{0x216E, 0x216E, idnaMapped, "d"},
+	{0x216F, 0x216F, idnaMapped, "m"},
+	{0x2170, 0x2170, idnaMapped, "i"},
+	{0x2171, 0x2171, idnaMapped, "ii"},
+	{0x2172, 0x2172, idnaMapped, "iii"},
+	{0x2173, 0x2173, idnaMapped, "iv"},
+	{0x2174, 0x2174, idnaMapped, "v"},
+	{0x2175, 0x2175, idnaMapped, "vi"},
+	{0x2176, 0x2176, idnaMapped, "vii"},
+	{0x2177, 0x2177, idnaMapped, "viii"},
+	{0x2178, 0x2178, idnaMapped, "ix"},
+	{0x2179, 0x2179, idnaMapped, "x"},
+	{0x217A, 0x217A, idnaMapped, "xi"},
+	{0x217B, 0x217B, idnaMapped, "xii"},
+	{0x217C, 0x217C, idnaMapped, "l"},
+	{0x217D, 0x217D, idnaMapped, "c"},
+	{0x217E, 0x217E, idnaMapped, "d"},
+	{0x217F, 0x217F, idnaMapped, "m"},
+	{0x2180, 0x2182, idnaValid, ""},
+	{0x2183, 0x2183, idnaMapped, "\u2184"},
+	{0x2184, 0x2188, idnaValid, ""},
+	{0x2189, 0x2189, idnaMapped, "0\u20443"},
+	{0x218A, 0x218B, idnaValid, ""},
+	{0x218C, 0x218F, idnaDisallowed, ""},
+	{0x2190, 0x222B, idnaValid, ""},
+	{0x222C, 0x222C, idnaMapped, "\u222b\u222b"},
+	{0x222D, 0x222D, idnaMapped, "\u222b\u222b\u222b"},
+	{0x222E, 0x222E, idnaValid, ""},
+	{0x222F, 0x222F, idnaMapped, "\u222e\u222e"},
+	{0x2230, 0x2230, idnaMapped, "\u222e\u222e\u222e"},
+	{0x2231, 0x2328, idnaValid, ""},
+	{0x2329, 0x2329, idnaMapped, "\u3008"},
+	{0x232A, 0x232A, idnaMapped, "\u3009"},
+	{0x232B, 0x2429, idnaValid, ""},
+	{0x242A, 0x243F, idnaDisallowed, ""},
+	{0x2440, 0x244A, idnaValid, ""},
+	{0x244B, 0x245F, idnaDisallowed, ""},
+	{0x2460, 0x2460, idnaMapped, "1"},
+	{0x2461, 0x2461, idnaMapped, "2"},
+	{0x2462, 0x2462, idnaMapped, "3"},
+	{0x2463, 0x2463, idnaMapped, "4"},
+	{0x2464, 0x2464, idnaMapped, "5"},
+	{0x2465, 0x2465, idnaMapped, "6"},
+	{0x2466, 0x2466, idnaMapped, "7"},
+	{0x2467, 0x2467, idnaMapped, "8"},
+	{0x2468, 0x2468, idnaMapped, "9"},
+	{0x2469, 0x2469, idnaMapped, "10"},
+	{0x246A, 0x246A, idnaMapped, "11"},
+	{0x246B, 0x246B, idnaMapped, "12"},
+	{0x246C, 0x246C, idnaMapped, "13"},
+	{0x246D, 0x246D, idnaMapped, "14"},
+	{0x246E, 0x246E, idnaMapped, "15"},
+	{0x246F, 0x246F, idnaMapped, "16"},
+	{0x2470, 0x2470, idnaMapped, "17"},
+	{0x2471, 0x2471, idnaMapped, "18"},
+	{0x2472, 0x2472, idnaMapped, "19"},
+	{0x2473, 0x2473, idnaMapped, "20"},
+	{0x2474, 0x2474, idnaMapped, "(1)"},
+	{0x2475, 0x2475, idnaMapped, "(2)"},
+	{0x2476, 0x2476, idnaMapped, "(3)"},
+	{0x2477, 0x2477, idnaMapped, "(4)"},
+	{0x2478, 0x2478, idnaMapped, "(5)"},
+	{0x2479, 0x2479, idnaMapped, "(6)"},
+	{0x247A, 0x247A, idnaMapped, "(7)"},
+	{0x247B, 0x247B, idnaMapped, "(8)"},
+	{0x247C, 0x247C, idnaMapped, "(9)"},
+	{0x247D, 0x247D, idnaMapped, "(10)"},
+	{0x247E, 0x247E, idnaMapped, "(11)"},
+	{0x247F, 0x247F, idnaMapped, "(12)"},
+	{0x2480, 0x2480, idnaMapped, "(13)"},
+	{0x2481, 0x2481, idnaMapped, "(14)"},
+	{0x2482, 0x2482, idnaMapped, "(15)"},
+	{0x2483, 0x2483, idnaMapped, "(16)"},
+	{0x2484, 0x2484, idnaMapped, "(17)"},
+	{0x2485, 0x2485, idnaMapped, "(18)"},
+	{0x2486, 0x2486, idnaMapped, "(19)"},
+	{0x2487, 0x2487, idnaMapped, "(20)"},
+	{0x2488, 0x249B, idnaDisallowed, ""},
+	{0x249C, 0x249C, idnaMapped, "(a)"},
+	{0x249D, 0x249D, idnaMapped, "(b)"},
+	{0x249E, 0x249E, idnaMapped, "(c)"},
+	{0x249F, 0x249F, idnaMapped, "(d)"},
+	{0x24A0, 0x24A0, idnaMapped, "(e)"},
+	{0x24A1, 0x24A1, idnaMapped, "(f)"},
+	{0x24A2, 0x24A2, idnaMapped, "(g)"},
+	{0x24A3, 0x24A3, idnaMapped, "(h)"},
+	{0x24A4, 0x24A4, idnaMapped, "(i)"},
+	{0x24A5, 0x24A5, idnaMapped, "(j)"},
+	{0x24A6, 0x24A6, idnaMapped, "(k)"},
+	{0x24A7, 0x24A7, idnaMapped, "(l)"},
+	{0x24A8, 0x24A8, idnaMapped, "(m)"},
+	{0x24A9, 0x24A9, idnaMapped, "(n)"},
+	{0x24AA, 0x24AA, idnaMapped, "(o)"},
+	{0x24AB, 0x24AB, idnaMapped, "(p)"},
+	{0x24AC, 0x24AC, idnaMapped, "(q)"},
+	{0x24AD, 0x24AD, idnaMapped, "(r)"},
+	{0x24AE, 0x24AE, idnaMapped, "(s)"},
+	{0x24AF, 0x24AF, idnaMapped, "(t)"},
+	{0x24B0, 0x24B0, idnaMapped, "(u)"},
+	{0x24B1, 0x24B1, idnaMapped, "(v)"},
+	{0x24B2, 0x24B2, idnaMapped, "(w)"},
+	{0x24B3, 0x24B3, idnaMapped, "(x)"},
+	{0x24B4, 0x24B4, idnaMapped, "(y)"},
+	{0x24B5, 0x24B5, idnaMapped, "(z)"},
+	{0x24B6, 0x24B6, idnaMapped, "a"},
+	{0x24B7, 0x24B7, idnaMapped, "b"},
+	{0x24B8, 0x24B8, idnaMapped, "c"},
+	{0x24B9, 0x24B9, idnaMapped, "d"},
+	{0x24BA, 0x24BA, idnaMapped, "e"},
+	{0x24BB, 0x24BB, idnaMapped, "f"},
+	{0x24BC, 0x24BC, idnaMapped, "g"},
+	{0x24BD, 0x24BD, idnaMapped, "h"},
+	{0x24BE, 0x24BE, idnaMapped, "i"},
+	{0x24BF, 0x24BF, idnaMapped, "j"},
+	{0x24C0, 0x24C0, idnaMapped, "k"},
+	{0x24C1, 0x24C1, idnaMapped, "l"},
+	{0x24C2, 0x24C2, idnaMapped, "m"},
+	{0x24C3, 0x24C3, idnaMapped, "n"},
+	{0x24C4, 0x24C4, idnaMapped, "o"},
+	{0x24C5, 0x24C5, idnaMapped, "p"},
+	{0x24C6, 0x24C6, idnaMapped, "q"},
+	{0x24C7, 0x24C7, idnaMapped, "r"},
+	{0x24C8, 0x24C8, idnaMapped, "s"},
+	{0x24C9, 0x24C9, idnaMapped, "t"},
+	{0x24CA, 0x24CA, idnaMapped, "u"},
+	{0x24CB, 0x24CB, idnaMapped, "v"},
+	{0x24CC, 0x24CC, idnaMapped, "w"},
+	{0x24CD, 0x24CD, idnaMapped, "x"},
+	{0x24CE, 0x24CE, idnaMapped, "y"},
+	{0x24CF, 0x24CF, idnaMapped, "z"},
+	{0x24D0, 0x24D0, idnaMapped, "a"},
+	{0x24D1, 0x24D1, idnaMapped, "b"},
+	{0x24D2, 0x24D2, idnaMapped, "c"},
+	{0x24D3, 0x24D3, idnaMapped, "d"},
+	{0x24D4, 0x24D4, idnaMapped, "e"},
+	{0x24D5, 0x24D5, idnaMapped, "f"},
+	{0x24D6, 0x24D6, idnaMapped, "g"},
+	{0x24D7, 0x24D7, idnaMapped, "h"},
+	{0x24D8, 0x24D8, idnaMapped, "i"},
+	{0x24D9, 0x24D9, idnaMapped, "j"},
+	{0x24DA, 0x24DA, idnaMapped, "k"},
+	{0x24DB, 0x24DB, idnaMapped, "l"},
+	{0x24DC, 0x24DC, idnaMapped, "m"},
+	{0x24DD, 0x24DD, idnaMapped, "n"},
+	{0x24DE, 0x24DE, idnaMapped, "o"},
+	{0x24DF, 0x24DF, idnaMapped, "p"},
+	{0x24E0, 0x24E0, idnaMapped, "q"},
+	{0x24E1, 0x24E1, idnaMapped, "r"},
+	{0x24E2, 0x24E2, idnaMapped, "s"},
+	{0x24E3, 0x24E3, idnaMapped, "t"},
+	{0x24E4, 0x24E4, idnaMapped, "u"},
+	{0x24E5, 0x24E5, idnaMapped, "v"},
+	{0x24E6, 0x24E6, idnaMapped, "w"},
+	{0x24E7, 0x24E7, idnaMapped, "x"},
+	{0x24E8, 0x24E8, idnaMapped, "y"},
+	{0x24E9, 0x24E9, idnaMapped, "z"},
+	{0x24EA, 0x24EA, idnaMapped, "0"},
+	{0x24EB, 0x2A0B, idnaValid, ""},
+	{0x2A0C, 0x2A0C, idnaMapped, "\u222b\u222b\u222b\u222b"},
+	{0x2A0D, 0x2A73, idnaValid, ""},
+	{0x2A74, 0x2A74, idnaMapped, "::="},
+	{0x2A75, 0x2A75, idnaMapped, "=="},
+	{0x2A76, 0x2A76, idnaMapped, "==="},
+	{0x2A77, 0x2ADB, idnaValid, ""},
+	{0x2ADC, 0x2ADC, idnaMapped, "\u2add\u0338"},
+	{0x2ADD, 0x2B73, idnaValid, ""},
+	{0x2B74, 0x2B75, idnaDisallowed, ""},
+	{0x2B76, 0x2BFF, idnaValid, ""},
+	{0x2C00, 0x2C00, idnaMapped, "\u2c30"},
+	{0x2C01, 0x2C01, idnaMapped, "\u2c31"},
+	{0x2C02, 0x2C02, idnaMapped, "\u2c32"},
+	{0x2C03, 0x2C03, idnaMapped, "\u2c33"},
+	{0x2C04, 0x2C04, idnaMapped, "\u2c34"},
+	{0x2C05, 0x2C05, idnaMapped, "\u2c35"},
+	{0x2C06, 0x2C06, idnaMapped, "\u2c36"},
+	{0x2C07, 0x2C07, idnaMapped, "\u2c37"},
+	{0x2C08, 0x2C08, idnaMapped, "\u2c38"},
+	{0x2C09, 0x2C09, idnaMapped, "\u2c39"},
+	{0x2C0A, 0x2C0A, idnaMapped, "\u2c3a"},
+	{0x2C0B, 0x2C0B, idnaMapped, "\u2c3b"},
+	{0x2C0C, 0x2C0C, idnaMapped, "\u2c3c"},
+	{0x2C0D, 0x2C0D, idnaMapped, "\u2c3d"},
+	{0x2C0E, 0x2C0E, idnaMapped, "\u2c3e"},
+	{0x2C0F, 0x2C0F, idnaMapped, "\u2c3f"},
+	{0x2C10, 0x2C10, idnaMapped, "\u2c40"},
+	{0x2C11, 0x2C11, idnaMapped, "\u2c41"},
+	{0x2C12, 0x2C12, idnaMapped, "\u2c42"},
+	{0x2C13, 0x2C13, idnaMapped, "\u2c43"},
+	{0x2C14, 0x2C14, idnaMapped, "\u2c44"},
+	{0x2C15, 0x2C15, idnaMapped, "\u2c45"},
+	{0x2C16, 0x2C16, idnaMapped, "\u2c46"},
+	{0x2C17, 0x2C17, idnaMapped, "\u2c47"},
+	{0x2C18, 0x2C18, idnaMapped, "\u2c48"},
+	{0x2C19, 0x2C19, idnaMapped, "\u2c49"},
+	{0x2C1A, 0x2C1A, idnaMapped, "\u2c4a"},
+	{0x2C1B, 0x2C1B, idnaMapped, "\u2c4b"},
+	{0x2C1C, 0x2C1C, idnaMapped, "\u2c4c"},
+	{0x2C1D, 0x2C1D, idnaMapped, "\u2c4d"},
+	{0x2C1E, 0x2C1E, idnaMapped, "\u2c4e"},
+	{0x2C1F, 0x2C1F, idnaMapped, "\u2c4f"},
+	{0x2C20, 0x2C20, idnaMapped, "\u2c50"},
+	{0x2C21, 0x2C21, idnaMapped, "\u2c51"},
+	{0x2C22, 0x2C22, idnaMapped, "\u2c52"},
+	{0x2C23, 0x2C23, idnaMapped, "\u2c53"},
+	{0x2C24, 0x2C24, idnaMapped, "\u2c54"},
+	{0x2C25, 0x2C25, idnaMapped, "\u2c55"},
+	{0x2C26, 0x2C26, idnaMapped, "\u2c56"},
+	{0x2C27, 0x2C27, idnaMapped, "\u2c57"},
+	{0x2C28, 0x2C28, idnaMapped, "\u2c58"},
+	{0x2C29, 0x2C29, idnaMapped, "\u2c59"},
+	{0x2C2A, 0x2C2A, idnaMapped, "\u2c5a"},
+	{0x2C2B, 0x2C2B, idnaMapped, "\u2c5b"},
+	{0x2C2C, 0x2C2C, idnaMapped, "\u2c5c"},
+	{0x2C2D, 0x2C2D, idnaMapped, "\u2c5d"},
+	{0x2C2E, 0x2C2E, idnaMapped, "\u2c5e"},
+	{0x2C2F, 0x2C2F, idnaMapped, "\u2c5f"},
+	{0x2C30, 0x2C5F, idnaValid, ""},
+	{0x2C60, 0x2C60, idnaMapped, "\u2c61"},
+	{0x2C61, 0x2C61, idnaValid, ""},
+	{0x2C62, 0x2C62, idnaMapped, "\u026b"},
+	{0x2C63, 0x2C63, idnaMapped, "\u1d7d"},
+	{0x2C64, 0x2C64, idnaMapped, "\u027d"},
+	{0x2C65, 0x2C66, idnaValid, ""},
+	{0x2C67, 0x2C67, idnaMapped, "\u2c68"},
+	{0x2C68, 0x2C68, idnaValid, ""},
+	{0x2C69, 0x2C69, idnaMapped, "\u2c6a"},
+	{0x2C6A, 0x2C6A, idnaValid, ""},
+	{0x2C6B, 0x2C6B, idnaMapped, "\u2c6c"},
+	{0x2C6C, 0x2C6C, idnaValid, ""},
+	{0x2C6D, 0x2C6D, idnaMapped, "\u0251"},
+	{0x2C6E, 0x2C6E, idnaMapped, "\u0271"},
+	{0x2C6F, 0x2C6F, idnaMapped, "\u0250"},
+	{0x2C70, 0x2C70, idnaMapped, "\u0252"},
+	{0x2C71, 0x2C71, idnaValid, ""},
+	{0x2C72, 0x2C72, idnaMapped, "\u2c73"},
+	{0x2C73, 0x2C74, idnaValid, ""},
+	{0x2C75, 0x2C75, idnaMapped, "\u2c76"},
+	{0x2C76, 0x2C7B, idnaValid, ""},
+	{0x2C7C, 0x2C7C, idnaMapped, "j"},
+	{0x2C7D, 0x2C7D, idnaMapped, "v"},
+	{0x2C7E, 0x2C7E, idnaMapped, "\u023f"},
+	{0x2C7F, 0x2C7F, idnaMapped, "\u0240"},
+	{0x2C80, 0x2C80, idnaMapped, "\u2c81"},
+	{0x2C81, 0x2C81, idnaValid, ""},
+	{0x2C82, 0x2C82, idnaMapped, "\u2c83"},
+	{0x2C83, 0x2C83, idnaValid, ""},
+	{0x2C84, 0x2C84, idnaMapped, "\u2c85"},
+	{0x2C85, 0x2C85, idnaValid, ""},
+	{0x2C86, 0x2C86, idnaMapped, "\u2c87"},
+	{0x2C87, 0x2C87, idnaValid, ""},
+	{0x2C88, 0x2C88, idnaMapped, "\u2c89"},
+	{0x2C89, 0x2C89, idnaValid, ""},
+	{0x2C8A, 0x2C8A, idnaMapped, "\u2c8b"},
+	{0x2C8B, 0x2C8B, idnaValid, ""},
+	{0x2C8C, 0x2C8C, idnaMapped, "\u2c8d"},
+	{0x2C8D, 0x2C8D, idnaValid, ""},
+	{0x2C8E, 0x2C8E, idnaMapped, "\u2c8f"},
+	{0x2C8F, 0x2C8F, idnaValid, ""},
+	{0x2C90, 0x2C90, idnaMapped, "\u2c91"},
+	{0x2C91, 0x2C91, idnaValid, ""},
+	{0x2C92, 0x2C92, idnaMapped, "\u2c93"},
+	{0x2C93, 0x2C93, idnaValid, ""},
+	{0x2C94, 0x2C94, idnaMapped, "\u2c95"},
+	{0x2C95, 0x2C95, idnaValid, ""},
+	{0x2C96, 0x2C96, idnaMapped, "\u2c97"},
+	{0x2C97, 0x2C97, idnaValid, ""},
+	{0x2C98, 0x2C98, idnaMapped, "\u2c99"},
+	{0x2C99, 0x2C99, idnaValid, ""},
+	{0x2C9A, 0x2C9A, idnaMapped, "\u2c9b"},
+	{0x2C9B, 0x2C9B, idnaValid, ""},
+	{0x2C9C, 0x2C9C, idnaMapped, "\u2c9d"},
+	{0x2C9D, 0x2C9D, idnaValid, ""},
+	{0x2C9E, 0x2C9E, idnaMapped, "\u2c9f"},
+	{0x2C9F, 0x2C9F, idnaValid, ""},
+	{0x2CA0, 0x2CA0, idnaMapped, "\u2ca1"},
+	{0x2CA1, 0x2CA1, idnaValid, ""},
+	{0x2CA2, 0x2CA2, idnaMapped, "\u2ca3"},
+	{0x2CA3, 0x2CA3, idnaValid, ""},
+	{0x2CA4, 0x2CA4, idnaMapped, "\u2ca5"},
+	{0x2CA5, 0x2CA5, idnaValid, ""},
+	{0x2CA6, 0x2CA6, idnaMapped, "\u2ca7"},
+	{0x2CA7, 0x2CA7, idnaValid, ""},
+	{0x2CA8, 0x2CA8, idnaMapped, "\u2ca9"},
+	{0x2CA9, 0x2CA9, idnaValid, ""},
+	{0x2CAA, 0x2CAA, idnaMapped, "\u2cab"},
+	{0x2CAB, 0x2CAB, idnaValid, ""},
+	{0x2CAC, 0x2CAC, idnaMapped, "\u2cad"},
+	{0x2CAD, 0x2CAD, idnaValid, ""},
+	{0x2CAE, 0x2CAE, idnaMapped, "\u2caf"},
+	{0x2CAF, 0x2CAF, idnaValid, ""},
+	{0x2CB0, 0x2CB0, idnaMapped, "\u2cb1"},
+	{0x2CB1, 0x2CB1, idnaValid, ""},
+	{0x2CB2, 0x2CB2, idnaMapped, "\u2cb3"},
+	{0x2CB3, 0x2CB3, idnaValid, ""},
+	{0x2CB4, 0x2CB4, idnaMapped, "\u2cb5"},
+	{0x2CB5, 0x2CB5, idnaValid, ""},
+	{0x2CB6, 0x2CB6, idnaMapped, "\u2cb7"},
+	{0x2CB7, 0x2CB7, idnaValid, ""},
+	{0x2CB8, 0x2CB8, idnaMapped, "\u2cb9"},
+	{0x2CB9, 0x2CB9, idnaValid, ""},
+	{0x2CBA, 0x2CBA, idnaMapped, "\u2cbb"},
+	{0x2CBB, 0x2CBB, idnaValid, ""},
+	{0x2CBC, 0x2CBC, idnaMapped, "\u2cbd"},
+	{0x2CBD, 0x2CBD, idnaValid, ""},
+	{0x2CBE, 0x2CBE, idnaMapped, "\u2cbf"},
+	{0x2CBF, 0x2CBF, idnaValid, ""},
+	{0x2CC0, 0x2CC0, idnaMapped, "\u2cc1"},
+	{0x2CC1, 0x2CC1, idnaValid, ""},
+	{0x2CC2, 0x2CC2, idnaMapped, "\u2cc3"},
+	{0x2CC3, 0x2CC3, idnaValid, ""},
+	{0x2CC4, 0x2CC4, idnaMapped, "\u2cc5"},
+	{0x2CC5, 0x2CC5, idnaValid, ""},
+	{0x2CC6, 0x2CC6, idnaMapped, "\u2cc7"},
+	{0x2CC7, 0x2CC7, idnaValid, ""},
+	{0x2CC8, 0x2CC8, idnaMapped, "\u2cc9"},
+	{0x2CC9, 0x2CC9, idnaValid, ""},
+	{0x2CCA, 0x2CCA, idnaMapped, "\u2ccb"},
+	{0x2CCB, 0x2CCB, idnaValid, ""},
+	{0x2CCC, 0x2CCC, idnaMapped, "\u2ccd"},
+	{0x2CCD, 0x2CCD, idnaValid, ""},
+	{0x2CCE, 0x2CCE, idnaMapped, "\u2ccf"},
+	{0x2CCF, 0x2CCF, idnaValid, ""},
+	{0x2CD0, 0x2CD0, idnaMapped, "\u2cd1"},
+	{0x2CD1, 0x2CD1, idnaValid, ""},
+	{0x2CD2, 0x2CD2, idnaMapped, "\u2cd3"},
+	{0x2CD3, 0x2CD3, idnaValid, ""},
+	{0x2CD4, 0x2CD4, idnaMapped, "\u2cd5"},
+	{0x2CD5, 0x2CD5, idnaValid, ""},
+	{0x2CD6, 0x2CD6, idnaMapped, "\u2cd7"},
+	{0x2CD7, 0x2CD7, idnaValid, ""},
+	{0x2CD8, 0x2CD8, idnaMapped, "\u2cd9"},
+	{0x2CD9, 0x2CD9, idnaValid, ""},
+	{0x2CDA, 0x2CDA, idnaMapped, "\u2cdb"},
+	{0x2CDB, 0x2CDB, idnaValid, ""},
+	{0x2CDC, 0x2CDC, idnaMapped, "\u2cdd"},
+	{0x2CDD, 0x2CDD, idnaValid, ""},
+	{0x2CDE, 0x2CDE, idnaMapped, "\u2cdf"},
+	{0x2CDF, 0x2CDF, idnaValid, ""},
+	{0x2CE0, 0x2CE0, idnaMapped, "\u2ce1"},
+	{0x2CE1, 0x2CE1, idnaValid, ""},
+	{0x2CE2, 0x2CE2, idnaMapped, "\u2ce3"},
+	{0x2CE3, 0x2CEA, idnaValid, ""},
+	{0x2CEB, 0x2CEB, idnaMapped, "\u2cec"},
+	{0x2CEC, 0x2CEC, idnaValid, ""},
+	{0x2CED, 0x2CED, idnaMapped, "\u2cee"},
+	{0x2CEE, 0x2CF1, idnaValid, ""},
+	{0x2CF2, 0x2CF2, idnaMapped, "\u2cf3"},
+	{0x2CF3, 0x2CF3, idnaValid, ""},
+	{0x2CF4, 0x2CF8, idnaDisallowed, ""},
+	{0x2CF9, 0x2D25, idnaValid, ""},
+	{0x2D26, 0x2D26, idnaDisallowed, ""},
+	{0x2D27, 0x2D27, idnaValid, ""},
+	{0x2D28, 0x2D2C, idnaDisallowed, ""},
+	{0x2D2D, 0x2D2D, idnaValid, ""},
+	{0x2D2E, 0x2D2F, idnaDisallowed, ""},
+	{0x2D30, 0x2D67, idnaValid, ""},
+	{0x2D68, 0x2D6E, idnaDisallowed, ""},
+	{0x2D6F, 0x2D6F, idnaMapped, "\u2d61"},
+	{0x2D70, 0x2D70, idnaValid, ""},
+	{0x2D71, 0x2D7E, idnaDisallowed, ""},
+	{0x2D7F, 0x2D96, idnaValid, ""},
+	{0x2D97, 0x2D9F, idnaDisallowed, ""},
+	{0x2DA0, 0x2DA6, idnaValid, ""},
+	{0x2DA7, 0x2DA7, idnaDisallowed, ""},
+	{0x2DA8, 0x2DAE, idnaValid, ""},
+	{0x2DAF, 0x2DAF, idnaDisallowed, ""},
+	{0x2DB0, 0x2DB6, idnaValid, ""},
+	{0x2DB7, 0x2DB7, idnaDisallowed, ""},
+	{0x2DB8, 0x2DBE, idnaValid, ""},
+	{0x2DBF, 0x2DBF, idnaDisallowed, ""},
+	{0x2DC0, 0x2DC6, idnaValid, ""},
+	{0x2DC7, 0x2DC7, idnaDisallowed, ""},
+	{0x2DC8, 0x2DCE, idnaValid, ""},
+	{0x2DCF, 0x2DCF, idnaDisallowed, ""},
+	{0x2DD0, 0x2DD6, idnaValid, ""},
+	{0x2DD7, 0x2DD7, idnaDisallowed, ""},
+	{0x2DD8, 0x2DDE, idnaValid, ""},
+	{0x2DDF, 0x2DDF, idnaDisallowed, ""},
+	{0x2DE0, 0x2E5D, idnaValid, ""},
+	{0x2E5E, 0x2E7F, idnaDisallowed, ""},
+	{0x2E80, 0x2E99, idnaValid, ""},
+	{0x2E9A, 0x2E9A, idnaDisallowed, ""},
+	{0x2E9B, 0x2E9E, idnaValid, ""},
+	{0x2E9F, 0x2E9F, idnaMapped, "\u6bcd"},
+	{0x2EA0, 0x2EF2, idnaValid, ""},
+	{0x2EF3, 0x2EF3, idnaMapped, "\u9f9f"},
+	{0x2EF4, 0x2EFF, idnaDisallowed, ""},
+	{0x2F00, 0x2F00, idnaMapped, "\u4e00"},
+	{0x2F01, 0x2F01, idnaMapped, "\u4e28"},
+	{0x2F02, 0x2F02, idnaMapped, "\u4e36"},
+	{0x2F03, 0x2F03, idnaMapped, "\u4e3f"},
+	{0x2F04, 0x2F04, idnaMapped, "\u4e59"},
+	{0x2F05, 0x2F05, idnaMapped, "\u4e85"},
+	{0x2F06, 0x2F06, idnaMapped, "\u4e8c"},
+	{0x2F07, 0x2F07, idnaMapped, "\u4ea0"},
+	{0x2F08, 0x2F08, idnaMapped, "\u4eba"},
+	{0x2F09, 0x2F09, idnaMapped, "\u513f"},
+	{0x2F0A, 0x2F0A, idnaMapped, "\u5165"},
+	{0x2F0B, 0x2F0B, idnaMapped, "\u516b"},
+	{0x2F0C, 0x2F0C, idnaMapped, "\u5182"},
+	{0x2F0D, 0x2F0D, idnaMapped, "\u5196"},
+	{0x2F0E, 0x2F0E, idnaMapped, "\u51ab"},
+	{0x2F0F, 0x2F0F, idnaMapped, "\u51e0"},
+	{0x2F10, 0x2F10, idnaMapped, "\u51f5"},
+	{0x2F11, 0x2F11, idnaMapped, "\u5200"},
+	{0x2F12, 0x2F12, idnaMapped, "\u529b"},
+	{0x2F13, 0x2F13, idnaMapped, "\u52f9"},
+	{0x2F14, 0x2F14, idnaMapped, "\u5315"},
+	{0x2F15, 0x2F15, idnaMapped, "\u531a"},
+	{0x2F16, 0x2F16, idnaMapped, "\u5338"},
+	{0x2F17, 0x2F17, idnaMapped, "\u5341"},
+	{0x2F18, 0x2F18, idnaMapped, "\u535c"},
+	{0x2F19, 0x2F19, idnaMapped, "\u5369"},
+	{0x2F1A, 0x2F1A, idnaMapped, "\u5382"},
+	{0x2F1B, 0x2F1B, idnaMapped, "\u53b6"},
+	{0x2F1C, 0x2F1C, idnaMapped, "\u53c8"},
+	{0x2F1D, 0x2F1D, idnaMapped, "\u53e3"},
+	{0x2F1E, 0x2F1E, idnaMapped, "\u56d7"},
+	{0x2F1F, 0x2F1F, idnaMapped, "\u571f"},
+	{0x2F20, 0x2F20, idnaMapped, "\u58eb"},
+	{0x2F21, 0x2F21, idnaMapped, "\u5902"},
+	{0x2F22, 0x2F22, idnaMapped, "\u590a"},
+	{0x2F23, 0x2F23, idnaMapped, "\u5915"},
+	{0x2F24, 0x2F24, idnaMapped, "\u5927"},
+	{0x2F25, 0x2F25, idnaMapped, "\u5973"},
+	{0x2F26, 0x2F26, idnaMapped, "\u5b50"},
+	{0x2F27, 0x2F27, idnaMapped, "\u5b80"},
+	{0x2F28, 0x2F28, idnaMapped, "\u5bf8"},
+	{0x2F29, 0x2F29, idnaMapped, "\u5c0f"},
+	{0x2F2A, 0x2F2A, idnaMapped, "\u5c22"},
+	{0x2F2B, 0x2F2B, idnaMapped, "\u5c38"},
+	{0x2F2C, 0x2F2C, idnaMapped, "\u5c6e"},
+	{0x2F2D, 0x2F2D, idnaMapped, "\u5c71"},
+	{0x2F2E, 0x2F2E, idnaMapped, "\u5ddb"},
+	{0x2F2F, 0x2F2F, idnaMapped, "\u5de5"},
+	{0x2F30, 0x2F30, idnaMapped, "\u5df1"},
+	{0x2F31, 0x2F31, idnaMapped, "\u5dfe"},
+	{0x2F32, 0x2F32, idnaMapped, "\u5e72"},
+	{0x2F33, 0x2F33, idnaMapped, "\u5e7a"},
+	{0x2F34, 0x2F34, idnaMapped, "\u5e7f"},
+	{0x2F35, 0x2F35, idnaMapped, "\u5ef4"},
+	{0x2F36, 0x2F36, idnaMapped, "\u5efe"},
+	{0x2F37, 0x2F37, idnaMapped, "\u5f0b"},
+	{0x2F38, 0x2F38, idnaMapped, "\u5f13"},
+	{0x2F39, 0x2F39, idnaMapped, "\u5f50"},
+	{0x2F3A, 0x2F3A, idnaMapped, "\u5f61"},
+	{0x2F3B, 0x2F3B, idnaMapped, "\u5f73"},
+	{0x2F3C, 0x2F3C, idnaMapped, "\u5fc3"},
+	{0x2F3D, 0x2F3D, idnaMapped, "\u6208"},
+	{0x2F3E, 0x2F3E, idnaMapped, "\u6236"},
+	{0x2F3F, 0x2F3F, idnaMapped, "\u624b"},
+	{0x2F40, 0x2F40, idnaMapped, "\u652f"},
+	{0x2F41, 0x2F41, idnaMapped, "\u6534"},
+	{0x2F42, 0x2F42, idnaMapped, "\u6587"},
+	{0x2F43, 0x2F43, idnaMapped, "\u6597"},
+	{0x2F44, 0x2F44, idnaMapped, "\u65a4"},
+	{0x2F45, 0x2F45, idnaMapped, "\u65b9"},
+	{0x2F46, 0x2F46, idnaMapped, "\u65e0"},
+	{0x2F47, 0x2F47, idnaMapped, "\u65e5"},
+	{0x2F48, 0x2F48, idnaMapped, "\u66f0"},
+	{0x2F49, 0x2F49, idnaMapped, "\u6708"},
+	{0x2F4A, 0x2F4A, idnaMapped, "\u6728"},
+	{0x2F4B, 0x2F4B, idnaMapped, "\u6b20"},
+	{0x2F4C, 0x2F4C, idnaMapped, "\u6b62"},
+	{0x2F4D, 0x2F4D, idnaMapped, "\u6b79"},
+	{0x2F4E, 0x2F4E, idnaMapped, "\u6bb3"},
+	{0x2F4F, 0x2F4F, idnaMapped, "\u6bcb"},
+	{0x2F50, 0x2F50, idnaMapped, "\u6bd4"},
+	{0x2F51, 0x2F51, idnaMapped, "\u6bdb"},
+	{0x2F52, 0x2F52, idnaMapped, "\u6c0f"},
+	{0x2F53, 0x2F53, idnaMapped, "\u6c14"},
+	{0x2F54, 0x2F54, idnaMapped, "\u6c34"},
+	{0x2F55, 0x2F55, idnaMapped, "\u706b"},
+	{0x2F56, 0x2F56, idnaMapped, "\u722a"},
+	{0x2F57, 0x2F57, idnaMapped, "\u7236"},
+	{0x2F58, 0x2F58, idnaMapped, "\u723b"},
+	{0x2F59, 0x2F59, idnaMapped, "\u723f"},
+	{0x2F5A, 0x2F5A, idnaMapped, "\u7247"},
+	{0x2F5B, 0x2F5B, idnaMapped, "\u7259"},
+	{0x2F5C, 0x2F5C, idnaMapped, "\u725b"},
+	{0x2F5D, 0x2F5D, idnaMapped, "\u72ac"},
+	{0x2F5E, 0x2F5E, idnaMapped, "\u7384"},
+	{0x2F5F, 0x2F5F, idnaMapped, "\u7389"},
+	{0x2F60, 0x2F60, idnaMapped, "\u74dc"},
+	{0x2F61, 0x2F61, idnaMapped, "\u74e6"},
+	{0x2F62, 0x2F62, idnaMapped, "\u7518"},
+	{0x2F63, 0x2F63, idnaMapped, "\u751f"},
+	{0x2F64, 0x2F64, idnaMapped, "\u7528"},
+	{0x2F65, 0x2F65, idnaMapped, "\u7530"},
+	{0x2F66, 0x2F66, idnaMapped, "\u758b"},
+	{0x2F67, 0x2F67, idnaMapped, "\u7592"},
+	{0x2F68, 0x2F68, idnaMapped, "\u7676"},
+	{0x2F69, 0x2F69, idnaMapped, "\u767d"},
+	{0x2F6A, 0x2F6A, idnaMapped, "\u76ae"},
+	{0x2F6B, 0x2F6B, idnaMapped, "\u76bf"},
+	{0x2F6C, 0x2F6C, idnaMapped, "\u76ee"},
+	{0x2F6D, 0x2F6D, idnaMapped, "\u77db"},
+	{0x2F6E, 0x2F6E, idnaMapped, "\u77e2"},
+	{0x2F6F, 0x2F6F, idnaMapped, "\u77f3"},
+	{0x2F70, 0x2F70, idnaMapped, "\u793a"},
+	{0x2F71, 0x2F71, idnaMapped, "\u79b8"},
+	{0x2F72, 0x2F72, idnaMapped, "\u79be"},
+	{0x2F73, 0x2F73, idnaMapped, "\u7a74"},
+	{0x2F74, 0x2F74, idnaMapped, "\u7acb"},
+	{0x2F75, 0x2F75, idnaMapped, "\u7af9"},
+	{0x2F76, 0x2F76, idnaMapped, "\u7c73"},
+	{0x2F77, 0x2F77, idnaMapped, "\u7cf8"},
+	{0x2F78, 0x2F78, idnaMapped, "\u7f36"},
+	{0x2F79, 0x2F79, idnaMapped, "\u7f51"},
+	{0x2F7A, 0x2F7A, idnaMapped, "\u7f8a"},
+	{0x2F7B, 0x2F7B, idnaMapped, "\u7fbd"},
+	{0x2F7C, 0x2F7C, idnaMapped, "\u8001"},
+	{0x2F7D, 0x2F7D, idnaMapped, "\u800c"},
+	{0x2F7E, 0x2F7E, idnaMapped, "\u8012"},
+	{0x2F7F, 0x2F7F, idnaMapped, "\u8033"},
+	{0x2F80, 0x2F80, idnaMapped, "\u807f"},
+	{0x2F81, 0x2F81, idnaMapped, "\u8089"},
+	{0x2F82, 0x2F82, idnaMapped, "\u81e3"},
+	{0x2F83, 0x2F83, idnaMapped, "\u81ea"},
+	{0x2F84, 0x2F84, idnaMapped, "\u81f3"},
+	{0x2F85, 0x2F85, idnaMapped, "\u81fc"},
+	{0x2F86, 0x2F86, idnaMapped, "\u820c"},
+	{0x2F87, 0x2F87, idnaMapped, "\u821b"},
+	{0x2F88, 0x2F88, idnaMapped, "\u821f"},
+	{0x2F89, 0x2F89, idnaMapped, "\u826e"},
+	{0x2F8A, 0x2F8A, idnaMapped, "\u8272"},
+	{0x2F8B, 0x2F8B, idnaMapped, "\u8278"},
+	{0x2F8C, 0x2F8C, idnaMapped, "\u864d"},
+	{0x2F8D, 0x2F8D, idnaMapped, "\u866b"},
+	{0x2F8E, 0x2F8E, idnaMapped, "\u8840"},
+	{0x2F8F, 0x2F8F, idnaMapped, "\u884c"},
+	{0x2F90, 0x2F90, idnaMapped, "\u8863"},
+	{0x2F91, 0x2F91, idnaMapped, "\u897e"},
+	{0x2F92, 0x2F92, idnaMapped, "\u898b"},
+	{0x2F93, 0x2F93, idnaMapped, "\u89d2"},
+	{0x2F94, 0x2F94, idnaMapped, "\u8a00"},
+	{0x2F95, 0x2F95, idnaMapped, "\u8c37"},
+	{0x2F96, 0x2F96, idnaMapped, "\u8c46"},
+	{0x2F97, 0x2F97, idnaMapped, "\u8c55"},
+	{0x2F98, 0x2F98, idnaMapped, "\u8c78"},
+	{0x2F99, 0x2F99, idnaMapped, "\u8c9d"},
+	{0x2F9A, 0x2F9A, idnaMapped, "\u8d64"},
+	{0x2F9B, 0x2F9B, idnaMapped, "\u8d70"},
+	{0x2F9C, 0x2F9C, idnaMapped, "\u8db3"},
+	{0x2F9D, 0x2F9D, idnaMapped, "\u8eab"},
+	{0x2F9E, 0x2F9E, idnaMapped, "\u8eca"},
+	{0x2F9F, 0x2F9F, idnaMapped, "\u8f9b"},
+	{0x2FA0, 0x2FA0, idnaMapped, "\u8fb0"},
+	{0x2FA1, 0x2FA1, idnaMapped, "\u8fb5"},
+	{0x2FA2, 0x2FA2, idnaMapped, "\u9091"},
+	{0x2FA3, 0x2FA3, idnaMapped, "\u9149"},
+	{0x2FA4, 0x2FA4, idnaMapped, "\u91c6"},
+	{0x2FA5, 0x2FA5, idnaMapped, "\u91cc"},
+	{0x2FA6, 0x2FA6, idnaMapped, "\u91d1"},
+	{0x2FA7, 0x2FA7, idnaMapped, "\u9577"},
+	{0x2FA8, 0x2FA8, idnaMapped, "\u9580"},
+	{0x2FA9, 0x2FA9, idnaMapped, "\u961c"},
+	{0x2FAA, 0x2FAA, idnaMapped, "\u96b6"},
+	{0x2FAB, 0x2FAB, idnaMapped, "\u96b9"},
+	{0x2FAC, 0x2FAC, idnaMapped, "\u96e8"},
+	{0x2FAD, 0x2FAD, idnaMapped, "\u9751"},
+	{0x2FAE, 0x2FAE, idnaMapped, "\u975e"},
+	{0x2FAF, 0x2FAF, idnaMapped, "\u9762"},
+	{0x2FB0, 0x2FB0, idnaMapped, "\u9769"},
+	{0x2FB1, 0x2FB1, idnaMapped, "\u97cb"},
+	{0x2FB2, 0x2FB2, idnaMapped, "\u97ed"},
+	{0x2FB3, 0x2FB3, idnaMapped, "\u97f3"},
+	{0x2FB4, 0x2FB4, idnaMapped, "\u9801"},
+	{0x2FB5, 0x2FB5, idnaMapped, "\u98a8"},
+	{0x2FB6, 0x2FB6, idnaMapped, "\u98db"},
+	{0x2FB7, 0x2FB7, idnaMapped, "\u98df"},
+	{0x2FB8, 0x2FB8, idnaMapped, "\u9996"},
+	{0x2FB9, 0x2FB9, idnaMapped, "\u9999"},
+	{0x2FBA, 0x2FBA, idnaMapped, "\u99ac"},
+	{0x2FBB, 0x2FBB, idnaMapped, "\u9aa8"},
+	{0x2FBC, 0x2FBC, idnaMapped, "\u9ad8"},
+	{0x2FBD, 0x2FBD, idnaMapped, "\u9adf"},
+	{0x2FBE, 0x2FBE, idnaMapped, "\u9b25"},
+	{0x2FBF, 0x2FBF, idnaMapped, "\u9b2f"},
+	{0x2FC0, 0x2FC0, idnaMapped, "\u9b32"},
+	{0x2FC1, 0x2FC1, idnaMapped, "\u9b3c"},
+	{0x2FC2, 0x2FC2, idnaMapped, "\u9b5a"},
+	{0x2FC3, 0x2FC3, idnaMapped, "\u9ce5"},
+	{0x2FC4, 0x2FC4, idnaMapped, "\u9e75"},
+	{0x2FC5, 0x2FC5, idnaMapped, "\u9e7f"},
+	{0x2FC6, 0x2FC6, idnaMapped, "\u9ea5"},
+	{0x2FC7, 0x2FC7, idnaMapped, "\u9ebb"},
+	{0x2FC8, 0x2FC8, idnaMapped, "\u9ec3"},
+	{0x2FC9, 0x2FC9, idnaMapped, "\u9ecd"},
+	{0x2FCA, 0x2FCA, idnaMapped, "\u9ed1"},
+	{0x2FCB, 0x2FCB, idnaMapped, "\u9ef9"},
+	{0x2FCC, 0x2FCC, idnaMapped, "\u9efd"},
+	{0x2FCD, 0x2FCD, idnaMapped, "\u9f0e"},
+	{0x2FCE, 0x2FCE, idnaMapped, "\u9f13"},
+	{0x2FCF, 0x2FCF, idnaMapped, "\u9f20"},
+	{0x2FD0, 0x2FD0, idnaMapped, "\u9f3b"},
+	{0x2FD1, 0x2FD1, idnaMapped, "\u9f4a"},
+	{0x2FD2, 0x2FD2, idnaMapped, "\u9f52"},
+	{0x2FD3, 0x2FD3, idnaMapped, "\u9f8d"},
+	{0x2FD4, 0x2FD4, idnaMapped, "\u9f9c"},
+	{0x2FD5, 0x2FD5, idnaMapped, "\u9fa0"},
+	{0x2FD6, 0x2FFF, idnaDisallowed, ""},
+	{0x3000, 0x3000, idnaMapped, " "},
+	{0x3001, 0x3001, idnaValid, ""},
+	{0x3002, 0x3002, idnaMapped, "."},
+	{0x3003, 0x3035, idnaValid, ""},
+	{0x3036, 0x3036, idnaMapped, "\u3012"},
+	{0x3037, 0x3037, idnaValid, ""},
+	{0x3038, 0x3038, idnaMapped, "\u5341"},
+	{0x3039, 0x3039, idnaMapped, "\u5344"},
+	{0x303A, 0x303A, idnaMapped, "\u5345"},
+	{0x303B, 0x303F, idnaValid, ""},
+	{0x3040, 0x3040, idnaDisallowed, ""},
+	{0x3041, 0x3096, idnaValid, ""},
+	{0x3097, 0x3098, idnaDisallowed, ""},
+	{0x3099, 0x309A, idnaValid, ""},
+	{0x309B, 0x309B, idnaMapped, " \u3099"},
+	{0x309C, 0x309C, idnaMapped, " \u309a"},
+	{0x309D, 0x309E, idnaValid, ""},
+	{0x309F, 0x309F, idnaMapped, "\u3088\u308a"},
+	{0x30A0, 0x30FE, idnaValid, ""},
+	{0x30FF, 0x30FF, idnaMapped, "\u30b3\u30c8"},
+	{0x3100, 0x3104, idnaDisallowed, ""},
+	{0x3105, 0x312F, idnaValid, ""},
+	{0x3130, 0x3130, idnaDisallowed, ""},
+	{0x3131, 0x3131, idnaMapped, "\u1100"},
+	{0x3132, 0x3132, idnaMapped, "\u1101"},
+	{0x3133, 0x3133, idnaMapped, "\u11aa"},
+	{0x3134, 0x3134, idnaMapped, "\u1102"},
+	{0x3135, 0x3135, idnaMapped, "\u11ac"},
+	{0x3136, 0x3136, idnaMapped, "\u11ad"},
+	{0x3137, 0x3137, idnaMapped, "\u1103"},
+	{0x3138, 0x3138, idnaMapped, "\u1104"},
+	{0x3139, 0x3139, idnaMapped, "\u1105"},
+	{0x313A, 0x313A, idnaMapped, "\u11b0"},
+	{0x313B, 0x313B, idnaMapped, "\u11b1"},
+	{0x313C, 0x313C, idnaMapped, "\u11b2"},
+	{0x313D, 0x313D, idnaMapped, "\u11b3"},
+	{0x313E, 0x313E, idnaMapped, "\u11b4"},
+	{0x313F, 0x313F, idnaMapped, "\u11b5"},
+	{0x3140, 0x3140, idnaMapped, "\u111a"},
+	{0x3141, 0x3141, idnaMapped, "\u1106"},
+	{0x3142, 0x3142, idnaMapped, "\u1107"},
+	{0x3143, 0x3143, idnaMapped, "\u1108"},
+	{0x3144, 0x3144, idnaMapped, "\u1121"},
+	{0x3145, 0x3145, idnaMapped, "\u1109"},
+	{0x3146, 0x3146, idnaMapped, "\u110a"},
+	{0x3147, 0x3147, idnaMapped, "\u110b"},
+	{0x3148, 0x3148, idnaMapped, "\u110c"},
+	{0x3149, 0x3149, idnaMapped, "\u110d"},
+	{0x314A, 0x314A, idnaMapped, "\u110e"},
+	{0x314B, 0x314B, idnaMapped, "\u110f"},
+	{0x314C, 0x314C, idnaMapped, "\u1110"},
+	{0x314D, 0x314D, idnaMapped, "\u1111"},
+	{0x314E, 0x314E, idnaMapped, "\u1112"},
+	{0x314F, 0x314F, idnaMapped, "\u1161"},
+	{0x3150, 0x3150, idnaMapped, "\u1162"},
+	{0x3151, 0x3151, idnaMapped, "\u1163"},
+	{0x3152, 0x3152, idnaMapped, "\u1164"},
+	{0x3153, 0x3153, idnaMapped, "\u1165"},
+	{0x3154, 0x3154, idnaMapped, "\u1166"},
+	{0x3155, 0x3155, idnaMapped, "\u1167"},
+	{0x3156, 0x3156, idnaMapped, "\u1168"},
+	{0x3157, 0x3157, idnaMapped, "\u1169"},
+	{0x3158, 0x3158, idnaMapped, "\u116a"},
+	{0x3159, 0x3159, idnaMapped, "\u116b"},
+	{0x315A, 0x315A, idnaMapped, "\u116c"},
+	{0x315B, 0x315B, idnaMapped, "\u116d"},
+	{0x315C, 0x315C, idnaMapped, "\u116e"},
+	{0x315D, 0x315D, idnaMapped, "\u116f"},
+	{0x315E, 0x315E, idnaMapped, "\u1170"},
+	{0x315F, 0x315F, idnaMapped, "\u1171"},
+	{0x3160, 0x3160, idnaMapped, "\u1172"},
+	{0x3161, 0x3161, idnaMapped, "\u1173"},
+	{0x3162, 0x3162, idnaMapped, "\u1174"},
+	{0x3163, 0x3163, idnaMapped, "\u1175"},
+	{0x3164, 0x3164, idnaIgnored, ""},
+	{0x3165, 0x3165, idnaMapped, "\u1114"},
+	{0x3166, 0x3166, idnaMapped, "\u1115"},
+	{0x3167, 0x3167, idnaMapped, "\u11c7"},
+	{0x3168, 0x3168, idnaMapped, "\u11c8"},
+	{0x3169, 0x3169, idnaMapped, "\u11cc"},
+	{0x316A, 0x316A, idnaMapped, "\u11ce"},
+	{0x316B, 0x316B, idnaMapped, "\u11d3"},
+	{0x316C, 0x316C, idnaMapped, "\u11d7"},
+	{0x316D, 0x316D, idnaMapped, "\u11d9"},
+	{0x316E, 0x316E, idnaMapped, "\u111c"},
+	{0x316F, 0x316F, idnaMapped, "\u11dd"},
+	{0x3170, 0x3170, idnaMapped, "\u11df"},
+	{0x3171, 0x3171, idnaMapped, "\u111d"},
+	{0x3172, 0x3172, idnaMapped, "\u111e"},
+	{0x3173, 0x3173, idnaMapped, "\u1120"},
+	{0x3174, 0x3174, idnaMapped, "\u1122"},
+	{0x3175, 0x3175, idnaMapped, "\u1123"},
+	{0x3176, 0x3176, idnaMapped, "\u1127"},
+	{0x3177, 0x3177, idnaMapped, "\u1129"},
+	{0x3178, 0x3178, idnaMapped, "\u112b"},
+	{0x3179, 0x3179, idnaMapped, "\u112c"},
+	{0x317A, 0x317A, idnaMapped, "\u112d"},
+	{0x317B, 0x317B, idnaMapped, "\u112e"},
+	{0x317C, 0x317C, idnaMapped, "\u112f"},
+	{0x317D, 0x317D, idnaMapped, "\u1132"},
+	{0x317E, 0x317E, idnaMapped, "\u1136"},
+	{0x317F, 0x317F, idnaMapped, "\u1140"},
+	{0x3180, 0x3180, idnaMapped, "\u1147"},
+	{0x3181, 0x3181, idnaMapped, "\u114c"},
+	{0x3182, 0x3182, idnaMapped, "\u11f1"},
+	{0x3183, 0x3183, idnaMapped, "\u11f2"},
+	{0x3184, 0x3184, idnaMapped, "\u1157"},
+	{0x3185, 0x3185, idnaMapped, "\u1158"},
+	{0x3186, 0x3186, idnaMapped, "\u1159"},
+	{0x3187, 0x3187, idnaMapped, "\u1184"},
+	{0x3188, 0x3188, idnaMapped, "\u1185"},
+	{0x3189, 0x3189, idnaMapped, "\u1188"},
+	{0x318A, 0x318A, idnaMapped, "\u1191"},
+	{0x318B, 0x318B, idnaMapped, "\u1192"},
+	{0x318C, 0x318C, idnaMapped, "\u1194"},
+	{0x318D, 0x318D, idnaMapped, "\u119e"},
+	{0x318E, 0x318E, idnaMapped, "\u11a1"},
+	{0x318F, 0x318F, idnaDisallowed, ""},
+	{0x3190, 0x3191, idnaValid, ""},
+	{0x3192, 0x3192, idnaMapped, "\u4e00"},
+	{0x3193, 0x3193, idnaMapped, "\u4e8c"},
+	{0x3194, 0x3194, idnaMapped, "\u4e09"},
+	{0x3195, 0x3195, idnaMapped, "\u56db"},
+	{0x3196, 0x3196, idnaMapped, "\u4e0a"},
+	{0x3197, 0x3197, idnaMapped, "\u4e2d"},
+	{0x3198, 0x3198, idnaMapped, "\u4e0b"},
+	{0x3199, 0x3199, idnaMapped, "\u7532"},
+	{0x319A, 0x319A, idnaMapped, "\u4e59"},
+	{0x319B, 0x319B, idnaMapped, "\u4e19"},
+	{0x319C, 0x319C, idnaMapped, "\u4e01"},
+	{0x319D, 0x319D, idnaMapped, "\u5929"},
+	{0x319E, 0x319E, idnaMapped, "\u5730"},
+	{0x319F, 0x319F, idnaMapped, "\u4eba"},
+	{0x31A0, 0x31E5, idnaValid, ""},
+	{0x31E6, 0x31EF, idnaDisallowed, ""},
+	{0x31F0, 0x31FF, idnaValid, ""},
+	{0x3200, 0x3200, idnaMapped, "(\u1100)"},
+	{0x3201, 0x3201, idnaMapped, "(\u1102)"},
+	{0x3202, 0x3202, idnaMapped, "(\u1103)"},
+	{0x3203, 0x3203, idnaMapped, "(\u1105)"},
+	{0x3204, 0x3204, idnaMapped, "(\u1106)"},
+	{0x3205, 0x3205, idnaMapped, "(\u1107)"},
+	{0x3206, 0x3206, idnaMapped, "(\u1109)"},
+	{0x3207, 0x3207, idnaMapped, "(\u110b)"},
+	{0x3208, 0x3208, idnaMapped, "(\u110c)"},
+	{0x3209, 0x3209, idnaMapped, "(\u110e)"},
+	{0x320A, 0x320A, idnaMapped, "(\u110f)"},
+	{0x320B, 0x320B, idnaMapped, "(\u1110)"},
+	{0x320C, 0x320C, idnaMapped, "(\u1111)"},
+	{0x320D, 0x320D, idnaMapped, "(\u1112)"},
+	{0x320E, 0x320E, idnaMapped, "(\uac00)"},
+	{0x320F, 0x320F, idnaMapped, "(\ub098)"},
+	{0x3210, 0x3210, idnaMapped, "(\ub2e4)"},
+	{0x3211, 0x3211, idnaMapped, "(\ub77c)"},
+	{0x3212, 0x3212, idnaMapped, "(\ub9c8)"},
+	{0x3213, 0x3213, idnaMapped, "(\ubc14)"},
+	{0x3214, 0x3214, idnaMapped, "(\uc0ac)"},
+	{0x3215, 0x3215, idnaMapped, "(\uc544)"},
+	{0x3216, 0x3216, idnaMapped, "(\uc790)"},
+	{0x3217, 0x3217, idnaMapped, "(\ucc28)"},
+	{0x3218, 0x3218, idnaMapped, "(\uce74)"},
+	{0x3219, 0x3219, idnaMapped, "(\ud0c0)"},
+	{0x321A, 0x321A, idnaMapped, "(\ud30c)"},
+	{0x321B, 0x321B, idnaMapped, "(\ud558)"},
+	{0x321C, 0x321C, idnaMapped, "(\uc8fc)"},
+	{0x321D, 0x321D, idnaMapped, "(\uc624\uc804)"},
+	{0x321E, 0x321E, idnaMapped, "(\uc624\ud6c4)"},
+	{0x321F, 0x321F, idnaDisallowed, ""},
+	{0x3220, 0x3220, idnaMapped, "(\u4e00)"},
+	{0x3221, 0x3221, idnaMapped, "(\u4e8c)"},
+	{0x3222, 0x3222, idnaMapped, "(\u4e09)"},
+	{0x3223, 0x3223, idnaMapped, "(\u56db)"},
+	{0x3224, 0x3224, idnaMapped, "(\u4e94)"},
+	{0x3225, 0x3225, idnaMapped, "(\u516d)"},
+	{0x3226, 0x3226, idnaMapped, "(\u4e03)"},
+	{0x3227, 0x3227, idnaMapped, "(\u516b)"},
+	{0x3228, 0x3228, idnaMapped, "(\u4e5d)"},
+	{0x3229, 0x3229, idnaMapped, "(\u5341)"},
+	{0x322A, 0x322A, idnaMapped, "(\u6708)"},
+	{0x322B, 0x322B, idnaMapped, "(\u706b)"},
+	{0x322C, 0x322C, idnaMapped, "(\u6c34)"},
+	{0x322D, 0x322D, idnaMapped, "(\u6728)"},
+	{0x322E, 0x322E, idnaMapped, "(\u91d1)"},
+	{0x322F, 0x322F, idnaMapped, "(\u571f)"},
+	{0x3230, 0x3230, idnaMapped, "(\u65e5)"},
+	{0x3231, 0x3231, idnaMapped, "(\u682a)"},
+	{0x3232, 0x3232, idnaMapped, "(\u6709)"},
+	{0x3233, 0x3233, idnaMapped, "(\u793e)"},
+	{0x3234, 0x3234, idnaMapped, "(\u540d)"},
+	{0x3235, 0x3235, idnaMapped, "(\u7279)"},
+	{0x3236, 0x3236, idnaMapped, "(\u8ca1)"},
+	{0x3237, 0x3237, idnaMapped, "(\u795d)"},
+	{0x3238, 0x3238, idnaMapped, "(\u52b4)"},
+	{0x3239, 0x3239, idnaMapped, "(\u4ee3)"},
+	{0x323A, 0x323A, idnaMapped, "(\u547c)"},
+	{0x323B, 0x323B, idnaMapped, "(\u5b66)"},
+	{0x323C, 0x323C, idnaMapped, "(\u76e3)"},
+	{0x323D, 0x323D, idnaMapped, "(\u4f01)"},
+	{0x323E, 0x323E, idnaMapped, "(\u8cc7)"},
+	{0x323F, 0x323F, idnaMapped, "(\u5354)"},
+	{0x3240, 0x3240, idnaMapped, "(\u796d)"},
+	{0x3241, 0x3241, idnaMapped, "(\u4f11)"},
+	{0x3242, 0x3242, idnaMapped, "(\u81ea)"},
+	{0x3243, 0x3243, idnaMapped, "(\u81f3)"},
+	{0x3244, 0x3244, idnaMapped, "\u554f"},
+	{0x3245, 0x3245, idnaMapped, "\u5e7c"},
+	{0x3246, 0x3246, idnaMapped, "\u6587"},
+	{0x3247, 0x3247, idnaMapped, "\u7b8f"},
+	{0x3248, 0x324F, idnaValid, ""},
+	{0x3250, 0x3250, idnaMapped, "pte"},
+	{0x3251, 0x3251, idnaMapped, "21"},
+	{0x3252, 0x3252, idnaMapped, "22"},
+	{0x3253, 0x3253, idnaMapped, "23"},
+	{0x3254, 0x3254, idnaMapped, "24"},
+	{0x3255, 0x3255, idnaMapped, "25"},
+	{0x3256, 0x3256, idnaMapped, "26"},
+	{0x3257, 0x3257, idnaMapped, "27"},
+	{0x3258, 0x3258, idnaMapped, "28"},
+	{0x3259, 0x3259, idnaMapped, "29"},
+	{0x325A, 0x325A, idnaMapped, "30"},
+	{0x325B, 0x325B, idnaMapped, "31"},
+	{0x325C, 0x325C, idnaMapped, "32"},
+	{0x325D, 0x325D, idnaMapped, "33"},
+	{0x325E, 0x325E, idnaMapped, "34"},
+	{0x325F, 0x325F, idnaMapped, "35"},
+	{0x3260, 0x3260, idnaMapped, "\u1100"},
+	{0x3261, 0x3261, idnaMapped, "\u1102"},
+	{0x3262, 0x3262, idnaMapped, "\u1103"},
+	{0x3263, 0x3263, idnaMapped, "\u1105"},
+	{0x3264, 0x3264, idnaMapped, "\u1106"},
+	{0x3265, 0x3265, idnaMapped, "\u1107"},
+	{0x3266, 0x3266, idnaMapped, "\u1109"},
+	{0x3267, 0x3267, idnaMapped, "\u110b"},
+	{0x3268, 0x3268, idnaMapped, "\u110c"},
+	{0x3269, 0x3269, idnaMapped, "\u110e"},
+	{0x326A, 0x326A, idnaMapped, "\u110f"},
+	{0x326B, 0x326B, idnaMapped, "\u1110"},
+	{0x326C, 0x326C, idnaMapped, "\u1111"},
+	{0x326D, 0x326D, idnaMapped, "\u1112"},
+	{0x326E, 0x326E, idnaMapped, "\uac00"},
+	{0x326F, 0x326F, idnaMapped, "\ub098"},
+	{0x3270, 0x3270, idnaMapped, "\ub2e4"},
+	{0x3271, 0x3271, idnaMapped, "\ub77c"},
+	{0x3272, 0x3272, idnaMapped, "\ub9c8"},
+	{0x3273, 0x3273, idnaMapped, "\ubc14"},
+	{0x3274, 0x3274, idnaMapped, "\uc0ac"},
+	{0x3275, 0x3275, idnaMapped, "\uc544"},
+	{0x3276, 0x3276, idnaMapped, "\uc790"},
+	{0x3277, 0x3277, idnaMapped, "\ucc28"},
+	{0x3278, 0x3278, idnaMapped, "\uce74"},
+	{0x3279, 0x3279, idnaMapped, "\ud0c0"},
+	{0x327A, 0x327A, idnaMapped, "\ud30c"},
+	{0x327B, 0x327B, idnaMapped, "\ud558"},
+	{0x327C, 0x327C, idnaMapped, "\ucc38\uace0"},
+	{0x327D, 0x327D, idnaMapped, "\uc8fc\uc758"},
+	{0x327E, 0x327E, idnaMapped, "\uc6b0"},
+	{0x327F, 0x327F, idnaValid, ""},
+	{0x3280, 0x3280, idnaMapped, "\u4e00"},
+	{0x3281, 0x3281, idnaMapped, "\u4e8c"},
+	{0x3282, 0x3282, idnaMapped, "\u4e09"},
+	{0x3283, 0x3283, idnaMapped, "\u56db"},
+	{0x3284, 0x3284, idnaMapped, "\u4e94"},
+	{0x3285, 0x3285, idnaMapped, "\u516d"},
+	{0x3286, 0x3286, idnaMapped, "\u4e03"},
+	{0x3287, 0x3287, idnaMapped, "\u516b"},
+	{0x3288, 0x3288, idnaMapped, "\u4e5d"},
+	{0x3289, 0x3289, idnaMapped, "\u5341"},
+	{0x328A, 0x328A, idnaMapped, "\u6708"},
+	{0x328B, 0x328B, idnaMapped, "\u706b"},
+	{0x328C, 0x328C, idnaMapped, "\u6c34"},
+	{0x328D, 0x328D, idnaMapped, "\u6728"},
+	{0x328E, 0x328E, idnaMapped, "\u91d1"},
+	{0x328F, 0x328F, idnaMapped, "\u571f"},
+	{0x3290, 0x3290, idnaMapped, "\u65e5"},
+	{0x3291, 0x3291, idnaMapped, "\u682a"},
+	{0x3292, 0x3292, idnaMapped, "\u6709"},
+	{0x3293, 0x3293, idnaMapped, "\u793e"},
+	{0x3294, 0x3294, idnaMapped, "\u540d"},
+	{0x3295, 0x3295, idnaMapped, "\u7279"},
+	{0x3296, 0x3296, idnaMapped, "\u8ca1"},
+	{0x3297, 0x3297, idnaMapped, "\u795d"},
+	{0x3298, 0x3298, idnaMapped, "\u52b4"},
+	{0x3299, 0x3299, idnaMapped, "\u79d8"},
+	{0x329A, 0x329A, idnaMapped, "\u7537"},
+	{0x329B, 0x329B, idnaMapped, "\u5973"},
+	{0x329C, 0x329C, idnaMapped, "\u9069"},
+	{0x329D, 0x329D, idnaMapped, "\u512a"},
+	{0x329E, 0x329E, idnaMapped, "\u5370"},
+	{0x329F, 0x329F, idnaMapped, "\u6ce8"},
+	{0x32A0, 0x32A0, idnaMapped, "\u9805"},
+	{0x32A1, 0x32A1, idnaMapped, "\u4f11"},
+	{0x32A2, 0x32A2, idnaMapped, "\u5199"},
+	{0x32A3, 0x32A3, idnaMapped, "\u6b63"},
+	{0x32A4, 0x32A4, idnaMapped, "\u4e0a"},
+	{0x32A5, 0x32A5, idnaMapped, "\u4e2d"},
+	{0x32A6, 0x32A6, idnaMapped, "\u4e0b"},
+	{0x32A7, 0x32A7, idnaMapped, "\u5de6"},
+	{0x32A8, 0x32A8, idnaMapped, "\u53f3"},
+	{0x32A9, 0x32A9, idnaMapped, "\u533b"},
+	{0x32AA, 0x32AA, idnaMapped, "\u5b97"},
+	{0x32AB, 0x32AB, idnaMapped, "\u5b66"},
+	{0x32AC, 0x32AC, idnaMapped, "\u76e3"},
+	{0x32AD, 0x32AD, idnaMapped, "\u4f01"},
+	{0x32AE, 0x32AE, idnaMapped, "\u8cc7"},
+	{0x32AF, 0x32AF, idnaMapped, "\u5354"},
+	{0x32B0, 0x32B0, idnaMapped, "\u591c"},
+	{0x32B1, 0x32B1, idnaMapped, "36"},
+	{0x32B2, 0x32B2, idnaMapped, "37"},
+	{0x32B3, 0x32B3, idnaMapped, "38"},
+	{0x32B4, 0x32B4, idnaMapped, "39"},
+	{0x32B5, 0x32B5, idnaMapped, "40"},
+	{0x32B6, 0x32B6, idnaMapped, "41"},
+	{0x32B7, 0x32B7, idnaMapped, "42"},
+	{0x32B8, 0x32B8, idnaMapped, "43"},
+	{0x32B9, 0x32B9, idnaMapped, "44"},
+	{0x32BA, 0x32BA, idnaMapped, "45"},
+	{0x32BB, 0x32BB, idnaMapped, "46"},
+	{0x32BC, 0x32BC, idnaMapped, "47"},
+	{0x32BD, 0x32BD, idnaMapped, "48"},
+	{0x32BE, 0x32BE, idnaMapped, "49"},
+	{0x32BF, 0x32BF, idnaMapped, "50"},
+	{0x32C0, 0x32C0, idnaMapped, "1\u6708"},
+	{0x32C1, 0x32C1, idnaMapped, "2\u6708"},
+	{0x32C2, 0x32C2, idnaMapped, "3\u6708"},
+	{0x32C3, 0x32C3, idnaMapped, "4\u6708"},
+	{0x32C4, 0x32C4, idnaMapped, "5\u6708"},
+	{0x32C5, 0x32C5, idnaMapped, "6\u6708"},
+	{0x32C6, 0x32C6, idnaMapped, "7\u6708"},
+	{0x32C7, 0x32C7, idnaMapped, "8\u6708"},
+	{0x32C8, 0x32C8, idnaMapped, "9\u6708"},
+	{0x32C9, 0x32C9, idnaMapped, "10\u6708"},
+	{0x32CA, 0x32CA, idnaMapped, "11\u6708"},
+	{0x32CB, 0x32CB, idnaMapped, "12\u6708"},
+	{0x32CC, 0x32CC, idnaMapped, "hg"},
+	{0x32CD, 0x32CD, idnaMapped, "erg"},
+	{0x32CE, 0x32CE, idnaMapped, "ev"},
+	{0x32CF, 0x32CF, idnaMapped, "ltd"},
+	{0x32D0, 0x32D0, idnaMapped, "\u30a2"},
+	{0x32D1, 0x32D1, idnaMapped, "\u30a4"},
+	{0x32D2, 0x32D2, idnaMapped, "\u30a6"},
+	{0x32D3, 0x32D3, idnaMapped, "\u30a8"},
+	{0x32D4, 0x32D4, idnaMapped, "\u30aa"},
+	{0x32D5, 0x32D5, idnaMapped, "\u30ab"},
+	{0x32D6, 0x32D6, idnaMapped, "\u30ad"},
+	{0x32D7, 0x32D7, idnaMapped, "\u30af"},
+	{0x32D8, 0x32D8, idnaMapped, "\u30b1"},
+	{0x32D9, 0x32D9, idnaMapped, "\u30b3"},
+	{0x32DA, 0x32DA, idnaMapped, "\u30b5"},
+	{0x32DB, 0x32DB, idnaMapped, "\u30b7"},
+	{0x32DC, 0x32DC, idnaMapped, "\u30b9"},
+	{0x32DD, 0x32DD, idnaMapped, "\u30bb"},
+	{0x32DE, 0x32DE, idnaMapped, "\u30bd"},
+	{0x32DF, 0x32DF, idnaMapped, "\u30bf"},
+	{0x32E0, 0x32E0, idnaMapped, "\u30c1"},
+	{0x32E1, 0x32E1, idnaMapped, "\u30c4"},
+	{0x32E2, 0x32E2, idnaMapped, "\u30c6"},
+	{0x32E3, 0x32E3, idnaMapped, "\u30c8"},
+	{0x32E4, 0x32E4, idnaMapped, "\u30ca"},
+	{0x32E5, 0x32E5, idnaMapped, "\u30cb"},
+	{0x32E6, 0x32E6, idnaMapped, "\u30cc"},
+	{0x32E7, 0x32E7, idnaMapped, "\u30cd"},
+	{0x32E8, 0x32E8, idnaMapped, "\u30ce"},
+	{0x32E9, 0x32E9, idnaMapped, "\u30cf"},
+	{0x32EA, 0x32EA, idnaMapped, "\u30d2"},
+	{0x32EB, 0x32EB, idnaMapped, "\u30d5"},
+	{0x32EC, 0x32EC, idnaMapped, "\u30d8"},
+	{0x32ED, 0x32ED, idnaMapped, "\u30db"},
+	{0x32EE, 0x32EE, idnaMapped, "\u30de"},
+	{0x32EF, 0x32EF, idnaMapped, "\u30df"},
+	{0x32F0, 0x32F0, idnaMapped, "\u30e0"},
+	{0x32F1, 0x32F1, idnaMapped, "\u30e1"},
+	{0x32F2, 0x32F2, idnaMapped, "\u30e2"},
+	{0x32F3, 0x32F3, idnaMapped, "\u30e4"},
+	{0x32F4, 0x32F4, idnaMapped, "\u30e6"},
+	{0x32F5, 0x32F5, idnaMapped, "\u30e8"},
+	{0x32F6, 0x32F6, idnaMapped, "\u30e9"},
+	{0x32F7, 0x32F7, idnaMapped, "\u30ea"},
+	{0x32F8, 0x32F8, idnaMapped, "\u30eb"},
+	{0x32F9, 0x32F9, idnaMapped, "\u30ec"},
+	{0x32FA, 0x32FA, idnaMapped, "\u30ed"},
+	{0x32FB, 0x32FB, idnaMapped, "\u30ef"},
+	{0x32FC, 0x32FC, idnaMapped, "\u30f0"},
+	{0x32FD, 0x32FD, idnaMapped, "\u30f1"},
+	{0x32FE, 0x32FE, idnaMapped, "\u30f2"},
+	{0x32FF, 0x32FF, idnaMapped, "\u4ee4\u548c"},
+	{0x3300, 0x3300, idnaMapped, "\u30a2\u30d1\u30fc\u30c8"},
+	{0x3301, 0x3301, idnaMapped, "\u30a2\u30eb\u30d5\u30a1"},
+	{0x3302, 0x3302, idnaMapped, "\u30a2\u30f3\u30da\u30a2"},
+	{0x3303, 0x3303, idnaMapped, "\u30a2\u30fc\u30eb"},
+	{0x3304, 0x3304, idnaMapped, "\u30a4\u30cb\u30f3\u30b0"},
+	{0x3305, 0x3305, idnaMapped, "\u30a4\u30f3\u30c1"},
+	{0x3306, 0x3306, idnaMapped, "\u30a6\u30a9\u30f3"},
+	{0x3307, 0x3307, idnaMapped, "\u30a8\u30b9\u30af\u30fc\u30c9"},
+	{0x3308, 0x3308, idnaMapped, "\u30a8\u30fc\u30ab\u30fc"},
+	{0x3309, 0x3309, idnaMapped, "\u30aa\u30f3\u30b9"},
+	{0x330A, 0x330A, idnaMapped, "\u30aa\u30fc\u30e0"},
+	{0x330B, 0x330B, idnaMapped, "\u30ab\u30a4\u30ea"},
+	{0x330C, 0x330C, idnaMapped, "\u30ab\u30e9\u30c3\u30c8"},
+	{0x330D, 0x330D, idnaMapped, "\u30ab\u30ed\u30ea\u30fc"},
+	{0x330E, 0x330E, idnaMapped, "\u30ac\u30ed\u30f3"},
+	{0x330F, 0x330F, idnaMapped, "\u30ac\u30f3\u30de"},
+	{0x3310, 0x3310, idnaMapped, "\u30ae\u30ac"},
+	{0x3311, 0x3311, idnaMapped, "\u30ae\u30cb\u30fc"},
+	{0x3312, 0x3312, idnaMapped, "\u30ad\u30e5\u30ea\u30fc"},
+	{0x3313, 0x3313, idnaMapped, "\u30ae\u30eb\u30c0\u30fc"},
+	{0x3314, 0x3314, idnaMapped, "\u30ad\u30ed"},
+	{0x3315, 0x3315, idnaMapped, "\u30ad\u30ed\u30b0\u30e9\u30e0"},
+	{0x3316, 0x3316, idnaMapped, "\u30ad\u30ed\u30e1\u30fc\u30c8\u30eb"},
+	{0x3317, 0x3317, idnaMapped, "\u30ad\u30ed\u30ef\u30c3\u30c8"},
+	{0x3318, 0x3318, idnaMapped, "\u30b0\u30e9\u30e0"},
+	{0x3319, 0x3319, idnaMapped, "\u30b0\u30e9\u30e0\u30c8\u30f3"},
+	{0x331A, 0x331A, idnaMapped, "\u30af\u30eb\u30bc\u30a4\u30ed"},
+	{0x331B, 0x331B, idnaMapped, "\u30af\u30ed\u30fc\u30cd"},
+	{0x331C, 0x331C, idnaMapped, "\u30b1\u30fc\u30b9"},
+	{0x331D, 0x331D, idnaMapped, "\u30b3\u30eb\u30ca"},
+	{0x331E, 0x331E, idnaMapped, "\u30b3\u30fc\u30dd"},
+	{0x331F, 0x331F, idnaMapped, "\u30b5\u30a4\u30af\u30eb"},
+	{0x3320, 0x3320, idnaMapped, "\u30b5\u30f3\u30c1\u30fc\u30e0"},
+	{0x3321, 0x3321, idnaMapped, "\u30b7\u30ea\u30f3\u30b0"},
+	{0x3322, 0x3322, idnaMapped, "\u30bb\u30f3\u30c1"},
+	{0x3323, 0x3323, idnaMapped, "\u30bb\u30f3\u30c8"},
+	{0x3324, 0x3324, idnaMapped, "\u30c0\u30fc\u30b9"},
+	{0x3325, 0x3325, idnaMapped, "\u30c7\u30b7"},
+	{0x3326, 0x3326, idnaMapped, "\u30c9\u30eb"},
+	{0x3327, 0x3327, idnaMapped, "\u30c8\u30f3"},
+	{0x3328, 0x3328, idnaMapped, "\u30ca\u30ce"},
+	{0x3329, 0x3329, idnaMapped, "\u30ce\u30c3\u30c8"},
+	{0x332A, 0x332A, idnaMapped, "\u30cf\u30a4\u30c4"},
+	{0x332B, 0x332B, idnaMapped, "\u30d1\u30fc\u30bb\u30f3\u30c8"},
+	{0x332C, 0x332C, idnaMapped, "\u30d1\u30fc\u30c4"},
+	{0x332D, 0x332D, idnaMapped, "\u30d0\u30fc\u30ec\u30eb"},
+	{0x332E, 0x332E, idnaMapped, "\u30d4\u30a2\u30b9\u30c8\u30eb"},
+	{0x332F, 0x332F, idnaMapped, "\u30d4\u30af\u30eb"},
+	{0x3330, 0x3330, idnaMapped, "\u30d4\u30b3"},
+	{0x3331, 0x3331, idnaMapped, "\u30d3\u30eb"},
+	{0x3332, 0x3332, idnaMapped, "\u30d5\u30a1\u30e9\u30c3\u30c9"},
+	{0x3333, 0x3333, idnaMapped, "\u30d5\u30a3\u30fc\u30c8"},
+	{0x3334, 0x3334, idnaMapped, "\u30d6\u30c3\u30b7\u30a7\u30eb"},
+	{0x3335, 0x3335, idnaMapped, "\u30d5\u30e9\u30f3"},
+	{0x3336, 0x3336, idnaMapped, "\u30d8\u30af\u30bf\u30fc\u30eb"},
+	{0x3337, 0x3337, idnaMapped, "\u30da\u30bd"},
+	{0x3338, 0x3338, idnaMapped, "\u30da\u30cb\u30d2"},
+	{0x3339, 0x3339, idnaMapped, "\u30d8\u30eb\u30c4"},
+	{0x333A, 0x333A, idnaMapped, "\u30da\u30f3\u30b9"},
+	{0x333B, 0x333B, idnaMapped, "\u30da\u30fc\u30b8"},
+	{0x333C, 0x333C, idnaMapped, "\u30d9\u30fc\u30bf"},
+	{0x333D, 0x333D, idnaMapped, "\u30dd\u30a4\u30f3\u30c8"},
+	{0x333E, 0x333E, idnaMapped, "\u30dc\u30eb\u30c8"},
+	{0x333F, 0x333F, idnaMapped, "\u30db\u30f3"},
+	{0x3340, 0x3340, idnaMapped, "\u30dd\u30f3\u30c9"},
+	{0x3341, 0x3341, idnaMapped, "\u30db\u30fc\u30eb"},
+	{0x3342, 0x3342, idnaMapped, "\u30db\u30fc\u30f3"},
+	{0x3343, 0x3343, idnaMapped, "\u30de\u30a4\u30af\u30ed"},
+	{0x3344, 0x3344, idnaMapped, "\u30de\u30a4\u30eb"},
+	{0x3345, 0x3345, idnaMapped, "\u30de\u30c3\u30cf"},
+	{0x3346, 0x3346, idnaMapped, "\u30de\u30eb\u30af"},
+	{0x3347, 0x3347, idnaMapped, "\u30de\u30f3\u30b7\u30e7\u30f3"},
+	{0x3348, 0x3348, idnaMapped, "\u30df\u30af\u30ed\u30f3"},
+	{0x3349, 0x3349, idnaMapped, "\u30df\u30ea"},
+	{0x334A, 0x334A, idnaMapped, "\u30df\u30ea\u30d0\u30fc\u30eb"},
+	{0x334B, 0x334B, idnaMapped, "\u30e1\u30ac"},
+	{0x334C, 0x334C, idnaMapped, "\u30e1\u30ac\u30c8\u30f3"},
+	{0x334D, 0x334D, idnaMapped, "\u30e1\u30fc\u30c8\u30eb"},
+	{0x334E, 0x334E, idnaMapped, "\u30e4\u30fc\u30c9"},
+	{0x334F, 0x334F, idnaMapped, "\u30e4\u30fc\u30eb"},
+	{0x3350, 0x3350, idnaMapped, "\u30e6\u30a2\u30f3"},
+	{0x3351, 0x3351, idnaMapped, "\u30ea\u30c3\u30c8\u30eb"},
+	{0x3352, 0x3352, idnaMapped, "\u30ea\u30e9"},
+	{0x3353, 0x3353, idnaMapped, "\u30eb\u30d4\u30fc"},
+	{0x3354, 0x3354, idnaMapped, "\u30eb\u30fc\u30d6\u30eb"},
+	{0x3355, 0x3355, idnaMapped, "\u30ec\u30e0"},
+	{0x3356, 0x3356, idnaMapped, "\u30ec\u30f3\u30c8\u30b2\u30f3"},
+	{0x3357, 0x3357, idnaMapped, "\u30ef\u30c3\u30c8"},
+	{0x3358, 0x3358, idnaMapped, "0\u70b9"},
+	{0x3359, 0x3359, idnaMapped, "1\u70b9"},
+	{0x335A, 0x335A, idnaMapped, "2\u70b9"},
+	{0x335B, 0x335B, idnaMapped, "3\u70b9"},
+	{0x335C, 0x335C, idnaMapped, "4\u70b9"},
+	{0x335D, 0x335D, idnaMapped, "5\u70b9"},
+	{0x335E, 0x335E, idnaMapped, "6\u70b9"},
+	{0x335F, 0x335F, idnaMapped, "7\u70b9"},
+	{0x3360, 0x3360, idnaMapped, "8\u70b9"},
+	{0x3361, 0x3361, idnaMapped, "9\u70b9"},
+	{0x3362, 0x3362, idnaMapped, "10\u70b9"},
+	{0x3363, 0x3363, idnaMapped, "11\u70b9"},
+	{0x3364, 0x3364, idnaMapped, "12\u70b9"},
+	{0x3365, 0x3365, idnaMapped, "13\u70b9"},
+	{0x3366, 0x3366, idnaMapped, "14\u70b9"},
+	{0x3367, 0x3367, idnaMapped, "15\u70b9"},
+	{0x3368, 0x3368, idnaMapped, "16\u70b9"},
+	{0x3369, 0x3369, idnaMapped, "17\u70b9"},
+	{0x336A, 0x336A, idnaMapped, "18\u70b9"},
+	{0x336B, 0x336B, idnaMapped, "19\u70b9"},
+	{0x336C, 0x336C, idnaMapped, "20\u70b9"},
+	{0x336D, 0x336D, idnaMapped, "21\u70b9"},
+	{0x336E, 0x336E, idnaMapped, "22\u70b9"},
+	{0x336F, 0x336F, idnaMapped, "23\u70b9"},
+	{0x3370, 0x3370, idnaMapped, "24\u70b9"},
+	{0x3371, 0x3371, idnaMapped, "hpa"},
+	{0x3372, 0x3372, idnaMapped, "da"},
+	{0x3373, 0x3373, idnaMapped, "au"},
+	{0x3374, 0x3374, idnaMapped, "bar"},
+	{0x3375, 0x3375, idnaMapped, "ov"},
+	{0x3376, 0x3376, idnaMapped, "pc"},
+	{0x3377, 0x3377, idnaMapped, "dm"},
+	{0x3378, 0x3378, idnaMapped, "dm2"},
+	{0x3379, 0x3379, idnaMapped, "dm3"},
+	{0x337A, 0x337A, idnaMapped, "iu"},
+	{0x337B, 0x337B, idnaMapped, "\u5e73\u6210"},
+	{0x337C, 0x337C, idnaMapped, "\u662d\u548c"},
+	{0x337D, 0x337D, idnaMapped, "\u5927\u6b63"},
+	{0x337E, 0x337E, idnaMapped, "\u660e\u6cbb"},
+	{0x337F, 0x337F, idnaMapped, "\u682a\u5f0f\u4f1a\u793e"},
+	{0x3380, 0x3380, idnaMapped, "pa"},
+	{0x3381, 0x3381, idnaMapped, "na"},
+	{0x3382, 0x3382, idnaMapped, "\u03bca"},
+	{0x3383, 0x3383, idnaMapped, "ma"},
+	{0x3384, 0x3384, idnaMapped, "ka"},
+	{0x3385, 0x3385, idnaMapped, "kb"},
+	{0x3386, 0x3386, idnaMapped, "mb"},
+	{0x3387, 0x3387, idnaMapped, "gb"},
+	{0x3388, 0x3388, idnaMapped, "cal"},
+	{0x3389, 0x3389, idnaMapped, "kcal"},
+	{0x338A, 0x338A, idnaMapped, "pf"},
+	{0x338B, 0x338B, idnaMapped, "nf"},
+	{0x338C, 0x338C, idnaMapped, "\u03bcf"},
+	{0x338D, 0x338D, idnaMapped, "\u03bcg"},
+	{0x338E, 0x338E, idnaMapped, "mg"},
+	{0x338F, 0x338F, idnaMapped, "kg"},
+	{0x3390, 0x3390, idnaMapped, "hz"},
+	{0x3391, 0x3391, idnaMapped, "khz"},
+	{0x3392, 0x3392, idnaMapped, "mhz"},
+	{0x3393, 0x3393, idnaMapped, "ghz"},
+	{0x3394, 0x3394, idnaMapped, "thz"},
+	{0x3395, 0x3395, idnaMapped, "\u03bcl"},
+	{0x3396, 0x3396, idnaMapped, "ml"},
+	{0x3397, 0x3397, idnaMapped, "dl"},
+	{0x3398, 0x3398, idnaMapped, "kl"},
+	{0x3399, 0x3399, idnaMapped, "fm"},
+	{0x339A, 0x339A, idnaMapped, "nm"},
+	{0x339B, 0x339B, idnaMapped, "\u03bcm"},
+	{0x339C, 0x339C, idnaMapped, "mm"},
+	{0x339D, 0x339D, idnaMapped, "cm"},
+	{0x339E, 0x339E, idnaMapped, "km"},
+	{0x339F, 0x339F, idnaMapped, "mm2"},
+	{0x33A0, 0x33A0, idnaMapped, "cm2"},
+	{0x33A1, 0x33A1, idnaMapped, "m2"},
+	{0x33A2, 0x33A2, idnaMapped, "km2"},
+	{0x33A3, 0x33A3, idnaMapped, "mm3"},
+	{0x33A4, 0x33A4, idnaMapped, "cm3"},
+	{0x33A5, 0x33A5, idnaMapped, "m3"},
+	{0x33A6, 0x33A6, idnaMapped, "km3"},
+	{0x33A7, 0x33A7, idnaMapped, "m\u2215s"},
+	{0x33A8, 0x33A8, idnaMapped, "m\u2215s2"},
+	{0x33A9, 0x33A9, idnaMapped, "pa"},
+	{0x33AA, 0x33AA, idnaMapped, "kpa"},
+	{0x33AB, 0x33AB, idnaMapped, "mpa"},
+	{0x33AC, 0x33AC, idnaMapped, "gpa"},
+	{0x33AD, 0x33AD, idnaMapped, "rad"},
+	{0x33AE, 0x33AE, idnaMapped, "rad\u2215s"},
+	{0x33AF, 0x33AF, idnaMapped, "rad\u2215s2"},
+	{0x33B0, 0x33B0, idnaMapped, "ps"},
+	{0x33B1, 0x33B1, idnaMapped, "ns"},
+	{0x33B2, 0x33B2, idnaMapped, "\u03bcs"},
+	{0x33B3, 0x33B3, idnaMapped, "ms"},
+	{0x33B4, 0x33B4, idnaMapped, "pv"},
+	{0x33B5, 0x33B5, idnaMapped, "nv"},
+	{0x33B6, 0x33B6, idnaMapped, "\u03bcv"},
+	{0x33B7, 0x33B7, idnaMapped, "mv"},
+	{0x33B8, 0x33B8, idnaMapped, "kv"},
+	{0x33B9, 0x33B9, idnaMapped, "mv"},
+	{0x33BA, 0x33BA, idnaMapped, "pw"},
+	{0x33BB, 0x33BB, idnaMapped, "nw"},
+	{0x33BC, 0x33BC, idnaMapped, "\u03bcw"},
+	{0x33BD, 0x33BD, idnaMapped, "mw"},
+	{0x33BE, 0x33BE, idnaMapped, "kw"},
+	{0x33BF, 0x33BF, idnaMapped, "mw"},
+	{0x33C0, 0x33C0, idnaMapped, "k\u03c9"},
+	{0x33C1, 0x33C1, idnaMapped, "m\u03c9"},
+	{0x33C2, 0x33C2, idnaDisallowed, ""},
+	{0x33C3, 0x33C3, idnaMapped, "bq"},
+	{0x33C4, 0x33C4, idnaMapped, "cc"},
+	{0x33C5, 0x33C5, idnaMapped, "cd"},
+	{0x33C6, 0x33C6, idnaMapped, "c\u2215kg"},
+	{0x33C7, 0x33C7, idnaDisallowed, ""},
+	{0x33C8, 0x33C8, idnaMapped, "db"},
+	{0x33C9, 0x33C9, idnaMapped, "gy"},
+	{0x33CA, 0x33CA, idnaMapped, "ha"},
+	{0x33CB, 0x33CB, idnaMapped, "hp"},
+	{0x33CC, 0x33CC, idnaMapped, "in"},
+	{0x33CD, 0x33CD, idnaMapped, "kk"},
+	{0x33CE, 0x33CE, idnaMapped, "km"},
+	{0x33CF, 0x33CF, idnaMapped, "kt"},
+	{0x33D0, 0x33D0, idnaMapped, "lm"},
+	{0x33D1, 0x33D1, idnaMapped, "ln"},
+	{0x33D2, 0x33D2, idnaMapped, "log"},
+	{0x33D3, 0x33D3, idnaMapped, "lx"},
+	{0x33D4, 0x33D4, idnaMapped, "mb"},
+	{0x33D5, 0x33D5, idnaMapped, "mil"},
+	{0x33D6, 0x33D6, idnaMapped, "mol"},
+	{0x33D7, 0x33D7, idnaMapped, "ph"},
+	{0x33D8, 0x33D8, idnaDisallowed, ""},
+	{0x33D9, 0x33D9, idnaMapped, "ppm"},
+	{0x33DA, 0x33DA, idnaMapped, "pr"},
+	{0x33DB, 0x33DB, idnaMapped, "sr"},
+	{0x33DC, 0x33DC, idnaMapped, "sv"},
+	{0x33DD, 0x33DD, idnaMapped, "wb"},
+	{0x33DE, 0x33DE, idnaMapped, "v\u2215m"},
+	{0x33DF, 0x33DF, idnaMapped, "a\u2215m"},
+	{0x33E0, 0x33E0, idnaMapped, "1\u65e5"},
+	{0x33E1, 0x33E1, idnaMapped, "2\u65e5"},
+	{0x33E2, 0x33E2, idnaMapped, "3\u65e5"},
+	{0x33E3, 0x33E3, idnaMapped, "4\u65e5"},
+	{0x33E4, 0x33E4, idnaMapped, "5\u65e5"},
+	{0x33E5, 0x33E5, idnaMapped, "6\u65e5"},
+	{0x33E6, 0x33E6, idnaMapped, "7\u65e5"},
+	{0x33E7, 0x33E7, idnaMapped, "8\u65e5"},
+	{0x33E8, 0x33E8, idnaMapped, "9\u65e5"},
+	{0x33E9, 0x33E9, idnaMapped, "10\u65e5"},
+	{0x33EA, 0x33EA, idnaMapped, "11\u65e5"},
+	{0x33EB, 0x33EB, idnaMapped, "12\u65e5"},
+	{0x33EC, 0x33EC, idnaMapped, "13\u65e5"},
+	{0x33ED, 0x33ED, idnaMapped, "14\u65e5"},
+	{0x33EE, 0x33EE, idnaMapped, "15\u65e5"},
+	{0x33EF, 0x33EF, idnaMapped, "16\u65e5"},
+	{0x33F0, 0x33F0, idnaMapped, "17\u65e5"},
+	{0x33F1, 0x33F1, idnaMapped, "18\u65e5"},
+	{0x33F2, 0x33F2, idnaMapped, "19\u65e5"},
+	{0x33F3, 0x33F3, idnaMapped, "20\u65e5"},
+	{0x33F4, 0x33F4, idnaMapped, "21\u65e5"},
+	{0x33F5, 0x33F5, idnaMapped, "22\u65e5"},
+	{0x33F6, 0x33F6, idnaMapped, "23\u65e5"},
+	{0x33F7, 0x33F7, idnaMapped, "24\u65e5"},
+	{0x33F8, 0x33F8, idnaMapped, "25\u65e5"},
+	{0x33F9, 0x33F9, idnaMapped, "26\u65e5"},
+	{0x33FA, 0x33FA, idnaMapped, "27\u65e5"},
+	{0x33FB, 0x33FB, idnaMapped, "28\u65e5"},
+	{0x33FC, 0x33FC, idnaMapped, "29\u65e5"},
+	{0x33FD, 0x33FD, idnaMapped, "30\u65e5"},
+	{0x33FE, 0x33FE, idnaMapped, "31\u65e5"},
+	{0x33FF, 0x33FF, idnaMapped, "gal"},
+	{0x3400, 0xA48C, idnaValid, ""},
+	{0xA48D, 0xA48F, idnaDisallowed, ""},
+	{0xA490, 0xA4C6, idnaValid, ""},
+	{0xA4C7, 0xA4CF, idnaDisallowed, ""},
+	{0xA4D0, 0xA62B, idnaValid, ""},
+	{0xA62C, 0xA63F, idnaDisallowed, ""},
+	{0xA640, 0xA640, idnaMapped, "\ua641"},
+	{0xA641, 0xA641, idnaValid, ""},
+	{0xA642, 0xA642, idnaMapped, "\ua643"},
+	{0xA643, 0xA643, idnaValid, ""},
+	{0xA644, 0xA644, idnaMapped, "\ua645"},
+	{0xA645, 0xA645, idnaValid, ""},
+	{0xA646, 0xA646, idnaMapped, "\ua647"},
+	{0xA647, 0xA647, idnaValid, ""},
+	{0xA648, 0xA648, idnaMapped, "\ua649"},
+	{0xA649, 0xA649, idnaValid, ""},
+	{0xA64A, 0xA64A, idnaMapped, "\ua64b"},
+	{0xA64B, 0xA64B, idnaValid, ""},
+	{0xA64C, 0xA64C, idnaMapped, "\ua64d"},
+	{0xA64D, 0xA64D, idnaValid, ""},
+	{0xA64E, 0xA64E, idnaMapped, "\ua64f"},
+	{0xA64F, 0xA64F, idnaValid, ""},
+	{0xA650, 0xA650, idnaMapped, "\ua651"},
+	{0xA651, 0xA651, idnaValid, ""},
+	{0xA652, 0xA652, idnaMapped, "\ua653"},
+	{0xA653, 0xA653, idnaValid, ""},
+	{0xA654, 0xA654, idnaMapped, "\ua655"},
+	{0xA655, 0xA655, idnaValid, ""},
+	{0xA656, 0xA656, idnaMapped, "\ua657"},
+	{0xA657, 0xA657, idnaValid, ""},
+	{0xA658, 0xA658, idnaMapped, "\ua659"},
+	{0xA659, 0xA659, idnaValid, ""},
+	{0xA65A, 0xA65A, idnaMapped, "\ua65b"},
+	{0xA65B, 0xA65B, idnaValid, ""},
+	{0xA65C, 0xA65C, idnaMapped, "\ua65d"},
+	{0xA65D, 0xA65D, idnaValid, ""},
+	{0xA65E, 0xA65E, idnaMapped, "\ua65f"},
+	{0xA65F, 0xA65F, idnaValid, ""},
+	{0xA660, 0xA660, idnaMapped, "\ua661"},
+	{0xA661, 0xA661, idnaValid, ""},
+	{0xA662, 0xA662, idnaMapped, "\ua663"},
+	{0xA663, 0xA663, idnaValid, ""},
+	{0xA664, 0xA664, idnaMapped, "\ua665"},
+	{0xA665, 0xA665, idnaValid, ""},
+	{0xA666, 0xA666, idnaMapped, "\ua667"},
+	{0xA667, 0xA667, idnaValid, ""},
+	{0xA668, 0xA668, idnaMapped, "\ua669"},
+	{0xA669, 0xA669, idnaValid, ""},
+	{0xA66A, 0xA66A, idnaMapped, "\ua66b"},
+	{0xA66B, 0xA66B, idnaValid, ""},
+	{0xA66C, 0xA66C, idnaMapped, "\ua66d"},
+	{0xA66D, 0xA67F, idnaValid, ""},
+	{0xA680, 0xA680, idnaMapped, "\ua681"},
+	{0xA681, 0xA681, idnaValid, ""},
+	{0xA682, 0xA682, idnaMapped, "\ua683"},
+	{0xA683, 0xA683, idnaValid, ""},
+	{0xA684, 0xA684, idnaMapped, "\ua685"},
+	{0xA685, 0xA685, idnaValid, ""},
+	{0xA686, 0xA686, idnaMapped, "\ua687"},
+	{0xA687, 0xA687, idnaValid, ""},
+	{0xA688, 0xA688, idnaMapped, "\ua689"},
+	{0xA689, 0xA689, idnaValid, ""},
+	{0xA68A, 0xA68A, idnaMapped, "\ua68b"},
+	{0xA68B, 0xA68B, idnaValid, ""},
+	{0xA68C, 0xA68C, idnaMapped, "\ua68d"},
+	{0xA68D, 0xA68D, idnaValid, ""},
+	{0xA68E, 0xA68E, idnaMapped, "\ua68f"},
+	{0xA68F, 0xA68F, idnaValid, ""},
+	{0xA690, 0xA690, idnaMapped, "\ua691"},
+	{0xA691, 0xA691, idnaValid, ""},
+	{0xA692, 0xA692, idnaMapped, "\ua693"},
+	{0xA693, 0xA693, idnaValid, ""},
+	{0xA694, 0xA694, idnaMapped, "\ua695"},
+	{0xA695, 0xA695, idnaValid, ""},
+	{0xA696, 0xA696, idnaMapped, "\ua697"},
+	{0xA697, 0xA697, idnaValid, ""},
+	{0xA698, 0xA698, idnaMapped, "\ua699"},
+	{0xA699, 0xA699, idnaValid, ""},
+	{0xA69A, 0xA69A, idnaMapped, "\ua69b"},
+	{0xA69B, 0xA69B, idnaValid, ""},
+	{0xA69C, 0xA69C, idnaMapped, "\u044a"},
+	{0xA69D, 0xA69D, idnaMapped, "\u044c"},
+	{0xA69E, 0xA6F7, idnaValid, ""},
+	{0xA6F8, 0xA6FF, idnaDisallowed, ""},
+	{0xA700, 0xA721, idnaValid, ""},
+	{0xA722, 0xA722, idnaMapped, "\ua723"},
+	{0xA723, 0xA723, idnaValid, ""},
+	{0xA724, 0xA724, idnaMapped, "\ua725"},
+	{0xA725, 0xA725, idnaValid, ""},
+	{0xA726, 0xA726, idnaMapped, "\ua727"},
+	{0xA727, 0xA727, idnaValid, ""},
+	{0xA728, 0xA728, idnaMapped, "\ua729"},
+	{0xA729, 0xA729, idnaValid, ""},
+	{0xA72A, 0xA72A, idnaMapped, "\ua72b"},
+	{0xA72B, 0xA72B, idnaValid, ""},
+	{0xA72C, 0xA72C, idnaMapped, "\ua72d"},
+	{0xA72D, 0xA72D, idnaValid, ""},
+	{0xA72E, 0xA72E, idnaMapped, "\ua72f"},
+	{0xA72F, 0xA731, idnaValid, ""},
+	{0xA732, 0xA732, idnaMapped, "\ua733"},
+	{0xA733, 0xA733, idnaValid, ""},
+	{0xA734, 0xA734, idnaMapped, "\ua735"},
+	{0xA735, 0xA735, idnaValid, ""},
+	{0xA736, 0xA736, idnaMapped, "\ua737"},
+	{0xA737, 0xA737, idnaValid, ""},
+	{0xA738, 0xA738, idnaMapped, "\ua739"},
+	{0xA739, 0xA739, idnaValid, ""},
+	{0xA73A, 0xA73A, idnaMapped, "\ua73b"},
+	{0xA73B, 0xA73B, idnaValid, ""},
+	{0xA73C, 0xA73C, idnaMapped, "\ua73d"},
+	{0xA73D, 0xA73D, idnaValid, ""},
+	{0xA73E, 0xA73E, idnaMapped, "\ua73f"},
+	{0xA73F, 0xA73F, idnaValid, ""},
+	{0xA740, 0xA740, idnaMapped, "\ua741"},
+	{0xA741, 0xA741, idnaValid, ""},
+	{0xA742, 0xA742, idnaMapped, "\ua743"},
+	{0xA743, 0xA743, idnaValid, ""},
+	{0xA744, 0xA744, idnaMapped, "\ua745"},
+	{0xA745, 0xA745, idnaValid, ""},
+	{0xA746, 0xA746, idnaMapped, "\ua747"},
+	{0xA747, 0xA747, idnaValid, ""},
+	{0xA748, 0xA748, idnaMapped, "\ua749"},
+	{0xA749, 0xA749, idnaValid, ""},
+	{0xA74A, 0xA74A, idnaMapped, "\ua74b"},
+	{0xA74B, 0xA74B, idnaValid, ""},
+	{0xA74C, 0xA74C, idnaMapped, "\ua74d"},
+	{0xA74D, 0xA74D, idnaValid, ""},
+	{0xA74E, 0xA74E, idnaMapped, "\ua74f"},
+	{0xA74F, 0xA74F, idnaValid, ""},
+	{0xA750, 0xA750, idnaMapped, "\ua751"},
+	{0xA751, 0xA751, idnaValid, ""},
+	{0xA752, 0xA752, idnaMapped, "\ua753"},
+	{0xA753, 0xA753, idnaValid, ""},
+	{0xA754, 0xA754, idnaMapped, "\ua755"},
+	{0xA755, 0xA755, idnaValid, ""},
+	{0xA756, 0xA756, idnaMapped, "\ua757"},
+	{0xA757, 0xA757, idnaValid, ""},
+	{0xA758, 0xA758, idnaMapped, "\ua759"},
+	{0xA759, 0xA759, idnaValid, ""},
+	{0xA75A, 0xA75A, idnaMapped, "\ua75b"},
+	{0xA75B, 0xA75B, idnaValid, ""},
+	{0xA75C, 0xA75C, idnaMapped, "\ua75d"},
+	{0xA75D, 0xA75D, idnaValid, ""},
+	{0xA75E, 0xA75E, idnaMapped, "\ua75f"},
+	{0xA75F, 0xA75F, idnaValid, ""},
+	{0xA760, 0xA760, idnaMapped, "\ua761"},
+	{0xA761, 0xA761, idnaValid, ""},
+	{0xA762, 0xA762, idnaMapped, "\ua763"},
+	{0xA763, 0xA763, idnaValid, ""},
+	{0xA764, 0xA764, idnaMapped, "\ua765"},
+	{0xA765, 0xA765, idnaValid, ""},
+	{0xA766, 0xA766, idnaMapped, "\ua767"},
+	{0xA767, 0xA767, idnaValid, ""},
+	{0xA768, 0xA768, idnaMapped, "\ua769"},
+	{0xA769, 0xA769, idnaValid, ""},
+	{0xA76A, 0xA76A, idnaMapped, "\ua76b"},
+	{0xA76B, 0xA76B, idnaValid, ""},
+	{0xA76C, 0xA76C, idnaMapped, "\ua76d"},
+	{0xA76D, 0xA76D, idnaValid, ""},
+	{0xA76E, 0xA76E, idnaMapped, "\ua76f"},
+	{0xA76F, 0xA76F, idnaValid, ""},
+	{0xA770, 0xA770, idnaMapped, "\ua76f"},
+	{0xA771, 0xA778, idnaValid, ""},
+	{0xA779, 0xA779, idnaMapped, "\ua77a"},
+	{0xA77A, 0xA77A, idnaValid, ""},
+	{0xA77B, 0xA77B, idnaMapped, "\ua77c"},
+	{0xA77C, 0xA77C, idnaValid, ""},
+	{0xA77D, 0xA77D, idnaMapped, "\u1d79"},
+	{0xA77E, 0xA77E, idnaMapped, "\ua77f"},
+	{0xA77F, 0xA77F, idnaValid, ""},
+	{0xA780, 0xA780, idnaMapped, "\ua781"},
+	{0xA781, 0xA781, idnaValid, ""},
+	{0xA782, 0xA782, idnaMapped, "\ua783"},
+	{0xA783, 0xA783, idnaValid, ""},
+	{0xA784, 0xA784, idnaMapped, "\ua785"},
+	{0xA785, 0xA785, idnaValid, ""},
+	{0xA786, 0xA786, idnaMapped, "\ua787"},
+	{0xA787, 0xA78A, idnaValid, ""},
+	{0xA78B, 0xA78B, idnaMapped, "\ua78c"},
+	{0xA78C, 0xA78C, idnaValid, ""},
+	{0xA78D, 0xA78D, idnaMapped, "\u0265"},
+	{0xA78E, 0xA78F, idnaValid, ""},
+	{0xA790, 0xA790, idnaMapped, "\ua791"},
+	{0xA791, 0xA791, idnaValid, ""},
+	{0xA792, 0xA792, idnaMapped, "\ua793"},
+	{0xA793, 0xA795, idnaValid, ""},
+	{0xA796, 0xA796, idnaMapped, "\ua797"},
+	{0xA797, 0xA797, idnaValid, ""},
+	{0xA798, 0xA798, idnaMapped, "\ua799"},
+	{0xA799, 0xA799, idnaValid, ""},
+	{0xA79A, 0xA79A, idnaMapped, "\ua79b"},
+	{0xA79B, 0xA79B, idnaValid, ""},
+	{0xA79C, 0xA79C, idnaMapped, "\ua79d"},
+	{0xA79D, 0xA79D, idnaValid, ""},
+	{0xA79E, 0xA79E, idnaMapped, "\ua79f"},
+	{0xA79F, 0xA79F, idnaValid, ""},
+	{0xA7A0, 0xA7A0, idnaMapped, "\ua7a1"},
+	{0xA7A1, 0xA7A1, idnaValid, ""},
+	{0xA7A2, 0xA7A2, idnaMapped, "\ua7a3"},
+	{0xA7A3, 0xA7A3, idnaValid, ""},
+	{0xA7A4, 0xA7A4, idnaMapped, "\ua7a5"},
+	{0xA7A5, 0xA7A5, idnaValid, ""},
+	{0xA7A6, 0xA7A6, idnaMapped, "\ua7a7"},
+	{0xA7A7, 0xA7A7, idnaValid, ""},
+	{0xA7A8, 0xA7A8, idnaMapped, "\ua7a9"},
+	{0xA7A9, 0xA7A9, idnaValid, ""},
+	{0xA7AA, 0xA7AA, idnaMapped, "\u0266"},
+	{0xA7AB, 0xA7AB, idnaMapped, "\u025c"},
+	{0xA7AC, 0xA7AC, idnaMapped, "\u0261"},
+	{0xA7AD, 0xA7AD, idnaMapped, "\u026c"},
+	{0xA7AE, 0xA7AE, idnaMapped, "\u026a"},
+	{0xA7AF, 0xA7AF, idnaValid, ""},
+	{0xA7B0, 0xA7B0, idnaMapped, "\u029e"},
+	{0xA7B1, 0xA7B1, idnaMapped, "\u0287"},
+	{0xA7B2, 0xA7B2, idnaMapped, "\u029d"},
+	{0xA7B3, 0xA7B3, idnaMapped, "\uab53"},
+	{0xA7B4, 0xA7B4, idnaMapped, "\ua7b5"},
+	{0xA7B5, 0xA7B5, idnaValid, ""},
+	{0xA7B6, 0xA7B6, idnaMapped, "\ua7b7"},
+	{0xA7B7, 0xA7B7, idnaValid, ""},
+	{0xA7B8, 0xA7B8, idnaMapped, "\ua7b9"},
+	{0xA7B9, 0xA7B9, idnaValid, ""},
+	{0xA7BA, 0xA7BA, idnaMapped, "\ua7bb"},
+	{0xA7BB, 0xA7BB, idnaValid, ""},
+	{0xA7BC, 0xA7BC, idnaMapped, "\ua7bd"},
+	{0xA7BD, 0xA7BD, idnaValid, ""},
+	{0xA7BE, 0xA7BE, idnaMapped, "\ua7bf"},
+	{0xA7BF, 0xA7BF, idnaValid, ""},
+	{0xA7C0, 0xA7C0, idnaMapped, "\ua7c1"},
+	{0xA7C1, 0xA7C1, idnaValid, ""},
+	{0xA7C2, 0xA7C2, idnaMapped, "\ua7c3"},
+	{0xA7C3, 0xA7C3, idnaValid, ""},
+	{0xA7C4, 0xA7C4, idnaMapped, "\ua794"},
+	{0xA7C5, 0xA7C5, idnaMapped, "\u0282"},
+	{0xA7C6, 0xA7C6, idnaMapped, "\u1d8e"},
+	{0xA7C7, 0xA7C7, idnaMapped, "\ua7c8"},
+	{0xA7C8, 0xA7C8, idnaValid, ""},
+	{0xA7C9, 0xA7C9, idnaMapped, "\ua7ca"},
+	{0xA7CA, 0xA7CA, idnaValid, ""},
+	{0xA7CB, 0xA7CB, idnaMapped, "\u0264"},
+	{0xA7CC, 0xA7CC, idnaMapped, "\ua7cd"},
+	{0xA7CD, 0xA7CD, idnaValid, ""},
+	{0xA7CE, 0xA7CE, idnaMapped, "\ua7cf"},
+	{0xA7CF, 0xA7CF, idnaValid, ""},
+	{0xA7D0, 0xA7D0, idnaMapped, "\ua7d1"},
+	{0xA7D1, 0xA7D1, idnaValid, ""},
+	{0xA7D2, 0xA7D2, idnaMapped, "\ua7d3"},
+	{0xA7D3, 0xA7D3, idnaValid, ""},
+	{0xA7D4, 0xA7D4, idnaMapped, "\ua7d5"},
+	{0xA7D5, 0xA7D5, idnaValid, ""},
+	{0xA7D6, 0xA7D6, idnaMapped, "\ua7d7"},
+	{0xA7D7, 0xA7D7, idnaValid, ""},
+	{0xA7D8, 0xA7D8, idnaMapped, "\ua7d9"},
+	{0xA7D9, 0xA7D9, idnaValid, ""},
+	{0xA7DA, 0xA7DA, idnaMapped, "\ua7db"},
+	{0xA7DB, 0xA7DB, idnaValid, ""},
+	{0xA7DC, 0xA7DC, idnaMapped, "\u019b"},
+	{0xA7DD, 0xA7F0, idnaDisallowed, ""},
+	{0xA7F1, 0xA7F1, idnaMapped, "s"},
+	{0xA7F2, 0xA7F2, idnaMapped, "c"},
+	{0xA7F3, 0xA7F3, idnaMapped, "f"},
+	{0xA7F4, 0xA7F4, idnaMapped, "q"},
+	{0xA7F5, 0xA7F5, idnaMapped, "\ua7f6"},
+	{0xA7F6, 0xA7F7, idnaValid, ""},
+	{0xA7F8, 0xA7F8, idnaMapped, "\u0127"},
+	{0xA7F9, 0xA7F9, idnaMapped, "\u0153"},
+	{0xA7FA, 0xA82C, idnaValid, ""},
+	{0xA82D, 0xA82F, idnaDisallowed, ""},
+	{0xA830, 0xA839, idnaValid, ""},
+	{0xA83A, 0xA83F, idnaDisallowed, ""},
+	{0xA840, 0xA877, idnaValid, ""},
+	{0xA878, 0xA87F, idnaDisallowed, ""},
+	{0xA880, 0xA8C5, idnaValid, ""},
+	{0xA8C6, 0xA8CD, idnaDisallowed, ""},
+	{0xA8CE, 0xA8D9, idnaValid, ""},
+	{0xA8DA, 0xA8DF, idnaDisallowed, ""},
+	{0xA8E0, 0xA953, idnaValid, ""},
+	{0xA954, 0xA95E, idnaDisallowed, ""},
+	{0xA95F, 0xA97C, idnaValid, ""},
+	{0xA97D, 0xA97F, idnaDisallowed, ""},
+	{0xA980, 0xA9CD, idnaValid, ""},
+	{0xA9CE, 0xA9CE, idnaDisallowed, ""},
+	{0xA9CF, 0xA9D9, idnaValid, ""},
+	{0xA9DA, 0xA9DD, idnaDisallowed, ""},
+	{0xA9DE, 0xA9FE, idnaValid, ""},
+	{0xA9FF, 0xA9FF, idnaDisallowed, ""},
+	{0xAA00, 0xAA36, idnaValid, ""},
+	{0xAA37, 0xAA3F, idnaDisallowed, ""},
+	{0xAA40, 0xAA4D, idnaValid, ""},
+	{0xAA4E, 0xAA4F, idnaDisallowed, ""},
+	{0xAA50, 0xAA59, idnaValid, ""},
+	{0xAA5A, 0xAA5B, idnaDisallowed, ""},
+	{0xAA5C, 0xAAC2, idnaValid, ""},
+	{0xAAC3, 0xAADA, idnaDisallowed, ""},
+	{0xAADB, 0xAAF6, idnaValid, ""},
+	{0xAAF7, 0xAB00, idnaDisallowed, ""},
+	{0xAB01, 0xAB06, idnaValid, ""},
+	{0xAB07, 0xAB08, idnaDisallowed, ""},
+	{0xAB09, 0xAB0E, idnaValid, ""},
+	{0xAB0F, 0xAB10, idnaDisallowed, ""},
+	{0xAB11, 0xAB16, idnaValid, ""},
+	{0xAB17, 0xAB1F, idnaDisallowed, ""},
+	{0xAB20, 0xAB26, idnaValid, ""},
+	{0xAB27, 0xAB27, idnaDisallowed, ""},
+	{0xAB28, 0xAB2E, idnaValid, ""},
+	{0xAB2F, 0xAB2F, idnaDisallowed, ""},
+	{0xAB30, 0xAB5B, idnaValid, ""},
+	{0xAB5C, 0xAB5C, idnaMapped, "\ua727"},
+	{0xAB5D, 0xAB5D, idnaMapped, "\uab37"},
+	{0xAB5E, 0xAB5E, idnaMapped, "\u026b"},
+	{0xAB5F, 0xAB5F, idnaMapped, "\uab52"},
+	{0xAB60, 0xAB68, idnaValid, ""},
+	{0xAB69, 0xAB69, idnaMapped, "\u028d"},
+	{0xAB6A, 0xAB6B, idnaValid, ""},
+	{0xAB6C, 0xAB6F, idnaDisallowed, ""},
+	{0xAB70, 0xAB70, idnaMapped, "\u13a0"},
+	{0xAB71, 0xAB71, idnaMapped, "\u13a1"},
+	{0xAB72, 0xAB72, idnaMapped, "\u13a2"},
+	{0xAB73, 0xAB73, idnaMapped, "\u13a3"},
+	{0xAB74, 0xAB74, idnaMapped, "\u13a4"},
+	{0xAB75, 0xAB75, idnaMapped, "\u13a5"},
+	{0xAB76, 0xAB76, idnaMapped, "\u13a6"},
+	{0xAB77, 0xAB77, idnaMapped, "\u13a7"},
+	{0xAB78, 0xAB78, idnaMapped, "\u13a8"},
+	{0xAB79, 0xAB79, idnaMapped, "\u13a9"},
+	{0xAB7A, 0xAB7A, idnaMapped, "\u13aa"},
+	{0xAB7B, 0xAB7B, idnaMapped, "\u13ab"},
+	{0xAB7C, 0xAB7C, idnaMapped, "\u13ac"},
+	{0xAB7D, 0xAB7D, idnaMapped, "\u13ad"},
+	{0xAB7E, 0xAB7E, idnaMapped, "\u13ae"},
+	{0xAB7F, 0xAB7F, idnaMapped, "\u13af"},
+	{0xAB80, 0xAB80, idnaMapped, "\u13b0"},
+	{0xAB81, 0xAB81, idnaMapped, "\u13b1"},
+	{0xAB82, 0xAB82, idnaMapped, "\u13b2"},
+	{0xAB83, 0xAB83, idnaMapped, "\u13b3"},
+	{0xAB84, 0xAB84, idnaMapped, "\u13b4"},
+	{0xAB85, 0xAB85, idnaMapped, "\u13b5"},
+	{0xAB86, 0xAB86, idnaMapped, "\u13b6"},
+	{0xAB87, 0xAB87, idnaMapped, "\u13b7"},
+	{0xAB88, 0xAB88, idnaMapped, "\u13b8"},
+	{0xAB89, 0xAB89, idnaMapped, "\u13b9"},
+	{0xAB8A, 0xAB8A, idnaMapped, "\u13ba"},
+	{0xAB8B, 0xAB8B, idnaMapped, "\u13bb"},
+	{0xAB8C, 0xAB8C, idnaMapped, "\u13bc"},
+	{0xAB8D, 0xAB8D, idnaMapped, "\u13bd"},
+	{0xAB8E, 0xAB8E, idnaMapped, "\u13be"},
+	{0xAB8F, 0xAB8F, idnaMapped, "\u13bf"},
+	{0xAB90, 0xAB90, idnaMapped, "\u13c0"},
+	{0xAB91, 0xAB91, idnaMapped, "\u13c1"},
+	{0xAB92, 0xAB92, idnaMapped, "\u13c2"},
+	{0xAB93, 0xAB93, idnaMapped, "\u13c3"},
+	{0xAB94, 0xAB94, idnaMapped, "\u13c4"},
+	{0xAB95, 0xAB95, idnaMapped, "\u13c5"},
+	{0xAB96, 0xAB96, idnaMapped, "\u13c6"},
+	{0xAB97, 0xAB97, idnaMapped, "\u13c7"},
+	{0xAB98, 0xAB98, idnaMapped, "\u13c8"},
+	{0xAB99, 0xAB99, idnaMapped, "\u13c9"},
+	{0xAB9A, 0xAB9A, idnaMapped, "\u13ca"},
+	{0xAB9B, 0xAB9B, idnaMapped, "\u13cb"},
+	{0xAB9C, 0xAB9C, idnaMapped, "\u13cc"},
+	{0xAB9D, 0xAB9D, idnaMapped, "\u13cd"},
+	{0xAB9E, 0xAB9E, idnaMapped, "\u13ce"},
+	{0xAB9F, 0xAB9F, idnaMapped, "\u13cf"},
+	{0xABA0, 0xABA0, idnaMapped, "\u13d0"},
+	{0xABA1, 0xABA1, idnaMapped, "\u13d1"},
+	{0xABA2, 0xABA2, idnaMapped, "\u13d2"},
+	{0xABA3, 0xABA3, idnaMapped, "\u13d3"},
+	{0xABA4, 0xABA4, idnaMapped, "\u13d4"},
+	{0xABA5, 0xABA5, idnaMapped, "\u13d5"},
+	{0xABA6, 0xABA6, idnaMapped, "\u13d6"},
+	{0xABA7, 0xABA7, idnaMapped, "\u13d7"},
+	{0xABA8, 0xABA8, idnaMapped, "\u13d8"},
+	{0xABA9, 0xABA9, idnaMapped, "\u13d9"},
+	{0xABAA, 0xABAA, idnaMapped, "\u13da"},
+	{0xABAB, 0xABAB, idnaMapped, "\u13db"},
+	{0xABAC, 0xABAC, idnaMapped, "\u13dc"},
+	{0xABAD, 0xABAD, idnaMapped, "\u13dd"},
+	{0xABAE, 0xABAE, idnaMapped, "\u13de"},
+	{0xABAF, 0xABAF, idnaMapped, "\u13df"},
+	{0xABB0, 0xABB0, idnaMapped, "\u13e0"},
+	{0xABB1, 0xABB1, idnaMapped, "\u13e1"},
+	{0xABB2, 0xABB2, idnaMapped, "\u13e2"},
+	{0xABB3, 0xABB3, idnaMapped, "\u13e3"},
+	{0xABB4, 0xABB4, idnaMapped, "\u13e4"},
+	{0xABB5, 0xABB5, idnaMapped, "\u13e5"},
+	{0xABB6, 0xABB6, idnaMapped, "\u13e6"},
+	{0xABB7, 0xABB7, idnaMapped, "\u13e7"},
+	{0xABB8, 0xABB8, idnaMapped, "\u13e8"},
+	{0xABB9, 0xABB9, idnaMapped, "\u13e9"},
+	{0xABBA, 0xABBA, idnaMapped, "\u13ea"},
+	{0xABBB, 0xABBB, idnaMapped, "\u13eb"},
+	{0xABBC, 0xABBC, idnaMapped, "\u13ec"},
+	{0xABBD, 0xABBD, idnaMapped, "\u13ed"},
+	{0xABBE, 0xABBE, idnaMapped, "\u13ee"},
+	{0xABBF, 0xABBF, idnaMapped, "\u13ef"},
+	{0xABC0, 0xABED, idnaValid, ""},
+	{0xABEE, 0xABEF, idnaDisallowed, ""},
+	{0xABF0, 0xABF9, idnaValid, ""},
+	{0xABFA, 0xABFF, idnaDisallowed, ""},
+	{0xAC00, 0xD7A3, idnaValid, ""},
+	{0xD7A4, 0xD7AF, idnaDisallowed, ""},
+	{0xD7B0, 0xD7C6, idnaValid, ""},
+	{0xD7C7, 0xD7CA, idnaDisallowed, ""},
+	{0xD7CB, 0xD7FB, idnaValid, ""},
+	{0xD7FC, 0xF8FF, idnaDisallowed, ""},
+	{0xF900, 0xF900, idnaMapped, "\u8c48"},
+	{0xF901, 0xF901, idnaMapped, "\u66f4"},
+	{0xF902, 0xF902, idnaMapped, "\u8eca"},
+	{0xF903, 0xF903, idnaMapped, "\u8cc8"},
+	{0xF904, 0xF904, idnaMapped, "\u6ed1"},
+	{0xF905, 0xF905, idnaMapped, "\u4e32"},
+	{0xF906, 0xF906, idnaMapped, "\u53e5"},
+	{0xF907, 0xF907, idnaMapped, "\u9f9c"},
+	{0xF908, 0xF908, idnaMapped, "\u9f9c"},
+	{0xF909, 0xF909, idnaMapped, "\u5951"},
+	{0xF90A, 0xF90A, idnaMapped, "\u91d1"},
+	{0xF90B, 0xF90B, idnaMapped, "\u5587"},
+	{0xF90C, 0xF90C, idnaMapped, "\u5948"},
+	{0xF90D, 0xF90D, idnaMapped, "\u61f6"},
+	{0xF90E, 0xF90E, idnaMapped, "\u7669"},
+	{0xF90F, 0xF90F, idnaMapped, "\u7f85"},
+	{0xF910, 0xF910, idnaMapped, "\u863f"},
+	{0xF911, 0xF911, idnaMapped, "\u87ba"},
+	{0xF912, 0xF912, idnaMapped, "\u88f8"},
+	{0xF913, 0xF913, idnaMapped, "\u908f"},
+	{0xF914, 0xF914, idnaMapped, "\u6a02"},
+	{0xF915, 0xF915, idnaMapped, "\u6d1b"},
+	{0xF916, 0xF916, idnaMapped, "\u70d9"},
+	{0xF917, 0xF917, idnaMapped, "\u73de"},
+	{0xF918, 0xF918, idnaMapped, "\u843d"},
+	{0xF919, 0xF919, idnaMapped, "\u916a"},
+	{0xF91A, 0xF91A, idnaMapped, "\u99f1"},
+	{0xF91B, 0xF91B, idnaMapped, "\u4e82"},
+	{0xF91C, 0xF91C, idnaMapped, "\u5375"},
+	{0xF91D, 0xF91D, idnaMapped, "\u6b04"},
+	{0xF91E, 0xF91E, idnaMapped, "\u721b"},
+	{0xF91F, 0xF91F, idnaMapped, "\u862d"},
+	{0xF920, 0xF920, idnaMapped, "\u9e1e"},
+	{0xF921, 0xF921, idnaMapped, "\u5d50"},
+	{0xF922, 0xF922, idnaMapped, "\u6feb"},
+	{0xF923, 0xF923, idnaMapped, "\u85cd"},
+	{0xF924, 0xF924, idnaMapped, "\u8964"},
+	{0xF925, 0xF925, idnaMapped, "\u62c9"},
+	{0xF926, 0xF926, idnaMapped, "\u81d8"},
+	{0xF927, 0xF927, idnaMapped, "\u881f"},
+	{0xF928, 0xF928, idnaMapped, "\u5eca"},
+	{0xF929, 0xF929, idnaMapped, "\u6717"},
+	{0xF92A, 0xF92A, idnaMapped, "\u6d6a"},
+	{0xF92B, 0xF92B, idnaMapped, "\u72fc"},
+	{0xF92C, 0xF92C, idnaMapped, "\u90ce"},
+	{0xF92D, 0xF92D, idnaMapped, "\u4f86"},
+	{0xF92E, 0xF92E, idnaMapped, "\u51b7"},
+	{0xF92F, 0xF92F, idnaMapped, "\u52de"},
+	{0xF930, 0xF930, idnaMapped, "\u64c4"},
+	{0xF931, 0xF931, idnaMapped, "\u6ad3"},
+	{0xF932, 0xF932, idnaMapped, "\u7210"},
+	{0xF933, 0xF933, idnaMapped, "\u76e7"},
+	{0xF934, 0xF934, idnaMapped, "\u8001"},
+	{0xF935, 0xF935, idnaMapped, "\u8606"},
+	{0xF936, 0xF936, idnaMapped, "\u865c"},
+	{0xF937, 0xF937, idnaMapped, "\u8def"},
+	{0xF938, 0xF938, idnaMapped, "\u9732"},
+	{0xF939, 0xF939, idnaMapped, "\u9b6f"},
+	{0xF93A, 0xF93A, idnaMapped, "\u9dfa"},
+	{0xF93B, 0xF93B, idnaMapped, "\u788c"},
+	{0xF93C, 0xF93C, idnaMapped, "\u797f"},
+	{0xF93D, 0xF93D, idnaMapped, "\u7da0"},
+	{0xF93E, 0xF93E, idnaMapped, "\u83c9"},
+	{0xF93F, 0xF93F, idnaMapped, "\u9304"},
+	{0xF940, 0xF940, idnaMapped, "\u9e7f"},
+	{0xF941, 0xF941, idnaMapped, "\u8ad6"},
+	{0xF942, 0xF942, idnaMapped, "\u58df"},
+	{0xF943, 0xF943, idnaMapped, "\u5f04"},
+	{0xF944, 0xF944, idnaMapped, "\u7c60"},
+	{0xF945, 0xF945, idnaMapped, "\u807e"},
+	{0xF946, 0xF946, idnaMapped, "\u7262"},
+	{0xF947, 0xF947, idnaMapped, "\u78ca"},
+	{0xF948, 0xF948, idnaMapped, "\u8cc2"},
+	{0xF949, 0xF949, idnaMapped, "\u96f7"},
+	{0xF94A, 0xF94A, idnaMapped, "\u58d8"},
+	{0xF94B, 0xF94B, idnaMapped, "\u5c62"},
+	{0xF94C, 0xF94C, idnaMapped, "\u6a13"},
+	{0xF94D, 0xF94D, idnaMapped, "\u6dda"},
+	{0xF94E, 0xF94E, idnaMapped, "\u6f0f"},
+	{0xF94F, 0xF94F, idnaMapped, "\u7d2f"},
+	{0xF950, 0xF950, idnaMapped, "\u7e37"},
+	{0xF951, 0xF951, idnaMapped, "\u964b"},
+	{0xF952, 0xF952, idnaMapped, "\u52d2"},
+	{0xF953, 0xF953, idnaMapped, "\u808b"},
+	{0xF954, 0xF954, idnaMapped, "\u51dc"},
+	{0xF955, 0xF955, idnaMapped, "\u51cc"},
+	{0xF956, 0xF956, idnaMapped, "\u7a1c"},
+	{0xF957, 0xF957, idnaMapped, "\u7dbe"},
+	{0xF958, 0xF958, idnaMapped, "\u83f1"},
+	{0xF959, 0xF959, idnaMapped, "\u9675"},
+	{0xF95A, 0xF95A, idnaMapped, "\u8b80"},
+	{0xF95B, 0xF95B, idnaMapped, "\u62cf"},
+	{0xF95C, 0xF95C, idnaMapped, "\u6a02"},
+	{0xF95D, 0xF95D, idnaMapped, "\u8afe"},
+	{0xF95E, 0xF95E, idnaMapped, "\u4e39"},
+	{0xF95F, 0xF95F, idnaMapped, "\u5be7"},
+	{0xF960, 0xF960, idnaMapped, "\u6012"},
+	{0xF961, 0xF961, idnaMapped, "\u7387"},
+	{0xF962, 0xF962, idnaMapped, "\u7570"},
+	{0xF963, 0xF963, idnaMapped, "\u5317"},
+	{0xF964, 0xF964, idnaMapped, "\u78fb"},
+	{0xF965, 0xF965, idnaMapped, "\u4fbf"},
+	{0xF966, 0xF966, idnaMapped, "\u5fa9"},
+	{0xF967, 0xF967, idnaMapped, "\u4e0d"},
+	{0xF968, 0xF968, idnaMapped, "\u6ccc"},
+	{0xF969, 0xF969, idnaMapped, "\u6578"},
+	{0xF96A, 0xF96A, idnaMapped, "\u7d22"},
+	{0xF96B, 0xF96B, idnaMapped, "\u53c3"},
+	{0xF96C, 0xF96C, idnaMapped, "\u585e"},
+	{0xF96D, 0xF96D, idnaMapped, "\u7701"},
+	{0xF96E, 0xF96E, idnaMapped, "\u8449"},
+	{0xF96F, 0xF96F, idnaMapped, "\u8aaa"},
+	{0xF970, 0xF970, idnaMapped, "\u6bba"},
+	{0xF971, 0xF971, idnaMapped, "\u8fb0"},
+	{0xF972, 0xF972, idnaMapped, "\u6c88"},
+	{0xF973, 0xF973, idnaMapped, "\u62fe"},
+	{0xF974, 0xF974, idnaMapped, "\u82e5"},
+	{0xF975, 0xF975, idnaMapped, "\u63a0"},
+	{0xF976, 0xF976, idnaMapped, "\u7565"},
+	{0xF977, 0xF977, idnaMapped, "\u4eae"},
+	{0xF978, 0xF978, idnaMapped, "\u5169"},
+	{0xF979, 0xF979, idnaMapped, "\u51c9"},
+	{0xF97A, 0xF97A, idnaMapped, "\u6881"},
+	{0xF97B, 0xF97B, idnaMapped, "\u7ce7"},
+	{0xF97C, 0xF97C, idnaMapped, "\u826f"},
+	{0xF97D, 0xF97D, idnaMapped, "\u8ad2"},
+	{0xF97E, 0xF97E, idnaMapped, "\u91cf"},
+	{0xF97F, 0xF97F, idnaMapped, "\u52f5"},
+	{0xF980, 0xF980, idnaMapped, "\u5442"},
+	{0xF981, 0xF981, idnaMapped, "\u5973"},
+	{0xF982, 0xF982, idnaMapped, "\u5eec"},
+	{0xF983, 0xF983, idnaMapped, "\u65c5"},
+	{0xF984, 0xF984, idnaMapped, "\u6ffe"},
+	{0xF985, 0xF985, idnaMapped, "\u792a"},
+	{0xF986, 0xF986, idnaMapped, "\u95ad"},
+	{0xF987, 0xF987, idnaMapped, "\u9a6a"},
+	{0xF988, 0xF988, idnaMapped, "\u9e97"},
+	{0xF989, 0xF989, idnaMapped, "\u9ece"},
+	{0xF98A, 0xF98A, idnaMapped, "\u529b"},
+	{0xF98B, 0xF98B, idnaMapped, "\u66c6"},
+	{0xF98C, 0xF98C, idnaMapped, "\u6b77"},
+	{0xF98D, 0xF98D, idnaMapped, "\u8f62"},
+	{0xF98E, 0xF98E, idnaMapped, "\u5e74"},
+	{0xF98F, 0xF98F, idnaMapped, "\u6190"},
+	{0xF990, 0xF990, idnaMapped, "\u6200"},
+	{0xF991, 0xF991, idnaMapped, "\u649a"},
+	{0xF992, 0xF992, idnaMapped, "\u6f23"},
+	{0xF993, 0xF993, idnaMapped, "\u7149"},
+	{0xF994, 0xF994, idnaMapped, "\u7489"},
+	{0xF995, 0xF995, idnaMapped, "\u79ca"},
+	{0xF996, 0xF996, idnaMapped, "\u7df4"},
+	{0xF997, 0xF997, idnaMapped, "\u806f"},
+	{0xF998, 0xF998, idnaMapped, "\u8f26"},
+	{0xF999, 0xF999, idnaMapped, "\u84ee"},
+	{0xF99A, 0xF99A, idnaMapped, "\u9023"},
+	{0xF99B, 0xF99B, idnaMapped, "\u934a"},
+	{0xF99C, 0xF99C, idnaMapped, "\u5217"},
+	{0xF99D, 0xF99D, idnaMapped, "\u52a3"},
+	{0xF99E, 0xF99E, idnaMapped, "\u54bd"},
+	{0xF99F, 0xF99F, idnaMapped, "\u70c8"},
+	{0xF9A0, 0xF9A0, idnaMapped, "\u88c2"},
+	{0xF9A1, 0xF9A1, idnaMapped, "\u8aaa"},
+	{0xF9A2, 0xF9A2, idnaMapped, "\u5ec9"},
+	{0xF9A3, 0xF9A3, idnaMapped, "\u5ff5"},
+	{0xF9A4, 0xF9A4, idnaMapped, "\u637b"},
+	{0xF9A5, 0xF9A5, idnaMapped, "\u6bae"},
+	{0xF9A6, 0xF9A6, idnaMapped, "\u7c3e"},
+	{0xF9A7, 0xF9A7, idnaMapped, "\u7375"},
+	{0xF9A8, 0xF9A8, idnaMapped, "\u4ee4"},
+	{0xF9A9, 0xF9A9, idnaMapped, "\u56f9"},
+	{0xF9AA, 0xF9AA, idnaMapped, "\u5be7"},
+	{0xF9AB, 0xF9AB, idnaMapped, "\u5dba"},
+	{0xF9AC, 0xF9AC, idnaMapped, "\u601c"},
+	{0xF9AD, 0xF9AD, idnaMapped, "\u73b2"},
+	{0xF9AE, 0xF9AE, idnaMapped, "\u7469"},
+	{0xF9AF, 0xF9AF, idnaMapped, "\u7f9a"},
+	{0xF9B0, 0xF9B0, idnaMapped, "\u8046"},
+	{0xF9B1, 0xF9B1, idnaMapped, "\u9234"},
+	{0xF9B2, 0xF9B2, idnaMapped, "\u96f6"},
+	{0xF9B3, 0xF9B3, idnaMapped, "\u9748"},
+	{0xF9B4, 0xF9B4, idnaMapped, "\u9818"},
+	{0xF9B5, 0xF9B5, idnaMapped, "\u4f8b"},
+	{0xF9B6, 0xF9B6, idnaMapped, "\u79ae"},
+	{0xF9B7, 0xF9B7, idnaMapped, "\u91b4"},
+	{0xF9B8, 0xF9B8, idnaMapped, "\u96b8"},
+	{0xF9B9, 0xF9B9, idnaMapped, "\u60e1"},
+	{0xF9BA, 0xF9BA, idnaMapped, "\u4e86"},
+	{0xF9BB, 0xF9BB, idnaMapped, "\u50da"},
+	{0xF9BC, 0xF9BC, idnaMapped, "\u5bee"},
+	{0xF9BD, 0xF9BD, idnaMapped, "\u5c3f"},
+	{0xF9BE, 0xF9BE, idnaMapped, "\u6599"},
+	{0xF9BF, 0xF9BF, idnaMapped, "\u6a02"},
+	{0xF9C0, 0xF9C0, idnaMapped, "\u71ce"},
+	{0xF9C1, 0xF9C1, idnaMapped, "\u7642"},
+	{0xF9C2, 0xF9C2, idnaMapped, "\u84fc"},
+	{0xF9C3, 0xF9C3, idnaMapped, "\u907c"},
+	{0xF9C4, 0xF9C4, idnaMapped, "\u9f8d"},
+	{0xF9C5, 0xF9C5, idnaMapped, "\u6688"},
+	{0xF9C6, 0xF9C6, idnaMapped, "\u962e"},
+	{0xF9C7, 0xF9C7, idnaMapped, "\u5289"},
+	{0xF9C8, 0xF9C8, idnaMapped, "\u677b"},
+	{0xF9C9, 0xF9C9, idnaMapped, "\u67f3"},
+	{0xF9CA, 0xF9CA, idnaMapped, "\u6d41"},
+	{0xF9CB, 0xF9CB, idnaMapped, "\u6e9c"},
+	{0xF9CC, 0xF9CC, idnaMapped, "\u7409"},
+	{0xF9CD, 0xF9CD, idnaMapped, "\u7559"},
+	{0xF9CE, 0xF9CE, idnaMapped, "\u786b"},
+	{0xF9CF, 0xF9CF, idnaMapped, "\u7d10"},
+	{0xF9D0, 0xF9D0, idnaMapped, "\u985e"},
+	{0xF9D1, 0xF9D1, idnaMapped, "\u516d"},
+	{0xF9D2, 0xF9D2, idnaMapped, "\u622e"},
+	{0xF9D3, 0xF9D3, idnaMapped, "\u9678"},
+	{0xF9D4, 0xF9D4, idnaMapped, "\u502b"},
+	{0xF9D5, 0xF9D5, idnaMapped, "\u5d19"},
+	{0xF9D6, 0xF9D6, idnaMapped, "\u6dea"},
+	{0xF9D7, 0xF9D7, idnaMapped, "\u8f2a"},
+	{0xF9D8, 0xF9D8, idnaMapped, "\u5f8b"},
+	{0xF9D9, 0xF9D9, idnaMapped, "\u6144"},
+	{0xF9DA, 0xF9DA, idnaMapped, "\u6817"},
+	{0xF9DB, 0xF9DB, idnaMapped, "\u7387"},
+	{0xF9DC, 0xF9DC, idnaMapped, "\u9686"},
+	{0xF9DD, 0xF9DD, idnaMapped, "\u5229"},
+	{0xF9DE, 0xF9DE, idnaMapped, "\u540f"},
+	{0xF9DF, 0xF9DF, idnaMapped, "\u5c65"},
+	{0xF9E0, 0xF9E0, idnaMapped, "\u6613"},
+	{0xF9E1, 0xF9E1, idnaMapped, "\u674e"},
+	{0xF9E2, 0xF9E2, idnaMapped, "\u68a8"},
+	{0xF9E3, 0xF9E3, idnaMapped, "\u6ce5"},
+	{0xF9E4, 0xF9E4, idnaMapped, "\u7406"},
+	{0xF9E5, 0xF9E5, idnaMapped, "\u75e2"},
+	{0xF9E6, 0xF9E6, idnaMapped, "\u7f79"},
+	{0xF9E7, 0xF9E7, idnaMapped, "\u88cf"},
+	{0xF9E8, 0xF9E8, idnaMapped, "\u88e1"},
+	{0xF9E9, 0xF9E9, idnaMapped, "\u91cc"},
+	{0xF9EA, 0xF9EA, idnaMapped, "\u96e2"},
+	{0xF9EB, 0xF9EB, idnaMapped, "\u533f"},
+	{0xF9EC, 0xF9EC, idnaMapped, "\u6eba"},
+	{0xF9ED, 0xF9ED, idnaMapped, "\u541d"},
+	{0xF9EE, 0xF9EE, idnaMapped, "\u71d0"},
+	{0xF9EF, 0xF9EF, idnaMapped, "\u7498"},
+	{0xF9F0, 0xF9F0, idnaMapped, "\u85fa"},
+	{0xF9F1, 0xF9F1, idnaMapped, "\u96a3"},
+	{0xF9F2, 0xF9F2, idnaMapped, "\u9c57"},
+	{0xF9F3, 0xF9F3, idnaMapped, "\u9e9f"},
+	{0xF9F4, 0xF9F4, idnaMapped, "\u6797"},
+	{0xF9F5, 0xF9F5, idnaMapped, "\u6dcb"},
+	{0xF9F6, 0xF9F6, idnaMapped, "\u81e8"},
+	{0xF9F7, 0xF9F7, idnaMapped, "\u7acb"},
+	{0xF9F8, 0xF9F8, idnaMapped, "\u7b20"},
+	{0xF9F9, 0xF9F9, idnaMapped, "\u7c92"},
+	{0xF9FA, 0xF9FA, idnaMapped, "\u72c0"},
+	{0xF9FB, 0xF9FB, idnaMapped, "\u7099"},
+	{0xF9FC, 0xF9FC, idnaMapped, "\u8b58"},
+	{0xF9FD, 0xF9FD, idnaMapped, "\u4ec0"},
+	{0xF9FE, 0xF9FE, idnaMapped, "\u8336"},
+	{0xF9FF, 0xF9FF, idnaMapped, "\u523a"},
+	{0xFA00, 0xFA00, idnaMapped, "\u5207"},
+	{0xFA01, 0xFA01, idnaMapped, "\u5ea6"},
+	{0xFA02, 0xFA02, idnaMapped, "\u62d3"},
+	{0xFA03, 0xFA03, idnaMapped, "\u7cd6"},
+	{0xFA04, 0xFA04, idnaMapped, "\u5b85"},
+	{0xFA05, 0xFA05, idnaMapped, "\u6d1e"},
+	{0xFA06, 0xFA06, idnaMapped, "\u66b4"},
+	{0xFA07, 0xFA07, idnaMapped, "\u8f3b"},
+	{0xFA08, 0xFA08, idnaMapped, "\u884c"},
+	{0xFA09, 0xFA09, idnaMapped, "\u964d"},
+	{0xFA0A, 0xFA0A, idnaMapped, "\u898b"},
+	{0xFA0B, 0xFA0B, idnaMapped, "\u5ed3"},
+	{0xFA0C, 0xFA0C, idnaMapped, "\u5140"},
+	{0xFA0D, 0xFA0D, idnaMapped, "\u55c0"},
+	{0xFA0E, 0xFA0F, idnaValid, ""},
+	{0xFA10, 0xFA10, idnaMapped, "\u585a"},
+	{0xFA11, 0xFA11, idnaValid, ""},
+	{0xFA12, 0xFA12, idnaMapped, "\u6674"},
+	{0xFA13, 0xFA14, idnaValid, ""},
+	{0xFA15, 0xFA15, idnaMapped, "\u51de"},
+	{0xFA16, 0xFA16, idnaMapped, "\u732a"},
+	{0xFA17, 0xFA17, idnaMapped, "\u76ca"},
+	{0xFA18, 0xFA18, idnaMapped, "\u793c"},
+	{0xFA19, 0xFA19, idnaMapped, "\u795e"},
+	{0xFA1A, 0xFA1A, idnaMapped, "\u7965"},
+	{0xFA1B, 0xFA1B, idnaMapped, "\u798f"},
+	{0xFA1C, 0xFA1C, idnaMapped, "\u9756"},
+	{0xFA1D, 0xFA1D, idnaMapped, "\u7cbe"},
+	{0xFA1E, 0xFA1E, idnaMapped, "\u7fbd"},
+	{0xFA1F, 0xFA1F, idnaValid, ""},
+	{0xFA20, 0xFA20, idnaMapped, "\u8612"},
+	{0xFA21, 0xFA21, idnaValid, ""},
+	{0xFA22, 0xFA22, idnaMapped, "\u8af8"},
+	{0xFA23, 0xFA24, idnaValid, ""},
+	{0xFA25, 0xFA25, idnaMapped, "\u9038"},
+	{0xFA26, 0xFA26, idnaMapped, "\u90fd"},
+	{0xFA27, 0xFA29, idnaValid, ""},
+	{0xFA2A, 0xFA2A, idnaMapped, "\u98ef"},
+	{0xFA2B, 0xFA2B, idnaMapped, "\u98fc"},
+	{0xFA2C, 0xFA2C, idnaMapped, "\u9928"},
+	{0xFA2D, 0xFA2D, idnaMapped, "\u9db4"},
+	{0xFA2E, 0xFA2E, idnaMapped, "\u90de"},
+	{0xFA2F, 0xFA2F, idnaMapped, "\u96b7"},
+	{0xFA30, 0xFA30, idnaMapped, "\u4fae"},
+	{0xFA31, 0xFA31, idnaMapped, "\u50e7"},
+	{0xFA32, 0xFA32, idnaMapped, "\u514d"},
+	{0xFA33, 0xFA33, idnaMapped, "\u52c9"},
+	{0xFA34, 0xFA34, idnaMapped, "\u52e4"},
+	{0xFA35, 0xFA35, idnaMapped, "\u5351"},
+	{0xFA36, 0xFA36, idnaMapped, "\u559d"},
+	{0xFA37, 0xFA37, idnaMapped, "\u5606"},
+	{0xFA38, 0xFA38, idnaMapped, "\u5668"},
+	{0xFA39, 0xFA39, idnaMapped, "\u5840"},
+	{0xFA3A, 0xFA3A, idnaMapped, "\u58a8"},
+	{0xFA3B, 0xFA3B, idnaMapped, "\u5c64"},
+	{0xFA3C, 0xFA3C, idnaMapped, "\u5c6e"},
+	{0xFA3D, 0xFA3D, idnaMapped, "\u6094"},
+	{0xFA3E, 0xFA3E, idnaMapped, "\u6168"},
+	{0xFA3F, 0xFA3F, idnaMapped, "\u618e"},
+	{0xFA40, 0xFA40, idnaMapped, "\u61f2"},
+	{0xFA41, 0xFA41, idnaMapped, "\u654f"},
+	{0xFA42, 0xFA42, idnaMapped, "\u65e2"},
+	{0xFA43, 0xFA43, idnaMapped, "\u6691"},
+	{0xFA44, 0xFA44, idnaMapped, "\u6885"},
+	{0xFA45, 0xFA45, idnaMapped, "\u6d77"},
+	{0xFA46, 0xFA46, idnaMapped, "\u6e1a"},
+	{0xFA47, 0xFA47, idnaMapped, "\u6f22"},
+	{0xFA48, 0xFA48, idnaMapped, "\u716e"},
+	{0xFA49, 0xFA49, idnaMapped, "\u722b"},
+	{0xFA4A, 0xFA4A, idnaMapped, "\u7422"},
+	{0xFA4B, 0xFA4B, idnaMapped, "\u7891"},
+	{0xFA4C, 0xFA4C, idnaMapped, "\u793e"},
+	{0xFA4D, 0xFA4D, idnaMapped, "\u7949"},
+	{0xFA4E, 0xFA4E, idnaMapped, "\u7948"},
+	{0xFA4F, 0xFA4F, idnaMapped, "\u7950"},
+	{0xFA50, 0xFA50, idnaMapped, "\u7956"},
+	{0xFA51, 0xFA51, idnaMapped, "\u795d"},
+	{0xFA52, 0xFA52, idnaMapped, "\u798d"},
+	{0xFA53, 0xFA53, idnaMapped, "\u798e"},
+	{0xFA54, 0xFA54, idnaMapped, "\u7a40"},
+	{0xFA55, 0xFA55, idnaMapped, "\u7a81"},
+	{0xFA56, 0xFA56, idnaMapped, "\u7bc0"},
+	{0xFA57, 0xFA57, idnaMapped, "\u7df4"},
+	{0xFA58, 0xFA58, idnaMapped, "\u7e09"},
+	{0xFA59, 0xFA59, idnaMapped, "\u7e41"},
+	{0xFA5A, 0xFA5A, idnaMapped, "\u7f72"},
+	{0xFA5B, 0xFA5B, idnaMapped, "\u8005"},
+	{0xFA5C, 0xFA5C, idnaMapped, "\u81ed"},
+	{0xFA5D, 0xFA5D, idnaMapped, "\u8279"},
+	{0xFA5E, 0xFA5E, idnaMapped, "\u8279"},
+	{0xFA5F, 0xFA5F, idnaMapped, "\u8457"},
+	{0xFA60, 0xFA60, idnaMapped, "\u8910"},
+	{0xFA61, 0xFA61, idnaMapped, "\u8996"},
+	{0xFA62, 0xFA62, idnaMapped, "\u8b01"},
+	{0xFA63, 0xFA63, idnaMapped, "\u8b39"},
+	{0xFA64, 0xFA64, idnaMapped, "\u8cd3"},
+	{0xFA65, 0xFA65, idnaMapped, "\u8d08"},
+	{0xFA66, 0xFA66, idnaMapped, "\u8fb6"},
+	{0xFA67, 0xFA67, idnaMapped, "\u9038"},
+	{0xFA68, 0xFA68, idnaMapped, "\u96e3"},
+	{0xFA69, 0xFA69, idnaMapped, "\u97ff"},
+	{0xFA6A, 0xFA6A, idnaMapped, "\u983b"},
+	{0xFA6B, 0xFA6B, idnaMapped, "\u6075"},
+	{0xFA6C, 0xFA6C, idnaMapped, "\U000242ee"},
+	{0xFA6D, 0xFA6D, idnaMapped, "\u8218"},
+	{0xFA6E, 0xFA6F, idnaDisallowed, ""},
+	{0xFA70, 0xFA70, idnaMapped, "\u4e26"},
+	{0xFA71, 0xFA71, idnaMapped, "\u51b5"},
+	{0xFA72, 0xFA72, idnaMapped, "\u5168"},
+	{0xFA73, 0xFA73, idnaMapped, "\u4f80"},
+	{0xFA74, 0xFA74, idnaMapped, "\u5145"},
+	{0xFA75, 0xFA75, idnaMapped, "\u5180"},
+	{0xFA76, 0xFA76, idnaMapped, "\u52c7"},
+	{0xFA77, 0xFA77, idnaMapped, "\u52fa"},
+	{0xFA78, 0xFA78, idnaMapped, "\u559d"},
+	{0xFA79, 0xFA79, idnaMapped, "\u5555"},
+	{0xFA7A, 0xFA7A, idnaMapped, "\u5599"},
+	{0xFA7B, 0xFA7B, idnaMapped, "\u55e2"},
+	{0xFA7C, 0xFA7C, idnaMapped, "\u585a"},
+	{0xFA7D, 0xFA7D, idnaMapped, "\u58b3"},
+	{0xFA7E, 0xFA7E, idnaMapped, "\u5944"},
+	{0xFA7F, 0xFA7F, idnaMapped, "\u5954"},
+	{0xFA80, 0xFA80, idnaMapped, "\u5a62"},
+	{0xFA81, 0xFA81, idnaMapped, "\u5b28"},
+	{0xFA82, 0xFA82, idnaMapped, "\u5ed2"},
+	{0xFA83, 0xFA83, idnaMapped, "\u5ed9"},
+	{0xFA84, 0xFA84, idnaMapped, "\u5f69"},
+	{0xFA85, 0xFA85, idnaMapped, "\u5fad"},
+	{0xFA86, 0xFA86, idnaMapped, "\u60d8"},
+	{0xFA87, 0xFA87, idnaMapped, "\u614e"},
+	{0xFA88, 0xFA88, idnaMapped, "\u6108"},
+	{0xFA89, 0xFA89, idnaMapped, "\u618e"},
+	{0xFA8A, 0xFA8A, idnaMapped, "\u6160"},
+	{0xFA8B, 0xFA8B, idnaMapped, "\u61f2"},
+	{0xFA8C, 0xFA8C, idnaMapped, "\u6234"},
+	{0xFA8D, 0xFA8D, idnaMapped, "\u63c4"},
+	{0xFA8E, 0xFA8E, idnaMapped, "\u641c"},
+	{0xFA8F, 0xFA8F, idnaMapped, "\u6452"},
+	{0xFA90, 0xFA90, idnaMapped, "\u6556"},
+	{0xFA91, 0xFA91, idnaMapped, "\u6674"},
+	{0xFA92, 0xFA92, idnaMapped, "\u6717"},
+	{0xFA93, 0xFA93, idnaMapped, "\u671b"},
+	{0xFA94, 0xFA94, idnaMapped, "\u6756"},
+	{0xFA95, 0xFA95, idnaMapped, "\u6b79"},
+	{0xFA96, 0xFA96, idnaMapped, "\u6bba"},
+	{0xFA97, 0xFA97, idnaMapped, "\u6d41"},
+	{0xFA98, 0xFA98, idnaMapped, "\u6edb"},
+	{0xFA99, 0xFA99, idnaMapped, "\u6ecb"},
+	{0xFA9A, 0xFA9A, idnaMapped, "\u6f22"},
+	{0xFA9B, 0xFA9B, idnaMapped, "\u701e"},
+	{0xFA9C, 0xFA9C, idnaMapped, "\u716e"},
+	{0xFA9D, 0xFA9D, idnaMapped, "\u77a7"},
+	{0xFA9E, 0xFA9E, idnaMapped, "\u7235"},
+	{0xFA9F, 0xFA9F, idnaMapped, "\u72af"},
+	{0xFAA0, 0xFAA0, idnaMapped, "\u732a"},
+	{0xFAA1, 0xFAA1, idnaMapped, "\u7471"},
+	{0xFAA2, 0xFAA2, idnaMapped, "\u7506"},
+	{0xFAA3, 0xFAA3, idnaMapped, "\u753b"},
+	{0xFAA4, 0xFAA4, idnaMapped, "\u761d"},
+	{0xFAA5, 0xFAA5, idnaMapped, "\u761f"},
+	{0xFAA6, 0xFAA6, idnaMapped, "\u76ca"},
+	{0xFAA7, 0xFAA7, idnaMapped, "\u76db"},
+	{0xFAA8, 0xFAA8, idnaMapped, "\u76f4"},
+	{0xFAA9, 0xFAA9, idnaMapped, "\u774a"},
+	{0xFAAA, 0xFAAA, idnaMapped, "\u7740"},
+	{0xFAAB, 0xFAAB, idnaMapped, "\u78cc"},
+	{0xFAAC, 0xFAAC, idnaMapped, "\u7ab1"},
+	{0xFAAD, 0xFAAD, idnaMapped, "\u7bc0"},
+	{0xFAAE, 0xFAAE, idnaMapped, "\u7c7b"},
+	{0xFAAF, 0xFAAF, idnaMapped, "\u7d5b"},
+	{0xFAB0, 0xFAB0, idnaMapped, "\u7df4"},
+	{0xFAB1, 0xFAB1, idnaMapped, "\u7f3e"},
+	{0xFAB2, 0xFAB2, idnaMapped, "\u8005"},
+	{0xFAB3, 0xFAB3, idnaMapped, "\u8352"},
+	{0xFAB4, 0xFAB4, idnaMapped, "\u83ef"},
+	{0xFAB5, 0xFAB5, idnaMapped, "\u8779"},
+	{0xFAB6, 0xFAB6, idnaMapped, "\u8941"},
+	{0xFAB7, 0xFAB7, idnaMapped, "\u8986"},
+	{0xFAB8, 0xFAB8, idnaMapped, "\u8996"},
+	{0xFAB9, 0xFAB9, idnaMapped, "\u8abf"},
+	{0xFABA, 0xFABA, idnaMapped, "\u8af8"},
+	{0xFABB, 0xFABB, idnaMapped, "\u8acb"},
+	{0xFABC, 0xFABC, idnaMapped, "\u8b01"},
+	{0xFABD, 0xFABD, idnaMapped, "\u8afe"},
+	{0xFABE, 0xFABE, idnaMapped, "\u8aed"},
+	{0xFABF, 0xFABF, idnaMapped, "\u8b39"},
+	{0xFAC0, 0xFAC0, idnaMapped, "\u8b8a"},
+	{0xFAC1, 0xFAC1, idnaMapped, "\u8d08"},
+	{0xFAC2, 0xFAC2, idnaMapped, "\u8f38"},
+	{0xFAC3, 0xFAC3, idnaMapped, "\u9072"},
+	{0xFAC4, 0xFAC4, idnaMapped, "\u9199"},
+	{0xFAC5, 0xFAC5, idnaMapped, "\u9276"},
+	{0xFAC6, 0xFAC6, idnaMapped, "\u967c"},
+	{0xFAC7, 0xFAC7, idnaMapped, "\u96e3"},
+	{0xFAC8, 0xFAC8, idnaMapped, "\u9756"},
+	{0xFAC9, 0xFAC9, idnaMapped, "\u97db"},
+	{0xFACA, 0xFACA, idnaMapped, "\u97ff"},
+	{0xFACB, 0xFACB, idnaMapped, "\u980b"},
+	{0xFACC, 0xFACC, idnaMapped, "\u983b"},
+	{0xFACD, 0xFACD, idnaMapped, "\u9b12"},
+	{0xFACE, 0xFACE, idnaMapped, "\u9f9c"},
+	{0xFACF, 0xFACF, idnaMapped, "\U0002284a"},
+	{0xFAD0, 0xFAD0, idnaMapped, "\U00022844"},
+	{0xFAD1, 0xFAD1, idnaMapped, "\U000233d5"},
+	{0xFAD2, 0xFAD2, idnaMapped, "\u3b9d"},
+	{0xFAD3, 0xFAD3, idnaMapped, "\u4018"},
+	{0xFAD4, 0xFAD4, idnaMapped, "\u4039"},
+	{0xFAD5, 0xFAD5, idnaMapped, "\U00025249"},
+	{0xFAD6, 0xFAD6, idnaMapped, "\U00025cd0"},
+	{0xFAD7, 0xFAD7, idnaMapped, "\U00027ed3"},
+	{0xFAD8, 0xFAD8, idnaMapped, "\u9f43"},
+	{0xFAD9, 0xFAD9, idnaMapped, "\u9f8e"},
+	{0xFADA, 0xFAFF, idnaDisallowed, ""},
+	{0xFB00, 0xFB00, idnaMapped, "ff"},
+	{0xFB01, 0xFB01, idnaMapped, "fi"},
+	{0xFB02, 0xFB02, idnaMapped, "fl"},
+	{0xFB03, 0xFB03, idnaMapped, "ffi"},
+	{0xFB04, 0xFB04, idnaMapped, "ffl"},
+	{0xFB05, 0xFB05, idnaMapped, "st"},
+	{0xFB06, 0xFB06, idnaMapped, "st"},
+	{0xFB07, 0xFB12, idnaDisallowed, ""},
+	{0xFB13, 0xFB13, idnaMapped, "\u0574\u0576"},
+	{0xFB14, 0xFB14, idnaMapped, "\u0574\u0565"},
+	{0xFB15, 0xFB15, idnaMapped, "\u0574\u056b"},
+	{0xFB16, 0xFB16, idnaMapped, "\u057e\u0576"},
+	{0xFB17, 0xFB17, idnaMapped, "\u0574\u056d"},
+	{0xFB18, 0xFB1C, idnaDisallowed, ""},
+	{0xFB1D, 0xFB1D, idnaMapped, "\u05d9\u05b4"},
+	{0xFB1E, 0xFB1E, idnaValid, ""},
+	{0xFB1F, 0xFB1F, idnaMapped, "\u05f2\u05b7"},
+	{0xFB20, 0xFB20, idnaMapped, "\u05e2"},
+	{0xFB21, 0xFB21, idnaMapped, "\u05d0"},
+	{0xFB22, 0xFB22, idnaMapped, "\u05d3"},
+	{0xFB23, 0xFB23, idnaMapped, "\u05d4"},
+	{0xFB24, 0xFB24, idnaMapped, "\u05db"},
+	{0xFB25, 0xFB25, idnaMapped, "\u05dc"},
+	{0xFB26, 0xFB26, idnaMapped, "\u05dd"},
+	{0xFB27, 0xFB27, idnaMapped, "\u05e8"},
+	{0xFB28, 0xFB28, idnaMapped, "\u05ea"},
+	{0xFB29, 0xFB29, idnaMapped, "+"},
+	{0xFB2A, 0xFB2A, idnaMapped, "\u05e9\u05c1"},
+	{0xFB2B, 0xFB2B, idnaMapped, "\u05e9\u05c2"},
+	{0xFB2C, 0xFB2C, idnaMapped, "\u05e9\u05bc\u05c1"},
+	{0xFB2D, 0xFB2D, idnaMapped, "\u05e9\u05bc\u05c2"},
+	{0xFB2E, 0xFB2E, idnaMapped, "\u05d0\u05b7"},
+	{0xFB2F, 0xFB2F, idnaMapped, "\u05d0\u05b8"},
+	{0xFB30, 0xFB30, idnaMapped, "\u05d0\u05bc"},
+	{0xFB31, 0xFB31, idnaMapped, "\u05d1\u05bc"},
+	{0xFB32, 0xFB32, idnaMapped, "\u05d2\u05bc"},
+	{0xFB33, 0xFB33, idnaMapped, "\u05d3\u05bc"},
+	{0xFB34, 0xFB34, idnaMapped, "\u05d4\u05bc"},
+	{0xFB35, 0xFB35, idnaMapped, "\u05d5\u05bc"},
+	{0xFB36, 0xFB36, idnaMapped, "\u05d6\u05bc"},
+	{0xFB37, 0xFB37, idnaDisallowed, ""},
+	{0xFB38, 0xFB38, idnaMapped, "\u05d8\u05bc"},
+	{0xFB39, 0xFB39, idnaMapped, "\u05d9\u05bc"},
+	{0xFB3A, 0xFB3A, idnaMapped, "\u05da\u05bc"},
+	{0xFB3B, 0xFB3B, idnaMapped, "\u05db\u05bc"},
+	{0xFB3C, 0xFB3C, idnaMapped, "\u05dc\u05bc"},
+	{0xFB3D, 0xFB3D, idnaDisallowed, ""},
+	{0xFB3E, 0xFB3E, idnaMapped, "\u05de\u05bc"},
+	{0xFB3F, 0xFB3F, idnaDisallowed, ""},
+	{0xFB40, 0xFB40, idnaMapped, "\u05e0\u05bc"},
+	{0xFB41, 0xFB41, idnaMapped, "\u05e1\u05bc"},
+	{0xFB42, 0xFB42, idnaDisallowed, ""},
+	{0xFB43, 0xFB43, idnaMapped, "\u05e3\u05bc"},
+	{0xFB44, 0xFB44, idnaMapped, "\u05e4\u05bc"},
+	{0xFB45, 0xFB45, idnaDisallowed, ""},
+	{0xFB46, 0xFB46, idnaMapped, "\u05e6\u05bc"},
+	{0xFB47, 0xFB47, idnaMapped, "\u05e7\u05bc"},
+	{0xFB48, 0xFB48, idnaMapped, "\u05e8\u05bc"},
+	{0xFB49, 0xFB49, idnaMapped, "\u05e9\u05bc"},
+	{0xFB4A, 0xFB4A, idnaMapped, "\u05ea\u05bc"},
+	{0xFB4B, 0xFB4B, idnaMapped, "\u05d5\u05b9"},
+	{0xFB4C, 0xFB4C, idnaMapped, "\u05d1\u05bf"},
+	{0xFB4D, 0xFB4D, idnaMapped, "\u05db\u05bf"},
+	{0xFB4E, 0xFB4E, idnaMapped, "\u05e4\u05bf"},
+	{0xFB4F, 0xFB4F, idnaMapped, "\u05d0\u05dc"},
+	{0xFB50, 0xFB50, idnaMapped, "\u0671"},
+	{0xFB51, 0xFB51, idnaMapped, "\u0671"},
+	{0xFB52, 0xFB52, idnaMapped, "\u067b"},
+	{0xFB53, 0xFB53, idnaMapped, "\u067b"},
+	{0xFB54, 0xFB54, idnaMapped, "\u067b"},
+	{0xFB55, 0xFB55, idnaMapped, "\u067b"},
+	{0xFB56, 0xFB56, idnaMapped, "\u067e"},
+	{0xFB57, 0xFB57, idnaMapped, "\u067e"},
+	{0xFB58, 0xFB58, idnaMapped, "\u067e"},
+	{0xFB59, 0xFB59, idnaMapped, "\u067e"},
+	{0xFB5A, 0xFB5A, idnaMapped, "\u0680"},
+	{0xFB5B, 0xFB5B, idnaMapped, "\u0680"},
+	{0xFB5C, 0xFB5C, idnaMapped, "\u0680"},
+	{0xFB5D, 0xFB5D, idnaMapped, "\u0680"},
+	{0xFB5E, 0xFB5E, idnaMapped, "\u067a"},
+	{0xFB5F, 0xFB5F, idnaMapped, "\u067a"},
+	{0xFB60, 0xFB60, idnaMapped, "\u067a"},
+	{0xFB61, 0xFB61, idnaMapped, "\u067a"},
+	{0xFB62, 0xFB62, idnaMapped, "\u067f"},
+	{0xFB63, 0xFB63, idnaMapped, "\u067f"},
+	{0xFB64, 0xFB64, idnaMapped, "\u067f"},
+	{0xFB65, 0xFB65, idnaMapped, "\u067f"},
+	{0xFB66, 0xFB66, idnaMapped, "\u0679"},
+	{0xFB67, 0xFB67, idnaMapped, "\u0679"},
+	{0xFB68, 0xFB68, idnaMapped, "\u0679"},
+	{0xFB69, 0xFB69, idnaMapped, "\u0679"},
+	{0xFB6A, 0xFB6A, idnaMapped, "\u06a4"},
+	{0xFB6B, 0xFB6B, idnaMapped, "\u06a4"},
+	{0xFB6C, 0xFB6C, idnaMapped, "\u06a4"},
+	{0xFB6D, 0xFB6D, idnaMapped, "\u06a4"},
+	{0xFB6E, 0xFB6E, idnaMapped, "\u06a6"},
+	{0xFB6F, 0xFB6F, idnaMapped, "\u06a6"},
+	{0xFB70, 0xFB70, idnaMapped, "\u06a6"},
+	{0xFB71, 0xFB71, idnaMapped, "\u06a6"},
+	{0xFB72, 0xFB72, idnaMapped, "\u0684"},
+	{0xFB73, 0xFB73, idnaMapped, "\u0684"},
+	{0xFB74, 0xFB74, idnaMapped, "\u0684"},
+	{0xFB75, 0xFB75, idnaMapped, "\u0684"},
+	{0xFB76, 0xFB76, idnaMapped, "\u0683"},
+	{0xFB77, 0xFB77, idnaMapped, "\u0683"},
+	{0xFB78, 0xFB78, idnaMapped, "\u0683"},
+	{0xFB79, 0xFB79, idnaMapped, "\u0683"},
+	{0xFB7A, 0xFB7A, idnaMapped, "\u0686"},
+	{0xFB7B, 0xFB7B, idnaMapped, "\u0686"},
+	{0xFB7C, 0xFB7C, idnaMapped, "\u0686"},
+	{0xFB7D, 0xFB7D, idnaMapped, "\u0686"},
+	{0xFB7E, 0xFB7E, idnaMapped, "\u0687"},
+	{0xFB7F, 0xFB7F, idnaMapped, "\u0687"},
+	{0xFB80, 0xFB80, idnaMapped, "\u0687"},
+	{0xFB81, 0xFB81, idnaMapped, "\u0687"},
+	{0xFB82, 0xFB82, idnaMapped, "\u068d"},
+	{0xFB83, 0xFB83, idnaMapped, "\u068d"},
+	{0xFB84, 0xFB84, idnaMapped, "\u068c"},
+	{0xFB85, 0xFB85, idnaMapped, "\u068c"},
+	{0xFB86, 0xFB86, idnaMapped, "\u068e"},
+	{0xFB87, 0xFB87, idnaMapped, "\u068e"},
+	{0xFB88, 0xFB88, idnaMapped, "\u0688"},
+	{0xFB89, 0xFB89, idnaMapped, "\u0688"},
+	{0xFB8A, 0xFB8A, idnaMapped, "\u0698"},
+	{0xFB8B, 0xFB8B, idnaMapped, "\u0698"},
+	{0xFB8C, 0xFB8C, idnaMapped, "\u0691"},
+	{0xFB8D, 0xFB8D, idnaMapped, "\u0691"},
+	{0xFB8E, 0xFB8E, idnaMapped, "\u06a9"},
+	{0xFB8F, 0xFB8F, idnaMapped, "\u06a9"},
+	{0xFB90, 0xFB90, idnaMapped, "\u06a9"},
+	{0xFB91, 0xFB91, idnaMapped, "\u06a9"},
+	{0xFB92, 0xFB92, idnaMapped, "\u06af"},
+	{0xFB93, 0xFB93, idnaMapped, "\u06af"},
+	{0xFB94, 0xFB94, idnaMapped, "\u06af"},
+	{0xFB95, 0xFB95, idnaMapped, "\u06af"},
+	{0xFB96, 0xFB96, idnaMapped, "\u06b3"},
+	{0xFB97, 0xFB97, idnaMapped, "\u06b3"},
+	{0xFB98, 0xFB98, idnaMapped, "\u06b3"},
+	{0xFB99, 0xFB99, idnaMapped, "\u06b3"},
+	{0xFB9A, 0xFB9A, idnaMapped, "\u06b1"},
+	{0xFB9B, 0xFB9B, idnaMapped, "\u06b1"},
+	{0xFB9C, 0xFB9C, idnaMapped, "\u06b1"},
+	{0xFB9D, 0xFB9D, idnaMapped, "\u06b1"},
+	{0xFB9E, 0xFB9E, idnaMapped, "\u06ba"},
+	{0xFB9F, 0xFB9F, idnaMapped, "\u06ba"},
+	{0xFBA0, 0xFBA0, idnaMapped, "\u06bb"},
+	{0xFBA1, 0xFBA1, idnaMapped, "\u06bb"},
+	{0xFBA2, 0xFBA2, idnaMapped, "\u06bb"},
+	{0xFBA3, 0xFBA3, idnaMapped, "\u06bb"},
+	{0xFBA4, 0xFBA4, idnaMapped, "\u06c0"},
+	{0xFBA5, 0xFBA5, idnaMapped, "\u06c0"},
+	{0xFBA6, 0xFBA6, idnaMapped, "\u06c1"},
+	{0xFBA7, 0xFBA7, idnaMapped, "\u06c1"},
+	{0xFBA8, 0xFBA8, idnaMapped, "\u06c1"},
+	{0xFBA9, 0xFBA9, idnaMapped, "\u06c1"},
+	{0xFBAA, 0xFBAA, idnaMapped, "\u06be"},
+	{0xFBAB, 0xFBAB, idnaMapped, "\u06be"},
+	{0xFBAC, 0xFBAC, idnaMapped, "\u06be"},
+	{0xFBAD, 0xFBAD, idnaMapped, "\u06be"},
+	{0xFBAE, 0xFBAE, idnaMapped, "\u06d2"},
+	{0xFBAF, 0xFBAF, idnaMapped, "\u06d2"},
+	{0xFBB0, 0xFBB0, idnaMapped, "\u06d3"},
+	{0xFBB1, 0xFBB1, idnaMapped, "\u06d3"},
+	{0xFBB2, 0xFBD2, idnaValid, ""},
+	{0xFBD3, 0xFBD3, idnaMapped, "\u06ad"},
+	{0xFBD4, 0xFBD4, idnaMapped, "\u06ad"},
+	{0xFBD5, 0xFBD5, idnaMapped, "\u06ad"},
+	{0xFBD6, 0xFBD6, idnaMapped, "\u06ad"},
+	{0xFBD7, 0xFBD7, idnaMapped, "\u06c7"},
+	{0xFBD8, 0xFBD8, idnaMapped, "\u06c7"},
+	{0xFBD9, 0xFBD9, idnaMapped, "\u06c6"},
+	{0xFBDA, 0xFBDA, idnaMapped, "\u06c6"},
+	{0xFBDB, 0xFBDB, idnaMapped, "\u06c8"},
+	{0xFBDC, 0xFBDC, idnaMapped, "\u06c8"},
+	{0xFBDD, 0xFBDD, idnaMapped, "\u06c7\u0674"},
+	{0xFBDE, 0xFBDE, idnaMapped, "\u06cb"},
+	{0xFBDF, 0xFBDF, idnaMapped, "\u06cb"},
+	{0xFBE0, 0xFBE0, idnaMapped, "\u06c5"},
+	{0xFBE1, 0xFBE1, idnaMapped, "\u06c5"},
+	{0xFBE2, 0xFBE2, idnaMapped, "\u06c9"},
+	{0xFBE3, 0xFBE3, idnaMapped, "\u06c9"},
+	{0xFBE4, 0xFBE4, idnaMapped, "\u06d0"},
+	{0xFBE5, 0xFBE5, idnaMapped, "\u06d0"},
+	{0xFBE6, 0xFBE6, idnaMapped, "\u06d0"},
+	{0xFBE7, 0xFBE7, idnaMapped, "\u06d0"},
+	{0xFBE8, 0xFBE8, idnaMapped, "\u0649"},
+	{0xFBE9, 0xFBE9, idnaMapped, "\u0649"},
+	{0xFBEA, 0xFBEA, idnaMapped, "\u0626\u0627"},
+	{0xFBEB, 0xFBEB, idnaMapped, "\u0626\u0627"},
+	{0xFBEC, 0xFBEC, idnaMapped, "\u0626\u06d5"},
+	{0xFBED, 0xFBED, idnaMapped, "\u0626\u06d5"},
+	{0xFBEE, 0xFBEE, idnaMapped, "\u0626\u0648"},
+	{0xFBEF, 0xFBEF, idnaMapped, "\u0626\u0648"},
+	{0xFBF0, 0xFBF0, idnaMapped, "\u0626\u06c7"},
+	{0xFBF1, 0xFBF1, idnaMapped, "\u0626\u06c7"},
+	{0xFBF2, 0xFBF2, idnaMapped, "\u0626\u06c6"},
+	{0xFBF3, 0xFBF3, idnaMapped, "\u0626\u06c6"},
+	{0xFBF4, 0xFBF4, idnaMapped, "\u0626\u06c8"},
+	{0xFBF5, 0xFBF5, idnaMapped, "\u0626\u06c8"},
+	{0xFBF6, 0xFBF6, idnaMapped, "\u0626\u06d0"},
+	{0xFBF7, 0xFBF7, idnaMapped, "\u0626\u06d0"},
+	{0xFBF8, 0xFBF8, idnaMapped, "\u0626\u06d0"},
+	{0xFBF9, 0xFBF9, idnaMapped, "\u0626\u0649"},
+	{0xFBFA, 0xFBFA, idnaMapped, "\u0626\u0649"},
+	{0xFBFB, 0xFBFB, idnaMapped, "\u0626\u0649"},
+	{0xFBFC, 0xFBFC, idnaMapped, "\u06cc"},
+	{0xFBFD, 0xFBFD, idnaMapped, "\u06cc"},
+	{0xFBFE, 0xFBFE, idnaMapped, "\u06cc"},
+	{0xFBFF, 0xFBFF, idnaMapped, "\u06cc"},
+	{0xFC00, 0xFC00, idnaMapped, "\u0626\u062c"},
+	{0xFC01, 0xFC01, idnaMapped, "\u0626\u062d"},
+	{0xFC02, 0xFC02, idnaMapped, "\u0626\u0645"},
+	{0xFC03, 0xFC03, idnaMapped, "\u0626\u0649"},
+	{0xFC04, 0xFC04, idnaMapped, "\u0626\u064a"},
+	{0xFC05, 0xFC05, idnaMapped, "\u0628\u062c"},
+	{0xFC06, 0xFC06, idnaMapped, "\u0628\u062d"},
+	{0xFC07, 0xFC07, idnaMapped, "\u0628\u062e"},
+	{0xFC08, 0xFC08, idnaMapped, "\u0628\u0645"},
+	{0xFC09, 0xFC09, idnaMapped, "\u0628\u0649"},
+	{0xFC0A, 0xFC0A, idnaMapped, "\u0628\u064a"},
+	{0xFC0B, 0xFC0B, idnaMapped, "\u062a\u062c"},
+	{0xFC0C, 0xFC0C, idnaMapped, "\u062a\u062d"},
+	{0xFC0D, 0xFC0D, idnaMapped, "\u062a\u062e"},
+	{0xFC0E, 0xFC0E, idnaMapped, "\u062a\u0645"},
+	{0xFC0F, 0xFC0F, idnaMapped, "\u062a\u0649"},
+	{0xFC10, 0xFC10, idnaMapped, "\u062a\u064a"},
+	{0xFC11, 0xFC11, idnaMapped, "\u062b\u062c"},
+	{0xFC12, 0xFC12, idnaMapped, "\u062b\u0645"},
+	{0xFC13, 0xFC13, idnaMapped, "\u062b\u0649"},
+	{0xFC14, 0xFC14, idnaMapped, "\u062b\u064a"},
+	{0xFC15, 0xFC15, idnaMapped, "\u062c\u062d"},
+	{0xFC16, 0xFC16, idnaMapped, "\u062c\u0645"},
+	{0xFC17, 0xFC17, idnaMapped, "\u062d\u062c"},
+	{0xFC18, 0xFC18, idnaMapped, "\u062d\u0645"},
+	{0xFC19, 0xFC19, idnaMapped, "\u062e\u062c"},
+	{0xFC1A, 0xFC1A, idnaMapped, "\u062e\u062d"},
+	{0xFC1B, 0xFC1B, idnaMapped, "\u062e\u0645"},
+	{0xFC1C, 0xFC1C, idnaMapped, "\u0633\u062c"},
+	{0xFC1D, 0xFC1D, idnaMapped, "\u0633\u062d"},
+	{0xFC1E, 0xFC1E, idnaMapped, "\u0633\u062e"},
+	{0xFC1F, 0xFC1F, idnaMapped, "\u0633\u0645"},
+	{0xFC20, 0xFC20, idnaMapped, "\u0635\u062d"},
+	{0xFC21, 0xFC21, idnaMapped, "\u0635\u0645"},
+	{0xFC22, 0xFC22, idnaMapped, "\u0636\u062c"},
+	{0xFC23, 0xFC23, idnaMapped, "\u0636\u062d"},
+	{0xFC24, 0xFC24, idnaMapped, "\u0636\u062e"},
+	{0xFC25, 0xFC25, idnaMapped, "\u0636\u0645"},
+	{0xFC26, 0xFC26, idnaMapped, "\u0637\u062d"},
+	{0xFC27, 0xFC27, idnaMapped, "\u0637\u0645"},
+	{0xFC28, 0xFC28, idnaMapped, "\u0638\u0645"},
+	{0xFC29, 0xFC29, idnaMapped, "\u0639\u062c"},
+	{0xFC2A, 0xFC2A, idnaMapped, "\u0639\u0645"},
+	{0xFC2B, 0xFC2B, idnaMapped, "\u063a\u062c"},
+	{0xFC2C, 0xFC2C, idnaMapped, "\u063a\u0645"},
+	{0xFC2D, 0xFC2D, idnaMapped, "\u0641\u062c"},
+	{0xFC2E, 0xFC2E, idnaMapped, "\u0641\u062d"},
+	{0xFC2F, 0xFC2F, idnaMapped, "\u0641\u062e"},
+	{0xFC30, 0xFC30, idnaMapped, "\u0641\u0645"},
+	{0xFC31, 0xFC31, idnaMapped, "\u0641\u0649"},
+	{0xFC32, 0xFC32, idnaMapped, "\u0641\u064a"},
+	{0xFC33, 0xFC33, idnaMapped, "\u0642\u062d"},
+	{0xFC34, 0xFC34, idnaMapped, "\u0642\u0645"},
+	{0xFC35, 0xFC35, idnaMapped, "\u0642\u0649"},
+	{0xFC36, 0xFC36, idnaMapped, "\u0642\u064a"},
+	{0xFC37, 0xFC37, idnaMapped, "\u0643\u0627"},
+	{0xFC38, 0xFC38, idnaMapped, "\u0643\u062c"},
+	{0xFC39, 0xFC39, idnaMapped, "\u0643\u062d"},
+	{0xFC3A, 0xFC3A, idnaMapped, "\u0643\u062e"},
+	{0xFC3B, 0xFC3B, idnaMapped, "\u0643\u0644"},
+	{0xFC3C, 0xFC3C, idnaMapped, "\u0643\u0645"},
+	{0xFC3D, 0xFC3D, idnaMapped, "\u0643\u0649"},
+	{0xFC3E, 0xFC3E, idnaMapped, "\u0643\u064a"},
+	{0xFC3F, 0xFC3F, idnaMapped, "\u0644\u062c"},
+	{0xFC40, 0xFC40, idnaMapped, "\u0644\u062d"},
+	{0xFC41, 0xFC41, idnaMapped, "\u0644\u062e"},
+	{0xFC42, 0xFC42, idnaMapped, "\u0644\u0645"},
+	{0xFC43, 0xFC43, idnaMapped, "\u0644\u0649"},
+	{0xFC44, 0xFC44, idnaMapped, "\u0644\u064a"},
+	{0xFC45, 0xFC45, idnaMapped, "\u0645\u062c"},
+	{0xFC46, 0xFC46, idnaMapped, "\u0645\u062d"},
+	{0xFC47, 0xFC47, idnaMapped, "\u0645\u062e"},
+	{0xFC48, 0xFC48, idnaMapped, "\u0645\u0645"},
+	{0xFC49, 0xFC49, idnaMapped, "\u0645\u0649"},
+	{0xFC4A, 0xFC4A, idnaMapped, "\u0645\u064a"},
+	{0xFC4B, 0xFC4B, idnaMapped, "\u0646\u062c"},
+	{0xFC4C, 0xFC4C, idnaMapped, "\u0646\u062d"},
+	{0xFC4D, 0xFC4D, idnaMapped, "\u0646\u062e"},
+	{0xFC4E, 0xFC4E, idnaMapped, "\u0646\u0645"},
+	{0xFC4F, 0xFC4F, idnaMapped, "\u0646\u0649"},
+	{0xFC50, 0xFC50, idnaMapped, "\u0646\u064a"},
+	{0xFC51, 0xFC51, idnaMapped, "\u0647\u062c"},
+	{0xFC52, 0xFC52, idnaMapped, "\u0647\u0645"},
+	{0xFC53, 0xFC53, idnaMapped, "\u0647\u0649"},
+	{0xFC54, 0xFC54, idnaMapped, "\u0647\u064a"},
+	{0xFC55, 0xFC55, idnaMapped, "\u064a\u062c"},
+	{0xFC56, 0xFC56, idnaMapped, "\u064a\u062d"},
+	{0xFC57, 0xFC57, idnaMapped, "\u064a\u062e"},
+	{0xFC58, 0xFC58, idnaMapped, "\u064a\u0645"},
+	{0xFC59, 0xFC59, idnaMapped, "\u064a\u0649"},
+	{0xFC5A, 0xFC5A, idnaMapped, "\u064a\u064a"},
+	{0xFC5B, 0xFC5B, idnaMapped, "\u0630\u0670"},
+	{0xFC5C, 0xFC5C, idnaMapped, "\u0631\u0670"},
+	{0xFC5D, 0xFC5D, idnaMapped, "\u0649\u0670"},
+	{0xFC5E, 0xFC5E, idnaMapped, " \u064c\u0651"},
+	{0xFC5F, 0xFC5F, idnaMapped, " \u064d\u0651"},
+	{0xFC60, 0xFC60, idnaMapped, " \u064e\u0651"},
+	{0xFC61, 0xFC61, idnaMapped, " \u064f\u0651"},
+	{0xFC62, 0xFC62, idnaMapped, " \u0650\u0651"},
+	{0xFC63, 0xFC63, idnaMapped, " \u0651\u0670"},
+	{0xFC64, 0xFC64, idnaMapped, "\u0626\u0631"},
+	{0xFC65, 0xFC65, idnaMapped, "\u0626\u0632"},
+	{0xFC66, 0xFC66, idnaMapped, "\u0626\u0645"},
+	{0xFC67, 0xFC67, idnaMapped, "\u0626\u0646"},
+	{0xFC68, 0xFC68, idnaMapped, "\u0626\u0649"},
+	{0xFC69, 0xFC69, idnaMapped, "\u0626\u064a"},
+	{0xFC6A, 0xFC6A, idnaMapped, "\u0628\u0631"},
+	{0xFC6B, 0xFC6B, idnaMapped, "\u0628\u0632"},
+	{0xFC6C, 0xFC6C, idnaMapped, "\u0628\u0645"},
+	{0xFC6D, 0xFC6D, idnaMapped, "\u0628\u0646"},
+	{0xFC6E, 0xFC6E, idnaMapped, "\u0628\u0649"},
+	{0xFC6F, 0xFC6F, idnaMapped, "\u0628\u064a"},
+	{0xFC70, 0xFC70, idnaMapped, "\u062a\u0631"},
+	{0xFC71, 0xFC71, idnaMapped, "\u062a\u0632"},
+	{0xFC72, 0xFC72, idnaMapped, "\u062a\u0645"},
+	{0xFC73, 0xFC73, idnaMapped, "\u062a\u0646"},
+	{0xFC74, 0xFC74, idnaMapped, "\u062a\u0649"},
+	{0xFC75, 0xFC75, idnaMapped, "\u062a\u064a"},
+	{0xFC76, 0xFC76, idnaMapped, "\u062b\u0631"},
+	{0xFC77, 0xFC77, idnaMapped, "\u062b\u0632"},
+	{0xFC78, 0xFC78, idnaMapped, "\u062b\u0645"},
+	{0xFC79, 0xFC79, idnaMapped, "\u062b\u0646"},
+	{0xFC7A, 0xFC7A, idnaMapped, "\u062b\u0649"},
+	{0xFC7B, 0xFC7B, idnaMapped, "\u062b\u064a"},
+	{0xFC7C, 0xFC7C, idnaMapped, "\u0641\u0649"},
+	{0xFC7D, 0xFC7D, idnaMapped, "\u0641\u064a"},
+	{0xFC7E, 0xFC7E, idnaMapped, "\u0642\u0649"},
+	{0xFC7F, 0xFC7F, idnaMapped, "\u0642\u064a"},
+	{0xFC80, 0xFC80, idnaMapped, "\u0643\u0627"},
+	{0xFC81, 0xFC81, idnaMapped, "\u0643\u0644"},
+	{0xFC82, 0xFC82, idnaMapped, "\u0643\u0645"},
+	{0xFC83, 0xFC83, idnaMapped, "\u0643\u0649"},
+	{0xFC84, 0xFC84, idnaMapped, "\u0643\u064a"},
+	{0xFC85, 0xFC85, idnaMapped, "\u0644\u0645"},
+	{0xFC86, 0xFC86, idnaMapped, "\u0644\u0649"},
+	{0xFC87, 0xFC87, idnaMapped, "\u0644\u064a"},
+	{0xFC88, 0xFC88, idnaMapped, "\u0645\u0627"},
+	{0xFC89, 0xFC89, idnaMapped, "\u0645\u0645"},
+	{0xFC8A, 0xFC8A, idnaMapped, "\u0646\u0631"},
+	{0xFC8B, 0xFC8B, idnaMapped, "\u0646\u0632"},
+	{0xFC8C, 0xFC8C, idnaMapped, "\u0646\u0645"},
+	{0xFC8D, 0xFC8D, idnaMapped, "\u0646\u0646"},
+	{0xFC8E, 0xFC8E, idnaMapped, "\u0646\u0649"},
+	{0xFC8F, 0xFC8F, idnaMapped, "\u0646\u064a"},
+	{0xFC90, 0xFC90, idnaMapped, "\u0649\u0670"},
+	{0xFC91, 0xFC91, idnaMapped, "\u064a\u0631"},
+	{0xFC92, 0xFC92, idnaMapped, "\u064a\u0632"},
+	{0xFC93, 0xFC93, idnaMapped, "\u064a\u0645"},
+	{0xFC94, 0xFC94, idnaMapped, "\u064a\u0646"},
+	{0xFC95, 0xFC95, idnaMapped, "\u064a\u0649"},
+	{0xFC96, 0xFC96, idnaMapped, "\u064a\u064a"},
+	{0xFC97, 0xFC97, idnaMapped, "\u0626\u062c"},
+	{0xFC98, 0xFC98, idnaMapped, "\u0626\u062d"},
+	{0xFC99, 0xFC99, idnaMapped, "\u0626\u062e"},
+	{0xFC9A, 0xFC9A, idnaMapped, "\u0626\u0645"},
+	{0xFC9B, 0xFC9B, idnaMapped, "\u0626\u0647"},
+	{0xFC9C, 0xFC9C, idnaMapped, "\u0628\u062c"},
+	{0xFC9D, 0xFC9D, idnaMapped, "\u0628\u062d"},
+	{0xFC9E, 0xFC9E, idnaMapped, "\u0628\u062e"},
+	{0xFC9F, 0xFC9F, idnaMapped, "\u0628\u0645"},
+	{0xFCA0, 0xFCA0, idnaMapped, "\u0628\u0647"},
+	{0xFCA1, 0xFCA1, idnaMapped, "\u062a\u062c"},
+	{0xFCA2, 0xFCA2, idnaMapped, "\u062a\u062d"},
+	{0xFCA3, 0xFCA3, idnaMapped, "\u062a\u062e"},
+	{0xFCA4, 0xFCA4, idnaMapped, "\u062a\u0645"},
+	{0xFCA5, 0xFCA5, idnaMapped, "\u062a\u0647"},
+	{0xFCA6, 0xFCA6, idnaMapped, "\u062b\u0645"},
+	{0xFCA7, 0xFCA7, idnaMapped, "\u062c\u062d"},
+	{0xFCA8, 0xFCA8, idnaMapped, "\u062c\u0645"},
+	{0xFCA9, 0xFCA9, idnaMapped, "\u062d\u062c"},
+	{0xFCAA, 0xFCAA, idnaMapped, "\u062d\u0645"},
+	{0xFCAB, 0xFCAB, idnaMapped, "\u062e\u062c"},
+	{0xFCAC, 0xFCAC, idnaMapped, "\u062e\u0645"},
+	{0xFCAD, 0xFCAD, idnaMapped, "\u0633\u062c"},
+	{0xFCAE, 0xFCAE, idnaMapped, "\u0633\u062d"},
+	{0xFCAF, 0xFCAF, idnaMapped, "\u0633\u062e"},
+	{0xFCB0, 0xFCB0, idnaMapped, "\u0633\u0645"},
+	{0xFCB1, 0xFCB1, idnaMapped, "\u0635\u062d"},
+	{0xFCB2, 0xFCB2, idnaMapped, "\u0635\u062e"},
+	{0xFCB3, 0xFCB3, idnaMapped, "\u0635\u0645"},
+	{0xFCB4, 0xFCB4, idnaMapped, "\u0636\u062c"},
+	{0xFCB5, 0xFCB5, idnaMapped, "\u0636\u062d"},
+	{0xFCB6, 0xFCB6, idnaMapped, "\u0636\u062e"},
+	{0xFCB7, 0xFCB7, idnaMapped, "\u0636\u0645"},
+	{0xFCB8, 0xFCB8, idnaMapped, "\u0637\u062d"},
+	{0xFCB9, 0xFCB9, idnaMapped, "\u0638\u0645"},
+	{0xFCBA, 0xFCBA, idnaMapped, "\u0639\u062c"},
+	{0xFCBB, 0xFCBB, idnaMapped, "\u0639\u0645"},
+	{0xFCBC, 0xFCBC, idnaMapped, "\u063a\u062c"},
+	{0xFCBD, 0xFCBD, idnaMapped, "\u063a\u0645"},
+	{0xFCBE, 0xFCBE, idnaMapped, "\u0641\u062c"},
+	{0xFCBF, 0xFCBF, idnaMapped, "\u0641\u062d"},
+	{0xFCC0, 0xFCC0, idnaMapped, "\u0641\u062e"},
+	{0xFCC1, 0xFCC1, idnaMapped, "\u0641\u0645"},
+	{0xFCC2, 0xFCC2, idnaMapped, "\u0642\u062d"},
+	{0xFCC3, 0xFCC3, idnaMapped, "\u0642\u0645"},
+	{0xFCC4, 0xFCC4, idnaMapped, "\u0643\u062c"},
+	{0xFCC5, 0xFCC5, idnaMapped, "\u0643\u062d"},
+	{0xFCC6, 0xFCC6, idnaMapped, "\u0643\u062e"},
+	{0xFCC7, 0xFCC7, idnaMapped, "\u0643\u0644"},
+	{0xFCC8, 0xFCC8, idnaMapped, "\u0643\u0645"},
+	{0xFCC9, 0xFCC9, idnaMapped, "\u0644\u062c"},
+	{0xFCCA, 0xFCCA, idnaMapped, "\u0644\u062d"},
+	{0xFCCB, 0xFCCB, idnaMapped, "\u0644\u062e"},
+	{0xFCCC, 0xFCCC, idnaMapped, "\u0644\u0645"},
+	{0xFCCD, 0xFCCD, idnaMapped, "\u0644\u0647"},
+	{0xFCCE, 0xFCCE, idnaMapped, "\u0645\u062c"},
+	{0xFCCF, 0xFCCF, idnaMapped, "\u0645\u062d"},
+	{0xFCD0, 0xFCD0, idnaMapped, "\u0645\u062e"},
+	{0xFCD1, 0xFCD1, idnaMapped, "\u0645\u0645"},
+	{0xFCD2, 0xFCD2, idnaMapped, "\u0646\u062c"},
+	{0xFCD3, 0xFCD3, idnaMapped, "\u0646\u062d"},
+	{0xFCD4, 0xFCD4, idnaMapped, "\u0646\u062e"},
+	{0xFCD5, 0xFCD5, idnaMapped, "\u0646\u0645"},
+	{0xFCD6, 0xFCD6, idnaMapped, "\u0646\u0647"},
+	{0xFCD7, 0xFCD7, idnaMapped, "\u0647\u062c"},
+	{0xFCD8, 0xFCD8, idnaMapped, "\u0647\u0645"},
+	{0xFCD9, 0xFCD9, idnaMapped, "\u0647\u0670"},
+	{0xFCDA, 0xFCDA, idnaMapped, "\u064a\u062c"},
+	{0xFCDB, 0xFCDB, idnaMapped, "\u064a\u062d"},
+	{0xFCDC, 0xFCDC, idnaMapped, "\u064a\u062e"},
+	{0xFCDD, 0xFCDD, idnaMapped, "\u064a\u0645"},
+	{0xFCDE, 0xFCDE, idnaMapped, "\u064a\u0647"},
+	{0xFCDF, 0xFCDF, idnaMapped, "\u0626\u0645"},
+	{0xFCE0, 0xFCE0, idnaMapped, "\u0626\u0647"},
+	{0xFCE1, 0xFCE1, idnaMapped, "\u0628\u0645"},
+	{0xFCE2, 0xFCE2, idnaMapped, "\u0628\u0647"},
+	{0xFCE3, 0xFCE3, idnaMapped, "\u062a\u0645"},
+	{0xFCE4, 0xFCE4, idnaMapped, "\u062a\u0647"},
+	{0xFCE5, 0xFCE5, idnaMapped, "\u062b\u0645"},
+	{0xFCE6, 0xFCE6, idnaMapped, "\u062b\u0647"},
+	{0xFCE7, 0xFCE7, idnaMapped, "\u0633\u0645"},
+	{0xFCE8, 0xFCE8, idnaMapped, "\u0633\u0647"},
+	{0xFCE9, 0xFCE9, idnaMapped, "\u0634\u0645"},
+	{0xFCEA, 0xFCEA, idnaMapped, "\u0634\u0647"},
+	{0xFCEB, 0xFCEB, idnaMapped, "\u0643\u0644"},
+	{0xFCEC, 0xFCEC, idnaMapped, "\u0643\u0645"},
+	{0xFCED, 0xFCED, idnaMapped, "\u0644\u0645"},
+	{0xFCEE, 0xFCEE, idnaMapped, "\u0646\u0645"},
+	{0xFCEF, 0xFCEF, idnaMapped, "\u0646\u0647"},
+	{0xFCF0, 0xFCF0, idnaMapped, "\u064a\u0645"},
+	{0xFCF1, 0xFCF1, idnaMapped, "\u064a\u0647"},
+	{0xFCF2, 0xFCF2, idnaMapped, "\u0640\u064e\u0651"},
+	{0xFCF3, 0xFCF3, idnaMapped, "\u0640\u064f\u0651"},
+	{0xFCF4, 0xFCF4, idnaMapped, "\u0640\u0650\u0651"},
+	{0xFCF5, 0xFCF5, idnaMapped, "\u0637\u0649"},
+	{0xFCF6, 0xFCF6, idnaMapped, "\u0637\u064a"},
+	{0xFCF7, 0xFCF7, idnaMapped, "\u0639\u0649"},
+	{0xFCF8, 0xFCF8, idnaMapped, "\u0639\u064a"},
+	{0xFCF9, 0xFCF9, idnaMapped, "\u063a\u0649"},
+	{0xFCFA, 0xFCFA, idnaMapped, "\u063a\u064a"},
+	{0xFCFB, 0xFCFB, idnaMapped, "\u0633\u0649"},
+	{0xFCFC, 0xFCFC, idnaMapped, "\u0633\u064a"},
+	{0xFCFD, 0xFCFD, idnaMapped, "\u0634\u0649"},
+	{0xFCFE, 0xFCFE, idnaMapped, "\u0634\u064a"},
+	{0xFCFF, 0xFCFF, idnaMapped, "\u062d\u0649"},
+	{0xFD00, 0xFD00, idnaMapped, "\u062d\u064a"},
+	{0xFD01, 0xFD01, idnaMapped, "\u062c\u0649"},
+	{0xFD02, 0xFD02, idnaMapped, "\u062c\u064a"},
+	{0xFD03, 0xFD03, idnaMapped, "\u062e\u0649"},
+	{0xFD04, 0xFD04, idnaMapped, "\u062e\u064a"},
+	{0xFD05, 0xFD05, idnaMapped, "\u0635\u0649"},
+	{0xFD06, 0xFD06, idnaMapped, "\u0635\u064a"},
+	{0xFD07, 0xFD07, idnaMapped, "\u0636\u0649"},
+	{0xFD08, 0xFD08, idnaMapped, "\u0636\u064a"},
+	{0xFD09, 0xFD09, idnaMapped, "\u0634\u062c"},
+	{0xFD0A, 0xFD0A, idnaMapped, "\u0634\u062d"},
+	{0xFD0B, 0xFD0B, idnaMapped, "\u0634\u062e"},
+	{0xFD0C, 0xFD0C, idnaMapped, "\u0634\u0645"},
+	{0xFD0D, 0xFD0D, idnaMapped, "\u0634\u0631"},
+	{0xFD0E, 0xFD0E, idnaMapped, "\u0633\u0631"},
+	{0xFD0F, 0xFD0F, idnaMapped, "\u0635\u0631"},
+	{0xFD10, 0xFD10, idnaMapped, "\u0636\u0631"},
+	{0xFD11, 0xFD11, idnaMapped, "\u0637\u0649"},
+	{0xFD12, 0xFD12, idnaMapped, "\u0637\u064a"},
+	{0xFD13, 0xFD13, idnaMapped, "\u0639\u0649"},
+	{0xFD14, 0xFD14, idnaMapped, "\u0639\u064a"},
+	{0xFD15, 0xFD15, idnaMapped, "\u063a\u0649"},
+	{0xFD16, 0xFD16, idnaMapped, "\u063a\u064a"},
+	{0xFD17, 0xFD17, idnaMapped, "\u0633\u0649"},
+	{0xFD18, 0xFD18, idnaMapped, "\u0633\u064a"},
+	{0xFD19, 0xFD19, idnaMapped, "\u0634\u0649"},
+	{0xFD1A, 0xFD1A, idnaMapped, "\u0634\u064a"},
+	{0xFD1B, 0xFD1B, idnaMapped, "\u062d\u0649"},
+	{0xFD1C, 0xFD1C, idnaMapped, "\u062d\u064a"},
+	{0xFD1D, 0xFD1D, idnaMapped, "\u062c\u0649"},
+	{0xFD1E, 0xFD1E, idnaMapped, "\u062c\u064a"},
+	{0xFD1F, 0xFD1F, idnaMapped, "\u062e\u0649"},
+	{0xFD20, 0xFD20, idnaMapped, "\u062e\u064a"},
+	{0xFD21, 0xFD21, idnaMapped, "\u0635\u0649"},
+	{0xFD22, 0xFD22, idnaMapped, "\u0635\u064a"},
+	{0xFD23, 0xFD23, idnaMapped, "\u0636\u0649"},
+	{0xFD24, 0xFD24, idnaMapped, "\u0636\u064a"},
+	{0xFD25, 0xFD25, idnaMapped, "\u0634\u062c"},
+	{0xFD26, 0xFD26, idnaMapped, "\u0634\u062d"},
+	{0xFD27, 0xFD27, idnaMapped, "\u0634\u062e"},
+	{0xFD28, 0xFD28, idnaMapped, "\u0634\u0645"},
+	{0xFD29, 0xFD29, idnaMapped, "\u0634\u0631"},
+	{0xFD2A, 0xFD2A, idnaMapped, "\u0633\u0631"},
+	{0xFD2B, 0xFD2B, idnaMapped, "\u0635\u0631"},
+	{0xFD2C, 0xFD2C, idnaMapped, "\u0636\u0631"},
+	{0xFD2D, 0xFD2D, idnaMapped, "\u0634\u062c"},
+	{0xFD2E, 0xFD2E, idnaMapped, "\u0634\u062d"},
+	{0xFD2F, 0xFD2F, idnaMapped, "\u0634\u062e"},
+	{0xFD30, 0xFD30, idnaMapped, "\u0634\u0645"},
+	{0xFD31, 0xFD31, idnaMapped, "\u0633\u0647"},
+	{0xFD32, 0xFD32, idnaMapped, "\u0634\u0647"},
+	{0xFD33, 0xFD33, idnaMapped, "\u0637\u0645"},
+	{0xFD34, 0xFD34, idnaMapped, "\u0633\u062c"},
+	{0xFD35, 0xFD35, idnaMapped, "\u0633\u062d"},
+	{0xFD36, 0xFD36, idnaMapped, "\u0633\u062e"},
+	{0xFD37, 0xFD37, idnaMapped, "\u0634\u062c"},
+	{0xFD38, 0xFD38, idnaMapped, "\u0634\u062d"},
+	{0xFD39, 0xFD39, idnaMapped, "\u0634\u062e"},
+	{0xFD3A, 0xFD3A, idnaMapped, "\u0637\u0645"},
+	{0xFD3B, 0xFD3B, idnaMapped, "\u0638\u0645"},
+	{0xFD3C, 0xFD3C, idnaMapped, "\u0627\u064b"},
+	{0xFD3D, 0xFD3D, idnaMapped, "\u0627\u064b"},
+	{0xFD3E, 0xFD4F, idnaValid, ""},
+	{0xFD50, 0xFD50, idnaMapped, "\u062a\u062c\u0645"},
+	{0xFD51, 0xFD51, idnaMapped, "\u062a\u062d\u062c"},
+	{0xFD52, 0xFD52, idnaMapped, "\u062a\u062d\u062c"},
+	{0xFD53, 0xFD53, idnaMapped, "\u062a\u062d\u0645"},
+	{0xFD54, 0xFD54, idnaMapped, "\u062a\u062e\u0645"},
+	{0xFD55, 0xFD55, idnaMapped, "\u062a\u0645\u062c"},
+	{0xFD56, 0xFD56, idnaMapped, "\u062a\u0645\u062d"},
+	{0xFD57, 0xFD57, idnaMapped, "\u062a\u0645\u062e"},
+	{0xFD58, 0xFD58, idnaMapped, "\u062c\u0645\u062d"},
+	{0xFD59, 0xFD59, idnaMapped, "\u062c\u0645\u062d"},
+	{0xFD5A, 0xFD5A, idnaMapped, "\u062d\u0645\u064a"},
+	{0xFD5B, 0xFD5B, idnaMapped, "\u062d\u0645\u0649"},
+	{0xFD5C, 0xFD5C, idnaMapped, "\u0633\u062d\u062c"},
+	{0xFD5D, 0xFD5D, idnaMapped, "\u0633\u062c\u062d"},
+	{0xFD5E, 0xFD5E, idnaMapped, "\u0633\u062c\u0649"},
+	{0xFD5F, 0xFD5F, idnaMapped, "\u0633\u0645\u062d"},
+	{0xFD60, 0xFD60, idnaMapped, "\u0633\u0645\u062d"},
+	{0xFD61, 0xFD61, idnaMapped, "\u0633\u0645\u062c"},
+	{0xFD62, 0xFD62, idnaMapped, "\u0633\u0645\u0645"},
+	{0xFD63, 0xFD63, idnaMapped, "\u0633\u0645\u0645"},
+	{0xFD64, 0xFD64, idnaMapped, "\u0635\u062d\u062d"},
+	{0xFD65, 0xFD65, idnaMapped, "\u0635\u062d\u062d"},
+	{0xFD66, 0xFD66, idnaMapped, "\u0635\u0645\u0645"},
+	{0xFD67, 0xFD67, idnaMapped, "\u0634\u062d\u0645"},
+	{0xFD68, 0xFD68, idnaMapped, "\u0634\u062d\u0645"},
+	{0xFD69, 0xFD69, idnaMapped, "\u0634\u062c\u064a"},
+	{0xFD6A, 0xFD6A, idnaMapped, "\u0634\u0645\u062e"},
+	{0xFD6B, 0xFD6B, idnaMapped, "\u0634\u0645\u062e"},
+	{0xFD6C, 0xFD6C, idnaMapped, "\u0634\u0645\u0645"},
+	{0xFD6D, 0xFD6D, idnaMapped, "\u0634\u0645\u0645"},
+	{0xFD6E, 0xFD6E, idnaMapped, "\u0636\u062d\u0649"},
+	{0xFD6F, 0xFD6F, idnaMapped, "\u0636\u062e\u0645"},
+	{0xFD70, 0xFD70, idnaMapped, "\u0636\u062e\u0645"},
+	{0xFD71, 0xFD71, idnaMapped, "\u0637\u0645\u062d"},
+	{0xFD72, 0xFD72, idnaMapped, "\u0637\u0645\u062d"},
+	{0xFD73, 0xFD73, idnaMapped, "\u0637\u0645\u0645"},
+	{0xFD74, 0xFD74, idnaMapped, "\u0637\u0645\u064a"},
+	{0xFD75, 0xFD75, idnaMapped, "\u0639\u062c\u0645"},
+	{0xFD76, 0xFD76, idnaMapped, "\u0639\u0645\u0645"},
+	{0xFD77, 0xFD77, idnaMapped, "\u0639\u0645\u0645"},
+	{0xFD78, 0xFD78, idnaMapped, "\u0639\u0645\u0649"},
+	{0xFD79, 0xFD79, idnaMapped, "\u063a\u0645\u0645"},
+	{0xFD7A, 0xFD7A, idnaMapped, "\u063a\u0645\u064a"},
+	{0xFD7B, 0xFD7B, idnaMapped, "\u063a\u0645\u0649"},
+	{0xFD7C, 0xFD7C, idnaMapped, "\u0641\u062e\u0645"},
+	{0xFD7D, 0xFD7D, idnaMapped, "\u0641\u062e\u0645"},
+	{0xFD7E, 0xFD7E, idnaMapped, "\u0642\u0645\u062d"},
+	{0xFD7F, 0xFD7F, idnaMapped, "\u0642\u0645\u0645"},
+	{0xFD80, 0xFD80, idnaMapped, "\u0644\u062d\u0645"},
+	{0xFD81, 0xFD81, idnaMapped, "\u0644\u062d\u064a"},
+	{0xFD82, 0xFD82, idnaMapped, "\u0644\u062d\u0649"},
+	{0xFD83, 0xFD83, idnaMapped, "\u0644\u062c\u062c"},
+	{0xFD84, 0xFD84, idnaMapped, "\u0644\u062c\u062c"},
+	{0xFD85, 0xFD85, idnaMapped, "\u0644\u062e\u0645"},
+	{0xFD86, 0xFD86, idnaMapped, "\u0644\u062e\u0645"},
+	{0xFD87, 0xFD87, idnaMapped, "\u0644\u0645\u062d"},
+	{0xFD88, 0xFD88, idnaMapped, "\u0644\u0645\u062d"},
+	{0xFD89, 0xFD89, idnaMapped, "\u0645\u062d\u062c"},
+	{0xFD8A, 0xFD8A, idnaMapped, "\u0645\u062d\u0645"},
+	{0xFD8B, 0xFD8B, idnaMapped, "\u0645\u062d\u064a"},
+	{0xFD8C, 0xFD8C, idnaMapped, "\u0645\u062c\u062d"},
+	{0xFD8D, 0xFD8D, idnaMapped, "\u0645\u062c\u0645"},
+	{0xFD8E, 0xFD8E, idnaMapped, "\u0645\u062e\u062c"},
+	{0xFD8F, 0xFD8F, idnaMapped, "\u0645\u062e\u0645"},
+	{0xFD90, 0xFD91, idnaValid, ""},
+	{0xFD92, 0xFD92, idnaMapped, "\u0645\u062c\u062e"},
+	{0xFD93, 0xFD93, idnaMapped, "\u0647\u0645\u062c"},
+	{0xFD94, 0xFD94, idnaMapped, "\u0647\u0645\u0645"},
+	{0xFD95, 0xFD95, idnaMapped, "\u0646\u062d\u0645"},
+	{0xFD96, 0xFD96, idnaMapped, "\u0646\u062d\u0649"},
+	{0xFD97, 0xFD97, idnaMapped, "\u0646\u062c\u0645"},
+	{0xFD98, 0xFD98, idnaMapped, "\u0646\u062c\u0645"},
+	{0xFD99, 0xFD99, idnaMapped, "\u0646\u062c\u0649"},
+	{0xFD9A, 0xFD9A, idnaMapped, "\u0646\u0645\u064a"},
+	{0xFD9B, 0xFD9B, idnaMapped, "\u0646\u0645\u0649"},
+	{0xFD9C, 0xFD9C, idnaMapped, "\u064a\u0645\u0645"},
+	{0xFD9D, 0xFD9D, idnaMapped, "\u064a\u0645\u0645"},
+	{0xFD9E, 0xFD9E, idnaMapped, "\u0628\u062e\u064a"},
+	{0xFD9F, 0xFD9F, idnaMapped, "\u062a\u062c\u064a"},
+	{0xFDA0, 0xFDA0, idnaMapped, "\u062a\u062c\u0649"},
+	{0xFDA1, 0xFDA1, idnaMapped, "\u062a\u062e\u064a"},
+	{0xFDA2, 0xFDA2, idnaMapped, "\u062a\u062e\u0649"},
+	{0xFDA3, 0xFDA3, idnaMapped, "\u062a\u0645\u064a"},
+	{0xFDA4, 0xFDA4, idnaMapped, "\u062a\u0645\u0649"},
+	{0xFDA5, 0xFDA5, idnaMapped, "\u062c\u0645\u064a"},
+	{0xFDA6, 0xFDA6, idnaMapped, "\u062c\u062d\u0649"},
+	{0xFDA7, 0xFDA7, idnaMapped, "\u062c\u0645\u0649"},
+	{0xFDA8, 0xFDA8, idnaMapped, "\u0633\u062e\u0649"},
+	{0xFDA9, 0xFDA9, idnaMapped, "\u0635\u062d\u064a"},
+	{0xFDAA, 0xFDAA, idnaMapped, "\u0634\u062d\u064a"},
+	{0xFDAB, 0xFDAB, idnaMapped, "\u0636\u062d\u064a"},
+	{0xFDAC, 0xFDAC, idnaMapped, "\u0644\u062c\u064a"},
+	{0xFDAD, 0xFDAD, idnaMapped, "\u0644\u0645\u064a"},
+	{0xFDAE, 0xFDAE, idnaMapped, "\u064a\u062d\u064a"},
+	{0xFDAF, 0xFDAF, idnaMapped, "\u064a\u062c\u064a"},
+	{0xFDB0, 0xFDB0, idnaMapped, "\u064a\u0645\u064a"},
+	{0xFDB1, 0xFDB1, idnaMapped, "\u0645\u0645\u064a"},
+	{0xFDB2, 0xFDB2, idnaMapped, "\u0642\u0645\u064a"},
+	{0xFDB3, 0xFDB3, idnaMapped, "\u0646\u062d\u064a"},
+	{0xFDB4, 0xFDB4, idnaMapped, "\u0642\u0645\u062d"},
+	{0xFDB5, 0xFDB5, idnaMapped, "\u0644\u062d\u0645"},
+	{0xFDB6, 0xFDB6, idnaMapped, "\u0639\u0645\u064a"},
+	{0xFDB7, 0xFDB7, idnaMapped, "\u0643\u0645\u064a"},
+	{0xFDB8, 0xFDB8, idnaMapped, "\u0646\u062c\u062d"},
+	{0xFDB9, 0xFDB9, idnaMapped, "\u0645\u062e\u064a"},
+	{0xFDBA, 0xFDBA, idnaMapped, "\u0644\u062c\u0645"},
+	{0xFDBB, 0xFDBB, idnaMapped, "\u0643\u0645\u0645"},
+	{0xFDBC, 0xFDBC, idnaMapped, "\u0644\u062c\u0645"},
+	{0xFDBD, 0xFDBD, idnaMapped, "\u0646\u062c\u062d"},
+	{0xFDBE, 0xFDBE, idnaMapped, "\u062c\u062d\u064a"},
+	{0xFDBF, 0xFDBF, idnaMapped, "\u062d\u062c\u064a"},
+	{0xFDC0, 0xFDC0, idnaMapped, "\u0645\u062c\u064a"},
+	{0xFDC1, 0xFDC1, idnaMapped, "\u0641\u0645\u064a"},
+	{0xFDC2, 0xFDC2, idnaMapped, "\u0628\u062d\u064a"},
+	{0xFDC3, 0xFDC3, idnaMapped, "\u0643\u0645\u0645"},
+	{0xFDC4, 0xFDC4, idnaMapped, "\u0639\u062c\u0645"},
+	{0xFDC5, 0xFDC5, idnaMapped, "\u0635\u0645\u0645"},
+	{0xFDC6, 0xFDC6, idnaMapped, "\u0633\u062e\u064a"},
+	{0xFDC7, 0xFDC7, idnaMapped, "\u0646\u062c\u064a"},
+	{0xFDC8, 0xFDCF, idnaValid, ""},
+	{0xFDD0, 0xFDEF, idnaDisallowed, ""},
+	{0xFDF0, 0xFDF0, idnaMapped, "\u0635\u0644\u06d2"},
+	{0xFDF1, 0xFDF1, idnaMapped, "\u0642\u0644\u06d2"},
+	{0xFDF2, 0xFDF2, idnaMapped, "\u0627\u0644\u0644\u0647"},
+	{0xFDF3, 0xFDF3, idnaMapped, "\u0627\u0643\u0628\u0631"},
+	{0xFDF4, 0xFDF4, idnaMapped, "\u0645\u062d\u0645\u062f"},
+	{0xFDF5, 0xFDF5, idnaMapped, "\u0635\u0644\u0639\u0645"},
+	{0xFDF6, 0xFDF6, idnaMapped, "\u0631\u0633\u0648\u0644"},
+	{0xFDF7, 0xFDF7, idnaMapped, "\u0639\u0644\u064a\u0647"},
+	{0xFDF8, 0xFDF8, idnaMapped, "\u0648\u0633\u0644\u0645"},
+	{0xFDF9, 0xFDF9, idnaMapped, "\u0635\u0644\u0649"},
+	{0xFDFA, 0xFDFA, idnaMapped, "\u0635\u0644\u0649 \u0627\u0644\u0644\u0647 \u0639\u0644\u064a\u0647 \u0648\u0633\u0644\u0645"},
+	{0xFDFB, 0xFDFB, idnaMapped, "\u062c\u0644 \u062c\u0644\u0627\u0644\u0647"},
+	{0xFDFC, 0xFDFC, idnaMapped, "\u0631\u06cc\u0627\u0644"},
+	{0xFDFD, 0xFDFF, idnaValid, ""},
+	{0xFE00, 0xFE0F, idnaIgnored, ""},
+	{0xFE10, 0xFE10, idnaMapped, ","},
+	{0xFE11, 0xFE11, idnaMapped, "\u3001"},
+	{0xFE12, 0xFE12, idnaDisallowed, ""},
+	{0xFE13, 0xFE13, idnaMapped, ":"},
+	{0xFE14, 0xFE14, idnaMapped, ";"},
+	{0xFE15, 0xFE15, idnaMapped, "!"},
+	{0xFE16, 0xFE16, idnaMapped, "?"},
+	{0xFE17, 0xFE17, idnaMapped, "\u3016"},
+	{0xFE18, 0xFE18, idnaMapped, "\u3017"},
+	{0xFE19, 0xFE1F, idnaDisallowed, ""},
+	{0xFE20, 0xFE2F, idnaValid, ""},
+	{0xFE30, 0xFE30, idnaDisallowed, ""},
+	{0xFE31, 0xFE31, idnaMapped, "\u2014"},
+	{0xFE32, 0xFE32, idnaMapped, "\u2013"},
+	{0xFE33, 0xFE33, idnaMapped, "_"},
+	{0xFE34, 0xFE34, idnaMapped, "_"},
+	{0xFE35, 0xFE35, idnaMapped, "("},
+	{0xFE36, 0xFE36, idnaMapped, ")"},
+	{0xFE37, 0xFE37, idnaMapped, "{"},
+	{0xFE38, 0xFE38, idnaMapped, "}"},
+	{0xFE39, 0xFE39, idnaMapped, "\u3014"},
+	{0xFE3A, 0xFE3A, idnaMapped, "\u3015"},
+	{0xFE3B, 0xFE3B, idnaMapped, "\u3010"},
+	{0xFE3C, 0xFE3C, idnaMapped, "\u3011"},
+	{0xFE3D, 0xFE3D, idnaMapped, "\u300a"},
+	{0xFE3E, 0xFE3E, idnaMapped, "\u300b"},
+	{0xFE3F, 0xFE3F, idnaMapped, "\u3008"},
+	{0xFE40, 0xFE40, idnaMapped, "\u3009"},
+	{0xFE41, 0xFE41, idnaMapped, "\u300c"},
+	{0xFE42, 0xFE42, idnaMapped, "\u300d"},
+	{0xFE43, 0xFE43, idnaMapped, "\u300e"},
+	{0xFE44, 0xFE44, idnaMapped, "\u300f"},
+	{0xFE45, 0xFE46, idnaValid, ""},
+	{0xFE47, 0xFE47, idnaMapped, "["},
+	{0xFE48, 0xFE48, idnaMapped, "]"},
+	{0xFE49, 0xFE49, idnaMapped, " \u0305"},
+	{0xFE4A, 0xFE4A, idnaMapped, " \u0305"},
+	{0xFE4B, 0xFE4B, idnaMapped, " \u0305"},
+	{0xFE4C, 0xFE4C, idnaMapped, " \u0305"},
+	{0xFE4D, 0xFE4D, idnaMapped, "_"},
+	{0xFE4E, 0xFE4E, idnaMapped, "_"},
+	{0xFE4F, 0xFE4F, idnaMapped, "_"},
+	{0xFE50, 0xFE50, idnaMapped, ","},
+	{0xFE51, 0xFE51, idnaMapped, "\u3001"},
+	{0xFE52, 0xFE53, idnaDisallowed, ""},
+	{0xFE54, 0xFE54, idnaMapped, ";"},
+	{0xFE55, 0xFE55, idnaMapped, ":"},
+	{0xFE56, 0xFE56, idnaMapped, "?"},
+	{0xFE57, 0xFE57, idnaMapped, "!"},
+	{0xFE58, 0xFE58, idnaMapped, "\u2014"},
+	{0xFE59, 0xFE59, idnaMapped, "("},
+	{0xFE5A, 0xFE5A, idnaMapped, ")"},
+	{0xFE5B, 0xFE5B, idnaMapped, "{"},
+	{0xFE5C, 0xFE5C, idnaMapped, "}"},
+	{0xFE5D, 0xFE5D, idnaMapped, "\u3014"},
+	{0xFE5E, 0xFE5E, idnaMapped, "\u3015"},
+	{0xFE5F, 0xFE5F, idnaMapped, "#"},
+	{0xFE60, 0xFE60, idnaMapped, "&"},
+	{0xFE61, 0xFE61, idnaMapped, "*"},
+	{0xFE62, 0xFE62, idnaMapped, "+"},
+	{0xFE63, 0xFE63, idnaMapped, "-"},
+	{0xFE64, 0xFE64, idnaMapped, "<"},
+	{0xFE65, 0xFE65, idnaMapped, ">"},
+	{0xFE66, 0xFE66, idnaMapped, "="},
+	{0xFE67, 0xFE67, idnaDisallowed, ""},
+	{0xFE68, 0xFE68, idnaMapped, "\\"},
+	{0xFE69, 0xFE69, idnaMapped, "$"},
+	{0xFE6A, 0xFE6A, idnaMapped, "%"},
+	{0xFE6B, 0xFE6B, idnaMapped, "@"},
+	{0xFE6C, 0xFE6F, idnaDisallowed, ""},
+	{0xFE70, 0xFE70, idnaMapped, " \u064b"},
+	{0xFE71, 0xFE71, idnaMapped, "\u0640\u064b"},
+	{0xFE72, 0xFE72, idnaMapped, " \u064c"},
+	{0xFE73, 0xFE73, idnaValid, ""},
+	{0xFE74, 0xFE74, idnaMapped, " \u064d"},
+	{0xFE75, 0xFE75, idnaDisallowed, ""},
+	{0xFE76, 0xFE76, idnaMapped, " \u064e"},
+	{0xFE77, 0xFE77, idnaMapped, "\u0640\u064e"},
+	{0xFE78, 0xFE78, idnaMapped, " \u064f"},
+	{0xFE79, 0xFE79, idnaMapped, "\u0640\u064f"},
+	{0xFE7A, 0xFE7A, idnaMapped, " \u0650"},
+	{0xFE7B, 0xFE7B, idnaMapped, "\u0640\u0650"},
+	{0xFE7C, 0xFE7C, idnaMapped, " \u0651"},
+	{0xFE7D, 0xFE7D, idnaMapped, "\u0640\u0651"},
+	{0xFE7E, 0xFE7E, idnaMapped, " \u0652"},
+	{0xFE7F, 0xFE7F, idnaMapped, "\u0640\u0652"},
+	{0xFE80, 0xFE80, idnaMapped, "\u0621"},
+	{0xFE81, 0xFE81, idnaMapped, "\u0622"},
+	{0xFE82, 0xFE82, idnaMapped, "\u0622"},
+	{0xFE83, 0xFE83, idnaMapped, "\u0623"},
+	{0xFE84, 0xFE84, idnaMapped, "\u0623"},
+	{0xFE85, 0xFE85, idnaMapped, "\u0624"},
+	{0xFE86, 0xFE86, idnaMapped, "\u0624"},
+	{0xFE87, 0xFE87, idnaMapped, "\u0625"},
+	{0xFE88, 0xFE88, idnaMapped, "\u0625"},
+	{0xFE89, 0xFE89, idnaMapped, "\u0626"},
+	{0xFE8A, 0xFE8A, idnaMapped, "\u0626"},
+	{0xFE8B, 0xFE8B, idnaMapped, "\u0626"},
+	{0xFE8C, 0xFE8C, idnaMapped, "\u0626"},
+	{0xFE8D, 0xFE8D, idnaMapped, "\u0627"},
+	{0xFE8E, 0xFE8E, idnaMapped, "\u0627"},
+	{0xFE8F, 0xFE8F, idnaMapped, "\u0628"},
+	{0xFE90, 0xFE90, idnaMapped, "\u0628"},
+	{0xFE91, 0xFE91, idnaMapped, "\u0628"},
+	{0xFE92, 0xFE92, idnaMapped, "\u0628"},
+	{0xFE93, 0xFE93, idnaMapped, "\u0629"},
+	{0xFE94, 0xFE94, idnaMapped, "\u0629"},
+	{0xFE95, 0xFE95, idnaMapped, "\u062a"},
+	{0xFE96, 0xFE96, idnaMapped, "\u062a"},
+	{0xFE97, 0xFE97, idnaMapped, "\u062a"},
+	{0xFE98, 0xFE98, idnaMapped, "\u062a"},
+	{0xFE99, 0xFE99, idnaMapped, "\u062b"},
+	{0xFE9A, 0xFE9A, idnaMapped, "\u062b"},
+	{0xFE9B, 0xFE9B, idnaMapped, "\u062b"},
+	{0xFE9C, 0xFE9C, idnaMapped, "\u062b"},
+	{0xFE9D, 0xFE9D, idnaMapped, "\u062c"},
+	{0xFE9E, 0xFE9E, idnaMapped, "\u062c"},
+	{0xFE9F, 0xFE9F, idnaMapped, "\u062c"},
+	{0xFEA0, 0xFEA0, idnaMapped, "\u062c"},
+	{0xFEA1, 0xFEA1, idnaMapped, "\u062d"},
+	{0xFEA2, 0xFEA2, idnaMapped, "\u062d"},
+	{0xFEA3, 0xFEA3, idnaMapped, "\u062d"},
+	{0xFEA4, 0xFEA4, idnaMapped, "\u062d"},
+	{0xFEA5, 0xFEA5, idnaMapped, "\u062e"},
+	{0xFEA6, 0xFEA6, idnaMapped, "\u062e"},
+	{0xFEA7, 0xFEA7, idnaMapped, "\u062e"},
+	{0xFEA8, 0xFEA8, idnaMapped, "\u062e"},
+	{0xFEA9, 0xFEA9, idnaMapped, "\u062f"},
+	{0xFEAA, 0xFEAA, idnaMapped, "\u062f"},
+	{0xFEAB, 0xFEAB, idnaMapped, "\u0630"},
+	{0xFEAC, 0xFEAC, idnaMapped, "\u0630"},
+	{0xFEAD, 0xFEAD, idnaMapped, "\u0631"},
+	{0xFEAE, 0xFEAE, idnaMapped, "\u0631"},
+	{0xFEAF, 0xFEAF, idnaMapped, "\u0632"},
+	{0xFEB0, 0xFEB0, idnaMapped, "\u0632"},
+	{0xFEB1, 0xFEB1, idnaMapped, "\u0633"},
+	{0xFEB2, 0xFEB2, idnaMapped, "\u0633"},
+	{0xFEB3, 0xFEB3, idnaMapped, "\u0633"},
+	{0xFEB4, 0xFEB4, idnaMapped, "\u0633"},
+	{0xFEB5, 0xFEB5, idnaMapped, "\u0634"},
+	{0xFEB6, 0xFEB6, idnaMapped, "\u0634"},
+	{0xFEB7, 0xFEB7, idnaMapped, "\u0634"},
+	{0xFEB8, 0xFEB8, idnaMapped, "\u0634"},
+	{0xFEB9, 0xFEB9, idnaMapped, "\u0635"},
+	{0xFEBA, 0xFEBA, idnaMapped, "\u0635"},
+	{0xFEBB, 0xFEBB, idnaMapped, "\u0635"},
+	{0xFEBC, 0xFEBC, idnaMapped, "\u0635"},
+	{0xFEBD, 0xFEBD, idnaMapped, "\u0636"},
+	{0xFEBE, 0xFEBE, idnaMapped, "\u0636"},
+	{0xFEBF, 0xFEBF, idnaMapped, "\u0636"},
+	{0xFEC0, 0xFEC0, idnaMapped, "\u0636"},
+	{0xFEC1, 0xFEC1, idnaMapped, "\u0637"},
+	{0xFEC2, 0xFEC2, idnaMapped, "\u0637"},
+	{0xFEC3, 0xFEC3, idnaMapped, "\u0637"},
+	{0xFEC4, 0xFEC4, idnaMapped, "\u0637"},
+	{0xFEC5, 0xFEC5, idnaMapped, "\u0638"},
+	{0xFEC6, 0xFEC6, idnaMapped, "\u0638"},
+	{0xFEC7, 0xFEC7, idnaMapped, "\u0638"},
+	{0xFEC8, 0xFEC8, idnaMapped, "\u0638"},
+	{0xFEC9, 0xFEC9, idnaMapped, "\u0639"},
+	{0xFECA, 0xFECA, idnaMapped, "\u0639"},
+	{0xFECB, 0xFECB, idnaMapped, "\u0639"},
+	{0xFECC, 0xFECC, idnaMapped, "\u0639"},
+	{0xFECD, 0xFECD, idnaMapped, "\u063a"},
+	{0xFECE, 0xFECE, idnaMapped, "\u063a"},
+	{0xFECF, 0xFECF, idnaMapped, "\u063a"},
+	{0xFED0, 0xFED0, idnaMapped, "\u063a"},
+	{0xFED1, 0xFED1, idnaMapped, "\u0641"},
+	{0xFED2, 0xFED2, idnaMapped, "\u0641"},
+	{0xFED3, 0xFED3, idnaMapped, "\u0641"},
+	{0xFED4, 0xFED4, idnaMapped, "\u0641"},
+	{0xFED5, 0xFED5, idnaMapped, "\u0642"},
+	{0xFED6, 0xFED6, idnaMapped, "\u0642"},
+	{0xFED7, 0xFED7, idnaMapped, "\u0642"},
+	{0xFED8, 0xFED8, idnaMapped, "\u0642"},
+	{0xFED9, 0xFED9, idnaMapped, "\u0643"},
+	{0xFEDA, 0xFEDA, idnaMapped, "\u0643"},
+	{0xFEDB, 0xFEDB, idnaMapped, "\u0643"},
+	{0xFEDC, 0xFEDC, idnaMapped, "\u0643"},
+	{0xFEDD, 0xFEDD, idnaMapped, "\u0644"},
+	{0xFEDE, 0xFEDE, idnaMapped, "\u0644"},
+	{0xFEDF, 0xFEDF, idnaMapped, "\u0644"},
+	{0xFEE0, 0xFEE0, idnaMapped, "\u0644"},
+	{0xFEE1, 0xFEE1, idnaMapped, "\u0645"},
+	{0xFEE2, 0xFEE2, idnaMapped, "\u0645"},
+	{0xFEE3, 0xFEE3, idnaMapped, "\u0645"},
+	{0xFEE4, 0xFEE4, idnaMapped, "\u0645"},
+	{0xFEE5, 0xFEE5, idnaMapped, "\u0646"},
+	{0xFEE6, 0xFEE6, idnaMapped, "\u0646"},
+	{0xFEE7, 0xFEE7, idnaMapped, "\u0646"},
+	{0xFEE8, 0xFEE8, idnaMapped, "\u0646"},
+	{0xFEE9, 0xFEE9, idnaMapped, "\u0647"},
+	{0xFEEA, 0xFEEA, idnaMapped, "\u0647"},
+	{0xFEEB, 0xFEEB, idnaMapped, "\u0647"},
+	{0xFEEC, 0xFEEC, idnaMapped, "\u0647"},
+	{0xFEED, 0xFEED, idnaMapped, "\u0648"},
+	{0xFEEE, 0xFEEE, idnaMapped, "\u0648"},
+	{0xFEEF, 0xFEEF, idnaMapped, "\u0649"},
+	{0xFEF0, 0xFEF0, idnaMapped, "\u0649"},
+	{0xFEF1, 0xFEF1, idnaMapped, "\u064a"},
+	{0xFEF2, 0xFEF2, idnaMapped, "\u064a"},
+	{0xFEF3, 0xFEF3, idnaMapped, "\u064a"},
+	{0xFEF4, 0xFEF4, idnaMapped, "\u064a"},
+	{0xFEF5, 0xFEF5, idnaMapped, "\u0644\u0622"},
+	{0xFEF6, 0xFEF6, idnaMapped, "\u0644\u0622"},
+	{0xFEF7, 0xFEF7, idnaMapped, "\u0644\u0623"},
+	{0xFEF8, 0xFEF8, idnaMapped, "\u0644\u0623"},
+	{0xFEF9, 0xFEF9, idnaMapped, "\u0644\u0625"},
+	{0xFEFA, 0xFEFA, idnaMapped, "\u0644\u0625"},
+	{0xFEFB, 0xFEFB, idnaMapped, "\u0644\u0627"},
+	{0xFEFC, 0xFEFC, idnaMapped, "\u0644\u0627"},
+	{0xFEFD, 0xFEFE, idnaDisallowed, ""},
+	{0xFEFF, 0xFEFF, idnaIgnored, ""},
+	{0xFF00, 0xFF00, idnaDisallowed, ""},
+	{0xFF01, 0xFF01, idnaMapped, "!"},
+	{0xFF02, 0xFF02, idnaMapped, "\""},
+	{0xFF03, 0xFF03, idnaMapped, "#"},
+	{0xFF04, 0xFF04, idnaMapped, "$"},
+	{0xFF05, 0xFF05, idnaMapped, "%"},
+	{0xFF06, 0xFF06, idnaMapped, "&"},
+	{0xFF07, 0xFF07, idnaMapped, "'"},
+	{0xFF08, 0xFF08, idnaMapped, "("},
+	{0xFF09, 0xFF09, idnaMapped, ")"},
+	{0xFF0A, 0xFF0A, idnaMapped, "*"},
+	{0xFF0B, 0xFF0B, idnaMapped, "+"},
+	{0xFF0C, 0xFF0C, idnaMapped, ","},
+	{0xFF0D, 0xFF0D, idnaMapped, "-"},
+	{0xFF0E, 0xFF0E, idnaMapped, "."},
+	{0xFF0F, 0xFF0F, idnaMapped, "/"},
+	{0xFF10, 0xFF10, idnaMapped, "0"},
+	{0xFF11, 0xFF11, idnaMapped, "1"},
+	{0xFF12, 0xFF12, idnaMapped, "2"},
+	{0xFF13, 0xFF13, idnaMapped, "3"},
+	{0xFF14, 0xFF14, idnaMapped, "4"},
+	{0xFF15, 0xFF15, idnaMapped, "5"},
+	{0xFF16, 0xFF16, idnaMapped, "6"},
+	{0xFF17, 0xFF17, idnaMapped, "7"},
+	{0xFF18, 0xFF18, idnaMapped, "8"},
+	{0xFF19, 0xFF19, idnaMapped, "9"},
+	{0xFF1A, 0xFF1A, idnaMapped, ":"},
+	{0xFF1B, 0xFF1B, idnaMapped, ";"},
+	{0xFF1C, 0xFF1C, idnaMapped, "<"},
+	{0xFF1D, 0xFF1D, idnaMapped, "="},
+	{0xFF1E, 0xFF1E, idnaMapped, ">"},
+	{0xFF1F, 0xFF1F, idnaMapped, "?"},
+	{0xFF20, 0xFF20, idnaMapped, "@"},
+	{0xFF21, 0xFF21, idnaMapped, "a"},
+	{0xFF22, 0xFF22, idnaMapped, "b"},
+	{0xFF23, 0xFF23, idnaMapped, "c"},
+	{0xFF24, 0xFF24, idnaMapped, "d"},
+	{0xFF25, 0xFF25, idnaMapped, "e"},
+	{0xFF26, 0xFF26, idnaMapped, "f"},
+	{0xFF27, 0xFF27, idnaMapped, "g"},
+	{0xFF28, 0xFF28, idnaMapped, "h"},
+	{0xFF29, 0xFF29, idnaMapped, "i"},
+	{0xFF2A, 0xFF2A, idnaMapped, "j"},
+	{0xFF2B, 0xFF2B, idnaMapped, "k"},
+	{0xFF2C, 0xFF2C, idnaMapped, "l"},
+	{0xFF2D, 0xFF2D, idnaMapped, "m"},
+	{0xFF2E, 0xFF2E, idnaMapped, "n"},
+	{0xFF2F, 0xFF2F, idnaMapped, "o"},
+	{0xFF30, 0xFF30, idnaMapped, "p"},
+	{0xFF31, 0xFF31, idnaMapped, "q"},
+	{0xFF32, 0xFF32, idnaMapped, "r"},
+	{0xFF33, 0xFF33, idnaMapped, "s"},
+	{0xFF34, 0xFF34, idnaMapped, "t"},
+	{0xFF35, 0xFF35, idnaMapped, "u"},
+	{0xFF36, 0xFF36, idnaMapped, "v"},
+	{0xFF37, 0xFF37, idnaMapped, "w"},
+	{0xFF38, 0xFF38, idnaMapped, "x"},
+	{0xFF39, 0xFF39, idnaMapped, "y"},
+	{0xFF3A, 0xFF3A, idnaMapped, "z"},
+	{0xFF3B, 0xFF3B, idnaMapped, "["},
+	{0xFF3C, 0xFF3C, idnaMapped, "\\"},
+	{0xFF3D, 0xFF3D, idnaMapped, "]"},
+	{0xFF3E, 0xFF3E, idnaMapped, "^"},
+	{0xFF3F, 0xFF3F, idnaMapped, "_"},
+	{0xFF40, 0xFF40, idnaMapped, "`"},
+	{0xFF41, 0xFF41, idnaMapped, "a"},
+	{0xFF42, 0xFF42, idnaMapped, "b"},
+	{0xFF43, 0xFF43, idnaMapped, "c"},
+	{0xFF44, 0xFF44, idnaMapped, "d"},
+	{0xFF45, 0xFF45, idnaMapped, "e"},
+	{0xFF46, 0xFF46, idnaMapped, "f"},
+	{0xFF47, 0xFF47, idnaMapped, "g"},
+	{0xFF48, 0xFF48, idnaMapped, "h"},
+	{0xFF49, 0xFF49, idnaMapped, "i"},
+	{0xFF4A, 0xFF4A, idnaMapped, "j"},
+	{0xFF4B, 0xFF4B, idnaMapped, "k"},
+	{0xFF4C, 0xFF4C, idnaMapped, "l"},
+	{0xFF4D, 0xFF4D, idnaMapped, "m"},
+	{0xFF4E, 0xFF4E, idnaMapped, "n"},
+	{0xFF4F, 0xFF4F, idnaMapped, "o"},
+	{0xFF50, 0xFF50, idnaMapped, "p"},
+	{0xFF51, 0xFF51, idnaMapped, "q"},
+	{0xFF52, 0xFF52, idnaMapped, "r"},
+	{0xFF53, 0xFF53, idnaMapped, "s"},
+	{0xFF54, 0xFF54, idnaMapped, "t"},
+	{0xFF55, 0xFF55, idnaMapped, "u"},
+	{0xFF56, 0xFF56, idnaMapped, "v"},
+	{0xFF57, 0xFF57, idnaMapped, "w"},
+	{0xFF58, 0xFF58, idnaMapped, "x"},
+	{0xFF59, 0xFF59, idnaMapped, "y"},
+	{0xFF5A, 0xFF5A, idnaMapped, "z"},
+	{0xFF5B, 0xFF5B, idnaMapped, "{"},
+	{0xFF5C, 0xFF5C, idnaMapped, "|"},
+	{0xFF5D, 0xFF5D, idnaMapped, "}"},
+	{0xFF5E, 0xFF5E, idnaMapped, "~"},
+	{0xFF5F, 0xFF5F, idnaMapped, "\u2985"},
+	{0xFF60, 0xFF60, idnaMapped, "\u2986"},
+	{0xFF61, 0xFF61, idnaMapped, "."},
+	{0xFF62, 0xFF62, idnaMapped, "\u300c"},
+	{0xFF63, 0xFF63, idnaMapped, "\u300d"},
+	{0xFF64, 0xFF64, idnaMapped, "\u3001"},
+	{0xFF65, 0xFF65, idnaMapped, "\u30fb"},
+	{0xFF66, 0xFF66, idnaMapped, "\u30f2"},
+	{0xFF67, 0xFF67, idnaMapped, "\u30a1"},
+	{0xFF68, 0xFF68, idnaMapped, "\u30a3"},
+	{0xFF69, 0xFF69, idnaMapped, "\u30a5"},
+	{0xFF6A, 0xFF6A, idnaMapped, "\u30a7"},
+	{0xFF6B, 0xFF6B, idnaMapped, "\u30a9"},
+	{0xFF6C, 0xFF6C, idnaMapped, "\u30e3"},
+	{0xFF6D, 0xFF6D, idnaMapped, "\u30e5"},
+	{0xFF6E, 0xFF6E, idnaMapped, "\u30e7"},
+	{0xFF6F, 0xFF6F, idnaMapped, "\u30c3"},
+	{0xFF70, 0xFF70, idnaMapped, "\u30fc"},
+	{0xFF71, 0xFF71, idnaMapped, "\u30a2"},
+	{0xFF72, 0xFF72, idnaMapped, "\u30a4"},
+	{0xFF73, 0xFF73, idnaMapped, "\u30a6"},
+	{0xFF74, 0xFF74, idnaMapped, "\u30a8"},
+	{0xFF75, 0xFF75, idnaMapped, "\u30aa"},
+	{0xFF76, 0xFF76, idnaMapped, "\u30ab"},
+	{0xFF77, 0xFF77, idnaMapped, "\u30ad"},
+	{0xFF78, 0xFF78, idnaMapped, "\u30af"},
+	{0xFF79, 0xFF79, idnaMapped, "\u30b1"},
+	{0xFF7A, 0xFF7A, idnaMapped, "\u30b3"},
+	{0xFF7B, 0xFF7B, idnaMapped, "\u30b5"},
+	{0xFF7C, 0xFF7C, idnaMapped, "\u30b7"},
+	{0xFF7D, 0xFF7D, idnaMapped, "\u30b9"},
+	{0xFF7E, 0xFF7E, idnaMapped, "\u30bb"},
+	{0xFF7F, 0xFF7F, idnaMapped, "\u30bd"},
+	{0xFF80, 0xFF80, idnaMapped, "\u30bf"},
+	{0xFF81, 0xFF81, idnaMapped, "\u30c1"},
+	{0xFF82, 0xFF82, idnaMapped, "\u30c4"},
+	{0xFF83, 0xFF83, idnaMapped, "\u30c6"},
+	{0xFF84, 0xFF84, idnaMapped, "\u30c8"},
+	{0xFF85, 0xFF85, idnaMapped, "\u30ca"},
+	{0xFF86, 0xFF86, idnaMapped, "\u30cb"},
+	{0xFF87, 0xFF87, idnaMapped, "\u30cc"},
+	{0xFF88, 0xFF88, idnaMapped, "\u30cd"},
+	{0xFF89, 0xFF89, idnaMapped, "\u30ce"},
+	{0xFF8A, 0xFF8A, idnaMapped, "\u30cf"},
+	{0xFF8B, 0xFF8B, idnaMapped, "\u30d2"},
+	{0xFF8C, 0xFF8C, idnaMapped, "\u30d5"},
+	{0xFF8D, 0xFF8D, idnaMapped, "\u30d8"},
+	{0xFF8E, 0xFF8E, idnaMapped, "\u30db"},
+	{0xFF8F, 0xFF8F, idnaMapped, "\u30de"},
+	{0xFF90, 0xFF90, idnaMapped, "\u30df"},
+	{0xFF91, 0xFF91, idnaMapped, "\u30e0"},
+	{0xFF92, 0xFF92, idnaMapped, "\u30e1"},
+	{0xFF93, 0xFF93, idnaMapped, "\u30e2"},
+	{0xFF94, 0xFF94, idnaMapped, "\u30e4"},
+	{0xFF95, 0xFF95, idnaMapped, "\u30e6"},
+	{0xFF96, 0xFF96, idnaMapped, "\u30e8"},
+	{0xFF97, 0xFF97, idnaMapped, "\u30e9"},
+	{0xFF98, 0xFF98, idnaMapped, "\u30ea"},
+	{0xFF99, 0xFF99, idnaMapped, "\u30eb"},
+	{0xFF9A, 0xFF9A, idnaMapped, "\u30ec"},
+	{0xFF9B, 0xFF9B, idnaMapped, "\u30ed"},
+	{0xFF9C, 0xFF9C, idnaMapped, "\u30ef"},
+	{0xFF9D, 0xFF9D, idnaMapped, "\u30f3"},
+	{0xFF9E, 0xFF9E, idnaMapped, "\u3099"},
+	{0xFF9F, 0xFF9F, idnaMapped, "\u309a"},
+	{0xFFA0, 0xFFA0, idnaIgnored, ""},
+	{0xFFA1, 0xFFA1, idnaMapped, "\u1100"},
+	{0xFFA2, 0xFFA2, idnaMapped, "\u1101"},
+	{0xFFA3, 0xFFA3, idnaMapped, "\u11aa"},
+	{0xFFA4, 0xFFA4, idnaMapped, "\u1102"},
+	{0xFFA5, 0xFFA5, idnaMapped, "\u11ac"},
+	{0xFFA6, 0xFFA6, idnaMapped, "\u11ad"},
+	{0xFFA7, 0xFFA7, idnaMapped, "\u1103"},
+	{0xFFA8, 0xFFA8, idnaMapped, "\u1104"},
+	{0xFFA9, 0xFFA9, idnaMapped, "\u1105"},
+	{0xFFAA, 0xFFAA, idnaMapped, "\u11b0"},
+	{0xFFAB, 0xFFAB, idnaMapped, "\u11b1"},
+	{0xFFAC, 0xFFAC, idnaMapped, "\u11b2"},
+	{0xFFAD, 0xFFAD, idnaMapped, "\u11b3"},
+	{0xFFAE, 0xFFAE, idnaMapped, "\u11b4"},
+	{0xFFAF, 0xFFAF, idnaMapped, "\u11b5"},
+	{0xFFB0, 0xFFB0, idnaMapped, "\u111a"},
+	{0xFFB1, 0xFFB1, idnaMapped, "\u1106"},
+	{0xFFB2, 0xFFB2, idnaMapped, "\u1107"},
+	{0xFFB3, 0xFFB3, idnaMapped, "\u1108"},
+	{0xFFB4, 0xFFB4, idnaMapped, "\u1121"},
+	{0xFFB5, 0xFFB5, idnaMapped, "\u1109"},
+	{0xFFB6, 0xFFB6, idnaMapped, "\u110a"},
+	{0xFFB7, 0xFFB7, idnaMapped, "\u110b"},
+	{0xFFB8, 0xFFB8, idnaMapped, "\u110c"},
+	{0xFFB9, 0xFFB9, idnaMapped, "\u110d"},
+	{0xFFBA, 0xFFBA, idnaMapped, "\u110e"},
+	{0xFFBB, 0xFFBB, idnaMapped, "\u110f"},
+	{0xFFBC, 0xFFBC, idnaMapped, "\u1110"},
+	{0xFFBD, 0xFFBD, idnaMapped, "\u1111"},
+	{0xFFBE, 0xFFBE, idnaMapped, "\u1112"},
+	{0xFFBF, 0xFFC1, idnaDisallowed, ""},
+	{0xFFC2, 0xFFC2, idnaMapped, "\u1161"},
+	{0xFFC3, 0xFFC3, idnaMapped, "\u1162"},
+	{0xFFC4, 0xFFC4, idnaMapped, "\u1163"},
+	{0xFFC5, 0xFFC5, idnaMapped, "\u1164"},
+	{0xFFC6, 0xFFC6, idnaMapped, "\u1165"},
+	{0xFFC7, 0xFFC7, idnaMapped, "\u1166"},
+	{0xFFC8, 0xFFC9, idnaDisallowed, ""},
+	{0xFFCA, 0xFFCA, idnaMapped, "\u1167"},
+	{0xFFCB, 0xFFCB, idnaMapped, "\u1168"},
+	{0xFFCC, 0xFFCC, idnaMapped, "\u1169"},
+	{0xFFCD, 0xFFCD, idnaMapped, "\u116a"},
+	{0xFFCE, 0xFFCE, idnaMapped, "\u116b"},
+	{0xFFCF, 0xFFCF, idnaMapped, "\u116c"},
+	{0xFFD0, 0xFFD1, idnaDisallowed, ""},
+	{0xFFD2, 0xFFD2, idnaMapped, "\u116d"},
+	{0xFFD3, 0xFFD3, idnaMapped, "\u116e"},
+	{0xFFD4, 0xFFD4, idnaMapped, "\u116f"},
+	{0xFFD5, 0xFFD5, idnaMapped, "\u1170"},
+	{0xFFD6, 0xFFD6, idnaMapped, "\u1171"},
+	{0xFFD7, 0xFFD7, idnaMapped, "\u1172"},
+	{0xFFD8, 0xFFD9, idnaDisallowed, ""},
+	{0xFFDA, 0xFFDA, idnaMapped, "\u1173"},
+	{0xFFDB, 0xFFDB, idnaMapped, "\u1174"},
+	{0xFFDC, 0xFFDC, idnaMapped, "\u1175"},
+	{0xFFDD, 0xFFDF, idnaDisallowed, ""},
+	{0xFFE0, 0xFFE0, idnaMapped, "\u00a2"},
+	{0xFFE1, 0xFFE1, idnaMapped, "\u00a3"},
+	{0xFFE2, 0xFFE2, idnaMapped, "\u00ac"},
+	{0xFFE3, 0xFFE3, idnaMapped, " \u0304"},
+	{0xFFE4, 0xFFE4, idnaMapped, "\u00a6"},
+	{0xFFE5, 0xFFE5, idnaMapped, "\u00a5"},
+	{0xFFE6, 0xFFE6, idnaMapped, "\u20a9"},
+	{0xFFE7, 0xFFE7, idnaDisallowed, ""},
+	{0xFFE8, 0xFFE8, idnaMapped, "\u2502"},
+	{0xFFE9, 0xFFE9, idnaMapped, "\u2190"},
+	{0xFFEA, 0xFFEA, idnaMapped, "\u2191"},
+	{0xFFEB, 0xFFEB, idnaMapped, "\u2192"},
+	{0xFFEC, 0xFFEC, idnaMapped, "\u2193"},
+	{0xFFED, 0xFFED, idnaMapped, "\u25a0"},
+	{0xFFEE, 0xFFEE, idnaMapped, "\u25cb"},
+	{0xFFEF, 0xFFFF, idnaDisallowed, ""},
+	{0x10000, 0x1000B, idnaValid, ""},
+	{0x1000C, 0x1000C, idnaDisallowed, ""},
+	{0x1000D, 0x10026, idnaValid, ""},
+	{0x10027, 0x10027, idnaDisallowed, ""},
+	{0x10028, 0x1003A, idnaValid, ""},
+	{0x1003B, 0x1003B, idnaDisallowed, ""},
+	{0x1003C, 0x1003D, idnaValid, ""},
+	{0x1003E, 0x1003E, idnaDisallowed, ""},
+	{0x1003F, 0x1004D, idnaValid, ""},
+	{0x1004E, 0x1004F, idnaDisallowed, ""},
+	{0x10050, 0x1005D, idnaValid, ""},
+	{0x1005E, 0x1007F, idnaDisallowed, ""},
+	{0x10080, 0x100FA, idnaValid, ""},
+	{0x100FB, 0x100FF, idnaDisallowed, ""},
+	{0x10100, 0x10102, idnaValid, ""},
+	{0x10103, 0x10106, idnaDisallowed, ""},
+	{0x10107, 0x10133, idnaValid, ""},
+	{0x10134, 0x10136, idnaDisallowed, ""},
+	{0x10137, 0x1018E, idnaValid, ""},
+	{0x1018F, 0x1018F, idnaDisallowed, ""},
+	{0x10190, 0x1019C, idnaValid, ""},
+	{0x1019D, 0x1019F, idnaDisallowed, ""},
+	{0x101A0, 0x101A0, idnaValid, ""},
+	{0x101A1, 0x101CF, idnaDisallowed, ""},
+	{0x101D0, 0x101FD, idnaValid, ""},
+	{0x101FE, 0x1027F, idnaDisallowed, ""},
+	{0x10280, 0x1029C, idnaValid, ""},
+	{0x1029D, 0x1029F, idnaDisallowed, ""},
+	{0x102A0, 0x102D0, idnaValid, ""},
+	{0x102D1, 0x102DF, idnaDisallowed, ""},
+	{0x102E0, 0x102FB, idnaValid, ""},
+	{0x102FC, 0x102FF, idnaDisallowed, ""},
+	{0x10300, 0x10323, idnaValid, ""},
+	{0x10324, 0x1032C, idnaDisallowed, ""},
+	{0x1032D, 0x1034A, idnaValid, ""},
+	{0x1034B, 0x1034F, idnaDisallowed, ""},
+	{0x10350, 0x1037A, idnaValid, ""},
+	{0x1037B, 0x1037F, idnaDisallowed, ""},
+	{0x10380, 0x1039D, idnaValid, ""},
+	{0x1039E, 0x1039E, idnaDisallowed, ""},
+	{0x1039F, 0x103C3, idnaValid, ""},
+	{0x103C4, 0x103C7, idnaDisallowed, ""},
+	{0x103C8, 0x103D5, idnaValid, ""},
+	{0x103D6, 0x103FF, idnaDisallowed, ""},
+	{0x10400, 0x10400, idnaMapped, "\U00010428"},
+	{0x10401, 0x10401, idnaMapped, "\U00010429"},
+	{0x10402, 0x10402, idnaMapped, "\U0001042a"},
+	{0x10403, 0x10403, idnaMapped, "\U0001042b"},
+	{0x10404, 0x10404, idnaMapped, "\U0001042c"},
+	{0x10405, 0x10405, idnaMapped, "\U0001042d"},
+	{0x10406, 0x10406, idnaMapped, "\U0001042e"},
+	{0x10407, 0x10407, idnaMapped, "\U0001042f"},
+	{0x10408, 0x10408, idnaMapped, "\U00010430"},
+	{0x10409, 0x10409, idnaMapped, "\U00010431"},
+	{0x1040A, 0x1040A, idnaMapped, "\U00010432"},
+	{0x1040B, 0x1040B, idnaMapped, "\U00010433"},
+	{0x1040C, 0x1040C, idnaMapped, "\U00010434"},
+	{0x1040D, 0x1040D, idnaMapped, "\U00010435"},
+	{0x1040E, 0x1040E, idnaMapped, "\U00010436"},
+	{0x1040F, 0x1040F, idnaMapped, "\U00010437"},
+	{0x10410, 0x10410, idnaMapped, "\U00010438"},
+	{0x10411, 0x10411, idnaMapped, "\U00010439"},
+	{0x10412, 0x10412, idnaMapped, "\U0001043a"},
+	{0x10413, 0x10413, idnaMapped, "\U0001043b"},
+	{0x10414, 0x10414, idnaMapped, "\U0001043c"},
+	{0x10415, 0x10415, idnaMapped, "\U0001043d"},
+	{0x10416, 0x10416, idnaMapped, "\U0001043e"},
+	{0x10417, 0x10417, idnaMapped, "\U0001043f"},
+	{0x10418, 0x10418, idnaMapped, "\U00010440"},
+	{0x10419, 0x10419, idnaMapped, "\U00010441"},
+	{0x1041A, 0x1041A, idnaMapped, "\U00010442"},
+	{0x1041B, 0x1041B, idnaMapped, "\U00010443"},
+	{0x1041C, 0x1041C, idnaMapped, "\U00010444"},
+	{0x1041D, 0x1041D, idnaMapped, "\U00010445"},
+	{0x1041E, 0x1041E, idnaMapped, "\U00010446"},
+	{0x1041F, 0x1041F, idnaMapped, "\U00010447"},
+	{0x10420, 0x10420, idnaMapped, "\U00010448"},
+	{0x10421, 0x10421, idnaMapped, "\U00010449"},
+	{0x10422, 0x10422, idnaMapped, "\U0001044a"},
+	{0x10423, 0x10423, idnaMapped, "\U0001044b"},
+	{0x10424, 0x10424, idnaMapped, "\U0001044c"},
+	{0x10425, 0x10425, idnaMapped, "\U0001044d"},
+	{0x10426, 0x10426, idnaMapped, "\U0001044e"},
+	{0x10427, 0x10427, idnaMapped, "\U0001044f"},
+	{0x10428, 0x1049D, idnaValid, ""},
+	{0x1049E, 0x1049F, idnaDisallowed, ""},
+	{0x104A0, 0x104A9, idnaValid, ""},
+	{0x104AA, 0x104AF, idnaDisallowed, ""},
+	{0x104B0, 0x104B0, idnaMapped, "\U000104d8"},
+	{0x104B1, 0x104B1, idnaMapped, "\U000104d9"},
+	{0x104B2, 0x104B2, idnaMapped, "\U000104da"},
+	{0x104B3, 0x104B3, idnaMapped, "\U000104db"},
+	{0x104B4, 0x104B4, idnaMapped, "\U000104dc"},
+	{0x104B5, 0x104B5, idnaMapped, "\U000104dd"},
+	{0x104B6, 0x104B6, idnaMapped, "\U000104de"},
+	{0x104B7, 0x104B7, idnaMapped, "\U000104df"},
+	{0x104B8, 0x104B8, idnaMapped, "\U000104e0"},
+	{0x104B9, 0x104B9, idnaMapped, "\U000104e1"},
+	{0x104BA, 0x104BA, idnaMapped, "\U000104e2"},
+	{0x104BB, 0x104BB, idnaMapped, "\U000104e3"},
+	{0x104BC, 0x104BC, idnaMapped, "\U000104e4"},
+	{0x104BD, 0x104BD, idnaMapped, "\U000104e5"},
+	{0x104BE, 0x104BE, idnaMapped, "\U000104e6"},
+	{0x104BF, 0x104BF, idnaMapped, "\U000104e7"},
+	{0x104C0, 0x104C0, idnaMapped, "\U000104e8"},
+	{0x104C1, 0x104C1, idnaMapped, "\U000104e9"},
+	{0x104C2, 0x104C2, idnaMapped, "\U000104ea"},
+	{0x104C3, 0x104C3, idnaMapped, "\U000104eb"},
+	{0x104C4, 0x104C4, idnaMapped, "\U000104ec"},
+	{0x104C5, 0x104C5, idnaMapped, "\U000104ed"},
+	{0x104C6, 0x104C6, idnaMapped, "\U000104ee"},
+	{0x104C7, 0x104C7, idnaMapped, "\U000104ef"},
+	{0x104C8, 0x104C8, idnaMapped, "\U000104f0"},
+	{0x104C9, 0x104C9, idnaMapped, "\U000104f1"},
+	{0x104CA, 0x104CA, idnaMapped, "\U000104f2"},
+	{0x104CB, 0x104CB, idnaMapped, "\U000104f3"},
+	{0x104CC, 0x104CC, idnaMapped, "\U000104f4"},
+	{0x104CD, 0x104CD, idnaMapped, "\U000104f5"},
+	{0x104CE, 0x104CE, idnaMapped, "\U000104f6"},
+	{0x104CF, 0x104CF, idnaMapped, "\U000104f7"},
+	{0x104D0, 0x104D0, idnaMapped, "\U000104f8"},
+	{0x104D1, 0x104D1, idnaMapped, "\U000104f9"},
+	{0x104D2, 0x104D2, idnaMapped, "\U000104fa"},
+	{0x104D3, 0x104D3, idnaMapped, "\U000104fb"},
+	{0x104D4, 0x104D7, idnaDisallowed, ""},
+	{0x104D8, 0x104FB, idnaValid, ""},
+	{0x104FC, 0x104FF, idnaDisallowed, ""},
+	{0x10500, 0x10527, idnaValid, ""},
+	{0x10528, 0x1052F, idnaDisallowed, ""},
+	{0x10530, 0x10563, idnaValid, ""},
+	{0x10564, 0x1056E, idnaDisallowed, ""},
+	{0x1056F, 0x1056F, idnaValid, ""},
+	{0x10570, 0x10570, idnaMapped, "\U00010597"},
+	{0x10571, 0x10571, idnaMapped, "\U00010598"},
+	{0x10572, 0x10572, idnaMapped, "\U00010599"},
+	{0x10573, 0x10573, idnaMapped, "\U0001059a"},
+	{0x10574, 0x10574, idnaMapped, "\U0001059b"},
+	{0x10575, 0x10575, idnaMapped, "\U0001059c"},
+	{0x10576, 0x10576, idnaMapped, "\U0001059d"},
+	{0x10577, 0x10577, idnaMapped, "\U0001059e"},
+	{0x10578, 0x10578, idnaMapped, "\U0001059f"},
+	{0x10579, 0x10579, idnaMapped, "\U000105a0"},
+	{0x1057A, 0x1057A, idnaMapped, "\U000105a1"},
+	{0x1057B, 0x1057B, idnaDisallowed, ""},
+	{0x1057C, 0x1057C, idnaMapped, "\U000105a3"},
+	{0x1057D, 0x1057D, idnaMapped, "\U000105a4"},
+	{0x1057E, 0x1057E, idnaMapped, "\U000105a5"},
+	{0x1057F, 0x1057F, idnaMapped, "\U000105a6"},
+	{0x10580, 0x10580, idnaMapped, "\U000105a7"},
+	{0x10581, 0x10581, idnaMapped, "\U000105a8"},
+	{0x10582, 0x10582, idnaMapped, "\U000105a9"},
+	{0x10583, 0x10583, idnaMapped, "\U000105aa"},
+	{0x10584, 0x10584, idnaMapped, "\U000105ab"},
+	{0x10585, 0x10585, idnaMapped, "\U000105ac"},
+	{0x10586, 0x10586, idnaMapped, "\U000105ad"},
+	{0x10587, 0x10587, idnaMapped, "\U000105ae"},
+	{0x10588, 0x10588, idnaMapped, "\U000105af"},
+	{0x10589, 0x10589, idnaMapped, "\U000105b0"},
+	{0x1058A, 0x1058A, idnaMapped, "\U000105b1"},
+	{0x1058B, 0x1058B, idnaDisallowed, ""},
+	{0x1058C, 0x1058C, idnaMapped, "\U000105b3"},
+	{0x1058D, 0x1058D, idnaMapped, "\U000105b4"},
+	{0x1058E, 0x1058E, idnaMapped, "\U000105b5"},
+	{0x1058F, 0x1058F, idnaMapped, "\U000105b6"},
+	{0x10590, 0x10590, idnaMapped, "\U000105b7"},
+	{0x10591, 0x10591, idnaMapped, "\U000105b8"},
+	{0x10592, 0x10592, idnaMapped, "\U000105b9"},
+	{0x10593, 0x10593, idnaDisallowed, ""},
+	{0x10594, 0x10594, idnaMapped, "\U000105bb"},
+	{0x10595, 0x10595, idnaMapped, "\U000105bc"},
+	{0x10596, 0x10596, idnaDisallowed, ""},
+	{0x10597, 0x105A1, idnaValid, ""},
+	{0x105A2, 0x105A2, idnaDisallowed, ""},
+	{0x105A3, 0x105B1, idnaValid, ""},
+	{0x105B2, 0x105B2, idnaDisallowed, ""},
+	{0x105B3, 0x105B9, idnaValid, ""},
+	{0x105BA, 0x105BA, idnaDisallowed, ""},
+	{0x105BB, 0x105BC, idnaValid, ""},
+	{0x105BD, 0x105BF, idnaDisallowed, ""},
+	{0x105C0, 0x105F3, idnaValid, ""},
+	{0x105F4, 0x105FF, idnaDisallowed, ""},
+	{0x10600, 0x10736, idnaValid, ""},
+	{0x10737, 0x1073F, idnaDisallowed, ""},
+	{0x10740, 0x10755, idnaValid, ""},
+	{0x10756, 0x1075F, idnaDisallowed, ""},
+	{0x10760, 0x10767, idnaValid, ""},
+	{0x10768, 0x1077F, idnaDisallowed, ""},
+	{0x10780, 0x10780, idnaValid, ""},
+	{0x10781, 0x10781, idnaMapped, "\u02d0"},
+	{0x10782, 0x10782, idnaMapped, "\u02d1"},
+	{0x10783, 0x10783, idnaMapped, "\u00e6"},
+	{0x10784, 0x10784, idnaMapped, "\u0299"},
+	{0x10785, 0x10785, idnaMapped, "\u0253"},
+	{0x10786, 0x10786, idnaDisallowed, ""},
+	{0x10787, 0x10787, idnaMapped, "\u02a3"},
+	{0x10788, 0x10788, idnaMapped, "\uab66"},
+	{0x10789, 0x10789, idnaMapped, "\u02a5"},
+	{0x1078A, 0x1078A, idnaMapped, "\u02a4"},
+	{0x1078B, 0x1078B, idnaMapped, "\u0256"},
+	{0x1078C, 0x1078C, idnaMapped, "\u0257"},
+	{0x1078D, 0x1078D, idnaMapped, "\u1d91"},
+	{0x1078E, 0x1078E, idnaMapped, "\u0258"},
+	{0x1078F, 0x1078F, idnaMapped, "\u025e"},
+	{0x10790, 0x10790, idnaMapped, "\u02a9"},
+	{0x10791, 0x10791, idnaMapped, "\u0264"},
+	{0x10792, 0x10792, idnaMapped, "\u0262"},
+	{0x10793, 0x10793, idnaMapped, "\u0260"},
+	{0x10794, 0x10794, idnaMapped, "\u029b"},
+	{0x10795, 0x10795, idnaMapped, "\u0127"},
+	{0x10796, 0x10796, idnaMapped, "\u029c"},
+	{0x10797, 0x10797, idnaMapped, "\u0267"},
+	{0x10798, 0x10798, idnaMapped, "\u0284"},
+	{0x10799, 0x10799, idnaMapped, "\u02aa"},
+	{0x1079A, 0x1079A, idnaMapped, "\u02ab"},
+	{0x1079B, 0x1079B, idnaMapped, "\u026c"},
+	{0x1079C, 0x1079C, idnaMapped, "\U0001df04"},
+	{0x1079D, 0x1079D, idnaMapped, "\ua78e"},
+	{0x1079E, 0x1079E, idnaMapped, "\u026e"},
+	{0x1079F, 0x1079F, idnaMapped, "\U0001df05"},
+	{0x107A0, 0x107A0, idnaMapped, "\u028e"},
+	{0x107A1, 0x107A1, idnaMapped, "\U0001df06"},
+	{0x107A2, 0x107A2, idnaMapped, "\u00f8"},
+	{0x107A3, 0x107A3, idnaMapped, "\u0276"},
+	{0x107A4, 0x107A4, idnaMapped, "\u0277"},
+	{0x107A5, 0x107A5, idnaMapped, "q"},
+	{0x107A6, 0x107A6, idnaMapped, "\u027a"},
+	{0x107A7, 0x107A7, idnaMapped, "\U0001df08"},
+	{0x107A8, 0x107A8, idnaMapped, "\u027d"},
+	{0x107A9, 0x107A9, idnaMapped, "\u027e"},
+	{0x107AA, 0x107AA, idnaMapped, "\u0280"},
+	{0x107AB, 0x107AB, idnaMapped, "\u02a8"},
+	{0x107AC, 0x107AC, idnaMapped, "\u02a6"},
+	{0x107AD, 0x107AD, idnaMapped, "\uab67"},
+	{0x107AE, 0x107AE, idnaMapped, "\u02a7"},
+	{0x107AF, 0x107AF, idnaMapped, "\u0288"},
+	{0x107B0, 0x107B0, idnaMapped, "\u2c71"},
+	{0x107B1, 0x107B1, idnaDisallowed, ""},
+	{0x107B2, 0x107B2, idnaMapped, "\u028f"},
+	{0x107B3, 0x107B3, idnaMapped, "\u02a1"},
+	{0x107B4, 0x107B4, idnaMapped, "\u02a2"},
+	{0x107B5, 0x107B5, idnaMapped, "\u0298"},
+	{0x107B6, 0x107B6, idnaMapped, "\u01c0"},
+	{0x107B7, 0x107B7, idnaMapped, "\u01c1"},
+	{0x107B8, 0x107B8, idnaMapped, "\u01c2"},
+	{0x107B9, 0x107B9, idnaMapped, "\U0001df0a"},
+	{0x107BA, 0x107BA, idnaMapped, "\U0001df1e"},
+	{0x107BB, 0x107FF, idnaDisallowed, ""},
+	{0x10800, 0x10805, idnaValid, ""},
+	{0x10806, 0x10807, idnaDisallowed, ""},
+	{0x10808, 0x10808, idnaValid, ""},
+	{0x10809, 0x10809, idnaDisallowed, ""},
+	{0x1080A, 0x10835, idnaValid, ""},
+	{0x10836, 0x10836, idnaDisallowed, ""},
+	{0x10837, 0x10838, idnaValid, ""},
+	{0x10839, 0x1083B, idnaDisallowed, ""},
+	{0x1083C, 0x1083C, idnaValid, ""},
+	{0x1083D, 0x1083E, idnaDisallowed, ""},
+	{0x1083F, 0x10855, idnaValid, ""},
+	{0x10856, 0x10856, idnaDisallowed, ""},
+	{0x10857, 0x1089E, idnaValid, ""},
+	{0x1089F, 0x108A6, idnaDisallowed, ""},
+	{0x108A7, 0x108AF, idnaValid, ""},
+	{0x108B0, 0x108DF, idnaDisallowed, ""},
+	{0x108E0, 0x108F2, idnaValid, ""},
+	{0x108F3, 0x108F3, idnaDisallowed, ""},
+	{0x108F4, 0x108F5, idnaValid, ""},
+	{0x108F6, 0x108FA, idnaDisallowed, ""},
+	{0x108FB, 0x1091B, idnaValid, ""},
+	{0x1091C, 0x1091E, idnaDisallowed, ""},
+	{0x1091F, 0x10939, idnaValid, ""},
+	{0x1093A, 0x1093E, idnaDisallowed, ""},
+	{0x1093F, 0x10959, idnaValid, ""},
+	{0x1095A, 0x1097F, idnaDisallowed, ""},
+	{0x10980, 0x109B7, idnaValid, ""},
+	{0x109B8, 0x109BB, idnaDisallowed, ""},
+	{0x109BC, 0x109CF, idnaValid, ""},
+	{0x109D0, 0x109D1, idnaDisallowed, ""},
+	{0x109D2, 0x10A03, idnaValid, ""},
+	{0x10A04, 0x10A04, idnaDisallowed, ""},
+	{0x10A05, 0x10A06, idnaValid, ""},
+	{0x10A07, 0x10A0B, idnaDisallowed, ""},
+	{0x10A0C, 0x10A13, idnaValid, ""},
+	{0x10A14, 0x10A14, idnaDisallowed, ""},
+	{0x10A15, 0x10A17, idnaValid, ""},
+	{0x10A18, 0x10A18, idnaDisallowed, ""},
+	{0x10A19, 0x10A35, idnaValid, ""},
+	{0x10A36, 0x10A37, idnaDisallowed, ""},
+	{0x10A38, 0x10A3A, idnaValid, ""},
+	{0x10A3B, 0x10A3E, idnaDisallowed, ""},
+	{0x10A3F, 0x10A48, idnaValid, ""},
+	{0x10A49, 0x10A4F, idnaDisallowed, ""},
+	{0x10A50, 0x10A58, idnaValid, ""},
+	{0x10A59, 0x10A5F, idnaDisallowed, ""},
+	{0x10A60, 0x10A9F, idnaValid, ""},
+	{0x10AA0, 0x10ABF, idnaDisallowed, ""},
+	{0x10AC0, 0x10AE6, idnaValid, ""},
+	{0x10AE7, 0x10AEA, idnaDisallowed, ""},
+	{0x10AEB, 0x10AF6, idnaValid, ""},
+	{0x10AF7, 0x10AFF, idnaDisallowed, ""},
+	{0x10B00, 0x10B35, idnaValid, ""},
+	{0x10B36, 0x10B38, idnaDisallowed, ""},
+	{0x10B39, 0x10B55, idnaValid, ""},
+	{0x10B56, 0x10B57, idnaDisallowed, ""},
+	{0x10B58, 0x10B72, idnaValid, ""},
+	{0x10B73, 0x10B77, idnaDisallowed, ""},
+	{0x10B78, 0x10B91, idnaValid, ""},
+	{0x10B92, 0x10B98, idnaDisallowed, ""},
+	{0x10B99, 0x10B9C, idnaValid, ""},
+	{0x10B9D, 0x10BA8, idnaDisallowed, ""},
+	{0x10BA9, 0x10BAF, idnaValid, ""},
+	{0x10BB0, 0x10BFF, idnaDisallowed, ""},
+	{0x10C00, 0x10C48, idnaValid, ""},
+	{0x10C49, 0x10C7F, idnaDisallowed, ""},
+	{0x10C80, 0x10C80, idnaMapped, "\U00010cc0"},
+	{0x10C81, 0x10C81, idnaMapped, "\U00010cc1"},
+	{0x10C82, 0x10C82, idnaMapped, "\U00010cc2"},
+	{0x10C83, 0x10C83, idnaMapped, "\U00010cc3"},
+	{0x10C84, 0x10C84, idnaMapped, "\U00010cc4"},
+	{0x10C85, 0x10C85, idnaMapped, "\U00010cc5"},
+	{0x10C86, 0x10C86, idnaMapped, "\U00010cc6"},
+	{0x10C87, 0x10C87, idnaMapped, "\U00010cc7"},
+	{0x10C88, 0x10C88, idnaMapped, "\U00010cc8"},
+	{0x10C89, 0x10C89, idnaMapped, "\U00010cc9"},
+	{0x10C8A, 0x10C8A, idnaMapped, "\U00010cca"},
+	{0x10C8B, 0x10C8B, idnaMapped, "\U00010ccb"},
+	{0x10C8C, 0x10C8C, idnaMapped, "\U00010ccc"},
+	{0x10C8D, 0x10C8D, idnaMapped, "\U00010ccd"},
+	{0x10C8E, 0x10C8E, idnaMapped, "\U00010cce"},
+	{0x10C8F, 0x10C8F, idnaMapped, "\U00010ccf"},
+	{0x10C90, 0x10C90, idnaMapped, "\U00010cd0"},
+	{0x10C91, 0x10C91, idnaMapped, "\U00010cd1"},
+	{0x10C92, 0x10C92, idnaMapped, "\U00010cd2"},
+	{0x10C93, 0x10C93, idnaMapped, "\U00010cd3"},
+	{0x10C94, 0x10C94, idnaMapped, "\U00010cd4"},
+	{0x10C95, 0x10C95, idnaMapped, "\U00010cd5"},
+	{0x10C96, 0x10C96, idnaMapped, "\U00010cd6"},
+	{0x10C97, 0x10C97, idnaMapped, "\U00010cd7"},
+	{0x10C98, 0x10C98, idnaMapped, "\U00010cd8"},
+	{0x10C99, 0x10C99, idnaMapped, "\U00010cd9"},
+	{0x10C9A, 0x10C9A, idnaMapped, "\U00010cda"},
+	{0x10C9B, 0x10C9B, idnaMapped, "\U00010cdb"},
+	{0x10C9C, 0x10C9C, idnaMapped, "\U00010cdc"},
+	{0x10C9D, 0x10C9D, idnaMapped, "\U00010cdd"},
+	{0x10C9E, 0x10C9E, idnaMapped, "\U00010cde"},
+	{0x10C9F, 0x10C9F, idnaMapped, "\U00010cdf"},
+	{0x10CA0, 0x10CA0, idnaMapped, "\U00010ce0"},
+	{0x10CA1, 0x10CA1, idnaMapped, "\U00010ce1"},
+	{0x10CA2, 0x10CA2, idnaMapped, "\U00010ce2"},
+	{0x10CA3, 0x10CA3, idnaMapped, "\U00010ce3"},
+	{0x10CA4, 0x10CA4, idnaMapped, "\U00010ce4"},
+	{0x10CA5, 0x10CA5, idnaMapped, "\U00010ce5"},
+	{0x10CA6, 0x10CA6, idnaMapped, "\U00010ce6"},
+	{0x10CA7, 0x10CA7, idnaMapped, "\U00010ce7"},
+	{0x10CA8, 0x10CA8, idnaMapped, "\U00010ce8"},
+	{0x10CA9, 0x10CA9, idnaMapped, "\U00010ce9"},
+	{0x10CAA, 0x10CAA, idnaMapped, "\U00010cea"},
+	{0x10CAB, 0x10CAB, idnaMapped, "\U00010ceb"},
+	{0x10CAC, 0x10CAC, idnaMapped, "\U00010cec"},
+	{0x10CAD, 0x10CAD, idnaMapped, "\U00010ced"},
+	{0x10CAE, 0x10CAE, idnaMapped, "\U00010cee"},
+	{0x10CAF, 0x10CAF, idnaMapped, "\U00010cef"},
+	{0x10CB0, 0x10CB0, idnaMapped, "\U00010cf0"},
+	{0x10CB1, 0x10CB1, idnaMapped, "\U00010cf1"},
+	{0x10CB2, 0x10CB2, idnaMapped, "\U00010cf2"},
+	{0x10CB3, 0x10CBF, idnaDisallowed, ""},
+	{0x10CC0, 0x10CF2, idnaValid, ""},
+	{0x10CF3, 0x10CF9, idnaDisallowed, ""},
+	{0x10CFA, 0x10D27, idnaValid, ""},
+	{0x10D28, 0x10D2F, idnaDisallowed, ""},
+	{0x10D30, 0x10D39, idnaValid, ""},
+	{0x10D3A, 0x10D3F, idnaDisallowed, ""},
+	{0x10D40, 0x10D4F, idnaValid, ""},
+	{0x10D50, 0x10D50, idnaMapped, "\U00010d70"},
+	{0x10D51, 0x10D51, idnaMapped, "\U00010d71"},
+	{0x10D52, 0x10D52, idnaMapped, "\U00010d72"},
+	{0x10D53, 0x10D53, idnaMapped, "\U00010d73"},
+	{0x10D54, 0x10D54, idnaMapped, "\U00010d74"},
+	{0x10D55, 0x10D55, idnaMapped, "\U00010d75"},
+	{0x10D56, 0x10D56, idnaMapped, "\U00010d76"},
+	{0x10D57, 0x10D57, idnaMapped, "\U00010d77"},
+	{0x10D58, 0x10D58, idnaMapped, "\U00010d78"},
+	{0x10D59, 0x10D59, idnaMapped, "\U00010d79"},
+	{0x10D5A, 0x10D5A, idnaMapped, "\U00010d7a"},
+	{0x10D5B, 0x10D5B, idnaMapped, "\U00010d7b"},
+	{0x10D5C, 0x10D5C, idnaMapped, "\U00010d7c"},
+	{0x10D5D, 0x10D5D, idnaMapped, "\U00010d7d"},
+	{0x10D5E, 0x10D5E, idnaMapped, "\U00010d7e"},
+	{0x10D5F, 0x10D5F, idnaMapped, "\U00010d7f"},
+	{0x10D60, 0x10D60, idnaMapped, "\U00010d80"},
+	{0x10D61, 0x10D61, idnaMapped, "\U00010d81"},
+	{0x10D62, 0x10D62, idnaMapped, "\U00010d82"},
+	{0x10D63, 0x10D63, idnaMapped, "\U00010d83"},
+	{0x10D64, 0x10D64, idnaMapped, "\U00010d84"},
+	{0x10D65, 0x10D65, idnaMapped, "\U00010d85"},
+	{0x10D66, 0x10D68, idnaDisallowed, ""},
+	{0x10D69, 0x10D85, idnaValid, ""},
+	{0x10D86, 0x10D8D, idnaDisallowed, ""},
+	{0x10D8E, 0x10D8F, idnaValid, ""},
+	{0x10D90, 0x10E5F, idnaDisallowed, ""},
+	{0x10E60, 0x10E7E, idnaValid, ""},
+	{0x10E7F, 0x10E7F, idnaDisallowed, ""},
+	{0x10E80, 0x10EA9, idnaValid, ""},
+	{0x10EAA, 0x10EAA, idnaDisallowed, ""},
+	{0x10EAB, 0x10EAD, idnaValid, ""},
+	{0x10EAE, 0x10EAF, idnaDisallowed, ""},
+	{0x10EB0, 0x10EB1, idnaValid, ""},
+	{0x10EB2, 0x10EC1, idnaDisallowed, ""},
+	{0x10EC2, 0x10EC7, idnaValid, ""},
+	{0x10EC8, 0x10ECF, idnaDisallowed, ""},
+	{0x10ED0, 0x10ED8, idnaValid, ""},
+	{0x10ED9, 0x10EF9, idnaDisallowed, ""},
+	{0x10EFA, 0x10F27, idnaValid, ""},
+	{0x10F28, 0x10F2F, idnaDisallowed, ""},
+	{0x10F30, 0x10F59, idnaValid, ""},
+	{0x10F5A, 0x10F6F, idnaDisallowed, ""},
+	{0x10F70, 0x10F89, idnaValid, ""},
+	{0x10F8A, 0x10FAF, idnaDisallowed, ""},
+	{0x10FB0, 0x10FCB, idnaValid, ""},
+	{0x10FCC, 0x10FDF, idnaDisallowed, ""},
+	{0x10FE0, 0x10FF6, idnaValid, ""},
+	{0x10FF7, 0x10FFF, idnaDisallowed, ""},
+	{0x11000, 0x1104D, idnaValid, ""},
+	{0x1104E, 0x11051, idnaDisallowed, ""},
+	{0x11052, 0x11075, idnaValid, ""},
+	{0x11076, 0x1107E, idnaDisallowed, ""},
+	{0x1107F, 0x110BC, idnaValid, ""},
+	{0x110BD, 0x110BD, idnaDisallowed, ""},
+	{0x110BE, 0x110C2, idnaValid, ""},
+	{0x110C3, 0x110CF, idnaDisallowed, ""},
+	{0x110D0, 0x110E8, idnaValid, ""},
+	{0x110E9, 0x110EF, idnaDisallowed, ""},
+	{0x110F0, 0x110F9, idnaValid, ""},
+	{0x110FA, 0x110FF, idnaDisallowed, ""},
+	{0x11100, 0x11134, idnaValid, ""},
+	{0x11135, 0x11135, idnaDisallowed, ""},
+	{0x11136, 0x11147, idnaValid, ""},
+	{0x11148, 0x1114F, idnaDisallowed, ""},
+	{0x11150, 0x11176, idnaValid, ""},
+	{0x11177, 0x1117F, idnaDisallowed, ""},
+	{0x11180, 0x111DF, idnaValid, ""},
+	{0x111E0, 0x111E0, idnaDisallowed, ""},
+	{0x111E1, 0x111F4, idnaValid, ""},
+	{0x111F5, 0x111FF, idnaDisallowed, ""},
+	{0x11200, 0x11211, idnaValid, ""},
+	{0x11212, 0x11212, idnaDisallowed, ""},
+	{0x11213, 0x11241, idnaValid, ""},
+	{0x11242, 0x1127F, idnaDisallowed, ""},
+	{0x11280, 0x11286, idnaValid, ""},
+	{0x11287, 0x11287, idnaDisallowed, ""},
+	{0x11288, 0x11288, idnaValid, ""},
+	{0x11289, 0x11289, idnaDisallowed, ""},
+	{0x1128A, 0x1128D, idnaValid, ""},
+	{0x1128E, 0x1128E, idnaDisallowed, ""},
+	{0x1128F, 0x1129D, idnaValid, ""},
+	{0x1129E, 0x1129E, idnaDisallowed, ""},
+	{0x1129F, 0x112A9, idnaValid, ""},
+	{0x112AA, 0x112AF, idnaDisallowed, ""},
+	{0x112B0, 0x112EA, idnaValid, ""},
+	{0x112EB, 0x112EF, idnaDisallowed, ""},
+	{0x112F0, 0x112F9, idnaValid, ""},
+	{0x112FA, 0x112FF, idnaDisallowed, ""},
+	{0x11300, 0x11303, idnaValid, ""},
+	{0x11304, 0x11304, idnaDisallowed, ""},
+	{0x11305, 0x1130C, idnaValid, ""},
+	{0x1130D, 0x1130E, idnaDisallowed, ""},
+	{0x1130F, 0x11310, idnaValid, ""},
+	{0x11311, 0x11312, idnaDisallowed, ""},
+	{0x11313, 0x11328, idnaValid, ""},
+	{0x11329, 0x11329, idnaDisallowed, ""},
+	{0x1132A, 0x11330, idnaValid, ""},
+	{0x11331, 0x11331, idnaDisallowed, ""},
+	{0x11332, 0x11333, idnaValid, ""},
+	{0x11334, 0x11334, idnaDisallowed, ""},
+	{0x11335, 0x11339, idnaValid, ""},
+	{0x1133A, 0x1133A, idnaDisallowed, ""},
+	{0x1133B, 0x11344, idnaValid, ""},
+	{0x11345, 0x11346, idnaDisallowed, ""},
+	{0x11347, 0x11348, idnaValid, ""},
+	{0x11349, 0x1134A, idnaDisallowed, ""},
+	{0x1134B, 0x1134D, idnaValid, ""},
+	{0x1134E, 0x1134F, idnaDisallowed, ""},
+	{0x11350, 0x11350, idnaValid, ""},
+	{0x11351, 0x11356, idnaDisallowed, ""},
+	{0x11357, 0x11357, idnaValid, ""},
+	{0x11358, 0x1135C, idnaDisallowed, ""},
+	{0x1135D, 0x11363, idnaValid, ""},
+	{0x11364, 0x11365, idnaDisallowed, ""},
+	{0x11366, 0x1136C, idnaValid, ""},
+	{0x1136D, 0x1136F, idnaDisallowed, ""},
+	{0x11370, 0x11374, idnaValid, ""},
+	{0x11375, 0x1137F, idnaDisallowed, ""},
+	{0x11380, 0x11389, idnaValid, ""},
+	{0x1138A, 0x1138A, idnaDisallowed, ""},
+	{0x1138B, 0x1138B, idnaValid, ""},
+	{0x1138C, 0x1138D, idnaDisallowed, ""},
+	{0x1138E, 0x1138E, idnaValid, ""},
+	{0x1138F, 0x1138F, idnaDisallowed, ""},
+	{0x11390, 0x113B5, idnaValid, ""},
+	{0x113B6, 0x113B6, idnaDisallowed, ""},
+	{0x113B7, 0x113C0, idnaValid, ""},
+	{0x113C1, 0x113C1, idnaDisallowed, ""},
+	{0x113C2, 0x113C2, idnaValid, ""},
+	{0x113C3, 0x113C4, idnaDisallowed, ""},
+	{0x113C5, 0x113C5, idnaValid, ""},
+	{0x113C6, 0x113C6, idnaDisallowed, ""},
+	{0x113C7, 0x113CA, idnaValid, ""},
+	{0x113CB, 0x113CB, idnaDisallowed, ""},
+	{0x113CC, 0x113D5, idnaValid, ""},
+	{0x113D6, 0x113D6, idnaDisallowed, ""},
+	{0x113D7, 0x113D8, idnaValid, ""},
+	{0x113D9, 0x113E0, idnaDisallowed, ""},
+	{0x113E1, 0x113E2, idnaValid, ""},
+	{0x113E3, 0x113FF, idnaDisallowed, ""},
+	{0x11400, 0x1145B, idnaValid, ""},
+	{0x1145C, 0x1145C, idnaDisallowed, ""},
+	{0x1145D, 0x11461, idnaValid, ""},
+	{0x11462, 0x1147F, idnaDisallowed, ""},
+	{0x11480, 0x114C7, idnaValid, ""},
+	{0x114C8, 0x114CF, idnaDisallowed, ""},
+	{0x114D0, 0x114D9, idnaValid, ""},
+	{0x114DA, 0x1157F, idnaDisallowed, ""},
+	{0x11580, 0x115B5, idnaValid, ""},
+	{0x115B6, 0x115B7, idnaDisallowed, ""},
+	{0x115B8, 0x115DD, idnaValid, ""},
+	{0x115DE, 0x115FF, idnaDisallowed, ""},
+	{0x11600, 0x11644, idnaValid, ""},
+	{0x11645, 0x1164F, idnaDisallowed, ""},
+	{0x11650, 0x11659, idnaValid, ""},
+	{0x1165A, 0x1165F, idnaDisallowed, ""},
+	{0x11660, 0x1166C, idnaValid, ""},
+	{0x1166D, 0x1167F, idnaDisallowed, ""},
+	{0x11680, 0x116B9, idnaValid, ""},
+	{0x116BA, 0x116BF, idnaDisallowed, ""},
+	{0x116C0, 0x116C9, idnaValid, ""},
+	{0x116CA, 0x116CF, idnaDisallowed, ""},
+	{0x116D0, 0x116E3, idnaValid, ""},
+	{0x116E4, 0x116FF, idnaDisallowed, ""},
+	{0x11700, 0x1171A, idnaValid, ""},
+	{0x1171B, 0x1171C, idnaDisallowed, ""},
+	{0x1171D, 0x1172B, idnaValid, ""},
+	{0x1172C, 0x1172F, idnaDisallowed, ""},
+	{0x11730, 0x11746, idnaValid, ""},
+	{0x11747, 0x117FF, idnaDisallowed, ""},
+	{0x11800, 0x1183B, idnaValid, ""},
+	{0x1183C, 0x1189F, idnaDisallowed, ""},
+	{0x118A0, 0x118A0, idnaMapped, "\U000118c0"},
+	{0x118A1, 0x118A1, idnaMapped, "\U000118c1"},
+	{0x118A2, 0x118A2, idnaMapped, "\U000118c2"},
+	{0x118A3, 0x118A3, idnaMapped, "\U000118c3"},
+	{0x118A4, 0x118A4, idnaMapped, "\U000118c4"},
+	{0x118A5, 0x118A5, idnaMapped, "\U000118c5"},
+	{0x118A6, 0x118A6, idnaMapped, "\U000118c6"},
+	{0x118A7, 0x118A7, idnaMapped, "\U000118c7"},
+	{0x118A8, 0x118A8, idnaMapped, "\U000118c8"},
+	{0x118A9, 0x118A9, idnaMapped, "\U000118c9"},
+	{0x118AA, 0x118AA, idnaMapped, "\U000118ca"},
+	{0x118AB, 0x118AB, idnaMapped, "\U000118cb"},
+	{0x118AC, 0x118AC, idnaMapped, "\U000118cc"},
+	{0x118AD, 0x118AD, idnaMapped, "\U000118cd"},
+	{0x118AE, 0x118AE, idnaMapped, "\U000118ce"},
+	{0x118AF, 0x118AF, idnaMapped, "\U000118cf"},
+	{0x118B0, 0x118B0, idnaMapped, "\U000118d0"},
+	{0x118B1, 0x118B1, idnaMapped, "\U000118d1"},
+	{0x118B2, 0x118B2, idnaMapped, "\U000118d2"},
+	{0x118B3, 0x118B3, idnaMapped, "\U000118d3"},
+	{0x118B4, 0x118B4, idnaMapped, "\U000118d4"},
+	{0x118B5, 0x118B5, idnaMapped, "\U000118d5"},
+	{0x118B6, 0x118B6, idnaMapped, "\U000118d6"},
+	{0x118B7, 0x118B7, idnaMapped, "\U000118d7"},
+	{0x118B8, 0x118B8, idnaMapped, "\U000118d8"},
+	{0x118B9, 0x118B9, idnaMapped, "\U000118d9"},
+	{0x118BA, 0x118BA, idnaMapped, "\U000118da"},
+	{0x118BB, 0x118BB, idnaMapped, "\U000118db"},
+	{0x118BC, 0x118BC, idnaMapped, "\U000118dc"},
+	{0x118BD, 0x118BD, idnaMapped, "\U000118dd"},
+	{0x118BE, 0x118BE, idnaMapped, "\U000118de"},
+	{0x118BF, 0x118BF, idnaMapped, "\U000118df"},
+	{0x118C0, 0x118F2, idnaValid, ""},
+	{0x118F3, 0x118FE, idnaDisallowed, ""},
+	{0x118FF, 0x11906, idnaValid, ""},
+	{0x11907, 0x11908, idnaDisallowed, ""},
+	{0x11909, 0x11909, idnaValid, ""},
+	{0x1190A, 0x1190B, idnaDisallowed, ""},
+	{0x1190C, 0x11913, idnaValid, ""},
+	{0x11914, 0x11914, idnaDisallowed, ""},
+	{0x11915, 0x11916, idnaValid, ""},
+	{0x11917, 0x11917, idnaDisallowed, ""},
+	{0x11918, 0x11935, idnaValid, ""},
+	{0x11936, 0x11936, idnaDisallowed, ""},
+	{0x11937, 0x11938, idnaValid, ""},
+	{0x11939, 0x1193A, idnaDisallowed, ""},
+	{0x1193B, 0x11946, idnaValid, ""},
+	{0x11947, 0x1194F, idnaDisallowed, ""},
+	{0x11950, 0x11959, idnaValid, ""},
+	{0x1195A, 0x1199F, idnaDisallowed, ""},
+	{0x119A0, 0x119A7, idnaValid, ""},
+	{0x119A8, 0x119A9, idnaDisallowed, ""},
+	{0x119AA, 0x119D7, idnaValid, ""},
+	{0x119D8, 0x119D9, idnaDisallowed, ""},
+	{0x119DA, 0x119E4, idnaValid, ""},
+	{0x119E5, 0x119FF, idnaDisallowed, ""},
+	{0x11A00, 0x11A47, idnaValid, ""},
+	{0x11A48, 0x11A4F, idnaDisallowed, ""},
+	{0x11A50, 0x11AA2, idnaValid, ""},
+	{0x11AA3, 0x11AAF, idnaDisallowed, ""},
+	{0x11AB0, 0x11AF8, idnaValid, ""},
+	{0x11AF9, 0x11AFF, idnaDisallowed, ""},
+	{0x11B00, 0x11B09, idnaValid, ""},
+	{0x11B0A, 0x11B5F, idnaDisallowed, ""},
+	{0x11B60, 0x11B67, idnaValid, ""},
+	{0x11B68, 0x11BBF, idnaDisallowed, ""},
+	{0x11BC0, 0x11BE1, idnaValid, ""},
+	{0x11BE2, 0x11BEF, idnaDisallowed, ""},
+	{0x11BF0, 0x11BF9, idnaValid, ""},
+	{0x11BFA, 0x11BFF, idnaDisallowed, ""},
+	{0x11C00, 0x11C08, idnaValid, ""},
+	{0x11C09, 0x11C09, idnaDisallowed, ""},
+	{0x11C0A, 0x11C36, idnaValid, ""},
+	{0x11C37, 0x11C37, idnaDisallowed, ""},
+	{0x11C38, 0x11C45, idnaValid, ""},
+	{0x11C46, 0x11C4F, idnaDisallowed, ""},
+	{0x11C50, 0x11C6C, idnaValid, ""},
+	{0x11C6D, 0x11C6F, idnaDisallowed, ""},
+	{0x11C70, 0x11C8F, idnaValid, ""},
+	{0x11C90, 0x11C91, idnaDisallowed, ""},
+	{0x11C92, 0x11CA7, idnaValid, ""},
+	{0x11CA8, 0x11CA8, idnaDisallowed, ""},
+	{0x11CA9, 0x11CB6, idnaValid, ""},
+	{0x11CB7, 0x11CFF, idnaDisallowed, ""},
+	{0x11D00, 0x11D06, idnaValid, ""},
+	{0x11D07, 0x11D07, idnaDisallowed, ""},
+	{0x11D08, 0x11D09, idnaValid, ""},
+	{0x11D0A, 0x11D0A, idnaDisallowed, ""},
+	{0x11D0B, 0x11D36, idnaValid, ""},
+	{0x11D37, 0x11D39, idnaDisallowed, ""},
+	{0x11D3A, 0x11D3A, idnaValid, ""},
+	{0x11D3B, 0x11D3B, idnaDisallowed, ""},
+	{0x11D3C, 0x11D3D, idnaValid, ""},
+	{0x11D3E, 0x11D3E, idnaDisallowed, ""},
+	{0x11D3F, 0x11D47, idnaValid, ""},
+	{0x11D48, 0x11D4F, idnaDisallowed, ""},
+	{0x11D50, 0x11D59, idnaValid, ""},
+	{0x11D5A, 0x11D5F, idnaDisallowed, ""},
+	{0x11D60, 0x11D65, idnaValid, ""},
+	{0x11D66, 0x11D66, idnaDisallowed, ""},
+	{0x11D67, 0x11D68, idnaValid, ""},
+	{0x11D69, 0x11D69, idnaDisallowed, ""},
+	{0x11D6A, 0x11D8E, idnaValid, ""},
+	{0x11D8F, 0x11D8F, idnaDisallowed, ""},
+	{0x11D90, 0x11D91, idnaValid, ""},
+	{0x11D92, 0x11D92, idnaDisallowed, ""},
+	{0x11D93, 0x11D98, idnaValid, ""},
+	{0x11D99, 0x11D9F, idnaDisallowed, ""},
+	{0x11DA0, 0x11DA9, idnaValid, ""},
+	{0x11DAA, 0x11DAF, idnaDisallowed, ""},
+	{0x11DB0, 0x11DDB, idnaValid, ""},
+	{0x11DDC, 0x11DDF, idnaDisallowed, ""},
+	{0x11DE0, 0x11DE9, idnaValid, ""},
+	{0x11DEA, 0x11EDF, idnaDisallowed, ""},
+	{0x11EE0, 0x11EF8, idnaValid, ""},
+	{0x11EF9, 0x11EFF, idnaDisallowed, ""},
+	{0x11F00, 0x11F10, idnaValid, ""},
+	{0x11F11, 0x11F11, idnaDisallowed, ""},
+	{0x11F12, 0x11F3A, idnaValid, ""},
+	{0x11F3B, 0x11F3D, idnaDisallowed, ""},
+	{0x11F3E, 0x11F5A, idnaValid, ""},
+	{0x11F5B, 0x11FAF, idnaDisallowed, ""},
+	{0x11FB0, 0x11FB0, idnaValid, ""},
+	{0x11FB1, 0x11FBF, idnaDisallowed, ""},
+	{0x11FC0, 0x11FF1, idnaValid, ""},
+	{0x11FF2, 0x11FFE, idnaDisallowed, ""},
+	{0x11FFF, 0x12399, idnaValid, ""},
+	{0x1239A, 0x123FF, idnaDisallowed, ""},
+	{0x12400, 0x1246E, idnaValid, ""},
+	{0x1246F, 0x1246F, idnaDisallowed, ""},
+	{0x12470, 0x12474, idnaValid, ""},
+	{0x12475, 0x1247F, idnaDisallowed, ""},
+	{0x12480, 0x12543, idnaValid, ""},
+	{0x12544, 0x12F8F, idnaDisallowed, ""},
+	{0x12F90, 0x12FF2, idnaValid, ""},
+	{0x12FF3, 0x12FFF, idnaDisallowed, ""},
+	{0x13000, 0x1342F, idnaValid, ""},
+	{0x13430, 0x1343F, idnaDisallowed, ""},
+	{0x13440, 0x13455, idnaValid, ""},
+	{0x13456, 0x1345F, idnaDisallowed, ""},
+	{0x13460, 0x143FA, idnaValid, ""},
+	{0x143FB, 0x143FF, idnaDisallowed, ""},
+	{0x14400, 0x14646, idnaValid, ""},
+	{0x14647, 0x160FF, idnaDisallowed, ""},
+	{0x16100, 0x16139, idnaValid, ""},
+	{0x1613A, 0x167FF, idnaDisallowed, ""},
+	{0x16800, 0x16A38, idnaValid, ""},
+	{0x16A39, 0x16A3F, idnaDisallowed, ""},
+	{0x16A40, 0x16A5E, idnaValid, ""},
+	{0x16A5F, 0x16A5F, idnaDisallowed, ""},
+	{0x16A60, 0x16A69, idnaValid, ""},
+	{0x16A6A, 0x16A6D, idnaDisallowed, ""},
+	{0x16A6E, 0x16ABE, idnaValid, ""},
+	{0x16ABF, 0x16ABF, idnaDisallowed, ""},
+	{0x16AC0, 0x16AC9, idnaValid, ""},
+	{0x16ACA, 0x16ACF, idnaDisallowed, ""},
+	{0x16AD0, 0x16AED, idnaValid, ""},
+	{0x16AEE, 0x16AEF, idnaDisallowed, ""},
+	{0x16AF0, 0x16AF5, idnaValid, ""},
+	{0x16AF6, 0x16AFF, idnaDisallowed, ""},
+	{0x16B00, 0x16B45, idnaValid, ""},
+	{0x16B46, 0x16B4F, idnaDisallowed, ""},
+	{0x16B50, 0x16B59, idnaValid, ""},
+	{0x16B5A, 0x16B5A, idnaDisallowed, ""},
+	{0x16B5B, 0x16B61, idnaValid, ""},
+	{0x16B62, 0x16B62, idnaDisallowed, ""},
+	{0x16B63, 0x16B77, idnaValid, ""},
+	{0x16B78, 0x16B7C, idnaDisallowed, ""},
+	{0x16B7D, 0x16B8F, idnaValid, ""},
+	{0x16B90, 0x16D3F, idnaDisallowed, ""},
+	{0x16D40, 0x16D79, idnaValid, ""},
+	{0x16D7A, 0x16E3F, idnaDisallowed, ""},
+	{0x16E40, 0x16E40, idnaMapped, "\U00016e60"},
+	{0x16E41, 0x16E41, idnaMapped, "\U00016e61"},
+	{0x16E42, 0x16E42, idnaMapped, "\U00016e62"},
+	{0x16E43, 0x16E43, idnaMapped, "\U00016e63"},
+	{0x16E44, 0x16E44, idnaMapped, "\U00016e64"},
+	{0x16E45, 0x16E45, idnaMapped, "\U00016e65"},
+	{0x16E46, 0x16E46, idnaMapped, "\U00016e66"},
+	{0x16E47, 0x16E47, idnaMapped, "\U00016e67"},
+	{0x16E48, 0x16E48, idnaMapped, "\U00016e68"},
+	{0x16E49, 0x16E49, idnaMapped, "\U00016e69"},
+	{0x16E4A, 0x16E4A, idnaMapped, "\U00016e6a"},
+	{0x16E4B, 0x16E4B, idnaMapped, "\U00016e6b"},
+	{0x16E4C, 0x16E4C, idnaMapped, "\U00016e6c"},
+	{0x16E4D, 0x16E4D, idnaMapped, "\U00016e6d"},
+	{0x16E4E, 0x16E4E, idnaMapped, "\U00016e6e"},
+	{0x16E4F, 0x16E4F, idnaMapped, "\U00016e6f"},
+	{0x16E50, 0x16E50, idnaMapped, "\U00016e70"},
+	{0x16E51, 0x16E51, idnaMapped, "\U00016e71"},
+	{0x16E52, 0x16E52, idnaMapped, "\U00016e72"},
+	{0x16E53, 0x16E53, idnaMapped, "\U00016e73"},
+	{0x16E54, 0x16E54, idnaMapped, "\U00016e74"},
+	{0x16E55, 0x16E55, idnaMapped, "\U00016e75"},
+	{0x16E56, 0x16E56, idnaMapped, "\U00016e76"},
+	{0x16E57, 0x16E57, idnaMapped, "\U00016e77"},
+	{0x16E58, 0x16E58, idnaMapped, "\U00016e78"},
+	{0x16E59, 0x16E59, idnaMapped, "\U00016e79"},
+	{0x16E5A, 0x16E5A, idnaMapped, "\U00016e7a"},
+	{0x16E5B, 0x16E5B, idnaMapped, "\U00016e7b"},
+	{0x16E5C, 0x16E5C, idnaMapped, "\U00016e7c"},
+	{0x16E5D, 0x16E5D, idnaMapped, "\U00016e7d"},
+	{0x16E5E, 0x16E5E, idnaMapped, "\U00016e7e"},
+	{0x16E5F, 0x16E5F, idnaMapped, "\U00016e7f"},
+	{0x16E60, 0x16E9A, idnaValid, ""},
+	{0x16E9B, 0x16E9F, idnaDisallowed, ""},
+	{0x16EA0, 0x16EA0, idnaMapped, "\U00016ebb"},
+	{0x16EA1, 0x16EA1, idnaMapped, "\U00016ebc"},
+	{0x16EA2, 0x16EA2, idnaMapped, "\U00016ebd"},
+	{0x16EA3, 0x16EA3, idnaMapped, "\U00016ebe"},
+	{0x16EA4, 0x16EA4, idnaMapped, "\U00016ebf"},
+	{0x16EA5, 0x16EA5, idnaMapped, "\U00016ec0"},
+	{0x16EA6, 0x16EA6, idnaMapped, "\U00016ec1"},
+	{0x16EA7, 0x16EA7, idnaMapped, "\U00016ec2"},
+	{0x16EA8, 0x16EA8, idnaMapped, "\U00016ec3"},
+	{0x16EA9, 0x16EA9, idnaMapped, "\U00016ec4"},
+	{0x16EAA, 0x16EAA, idnaMapped, "\U00016ec5"},
+	{0x16EAB, 0x16EAB, idnaMapped, "\U00016ec6"},
+	{0x16EAC, 0x16EAC, idnaMapped, "\U00016ec7"},
+	{0x16EAD, 0x16EAD, idnaMapped, "\U00016ec8"},
+	{0x16EAE, 0x16EAE, idnaMapped, "\U00016ec9"},
+	{0x16EAF, 0x16EAF, idnaMapped, "\U00016eca"},
+	{0x16EB0, 0x16EB0, idnaMapped, "\U00016ecb"},
+	{0x16EB1, 0x16EB1, idnaMapped, "\U00016ecc"},
+	{0x16EB2, 0x16EB2, idnaMapped, "\U00016ecd"},
+	{0x16EB3, 0x16EB3, idnaMapped, "\U00016ece"},
+	{0x16EB4, 0x16EB4, idnaMapped, "\U00016ecf"},
+	{0x16EB5, 0x16EB5, idnaMapped, "\U00016ed0"},
+	{0x16EB6, 0x16EB6, idnaMapped, "\U00016ed1"},
+	{0x16EB7, 0x16EB7, idnaMapped, "\U00016ed2"},
+	{0x16EB8, 0x16EB8, idnaMapped, "\U00016ed3"},
+	{0x16EB9, 0x16EBA, idnaDisallowed, ""},
+	{0x16EBB, 0x16ED3, idnaValid, ""},
+	{0x16ED4, 0x16EFF, idnaDisallowed, ""},
+	{0x16F00, 0x16F4A, idnaValid, ""},
+	{0x16F4B, 0x16F4E, idnaDisallowed, ""},
+	{0x16F4F, 0x16F87, idnaValid, ""},
+	{0x16F88, 0x16F8E, idnaDisallowed, ""},
+	{0x16F8F, 0x16F9F, idnaValid, ""},
+	{0x16FA0, 0x16FDF, idnaDisallowed, ""},
+	{0x16FE0, 0x16FE4, idnaValid, ""},
+	{0x16FE5, 0x16FEF, idnaDisallowed, ""},
+	{0x16FF0, 0x16FF6, idnaValid, ""},
+	{0x16FF7, 0x16FFF, idnaDisallowed, ""},
+	{0x17000, 0x18CD5, idnaValid, ""},
+	{0x18CD6, 0x18CFE, idnaDisallowed, ""},
+	{0x18CFF, 0x18D1E, idnaValid, ""},
+	{0x18D1F, 0x18D7F, idnaDisallowed, ""},
+	{0x18D80, 0x18DF2, idnaValid, ""},
+	{0x18DF3, 0x1AFEF, idnaDisallowed, ""},
+	{0x1AFF0, 0x1AFF3, idnaValid, ""},
+	{0x1AFF4, 0x1AFF4, idnaDisallowed, ""},
+	{0x1AFF5, 0x1AFFB, idnaValid, ""},
+	{0x1AFFC, 0x1AFFC, idnaDisallowed, ""},
+	{0x1AFFD, 0x1AFFE, idnaValid, ""},
+	{0x1AFFF, 0x1AFFF, idnaDisallowed, ""},
+	{0x1B000, 0x1B122, idnaValid, ""},
+	{0x1B123, 0x1B131, idnaDisallowed, ""},
+	{0x1B132, 0x1B132, idnaValid, ""},
+	{0x1B133, 0x1B14F, idnaDisallowed, ""},
+	{0x1B150, 0x1B152, idnaValid, ""},
+	{0x1B153, 0x1B154, idnaDisallowed, ""},
+	{0x1B155, 0x1B155, idnaValid, ""},
+	{0x1B156, 0x1B163, idnaDisallowed, ""},
+	{0x1B164, 0x1B167, idnaValid, ""},
+	{0x1B168, 0x1B16F, idnaDisallowed, ""},
+	{0x1B170, 0x1B2FB, idnaValid, ""},
+	{0x1B2FC, 0x1BBFF, idnaDisallowed, ""},
+	{0x1BC00, 0x1BC6A, idnaValid, ""},
+	{0x1BC6B, 0x1BC6F, idnaDisallowed, ""},
+	{0x1BC70, 0x1BC7C, idnaValid, ""},
+	{0x1BC7D, 0x1BC7F, idnaDisallowed, ""},
+	{0x1BC80, 0x1BC88, idnaValid, ""},
+	{0x1BC89, 0x1BC8F, idnaDisallowed, ""},
+	{0x1BC90, 0x1BC99, idnaValid, ""},
+	{0x1BC9A, 0x1BC9B, idnaDisallowed, ""},
+	{0x1BC9C, 0x1BC9F, idnaValid, ""},
+	{0x1BCA0, 0x1BCA3, idnaIgnored, ""},
+	{0x1BCA4, 0x1CBFF, idnaDisallowed, ""},
+	{0x1CC00, 0x1CCD5, idnaValid, ""},
+	{0x1CCD6, 0x1CCD6, idnaMapped, "a"},
+	{0x1CCD7, 0x1CCD7, idnaMapped, "b"},
+	{0x1CCD8, 0x1CCD8, idnaMapped, "c"},
+	{0x1CCD9, 0x1CCD9, idnaMapped, "d"},
+	{0x1CCDA, 0x1CCDA, idnaMapped, "e"},
+	{0x1CCDB, 0x1CCDB, idnaMapped, "f"},
+	{0x1CCDC, 0x1CCDC, idnaMapped, "g"},
+	{0x1CCDD, 0x1CCDD, idnaMapped, "h"},
+	{0x1CCDE, 0x1CCDE, idnaMapped, "i"},
+	{0x1CCDF, 0x1CCDF, idnaMapped, "j"},
+	{0x1CCE0, 0x1CCE0, idnaMapped, "k"},
+	{0x1CCE1, 0x1CCE1, idnaMapped, "l"},
+	{0x1CCE2, 0x1CCE2, idnaMapped, "m"},
+	{0x1CCE3, 0x1CCE3, idnaMapped, "n"},
+	{0x1CCE4, 0x1CCE4, idnaMapped, "o"},
+	{0x1CCE5, 0x1CCE5, idnaMapped, "p"},
+	{0x1CCE6, 0x1CCE6, idnaMapped, "q"},
+	{0x1CCE7, 0x1CCE7, idnaMapped, "r"},
+	{0x1CCE8, 0x1CCE8, idnaMapped, "s"},
+	{0x1CCE9, 0x1CCE9, idnaMapped, "t"},
+	{0x1CCEA, 0x1CCEA, idnaMapped, "u"},
+	{0x1CCEB, 0x1CCEB, idnaMapped, "v"},
+	{0x1CCEC, 0x1CCEC, idnaMapped, "w"},
+	{0x1CCED, 0x1CCED, idnaMapped, "x"},
+	{0x1CCEE, 0x1CCEE, idnaMapped, "y"},
+	{0x1CCEF, 0x1CCEF, idnaMapped, "z"},
+	{0x1CCF0, 0x1CCF0, idnaMapped, "0"},
+	{0x1CCF1, 0x1CCF1, idnaMapped, "1"},
+	{0x1CCF2, 0x1CCF2, idnaMapped, "2"},
+	{0x1CCF3, 0x1CCF3, idnaMapped, "3"},
+	{0x1CCF4, 0x1CCF4, idnaMapped, "4"},
+	{0x1CCF5, 0x1CCF5, idnaMapped, "5"},
+	{0x1CCF6, 0x1CCF6, idnaMapped, "6"},
+	{0x1CCF7, 0x1CCF7, idnaMapped, "7"},
+	{0x1CCF8, 0x1CCF8, idnaMapped, "8"},
+	{0x1CCF9, 0x1CCF9, idnaMapped, "9"},
+	{0x1CCFA, 0x1CCFC, idnaValid, ""},
+	{0x1CCFD, 0x1CCFF, idnaDisallowed, ""},
+	{0x1CD00, 0x1CEB3, idnaValid, ""},
+	{0x1CEB4, 0x1CEB9, idnaDisallowed, ""},
+	{0x1CEBA, 0x1CED0, idnaValid, ""},
+	{0x1CED1, 0x1CEDF, idnaDisallowed, ""},
+	{0x1CEE0, 0x1CEF0, idnaValid, ""},
+	{0x1CEF1, 0x1CEFF, idnaDisallowed, ""},
+	{0x1CF00, 0x1CF2D, idnaValid, ""},
+	{0x1CF2E, 0x1CF2F, idnaDisallowed, ""},
+	{0x1CF30, 0x1CF46, idnaValid, ""},
+	{0x1CF47, 0x1CF4F, idnaDisallowed, ""},
+	{0x1CF50, 0x1CFC3, idnaValid, ""},
+	{0x1CFC4, 0x1CFFF, idnaDisallowed, ""},
+	{0x1D000, 0x1D0F5, idnaValid, ""},
+	{0x1D0F6, 0x1D0FF, idnaDisallowed, ""},
+	{0x1D100, 0x1D126, idnaValid, ""},
+	{0x1D127, 0x1D128, idnaDisallowed, ""},
+	{0x1D129, 0x1D15D, idnaValid, ""},
+	{0x1D15E, 0x1D15E, idnaMapped, "\U0001d157\U0001d165"},
+	{0x1D15F, 0x1D15F, idnaMapped, "\U0001d158\U0001d165"},
+	{0x1D160, 0x1D160, idnaMapped, "\U0001d158\U0001d165\U0001d16e"},
+	{0x1D161, 0x1D161, idnaMapped, "\U0001d158\U0001d165\U0001d16f"},
+	{0x1D162, 0x1D162, idnaMapped, "\U0001d158\U0001d165\U0001d170"},
+	{0x1D163, 0x1D163, idnaMapped, "\U0001d158\U0001d165\U0001d171"},
+	{0x1D164, 0x1D164, idnaMapped, "\U0001d158\U0001d165\U0001d172"},
+	{0x1D165, 0x1D172, idnaValid, ""},
+	{0x1D173, 0x1D17A, idnaIgnored, ""},
+	{0x1D17B, 0x1D1BA, idnaValid, ""},
+	{0x1D1BB, 0x1D1BB, idnaMapped, "\U0001d1b9\U0001d165"},
+	{0x1D1BC, 0x1D1BC, idnaMapped, "\U0001d1ba\U0001d165"},
+	{0x1D1BD, 0x1D1BD, idnaMapped, "\U0001d1b9\U0001d165\U0001d16e"},
+	{0x1D1BE, 0x1D1BE, idnaMapped, "\U0001d1ba\U0001d165\U0001d16e"},
+	{0x1D1BF, 0x1D1BF, idnaMapped, "\U0001d1b9\U0001d165\U0001d16f"},
+	{0x1D1C0, 0x1D1C0, idnaMapped, "\U0001d1ba\U0001d165\U0001d16f"},
+	{0x1D1C1, 0x1D1EA, idnaValid, ""},
+	{0x1D1EB, 0x1D1FF, idnaDisallowed, ""},
+	{0x1D200, 0x1D245, idnaValid, ""},
+	{0x1D246, 0x1D2BF, idnaDisallowed, ""},
+	{0x1D2C0, 0x1D2D3, idnaValid, ""},
+	{0x1D2D4, 0x1D2DF, idnaDisallowed, ""},
+	{0x1D2E0, 0x1D2F3, idnaValid, ""},
+	{0x1D2F4, 0x1D2FF, idnaDisallowed, ""},
+	{0x1D300, 0x1D356, idnaValid, ""},
+	{0x1D357, 0x1D35F, idnaDisallowed, ""},
+	{0x1D360, 0x1D378, idnaValid, ""},
+	{0x1D379, 0x1D3FF, idnaDisallowed, ""},
+	{0x1D400, 0x1D400, idnaMapped, "a"},
+	{0x1D401, 0x1D401, idnaMapped, "b"},
+	{0x1D402, 0x1D402, idnaMapped, "c"},
+	{0x1D403, 0x1D403, idnaMapped, "d"},
+	{0x1D404, 0x1D404, idnaMapped, "e"},
+	{0x1D405, 0x1D405, idnaMapped, "f"},
+	{0x1D406, 0x1D406, idnaMapped, "g"},
+	{0x1D407, 0x1D407, idnaMapped, "h"},
+	{0x1D408, 0x1D408, idnaMapped, "i"},
+	{0x1D409, 0x1D409, idnaMapped, "j"},
+	{0x1D40A, 0x1D40A, idnaMapped, "k"},
+	{0x1D40B, 0x1D40B, idnaMapped, "l"},
+	{0x1D40C, 0x1D40C, idnaMapped, "m"},
+	{0x1D40D, 0x1D40D, idnaMapped, "n"},
+	{0x1D40E, 0x1D40E, idnaMapped, "o"},
+	{0x1D40F, 0x1D40F, idnaMapped, "p"},
+	{0x1D410, 0x1D410, idnaMapped, "q"},
+	{0x1D411, 0x1D411, idnaMapped, "r"},
+	{0x1D412, 0x1D412, idnaMapped, "s"},
+	{0x1D413, 0x1D413, idnaMapped, "t"},
+	{0x1D414, 0x1D414, idnaMapped, "u"},
+	{0x1D415, 0x1D415, idnaMapped, "v"},
+	{0x1D416, 0x1D416, idnaMapped, "w"},
+	{0x1D417, 0x1D417, idnaMapped, "x"},
+	{0x1D418, 0x1D418, idnaMapped, "y"},
+	{0x1D419, 0x1D419, idnaMapped, "z"},
+	{0x1D41A, 0x1D41A, idnaMapped, "a"},
+	{0x1D41B, 0x1D41B, idnaMapped, "b"},
+	{0x1D41C, 0x1D41C, idnaMapped, "c"},
+	{0x1D41D, 0x1D41D, idnaMapped, "d"},
+	{0x1D41E, 0x1D41E, idnaMapped, "e"},
+	{0x1D41F, 0x1D41F, idnaMapped, "f"},
+	{0x1D420, 0x1D420, idnaMapped, "g"},
+	{0x1D421, 0x1D421, idnaMapped, "h"},
+	{0x1D422, 0x1D422, idnaMapped, "i"},
+	{0x1D423, 0x1D423, idnaMapped, "j"},
+	{0x1D424, 0x1D424, idnaMapped, "k"},
+	{0x1D425, 0x1D425, idnaMapped, "l"},
+	{0x1D426, 0x1D426, idnaMapped, "m"},
+	{0x1D427, 0x1D427, idnaMapped, "n"},
+	{0x1D428, 0x1D428, idnaMapped, "o"},
+	{0x1D429, 0x1D429, idnaMapped, "p"},
+	{0x1D42A, 0x1D42A, idnaMapped, "q"},
+	{0x1D42B, 0x1D42B, idnaMapped, "r"},
+	{0x1D42C, 0x1D42C, idnaMapped, "s"},
+	{0x1D42D, 0x1D42D, idnaMapped, "t"},
+	{0x1D42E, 0x1D42E, idnaMapped, "u"},
+	{0x1D42F, 0x1D42F, idnaMapped, "v"},
+	{0x1D430, 0x1D430, idnaMapped, "w"},
+	{0x1D431, 0x1D431, idnaMapped, "x"},
+	{0x1D432, 0x1D432, idnaMapped, "y"},
+	{0x1D433, 0x1D433, idnaMapped, "z"},
+	{0x1D434, 0x1D434, idnaMapped, "a"},
+	{0x1D435, 0x1D435, idnaMapped, "b"},
+	{0x1D436, 0x1D436, idnaMapped, "c"},
+	{0x1D437, 0x1D437, idnaMapped, "d"},
+	{0x1D438, 0x1D438, idnaMapped, "e"},
+	{0x1D439, 0x1D439, idnaMapped, "f"},
+	{0x1D43A, 0x1D43A, idnaMapped, "g"},
+	{0x1D43B, 0x1D43B, idnaMapped, "h"},
+	{0x1D43C, 0x1D43C, idnaMapped, "i"},
+	{0x1D43D, 0x1D43D, idnaMapped, "j"},
+	{0x1D43E, 0x1D43E, idnaMapped, "k"},
+	{0x1D43F, 0x1D43F, idnaMapped, "l"},
+	{0x1D440, 0x1D440, idnaMapped, "m"},
+	{0x1D441, 0x1D441, idnaMapped, "n"},
+	{0x1D442, 0x1D442, idnaMapped, "o"},
+	{0x1D443, 0x1D443, idnaMapped, "p"},
+	{0x1D444, 0x1D444, idnaMapped, "q"},
+	{0x1D445, 0x1D445, idnaMapped, "r"},
+	{0x1D446, 0x1D446, idnaMapped, "s"},
+	{0x1D447, 0x1D447, idnaMapped, "t"},
+	{0x1D448, 0x1D448, idnaMapped, "u"},
+	{0x1D449, 0x1D449, idnaMapped, "v"},
+	{0x1D44A, 0x1D44A, idnaMapped, "w"},
+	{0x1D44B, 0x1D44B, idnaMapped, "x"},
+	{0x1D44C, 0x1D44C, idnaMapped, "y"},
+	{0x1D44D, 0x1D44D, idnaMapped, "z"},
+	{0x1D44E, 0x1D44E, idnaMapped, "a"},
+	{0x1D44F, 0x1D44F, idnaMapped, "b"},
+	{0x1D450, 0x1D450, idnaMapped, "c"},
+	{0x1D451, 0x1D451, idnaMapped, "d"},
+	{0x1D452, 0x1D452, idnaMapped, "e"},
+	{0x1D453, 0x1D453, idnaMapped, "f"},
+	{0x1D454, 0x1D454, idnaMapped, "g"},
+	{0x1D455, 0x1D455, idnaDisallowed, ""},
+	{0x1D456, 0x1D456, idnaMapped, "i"},
+	{0x1D457, 0x1D457, idnaMapped, "j"},
+	{0x1D458, 0x1D458, idnaMapped, "k"},
+	{0x1D459, 0x1D459, idnaMapped, "l"},
+	{0x1D45A, 0x1D45A, idnaMapped, "m"},
+	{0x1D45B, 0x1D45B, idnaMapped, "n"},
+	{0x1D45C, 0x1D45C, idnaMapped, "o"},
+	{0x1D45D, 0x1D45D, idnaMapped, "p"},
+	{0x1D45E, 0x1D45E, idnaMapped, "q"},
+	{0x1D45F, 0x1D45F, idnaMapped, "r"},
+	{0x1D460, 0x1D460, idnaMapped, "s"},
+	{0x1D461, 0x1D461, idnaMapped, "t"},
+	{0x1D462, 0x1D462, idnaMapped, "u"},
+	{0x1D463, 0x1D463, idnaMapped, "v"},
+	{0x1D464, 0x1D464, idnaMapped, "w"},
+	{0x1D465, 0x1D465, idnaMapped, "x"},
+	{0x1D466, 0x1D466, idnaMapped, "y"},
+	{0x1D467, 0x1D467, idnaMapped, "z"},
+	{0x1D468, 0x1D468, idnaMapped, "a"},
+	{0x1D469, 0x1D469, idnaMapped, "b"},
+	{0x1D46A, 0x1D46A, idnaMapped, "c"},
+	{0x1D46B, 0x1D46B, idnaMapped, "d"},
+	{0x1D46C, 0x1D46C, idnaMapped, "e"},
+	{0x1D46D, 0x1D46D, idnaMapped, "f"},
+	{0x1D46E, 0x1D46E, idnaMapped, "g"},
+	{0x1D46F, 0x1D46F, idnaMapped, "h"},
+	{0x1D470, 0x1D470, idnaMapped, "i"},
+	{0x1D471, 0x1D471, idnaMapped, "j"},
+	{0x1D472, 0x1D472, idnaMapped, "k"},
+	{0x1D473, 0x1D473, idnaMapped, "l"},
+	{0x1D474, 0x1D474, idnaMapped, "m"},
+	{0x1D475, 0x1D475, idnaMapped, "n"},
+	{0x1D476, 0x1D476, idnaMapped, "o"},
+	{0x1D477, 0x1D477, idnaMapped, "p"},
+	{0x1D478, 0x1D478, idnaMapped, "q"},
+	{0x1D479, 0x1D479, idnaMapped, "r"},
+	{0x1D47A, 0x1D47A, idnaMapped, "s"},
+	{0x1D47B, 0x1D47B, idnaMapped, "t"},
+	{0x1D47C, 0x1D47C, idnaMapped, "u"},
+	{0x1D47D, 0x1D47D, idnaMapped, "v"},
+	{0x1D47E, 0x1D47E, idnaMapped, "w"},
+	{0x1D47F, 0x1D47F, idnaMapped, "x"},
+	{0x1D480, 0x1D480, idnaMapped, "y"},
+	{0x1D481, 0x1D481, idnaMapped, "z"},
+	{0x1D482, 0x1D482, idnaMapped, "a"},
+	{0x1D483, 0x1D483, idnaMapped, "b"},
+	{0x1D484, 0x1D484, idnaMapped, "c"},
+	{0x1D485, 0x1D485, idnaMapped, "d"},
+	{0x1D486, 0x1D486, idnaMapped, "e"},
+	{0x1D487, 0x1D487, idnaMapped, "f"},
+	{0x1D488, 0x1D488, idnaMapped, "g"},
+	{0x1D489, 0x1D489, idnaMapped, "h"},
+	{0x1D48A, 0x1D48A, idnaMapped, "i"},
+	{0x1D48B, 0x1D48B, idnaMapped, "j"},
+	{0x1D48C, 0x1D48C, idnaMapped, "k"},
+	{0x1D48D, 0x1D48D, idnaMapped, "l"},
+	{0x1D48E, 0x1D48E, idnaMapped, "m"},
+	{0x1D48F, 0x1D48F, idnaMapped, "n"},
+	{0x1D490, 0x1D490, idnaMapped, "o"},
+	{0x1D491, 0x1D491, idnaMapped, "p"},
+	{0x1D492, 0x1D492, idnaMapped, "q"},
+	{0x1D493, 0x1D493, idnaMapped, "r"},
+	{0x1D494, 0x1D494, idnaMapped, "s"},
+	{0x1D495, 0x1D495, idnaMapped, "t"},
+	{0x1D496, 0x1D496, idnaMapped, "u"},
+	{0x1D497, 0x1D497, idnaMapped, "v"},
+	{0x1D498, 0x1D498, idnaMapped, "w"},
+	{0x1D499, 0x1D499, idnaMapped, "x"},
+	{0x1D49A, 0x1D49A, idnaMapped, "y"},
+	{0x1D49B, 0x1D49B, idnaMapped, "z"},
+	{0x1D49C, 0x1D49C, idnaMapped, "a"},
+	{0x1D49D, 0x1D49D, idnaDisallowed, ""},
+	{0x1D49E, 0x1D49E, idnaMapped, "c"},
+	{0x1D49F, 0x1D49F, idnaMapped, "d"},
+	{0x1D4A0, 0x1D4A1, idnaDisallowed, ""},
+	{0x1D4A2, 0x1D4A2, idnaMapped, "g"},
+	{0x1D4A3, 0x1D4A4, idnaDisallowed, ""},
+	{0x1D4A5, 0x1D4A5, idnaMapped, "j"},
+	{0x1D4A6, 0x1D4A6, idnaMapped, "k"},
+	{0x1D4A7, 0x1D4A8, idnaDisallowed, ""},
+	{0x1D4A9, 0x1D4A9, idnaMapped, "n"},
+	{0x1D4AA, 0x1D4AA, idnaMapped, "o"},
+	{0x1D4AB, 0x1D4AB, idnaMapped, "p"},
+	{0x1D4AC, 0x1D4AC, idnaMapped, "q"},
+	{0x1D4AD, 0x1D4AD, idnaDisallowed, ""},
+	{0x1D4AE, 0x1D4AE, idnaMapped, "s"},
+	{0x1D4AF, 0x1D4AF, idnaMapped, "t"},
+	{0x1D4B0, 0x1D4B0, idnaMapped, "u"},
+	{0x1D4B1, 0x1D4B1, idnaMapped, "v"},
+	{0x1D4B2, 0x1D4B2, idnaMapped, "w"},
+	{0x1D4B3, 0x1D4B3, idnaMapped, "x"},
+	{0x1D4B4, 0x1D4B4, idnaMapped, "y"},
+	{0x1D4B5, 0x1D4B5, idnaMapped, "z"},
+	{0x1D4B6, 0x1D4B6, idnaMapped, "a"},
+	{0x1D4B7, 0x1D4B7, idnaMapped, "b"},
+	{0x1D4B8, 0x1D4B8, idnaMapped, "c"},
+	{0x1D4B9, 0x1D4B9, idnaMapped, "d"},
+	{0x1D4BA, 0x1D4BA, idnaDisallowed, ""},
+	{0x1D4BB, 0x1D4BB, idnaMapped, "f"},
+	{0x1D4BC, 0x1D4BC, idnaDisallowed, ""},
+	{0x1D4BD, 0x1D4BD, idnaMapped, "h"},
+	{0x1D4BE, 0x1D4BE, idnaMapped, "i"},
+	{0x1D4BF, 0x1D4BF, idnaMapped, "j"},
+	{0x1D4C0, 0x1D4C0, idnaMapped, "k"},
+	{0x1D4C1, 0x1D4C1, idnaMapped, "l"},
+	{0x1D4C2, 0x1D4C2, idnaMapped, "m"},
+	{0x1D4C3, 0x1D4C3, idnaMapped, "n"},
+	{0x1D4C4, 0x1D4C4, idnaDisallowed, ""},
+	{0x1D4C5, 0x1D4C5, idnaMapped, "p"},
+	{0x1D4C6, 0x1D4C6, idnaMapped, "q"},
+	{0x1D4C7, 0x1D4C7, idnaMapped, "r"},
+	{0x1D4C8, 0x1D4C8, idnaMapped, "s"},
+	{0x1D4C9, 0x1D4C9, idnaMapped, "t"},
+	{0x1D4CA, 0x1D4CA, idnaMapped, "u"},
+	{0x1D4CB, 0x1D4CB, idnaMapped, "v"},
+	{0x1D4CC, 0x1D4CC, idnaMapped, "w"},
+	{0x1D4CD, 0x1D4CD, idnaMapped, "x"},
+	{0x1D4CE, 0x1D4CE, idnaMapped, "y"},
+	{0x1D4CF, 0x1D4CF, idnaMapped, "z"},
+	{0x1D4D0, 0x1D4D0, idnaMapped, "a"},
+	{0x1D4D1, 0x1D4D1, idnaMapped, "b"},
+	{0x1D4D2, 0x1D4D2, idnaMapped, "c"},
+	{0x1D4D3, 0x1D4D3, idnaMapped, "d"},
+	{0x1D4D4, 0x1D4D4, idnaMapped, "e"},
+	{0x1D4D5, 0x1D4D5, idnaMapped, "f"},
+	{0x1D4D6, 0x1D4D6, idnaMapped, "g"},
+	{0x1D4D7, 0x1D4D7, idnaMapped, "h"},
+	{0x1D4D8, 0x1D4D8, idnaMapped, "i"},
+	{0x1D4D9, 0x1D4D9, idnaMapped, "j"},
+	{0x1D4DA, 0x1D4DA, idnaMapped, "k"},
+	{0x1D4DB, 0x1D4DB, idnaMapped, "l"},
+	{0x1D4DC, 0x1D4DC, idnaMapped, "m"},
+	{0x1D4DD, 0x1D4DD, idnaMapped, "n"},
+	{0x1D4DE, 0x1D4DE, idnaMapped, "o"},
+	{0x1D4DF, 0x1D4DF, idnaMapped, "p"},
+	{0x1D4E0, 0x1D4E0, idnaMapped, "q"},
+	{0x1D4E1, 0x1D4E1, idnaMapped, "r"},
+	{0x1D4E2, 0x1D4E2, idnaMapped, "s"},
+	{0x1D4E3, 0x1D4E3, idnaMapped, "t"},
+	{0x1D4E4, 0x1D4E4, idnaMapped, "u"},
+	{0x1D4E5, 0x1D4E5, idnaMapped, "v"},
+	{0x1D4E6, 0x1D4E6, idnaMapped, "w"},
+	{0x1D4E7, 0x1D4E7, idnaMapped, "x"},
+	{0x1D4E8, 0x1D4E8, idnaMapped, "y"},
+	{0x1D4E9, 0x1D4E9, idnaMapped, "z"},
+	{0x1D4EA, 0x1D4EA, idnaMapped, "a"},
+	{0x1D4EB, 0x1D4EB, idnaMapped, "b"},
+	{0x1D4EC, 0x1D4EC, idnaMapped, "c"},
+	{0x1D4ED, 0x1D4ED, idnaMapped, "d"},
+	{0x1D4EE, 0x1D4EE, idnaMapped, "e"},
+	{0x1D4EF, 0x1D4EF, idnaMapped, "f"},
+	{0x1D4F0, 0x1D4F0, idnaMapped, "g"},
+	{0x1D4F1, 0x1D4F1, idnaMapped, "h"},
+	{0x1D4F2, 0x1D4F2, idnaMapped, "i"},
+	{0x1D4F3, 0x1D4F3, idnaMapped, "j"},
+	{0x1D4F4, 0x1D4F4, idnaMapped, "k"},
+	{0x1D4F5, 0x1D4F5, idnaMapped, "l"},
+	{0x1D4F6, 0x1D4F6, idnaMapped, "m"},
+	{0x1D4F7, 0x1D4F7, idnaMapped, "n"},
+	{0x1D4F8, 0x1D4F8, idnaMapped, "o"},
+	{0x1D4F9, 0x1D4F9, idnaMapped, "p"},
+	{0x1D4FA, 0x1D4FA, idnaMapped, "q"},
+	{0x1D4FB, 0x1D4FB, idnaMapped, "r"},
+	{0x1D4FC, 0x1D4FC, idnaMapped, "s"},
+	{0x1D4FD, 0x1D4FD, idnaMapped, "t"},
+	{0x1D4FE, 0x1D4FE, idnaMapped, "u"},
+	{0x1D4FF, 0x1D4FF, idnaMapped, "v"},
+	{0x1D500, 0x1D500, idnaMapped, "w"},
+	{0x1D501, 0x1D501, idnaMapped, "x"},
+	{0x1D502, 0x1D502, idnaMapped, "y"},
+	{0x1D503, 0x1D503, idnaMapped, "z"},
+	{0x1D504, 0x1D504, idnaMapped, "a"},
+	{0x1D505, 0x1D505, idnaMapped, "b"},
+	{0x1D506, 0x1D506, idnaDisallowed, ""},
+	{0x1D507, 0x1D507, idnaMapped, "d"},
+	{0x1D508, 0x1D508, idnaMapped, "e"},
+	{0x1D509, 0x1D509, idnaMapped, "f"},
+	{0x1D50A, 0x1D50A, idnaMapped, "g"},
+	{0x1D50B, 0x1D50C, idnaDisallowed, ""},
+	{0x1D50D, 0x1D50D, idnaMapped, "j"},
+	{0x1D50E, 0x1D50E, idnaMapped, "k"},
+	{0x1D50F, 0x1D50F, idnaMapped, "l"},
+	{0x1D510, 0x1D510, idnaMapped, "m"},
+	{0x1D511, 0x1D511, idnaMapped, "n"},
+	{0x1D512, 0x1D512, idnaMapped, "o"},
+	{0x1D513, 0x1D513, idnaMapped, "p"},
+	{0x1D514, 0x1D514, idnaMapped, "q"},
+	{0x1D515, 0x1D515, idnaDisallowed, ""},
+	{0x1D516, 0x1D516, idnaMapped, "s"},
+	{0x1D517, 0x1D517, idnaMapped, "t"},
+	{0x1D518, 0x1D518, idnaMapped, "u"},
+	{0x1D519, 0x1D519, idnaMapped, "v"},
+	{0x1D51A, 0x1D51A, idnaMapped, "w"},
+	{0x1D51B, 0x1D51B, idnaMapped, "x"},
+	{0x1D51C, 0x1D51C, idnaMapped, "y"},
+	{0x1D51D, 0x1D51D, idnaDisallowed, ""},
+	{0x1D51E, 0x1D51E, idnaMapped, "a"},
+	{0x1D51F, 0x1D51F, idnaMapped, "b"},
+	{0x1D520, 0x1D520, idnaMapped, "c"},
+	{0x1D521, 0x1D521, idnaMapped, "d"},
+	{0x1D522, 0x1D522, idnaMapped, "e"},
+	{0x1D523, 0x1D523, idnaMapped, "f"},
+	{0x1D524, 0x1D524, idnaMapped, "g"},
+	{0x1D525, 0x1D525, idnaMapped, "h"},
+	{0x1D526, 0x1D526, idnaMapped, "i"},
+	{0x1D527, 0x1D527, idnaMapped, "j"},
+	{0x1D528, 0x1D528, idnaMapped, "k"},
+	{0x1D529, 0x1D529, idnaMapped, "l"},
+	{0x1D52A, 0x1D52A, idnaMapped, "m"},
+	{0x1D52B, 0x1D52B, idnaMapped, "n"},
+	{0x1D52C, 0x1D52C, idnaMapped, "o"},
+	{0x1D52D, 0x1D52D, idnaMapped, "p"},
+	{0x1D52E, 0x1D52E, idnaMapped, "q"},
+	{0x1D52F, 0x1D52F, idnaMapped, "r"},
+	{0x1D530, 0x1D530, idnaMapped, "s"},
+	{0x1D531, 0x1D531, idnaMapped, "t"},
+	{0x1D532, 0x1D532, idnaMapped, "u"},
+	{0x1D533, 0x1D533, idnaMapped, "v"},
+	{0x1D534, 0x1D534, idnaMapped, "w"},
+	{0x1D535, 0x1D535, idnaMapped, "x"},
+	{0x1D536, 0x1D536, idnaMapped, "y"},
+	{0x1D537, 0x1D537, idnaMapped, "z"},
+	{0x1D538, 0x1D538, idnaMapped, "a"},
+	{0x1D539, 0x1D539, idnaMapped, "b"},
+	{0x1D53A, 0x1D53A, idnaDisallowed, ""},
+	{0x1D53B, 0x1D53B, idnaMapped, "d"},
+	{0x1D53C, 0x1D53C, idnaMapped, "e"},
+	{0x1D53D, 0x1D53D, idnaMapped, "f"},
+	{0x1D53E, 0x1D53E, idnaMapped, "g"},
+	{0x1D53F, 0x1D53F, idnaDisallowed, ""},
+	{0x1D540, 0x1D540, idnaMapped, "i"},
+	{0x1D541, 0x1D541, idnaMapped, "j"},
+	{0x1D542, 0x1D542, idnaMapped, "k"},
+	{0x1D543, 0x1D543, idnaMapped, "l"},
+	{0x1D544, 0x1D544, idnaMapped, "m"},
+	{0x1D545, 0x1D545, idnaDisallowed, ""},
+	{0x1D546, 0x1D546, idnaMapped, "o"},
+	{0x1D547, 0x1D549, idnaDisallowed, ""},
+	{0x1D54A, 0x1D54A, idnaMapped, "s"},
+	{0x1D54B, 0x1D54B, idnaMapped, "t"},
+	{0x1D54C, 0x1D54C, idnaMapped, "u"},
+	{0x1D54D, 0x1D54D, idnaMapped, "v"},
+	{0x1D54E, 0x1D54E, idnaMapped, "w"},
+	{0x1D54F, 0x1D54F, idnaMapped, "x"},
+	{0x1D550, 0x1D550, idnaMapped, "y"},
+	{0x1D551, 0x1D551, idnaDisallowed, ""},
+	{0x1D552, 0x1D552, idnaMapped, "a"},
+	{0x1D553, 0x1D553, idnaMapped, "b"},
+	{0x1D554, 0x1D554, idnaMapped, "c"},
+	{0x1D555, 0x1D555, idnaMapped, "d"},
+	{0x1D556, 0x1D556, idnaMapped, "e"},
+	{0x1D557, 0x1D557, idnaMapped, "f"},
+	{0x1D558, 0x1D558, idnaMapped, "g"},
+	{0x1D559, 0x1D559, idnaMapped, "h"},
+	{0x1D55A, 0x1D55A, idnaMapped, "i"},
+	{0x1D55B, 0x1D55B, idnaMapped, "j"},
+	{0x1D55C, 0x1D55C, idnaMapped, "k"},
+	{0x1D55D, 0x1D55D, idnaMapped, "l"},
+	{0x1D55E, 0x1D55E, idnaMapped, "m"},
+	{0x1D55F, 0x1D55F, idnaMapped, "n"},
+	{0x1D560, 0x1D560, idnaMapped, "o"},
+	{0x1D561, 0x1D561, idnaMapped, "p"},
+	{0x1D562, 0x1D562, idnaMapped, "q"},
+	{0x1D563, 0x1D563, idnaMapped, "r"},
+	{0x1D564, 0x1D564, idnaMapped, "s"},
+	{0x1D565, 0x1D565, idnaMapped, "t"},
+	{0x1D566, 0x1D566, idnaMapped, "u"},
+	{0x1D567, 0x1D567, idnaMapped, "v"},
+	{0x1D568, 0x1D568, idnaMapped, "w"},
+	{0x1D569, 0x1D569, idnaMapped, "x"},
+	{0x1D56A, 0x1D56A, idnaMapped, "y"},
+	{0x1D56B, 0x1D56B, idnaMapped, "z"},
+	{0x1D56C, 0x1D56C, idnaMapped, "a"},
+	{0x1D56D, 0x1D56D, idnaMapped, "b"},
+	{0x1D56E, 0x1D56E, idnaMapped, "c"},
+	{0x1D56F, 0x1D56F, idnaMapped, "d"},
+	{0x1D570, 0x1D570, idnaMapped, "e"},
+	{0x1D571, 0x1D571, idnaMapped, "f"},
+	{0x1D572, 0x1D572, idnaMapped, "g"},
+	{0x1D573, 0x1D573, idnaMapped, "h"},
+	{0x1D574, 0x1D574, idnaMapped, "i"},
+	{0x1D575, 0x1D575, idnaMapped, "j"},
+	{0x1D576, 0x1D576, idnaMapped, "k"},
+	{0x1D577, 0x1D577, idnaMapped, "l"},
+	{0x1D578, 0x1D578, idnaMapped, "m"},
+	{0x1D579, 0x1D579, idnaMapped, "n"},
+	{0x1D57A, 0x1D57A, idnaMapped, "o"},
+	{0x1D57B, 0x1D57B, idnaMapped, "p"},
+	{0x1D57C, 0x1D57C, idnaMapped, "q"},
+	{0x1D57D, 0x1D57D, idnaMapped, "r"},
+	{0x1D57E, 0x1D57E, idnaMapped, "s"},
+	{0x1D57F, 0x1D57F, idnaMapped, "t"},
+	{0x1D580, 0x1D580, idnaMapped, "u"},
+	{0x1D581, 0x1D581, idnaMapped, "v"},
+	{0x1D582, 0x1D582, idnaMapped, "w"},
+	{0x1D583, 0x1D583, idnaMapped, "x"},
+	{0x1D584, 0x1D584, idnaMapped, "y"},
+	{0x1D585, 0x1D585, idnaMapped, "z"},
+	{0x1D586, 0x1D586, idnaMapped, "a"},
+	{0x1D587, 0x1D587, idnaMapped, "b"},
+	{0x1D588, 0x1D588, idnaMapped, "c"},
+	{0x1D589, 0x1D589, idnaMapped, "d"},
+	{0x1D58A, 0x1D58A, idnaMapped, "e"},
+	{0x1D58B, 0x1D58B, idnaMapped, "f"},
+	{0x1D58C, 0x1D58C, idnaMapped, "g"},
+	{0x1D58D, 0x1D58D, idnaMapped, "h"},
+	{0x1D58E, 0x1D58E, idnaMapped, "i"},
+	{0x1D58F, 0x1D58F, idnaMapped, "j"},
+	{0x1D590, 0x1D590, idnaMapped, "k"},
+	{0x1D591, 0x1D591, idnaMapped, "l"},
+	{0x1D592, 0x1D592, idnaMapped, "m"},
+	{0x1D593, 0x1D593, idnaMapped, "n"},
+	{0x1D594, 0x1D594, idnaMapped, "o"},
+	{0x1D595, 0x1D595, idnaMapped, "p"},
+	{0x1D596, 0x1D596, idnaMapped, "q"},
+	{0x1D597, 0x1D597, idnaMapped, "r"},
+	{0x1D598, 0x1D598, idnaMapped, "s"},
+	{0x1D599, 0x1D599, idnaMapped, "t"},
+	{0x1D59A, 0x1D59A, idnaMapped, "u"},
+	{0x1D59B, 0x1D59B, idnaMapped, "v"},
+	{0x1D59C, 0x1D59C, idnaMapped, "w"},
+	{0x1D59D, 0x1D59D, idnaMapped, "x"},
+	{0x1D59E, 0x1D59E, idnaMapped, "y"},
+	{0x1D59F, 0x1D59F, idnaMapped, "z"},
+	{0x1D5A0, 0x1D5A0, idnaMapped, "a"},
+	{0x1D5A1, 0x1D5A1, idnaMapped, "b"},
+	{0x1D5A2, 0x1D5A2, idnaMapped, "c"},
+	{0x1D5A3, 0x1D5A3, idnaMapped, "d"},
+	{0x1D5A4, 0x1D5A4, idnaMapped, "e"},
+	{0x1D5A5, 0x1D5A5, idnaMapped, "f"},
+	{0x1D5A6, 0x1D5A6, idnaMapped, "g"},
+	{0x1D5A7, 0x1D5A7, idnaMapped, "h"},
+	{0x1D5A8, 0x1D5A8, idnaMapped, "i"},
+	{0x1D5A9, 0x1D5A9, idnaMapped, "j"},
+	{0x1D5AA, 0x1D5AA, idnaMapped, "k"},
+	{0x1D5AB, 0x1D5AB, idnaMapped, "l"},
+	{0x1D5AC, 0x1D5AC, idnaMapped, "m"},
+	{0x1D5AD, 0x1D5AD, idnaMapped, "n"},
+	{0x1D5AE, 0x1D5AE, idnaMapped, "o"},
+	{0x1D5AF, 0x1D5AF, idnaMapped, "p"},
+	{0x1D5B0, 0x1D5B0, idnaMapped, "q"},
+	{0x1D5B1, 0x1D5B1, idnaMapped, "r"},
+	{0x1D5B2, 0x1D5B2, idnaMapped, "s"},
+	{0x1D5B3, 0x1D5B3, idnaMapped, "t"},
+	{0x1D5B4, 0x1D5B4, idnaMapped, "u"},
+	{0x1D5B5, 0x1D5B5, idnaMapped, "v"},
+	{0x1D5B6, 0x1D5B6, idnaMapped, "w"},
+	{0x1D5B7, 0x1D5B7, idnaMapped, "x"},
+	{0x1D5B8, 0x1D5B8, idnaMapped, "y"},
+	{0x1D5B9, 0x1D5B9, idnaMapped, "z"},
+	{0x1D5BA, 0x1D5BA, idnaMapped, "a"},
+	{0x1D5BB, 0x1D5BB, idnaMapped, "b"},
+	{0x1D5BC, 0x1D5BC, idnaMapped, "c"},
+	{0x1D5BD, 0x1D5BD, idnaMapped, "d"},
+	{0x1D5BE, 0x1D5BE, idnaMapped, "e"},
+	{0x1D5BF, 0x1D5BF, idnaMapped, "f"},
+	{0x1D5C0, 0x1D5C0, idnaMapped, "g"},
+	{0x1D5C1, 0x1D5C1, idnaMapped, "h"},
+	{0x1D5C2, 0x1D5C2, idnaMapped, "i"},
+	{0x1D5C3, 0x1D5C3, idnaMapped, "j"},
+	{0x1D5C4, 0x1D5C4, idnaMapped, "k"},
+	{0x1D5C5, 0x1D5C5, idnaMapped, "l"},
+	{0x1D5C6, 0x1D5C6, idnaMapped, "m"},
+	{0x1D5C7, 0x1D5C7, idnaMapped, "n"},
+	{0x1D5C8, 0x1D5C8, idnaMapped, "o"},
+	{0x1D5C9, 0x1D5C9, idnaMapped, "p"},
+	{0x1D5CA, 0x1D5CA, idnaMapped, "q"},
+	{0x1D5CB, 0x1D5CB, idnaMapped, "r"},
+	{0x1D5CC, 0x1D5CC, idnaMapped, "s"},
+	{0x1D5CD, 0x1D5CD, idnaMapped, "t"},
+	{0x1D5CE, 0x1D5CE, idnaMapped, "u"},
+	{0x1D5CF, 0x1D5CF, idnaMapped, "v"},
+	{0x1D5D0, 0x1D5D0, idnaMapped, "w"},
+	{0x1D5D1, 0x1D5D1, idnaMapped, "x"},
+	{0x1D5D2, 0x1D5D2, idnaMapped, "y"},
+	{0x1D5D3, 0x1D5D3, idnaMapped, "z"},
+	{0x1D5D4, 0x1D5D4, idnaMapped, "a"},
+	{0x1D5D5, 0x1D5D5, idnaMapped, "b"},
+	{0x1D5D6, 0x1D5D6, idnaMapped, "c"},
+	{0x1D5D7, 0x1D5D7, idnaMapped, "d"},
+	{0x1D5D8, 0x1D5D8, idnaMapped, "e"},
+	{0x1D5D9, 0x1D5D9, idnaMapped, "f"},
+	{0x1D5DA, 0x1D5DA, idnaMapped, "g"},
+	{0x1D5DB, 0x1D5DB, idnaMapped, "h"},
+	{0x1D5DC, 0x1D5DC, idnaMapped, "i"},
+	{0x1D5DD, 0x1D5DD, idnaMapped, "j"},
+	{0x1D5DE, 0x1D5DE, idnaMapped, "k"},
+	{0x1D5DF, 0x1D5DF, idnaMapped, "l"},
+	{0x1D5E0, 0x1D5E0, idnaMapped, "m"},
+	{0x1D5E1, 0x1D5E1, idnaMapped, "n"},
+	{0x1D5E2, 0x1D5E2, idnaMapped, "o"},
+	{0x1D5E3, 0x1D5E3, idnaMapped, "p"},
+	{0x1D5E4, 0x1D5E4, idnaMapped, "q"},
+	{0x1D5E5, 0x1D5E5, idnaMapped, "r"},
+	{0x1D5E6, 0x1D5E6, idnaMapped, "s"},
+	{0x1D5E7, 0x1D5E7, idnaMapped, "t"},
+	{0x1D5E8, 0x1D5E8, idnaMapped, "u"},
+	{0x1D5E9, 0x1D5E9, idnaMapped, "v"},
+	{0x1D5EA, 0x1D5EA, idnaMapped, "w"},
+	{0x1D5EB, 0x1D5EB, idnaMapped, "x"},
+	{0x1D5EC, 0x1D5EC, idnaMapped, "y"},
+	{0x1D5ED, 0x1D5ED, idnaMapped, "z"},
+	{0x1D5EE, 0x1D5EE, idnaMapped, "a"},
+	{0x1D5EF, 0x1D5EF, idnaMapped, "b"},
+	{0x1D5F0, 0x1D5F0, idnaMapped, "c"},
+	{0x1D5F1, 0x1D5F1, idnaMapped, "d"},
+	{0x1D5F2, 0x1D5F2, idnaMapped, "e"},
+	{0x1D5F3, 0x1D5F3, idnaMapped, "f"},
+	{0x1D5F4, 0x1D5F4, idnaMapped, "g"},
+	{0x1D5F5, 0x1D5F5, idnaMapped, "h"},
+	{0x1D5F6, 0x1D5F6, idnaMapped, "i"},
+	{0x1D5F7, 0x1D5F7, idnaMapped, "j"},
+	{0x1D5F8, 0x1D5F8, idnaMapped, "k"},
+	{0x1D5F9, 0x1D5F9, idnaMapped, "l"},
+	{0x1D5FA, 0x1D5FA, idnaMapped, "m"},
+	{0x1D5FB, 0x1D5FB, idnaMapped, "n"},
+	{0x1D5FC, 0x1D5FC, idnaMapped, "o"},
+	{0x1D5FD, 0x1D5FD, idnaMapped, "p"},
+	{0x1D5FE, 0x1D5FE, idnaMapped, "q"},
+	{0x1D5FF, 0x1D5FF, idnaMapped, "r"},
+	{0x1D600, 0x1D600, idnaMapped, "s"},
+	{0x1D601, 0x1D601, idnaMapped, "t"},
+	{0x1D602, 0x1D602, idnaMapped, "u"},
+	{0x1D603, 0x1D603, idnaMapped, "v"},
+	{0x1D604, 0x1D604, idnaMapped, "w"},
+	{0x1D605, 0x1D605, idnaMapped, "x"},
+	{0x1D606, 0x1D606, idnaMapped, "y"},
+	{0x1D607, 0x1D607, idnaMapped, "z"},
+	{0x1D608, 0x1D608, idnaMapped, "a"},
+	{0x1D609, 0x1D609, idnaMapped, "b"},
+	{0x1D60A, 0x1D60A, idnaMapped, "c"},
+	{0x1D60B, 0x1D60B, idnaMapped, "d"},
+	{0x1D60C, 0x1D60C, idnaMapped, "e"},
+	{0x1D60D, 0x1D60D, idnaMapped, "f"},
+	{0x1D60E, 0x1D60E, idnaMapped, "g"},
+	{0x1D60F, 0x1D60F, idnaMapped, "h"},
+	{0x1D610, 0x1D610, idnaMapped, "i"},
+	{0x1D611, 0x1D611, idnaMapped, "j"},
+	{0x1D612, 0x1D612, idnaMapped, "k"},
+	{0x1D613, 0x1D613, idnaMapped, "l"},
+	{0x1D614, 0x1D614, idnaMapped, "m"},
+	{0x1D615, 0x1D615, idnaMapped, "n"},
+	{0x1D616, 0x1D616, idnaMapped, "o"},
+	{0x1D617, 0x1D617, idnaMapped, "p"},
+	{0x1D618, 0x1D618, idnaMapped, "q"},
+	{0x1D619, 0x1D619, idnaMapped, "r"},
+	{0x1D61A, 0x1D61A, idnaMapped, "s"},
+	{0x1D61B, 0x1D61B, idnaMapped, "t"},
+	{0x1D61C, 0x1D61C, idnaMapped, "u"},
+	{0x1D61D, 0x1D61D, idnaMapped, "v"},
+	{0x1D61E, 0x1D61E, idnaMapped, "w"},
+	{0x1D61F, 0x1D61F, idnaMapped, "x"},
+	{0x1D620, 0x1D620, idnaMapped, "y"},
+	{0x1D621, 0x1D621, idnaMapped, "z"},
+	{0x1D622, 0x1D622, idnaMapped, "a"},
+	{0x1D623, 0x1D623, idnaMapped, "b"},
+	{0x1D624, 0x1D624, idnaMapped, "c"},
+	{0x1D625, 0x1D625, idnaMapped, "d"},
+	{0x1D626, 0x1D626, idnaMapped, "e"},
+	{0x1D627, 0x1D627, idnaMapped, "f"},
+	{0x1D628, 0x1D628, idnaMapped, "g"},
+	{0x1D629, 0x1D629, idnaMapped, "h"},
+	{0x1D62A, 0x1D62A, idnaMapped, "i"},
+	{0x1D62B, 0x1D62B, idnaMapped, "j"},
+	{0x1D62C, 0x1D62C, idnaMapped, "k"},
+	{0x1D62D, 0x1D62D, idnaMapped, "l"},
+	{0x1D62E, 0x1D62E, idnaMapped, "m"},
+	{0x1D62F, 0x1D62F, idnaMapped, "n"},
+	{0x1D630, 0x1D630, idnaMapped, "o"},
+	{0x1D631, 0x1D631, idnaMapped, "p"},
+	{0x1D632, 0x1D632, idnaMapped, "q"},
+	{0x1D633, 0x1D633, idnaMapped, "r"},
+	{0x1D634, 0x1D634, idnaMapped, "s"},
+	{0x1D635, 0x1D635, idnaMapped, "t"},
+	{0x1D636, 0x1D636, idnaMapped, "u"},
+	{0x1D637, 0x1D637, idnaMapped, "v"},
+	{0x1D638, 0x1D638, idnaMapped, "w"},
+	{0x1D639, 0x1D639, idnaMapped, "x"},
+	{0x1D63A, 0x1D63A, idnaMapped, "y"},
+	{0x1D63B, 0x1D63B, idnaMapped, "z"},
+	{0x1D63C, 0x1D63C, idnaMapped, "a"},
+	{0x1D63D, 0x1D63D, idnaMapped, "b"},
+	{0x1D63E, 0x1D63E, idnaMapped, "c"},
+	{0x1D63F, 0x1D63F, idnaMapped, "d"},
+	{0x1D640, 0x1D640, idnaMapped, "e"},
+	{0x1D641, 0x1D641, idnaMapped, "f"},
+	{0x1D642, 0x1D642, idnaMapped, "g"},
+	{0x1D643, 0x1D643, idnaMapped, "h"},
+	{0x1D644, 0x1D644, idnaMapped, "i"},
+	{0x1D645, 0x1D645, idnaMapped, "j"},
+	{0x1D646, 0x1D646, idnaMapped, "k"},
+	{0x1D647, 0x1D647, idnaMapped, "l"},
+	{0x1D648, 0x1D648, idnaMapped, "m"},
+	{0x1D649, 0x1D649, idnaMapped, "n"},
+	{0x1D64A, 0x1D64A, idnaMapped, "o"},
+	{0x1D64B, 0x1D64B, idnaMapped, "p"},
+	{0x1D64C, 0x1D64C, idnaMapped, "q"},
+	{0x1D64D, 0x1D64D, idnaMapped, "r"},
+	{0x1D64E, 0x1D64E, idnaMapped, "s"},
+	{0x1D64F, 0x1D64F, idnaMapped, "t"},
+	{0x1D650, 0x1D650, idnaMapped, "u"},
+	{0x1D651, 0x1D651, idnaMapped, "v"},
+	{0x1D652, 0x1D652, idnaMapped, "w"},
+	{0x1D653, 0x1D653, idnaMapped, "x"},
+	{0x1D654, 0x1D654, idnaMapped, "y"},
+	{0x1D655, 0x1D655, idnaMapped, "z"},
+	{0x1D656, 0x1D656, idnaMapped, "a"},
+	{0x1D657, 0x1D657, idnaMapped, "b"},
+	{0x1D658, 0x1D658, idnaMapped, "c"},
+	{0x1D659, 0x1D659, idnaMapped, "d"},
+	{0x1D65A, 0x1D65A, idnaMapped, "e"},
+	{0x1D65B, 0x1D65B, idnaMapped, "f"},
+	{0x1D65C, 0x1D65C, idnaMapped, "g"},
+	{0x1D65D, 0x1D65D, idnaMapped, "h"},
+	{0x1D65E, 0x1D65E, idnaMapped, "i"},
+	{0x1D65F, 0x1D65F, idnaMapped, "j"},
+	{0x1D660, 0x1D660, idnaMapped, "k"},
+	{0x1D661, 0x1D661, idnaMapped, "l"},
+	{0x1D662, 0x1D662, idnaMapped, "m"},
+	{0x1D663, 0x1D663, idnaMapped, "n"},
+	{0x1D664, 0x1D664, idnaMapped, "o"},
+	{0x1D665, 0x1D665, idnaMapped, "p"},
+	{0x1D666, 0x1D666, idnaMapped, "q"},
+	{0x1D667, 0x1D667, idnaMapped, "r"},
+	{0x1D668, 0x1D668, idnaMapped, "s"},
+	{0x1D669, 0x1D669, idnaMapped, "t"},
+	{0x1D66A, 0x1D66A, idnaMapped, "u"},
+	{0x1D66B, 0x1D66B, idnaMapped, "v"},
+	{0x1D66C, 0x1D66C, idnaMapped, "w"},
+	{0x1D66D, 0x1D66D, idnaMapped, "x"},
+	{0x1D66E, 0x1D66E, idnaMapped, "y"},
+	{0x1D66F, 0x1D66F, idnaMapped, "z"},
+	{0x1D670, 0x1D670, idnaMapped, "a"},
+	{0x1D671, 0x1D671, idnaMapped, "b"},
+	{0x1D672, 0x1D672, idnaMapped, "c"},
+	{0x1D673, 0x1D673, idnaMapped, "d"},
+	{0x1D674, 0x1D674, idnaMapped, "e"},
+	{0x1D675, 0x1D675, idnaMapped, "f"},
+	{0x1D676, 0x1D676, idnaMapped, "g"},
+	{0x1D677, 0x1D677, idnaMapped, "h"},
+	{0x1D678, 0x1D678, idnaMapped, "i"},
+	{0x1D679, 0x1D679, idnaMapped, "j"},
+	{0x1D67A, 0x1D67A, idnaMapped, "k"},
+	{0x1D67B, 0x1D67B, idnaMapped, "l"},
+	{0x1D67C, 0x1D67C, idnaMapped, "m"},
+	{0x1D67D, 0x1D67D, idnaMapped, "n"},
+	{0x1D67E, 0x1D67E, idnaMapped, "o"},
+	{0x1D67F, 0x1D67F, idnaMapped, "p"},
+	{0x1D680, 0x1D680, idnaMapped, "q"},
+	{0x1D681, 0x1D681, idnaMapped, "r"},
+	{0x1D682, 0x1D682, idnaMapped, "s"},
+	{0x1D683, 0x1D683, idnaMapped, "t"},
+	{0x1D684, 0x1D684, idnaMapped, "u"},
+	{0x1D685, 0x1D685, idnaMapped, "v"},
+	{0x1D686, 0x1D686, idnaMapped, "w"},
+	{0x1D687, 0x1D687, idnaMapped, "x"},
+	{0x1D688, 0x1D688, idnaMapped, "y"},
+	{0x1D689, 0x1D689, idnaMapped, "z"},
+	{0x1D68A, 0x1D68A, idnaMapped, "a"},
+	{0x1D68B, 0x1D68B, idnaMapped, "b"},
+	{0x1D68C, 0x1D68C, idnaMapped, "c"},
+	{0x1D68D, 0x1D68D, idnaMapped, "d"},
+	{0x1D68E, 0x1D68E, idnaMapped, "e"},
+	{0x1D68F, 0x1D68F, idnaMapped, "f"},
+	{0x1D690, 0x1D690, idnaMapped, "g"},
+	{0x1D691, 0x1D691, idnaMapped, "h"},
+	{0x1D692, 0x1D692, idnaMapped, "i"},
+	{0x1D693, 0x1D693, idnaMapped, "j"},
+	{0x1D694, 0x1D694, idnaMapped, "k"},
+	{0x1D695, 0x1D695, idnaMapped, "l"},
+	{0x1D696, 0x1D696, idnaMapped, "m"},
+	{0x1D697, 0x1D697, idnaMapped, "n"},
+	{0x1D698, 0x1D698, idnaMapped, "o"},
+	{0x1D699, 0x1D699, idnaMapped, "p"},
+	{0x1D69A, 0x1D69A, idnaMapped, "q"},
+	{0x1D69B, 0x1D69B, idnaMapped, "r"},
+	{0x1D69C, 0x1D69C, idnaMapped, "s"},
+	{0x1D69D, 0x1D69D, idnaMapped, "t"},
+	{0x1D69E, 0x1D69E, idnaMapped, "u"},
+	{0x1D69F, 0x1D69F, idnaMapped, "v"},
+	{0x1D6A0, 0x1D6A0, idnaMapped, "w"},
+	{0x1D6A1, 0x1D6A1, idnaMapped, "x"},
+	{0x1D6A2, 0x1D6A2, idnaMapped, "y"},
+	{0x1D6A3, 0x1D6A3, idnaMapped, "z"},
+	{0x1D6A4, 0x1D6A4, idnaMapped, "\u0131"},
+	{0x1D6A5, 0x1D6A5, idnaMapped, "\u0237"},
+	{0x1D6A6, 0x1D6A7, idnaDisallowed, ""},
+	{0x1D6A8, 0x1D6A8, idnaMapped, "\u03b1"},
+	{0x1D6A9, 0x1D6A9, idnaMapped, "\u03b2"},
+	{0x1D6AA, 0x1D6AA, idnaMapped, "\u03b3"},
+	{0x1D6AB, 0x1D6AB, idnaMapped, "\u03b4"},
+	{0x1D6AC, 0x1D6AC, idnaMapped, "\u03b5"},
+	{0x1D6AD, 0x1D6AD, idnaMapped, "\u03b6"},
+	{0x1D6AE, 0x1D6AE, idnaMapped, "\u03b7"},
+	{0x1D6AF, 0x1D6AF, idnaMapped, "\u03b8"},
+	{0x1D6B0, 0x1D6B0, idnaMapped, "\u03b9"},
+	{0x1D6B1, 0x1D6B1, idnaMapped, "\u03ba"},
+	{0x1D6B2, 0x1D6B2, idnaMapped, "\u03bb"},
+	{0x1D6B3, 0x1D6B3, idnaMapped, "\u03bc"},
+	{0x1D6B4, 0x1D6B4, idnaMapped, "\u03bd"},
+	{0x1D6B5, 0x1D6B5, idnaMapped, "\u03be"},
+	{0x1D6B6, 0x1D6B6, idnaMapped, "\u03bf"},
+	{0x1D6B7, 0x1D6B7, idnaMapped, "\u03c0"},
+	{0x1D6B8, 0x1D6B8, idnaMapped, "\u03c1"},
+	{0x1D6B9, 0x1D6B9, idnaMapped, "\u03b8"},
+	{0x1D6BA, 0x1D6BA, idnaMapped, "\u03c3"},
+	{0x1D6BB, 0x1D6BB, idnaMapped, "\u03c4"},
+	{0x1D6BC, 0x1D6BC, idnaMapped, "\u03c5"},
+	{0x1D6BD, 0x1D6BD, idnaMapped, "\u03c6"},
+	{0x1D6BE, 0x1D6BE, idnaMapped, "\u03c7"},
+	{0x1D6BF, 0x1D6BF, idnaMapped, "\u03c8"},
+	{0x1D6C0, 0x1D6C0, idnaMapped, "\u03c9"},
+	{0x1D6C1, 0x1D6C1, idnaMapped, "\u2207"},
+	{0x1D6C2, 0x1D6C2, idnaMapped, "\u03b1"},
+	{0x1D6C3, 0x1D6C3, idnaMapped, "\u03b2"},
+	{0x1D6C4, 0x1D6C4, idnaMapped, "\u03b3"},
+	{0x1D6C5, 0x1D6C5, idnaMapped, "\u03b4"},
+	{0x1D6C6, 0x1D6C6, idnaMapped, "\u03b5"},
+	{0x1D6C7, 0x1D6C7, idnaMapped, "\u03b6"},
+	{0x1D6C8, 0x1D6C8, idnaMapped, "\u03b7"},
+	{0x1D6C9, 0x1D6C9, idnaMapped, "\u03b8"},
+	{0x1D6CA, 0x1D6CA, idnaMapped, "\u03b9"},
+	{0x1D6CB, 0x1D6CB, idnaMapped, "\u03ba"},
+	{0x1D6CC, 0x1D6CC, idnaMapped, "\u03bb"},
+	{0x1D6CD, 0x1D6CD, idnaMapped, "\u03bc"},
+	{0x1D6CE, 0x1D6CE, idnaMapped, "\u03bd"},
+	{0x1D6CF, 0x1D6CF, idnaMapped, "\u03be"},
+	{0x1D6D0, 0x1D6D0, idnaMapped, "\u03bf"},
+	{0x1D6D1, 0x1D6D1, idnaMapped, "\u03c0"},
+	{0x1D6D2, 0x1D6D2, idnaMapped, "\u03c1"},
+	{0x1D6D3, 0x1D6D3, idnaMapped, "\u03c3"},
+	{0x1D6D4, 0x1D6D4, idnaMapped, "\u03c3"},
+	{0x1D6D5, 0x1D6D5, idnaMapped, "\u03c4"},
+	{0x1D6D6, 0x1D6D6, idnaMapped, "\u03c5"},
+	{0x1D6D7, 0x1D6D7, idnaMapped, "\u03c6"},
+	{0x1D6D8, 0x1D6D8, idnaMapped, "\u03c7"},
+	{0x1D6D9, 0x1D6D9, idnaMapped, "\u03c8"},
+	{0x1D6DA, 0x1D6DA, idnaMapped, "\u03c9"},
+	{0x1D6DB, 0x1D6DB, idnaMapped, "\u2202"},
+	{0x1D6DC, 0x1D6DC, idnaMapped, "\u03b5"},
+	{0x1D6DD, 0x1D6DD, idnaMapped, "\u03b8"},
+	{0x1D6DE, 0x1D6DE, idnaMapped, "\u03ba"},
+	{0x1D6DF, 0x1D6DF, idnaMapped, "\u03c6"},
+	{0x1D6E0, 0x1D6E0, idnaMapped, "\u03c1"},
+	{0x1D6E1, 0x1D6E1, idnaMapped, "\u03c0"},
+	{0x1D6E2, 0x1D6E2, idnaMapped, "\u03b1"},
+	{0x1D6E3, 0x1D6E3, idnaMapped, "\u03b2"},
+	{0x1D6E4, 0x1D6E4, idnaMapped, "\u03b3"},
+	{0x1D6E5, 0x1D6E5, idnaMapped, "\u03b4"},
+	{0x1D6E6, 0x1D6E6, idnaMapped, "\u03b5"},
+	{0x1D6E7, 0x1D6E7, idnaMapped, "\u03b6"},
+	{0x1D6E8, 0x1D6E8, idnaMapped, "\u03b7"},
+	{0x1D6E9, 0x1D6E9, idnaMapped, "\u03b8"},
+	{0x1D6EA, 0x1D6EA, idnaMapped, "\u03b9"},
+	{0x1D6EB, 0x1D6EB, idnaMapped, "\u03ba"},
+	{0x1D6EC, 0x1D6EC, idnaMapped, "\u03bb"},
+	{0x1D6ED, 0x1D6ED, idnaMapped, "\u03bc"},
+	{0x1D6EE, 0x1D6EE, idnaMapped, "\u03bd"},
+	{0x1D6EF, 0x1D6EF, idnaMapped, "\u03be"},
+	{0x1D6F0, 0x1D6F0, idnaMapped, "\u03bf"},
+	{0x1D6F1, 0x1D6F1, idnaMapped, "\u03c0"},
+	{0x1D6F2, 0x1D6F2, idnaMapped, "\u03c1"},
+	{0x1D6F3, 0x1D6F3, idnaMapped, "\u03b8"},
+	{0x1D6F4, 0x1D6F4, idnaMapped, "\u03c3"},
+	{0x1D6F5, 0x1D6F5, idnaMapped, "\u03c4"},
+	{0x1D6F6, 0x1D6F6, idnaMapped, "\u03c5"},
+	{0x1D6F7, 0x1D6F7, idnaMapped, "\u03c6"},
+	{0x1D6F8, 0x1D6F8, idnaMapped, "\u03c7"},
+	{0x1D6F9, 0x1D6F9, idnaMapped, "\u03c8"},
+	{0x1D6FA, 0x1D6FA, idnaMapped, "\u03c9"},
+	{0x1D6FB, 0x1D6FB, idnaMapped, "\u2207"},
+	{0x1D6FC, 0x1D6FC, idnaMapped, "\u03b1"},
+	{0x1D6FD, 0x1D6FD, idnaMapped, "\u03b2"},
+	{0x1D6FE, 0x1D6FE, idnaMapped, "\u03b3"},
+	{0x1D6FF, 0x1D6FF, idnaMapped, "\u03b4"},
+	{0x1D700, 0x1D700, idnaMapped, "\u03b5"},
+	{0x1D701, 0x1D701, idnaMapped, "\u03b6"},
+	{0x1D702, 0x1D702, idnaMapped, "\u03b7"},
+	{0x1D703, 0x1D703, idnaMapped, "\u03b8"},
+	{0x1D704, 0x1D704, idnaMapped, "\u03b9"},
+	{0x1D705, 0x1D705, idnaMapped, "\u03ba"},
+	{0x1D706, 0x1D706, idnaMapped, "\u03bb"},
+	{0x1D707, 0x1D707, idnaMapped, "\u03bc"},
+	{0x1D708, 0x1D708, idnaMapped, "\u03bd"},
+	{0x1D709, 0x1D709, idnaMapped, "\u03be"},
+	{0x1D70A, 0x1D70A, idnaMapped, "\u03bf"},
+	{0x1D70B, 0x1D70B, idnaMapped, "\u03c0"},
+	{0x1D70C, 0x1D70C, idnaMapped, "\u03c1"},
+	{0x1D70D, 0x1D70D, idnaMapped, "\u03c3"},
+	{0x1D70E, 0x1D70E, idnaMapped, "\u03c3"},
+	{0x1D70F, 0x1D70F, idnaMapped, "\u03c4"},
+	{0x1D710, 0x1D710, idnaMapped, "\u03c5"},
+	{0x1D711, 0x1D711, idnaMapped, "\u03c6"},
+	{0x1D712, 0x1D712, idnaMapped, "\u03c7"},
+	{0x1D713, 0x1D713, idnaMapped, "\u03c8"},
+	{0x1D714, 0x1D714, idnaMapped, "\u03c9"},
+	{0x1D715, 0x1D715, idnaMapped, "\u2202"},
+	{0x1D716, 0x1D716, idnaMapped, "\u03b5"},
+	{0x1D717, 0x1D717, idnaMapped, "\u03b8"},
+	{0x1D718, 0x1D718, idnaMapped, "\u03ba"},
+	{0x1D719, 0x1D719, idnaMapped, "\u03c6"},
+	{0x1D71A, 0x1D71A, idnaMapped, "\u03c1"},
+	{0x1D71B, 0x1D71B, idnaMapped, "\u03c0"},
+	{0x1D71C, 0x1D71C, idnaMapped, "\u03b1"},
+	{0x1D71D, 0x1D71D, idnaMapped, "\u03b2"},
+	{0x1D71E, 0x1D71E, idnaMapped, "\u03b3"},
+	{0x1D71F, 0x1D71F, idnaMapped, "\u03b4"},
+	{0x1D720, 0x1D720, idnaMapped, "\u03b5"},
+	{0x1D721, 0x1D721, idnaMapped, "\u03b6"},
+	{0x1D722, 0x1D722, idnaMapped, "\u03b7"},
+	{0x1D723, 0x1D723, idnaMapped, "\u03b8"},
+	{0x1D724, 0x1D724, idnaMapped, "\u03b9"},
+	{0x1D725, 0x1D725, idnaMapped, "\u03ba"},
+	{0x1D726, 0x1D726, idnaMapped, "\u03bb"},
+	{0x1D727, 0x1D727, idnaMapped, "\u03bc"},
+	{0x1D728, 0x1D728, idnaMapped, "\u03bd"},
+	{0x1D729, 0x1D729, idnaMapped, "\u03be"},
+	{0x1D72A, 0x1D72A, idnaMapped, "\u03bf"},
+	{0x1D72B, 0x1D72B, idnaMapped, "\u03c0"},
+	{0x1D72C, 0x1D72C, idnaMapped, "\u03c1"},
+	{0x1D72D, 0x1D72D, idnaMapped, "\u03b8"},
+	{0x1D72E, 0x1D72E, idnaMapped, "\u03c3"},
+	{0x1D72F, 0x1D72F, idnaMapped, "\u03c4"},
+	{0x1D730, 0x1D730, idnaMapped, "\u03c5"},
+	{0x1D731, 0x1D731, idnaMapped, "\u03c6"},
+	{0x1D732, 0x1D732, idnaMapped, "\u03c7"},
+	{0x1D733, 0x1D733, idnaMapped, "\u03c8"},
+	{0x1D734, 0x1D734, idnaMapped, "\u03c9"},
+	{0x1D735, 0x1D735, idnaMapped, "\u2207"},
+	{0x1D736, 0x1D736, idnaMapped, "\u03b1"},
+	{0x1D737, 0x1D737, idnaMapped, "\u03b2"},
+	{0x1D738, 0x1D738, idnaMapped, "\u03b3"},
+	{0x1D739, 0x1D739, idnaMapped, "\u03b4"},
+	{0x1D73A, 0x1D73A, idnaMapped, "\u03b5"},
+	{0x1D73B, 0x1D73B, idnaMapped, "\u03b6"},
+	{0x1D73C, 0x1D73C, idnaMapped, "\u03b7"},
+	{0x1D73D, 0x1D73D, idnaMapped, "\u03b8"},
+	{0x1D73E, 0x1D73E, idnaMapped, "\u03b9"},
+	{0x1D73F, 0x1D73F, idnaMapped, "\u03ba"},
+	{0x1D740, 0x1D740, idnaMapped, "\u03bb"},
+	{0x1D741, 0x1D741, idnaMapped, "\u03bc"},
+	{0x1D742, 0x1D742, idnaMapped, "\u03bd"},
+	{0x1D743, 0x1D743, idnaMapped, "\u03be"},
+	{0x1D744, 0x1D744, idnaMapped, "\u03bf"},
+	{0x1D745, 0x1D745, idnaMapped, "\u03c0"},
+	{0x1D746, 0x1D746, idnaMapped, "\u03c1"},
+	{0x1D747, 0x1D747, idnaMapped, "\u03c3"},
+	{0x1D748, 0x1D748, idnaMapped, "\u03c3"},
+	{0x1D749, 0x1D749, idnaMapped, "\u03c4"},
+	{0x1D74A, 0x1D74A, idnaMapped, "\u03c5"},
+	{0x1D74B, 0x1D74B, idnaMapped, "\u03c6"},
+	{0x1D74C, 0x1D74C, idnaMapped, "\u03c7"},
+	{0x1D74D, 0x1D74D, idnaMapped, "\u03c8"},
+	{0x1D74E, 0x1D74E, idnaMapped, "\u03c9"},
+	{0x1D74F, 0x1D74F, idnaMapped, "\u2202"},
+	{0x1D750, 0x1D750, idnaMapped, "\u03b5"},
+	{0x1D751, 0x1D751, idnaMapped, "\u03b8"},
+	{0x1D752, 0x1D752, idnaMapped, "\u03ba"},
+	{0x1D753, 0x1D753, idnaMapped, "\u03c6"},
+	{0x1D754, 0x1D754, idnaMapped, "\u03c1"},
+	{0x1D755, 0x1D755, idnaMapped, "\u03c0"},
+	{0x1D756, 0x1D756, idnaMapped, "\u03b1"},
+	{0x1D757, 0x1D757, idnaMapped, "\u03b2"},
+	{0x1D758, 0x1D758, idnaMapped, "\u03b3"},
+	{0x1D759, 0x1D759, idnaMapped, "\u03b4"},
+	{0x1D75A, 0x1D75A, idnaMapped, "\u03b5"},
+	{0x1D75B, 0x1D75B, idnaMapped, "\u03b6"},
+	{0x1D75C, 0x1D75C, idnaMapped, "\u03b7"},
+	{0x1D75D, 0x1D75D, idnaMapped, "\u03b8"},
+	{0x1D75E, 0x1D75E, idnaMapped, "\u03b9"},
+	{0x1D75F, 0x1D75F, idnaMapped, "\u03ba"},
+	{0x1D760, 0x1D760, idnaMapped, "\u03bb"},
+	{0x1D761, 0x1D761, idnaMapped, "\u03bc"},
+	{0x1D762, 0x1D762, idnaMapped, "\u03bd"},
+	{0x1D763, 0x1D763, idnaMapped, "\u03be"},
+	{0x1D764, 0x1D764, idnaMapped, "\u03bf"},
+	{0x1D765, 0x1D765, idnaMapped, "\u03c0"},
+	{0x1D766, 0x1D766, idnaMapped, "\u03c1"},
+	{0x1D767, 0x1D767, idnaMapped, "\u03b8"},
+	{0x1D768, 0x1D768, idnaMapped, "\u03c3"},
+	{0x1D769, 0x1D769, idnaMapped, "\u03c4"},
+	{0x1D76A, 0x1D76A, idnaMapped, "\u03c5"},
+	{0x1D76B, 0x1D76B, idnaMapped, "\u03c6"},
+	{0x1D76C, 0x1D76C, idnaMapped, "\u03c7"},
+	{0x1D76D, 0x1D76D, idnaMapped, "\u03c8"},
+	{0x1D76E, 0x1D76E, idnaMapped, "\u03c9"},
+	{0x1D76F, 0x1D76F, idnaMapped, "\u2207"},
+	{0x1D770, 0x1D770, idnaMapped, "\u03b1"},
+	{0x1D771, 0x1D771, idnaMapped, "\u03b2"},
+	{0x1D772, 0x1D772, idnaMapped, "\u03b3"},
+	{0x1D773, 0x1D773, idnaMapped, "\u03b4"},
+	{0x1D774, 0x1D774, idnaMapped, "\u03b5"},
+	{0x1D775, 0x1D775, idnaMapped, "\u03b6"},
+	{0x1D776, 0x1D776, idnaMapped, "\u03b7"},
+	{0x1D777, 0x1D777, idnaMapped, "\u03b8"},
+	{0x1D778, 0x1D778, idnaMapped, "\u03b9"},
+	{0x1D779, 0x1D779, idnaMapped, "\u03ba"},
+	{0x1D77A, 0x1D77A, idnaMapped, "\u03bb"},
+	{0x1D77B, 0x1D77B, idnaMapped, "\u03bc"},
+	{0x1D77C, 0x1D77C, idnaMapped, "\u03bd"},
+	{0x1D77D, 0x1D77D, idnaMapped, "\u03be"},
+	{0x1D77E, 0x1D77E, idnaMapped, "\u03bf"},
+	{0x1D77F, 0x1D77F, idnaMapped, "\u03c0"},
+	{0x1D780, 0x1D780, idnaMapped, "\u03c1"},
+	{0x1D781, 0x1D781, idnaMapped, "\u03c3"},
+	{0x1D782, 0x1D782, idnaMapped, "\u03c3"},
+	{0x1D783, 0x1D783, idnaMapped, "\u03c4"},
+	{0x1D784, 0x1D784, idnaMapped, "\u03c5"},
+	{0x1D785, 0x1D785, idnaMapped, "\u03c6"},
+	{0x1D786, 0x1D786, idnaMapped, "\u03c7"},
+	{0x1D787, 0x1D787, idnaMapped, "\u03c8"},
+	{0x1D788, 0x1D788, idnaMapped, "\u03c9"},
+	{0x1D789, 0x1D789, idnaMapped, "\u2202"},
+	{0x1D78A, 0x1D78A, idnaMapped, "\u03b5"},
+	{0x1D78B, 0x1D78B, idnaMapped, "\u03b8"},
+	{0x1D78C, 0x1D78C, idnaMapped, "\u03ba"},
+	{0x1D78D, 0x1D78D, idnaMapped, "\u03c6"},
+	{0x1D78E, 0x1D78E, idnaMapped, "\u03c1"},
+	{0x1D78F, 0x1D78F, idnaMapped, "\u03c0"},
+	{0x1D790, 0x1D790, idnaMapped, "\u03b1"},
+	{0x1D791, 0x1D791, idnaMapped, "\u03b2"},
+	{0x1D792, 0x1D792, idnaMapped, "\u03b3"},
+	{0x1D793, 0x1D793, idnaMapped, "\u03b4"},
+	{0x1D794, 0x1D794, idnaMapped, "\u03b5"},
+	{0x1D795, 0x1D795, idnaMapped, "\u03b6"},
+	{0x1D796, 0x1D796, idnaMapped, "\u03b7"},
+	{0x1D797, 0x1D797, idnaMapped, "\u03b8"},
+	{0x1D798, 0x1D798, idnaMapped, "\u03b9"},
+	{0x1D799, 0x1D799, idnaMapped, "\u03ba"},
+	{0x1D79A, 0x1D79A, idnaMapped, "\u03bb"},
+	{0x1D79B, 0x1D79B, idnaMapped, "\u03bc"},
+	{0x1D79C, 0x1D79C, idnaMapped, "\u03bd"},
+	{0x1D79D, 0x1D79D, idnaMapped, "\u03be"},
+	{0x1D79E, 0x1D79E, idnaMapped, "\u03bf"},
+	{0x1D79F, 0x1D79F, idnaMapped, "\u03c0"},
+	{0x1D7A0, 0x1D7A0, idnaMapped, "\u03c1"},
+	{0x1D7A1, 0x1D7A1, idnaMapped, "\u03b8"},
+	{0x1D7A2, 0x1D7A2, idnaMapped, "\u03c3"},
+	{0x1D7A3, 0x1D7A3, idnaMapped, "\u03c4"},
+	{0x1D7A4, 0x1D7A4, idnaMapped, "\u03c5"},
+	{0x1D7A5, 0x1D7A5, idnaMapped, "\u03c6"},
+	{0x1D7A6, 0x1D7A6, idnaMapped, "\u03c7"},
+	{0x1D7A7, 0x1D7A7, idnaMapped, "\u03c8"},
+	{0x1D7A8, 0x1D7A8, idnaMapped, "\u03c9"},
+	{0x1D7A9, 0x1D7A9, idnaMapped, "\u2207"},
+	{0x1D7AA, 0x1D7AA, idnaMapped, "\u03b1"},
+	{0x1D7AB, 0x1D7AB, idnaMapped, "\u03b2"},
+	{0x1D7AC, 0x1D7AC, idnaMapped, "\u03b3"},
+	{0x1D7AD, 0x1D7AD, idnaMapped, "\u03b4"},
+	{0x1D7AE, 0x1D7AE, idnaMapped, "\u03b5"},
+	{0x1D7AF, 0x1D7AF, idnaMapped, "\u03b6"},
+	{0x1D7B0, 0x1D7B0, idnaMapped, "\u03b7"},
+	{0x1D7B1, 0x1D7B1, idnaMapped, "\u03b8"},
+	{0x1D7B2, 0x1D7B2, idnaMapped, "\u03b9"},
+	{0x1D7B3, 0x1D7B3, idnaMapped, "\u03ba"},
+	{0x1D7B4, 0x1D7B4, idnaMapped, "\u03bb"},
+	{0x1D7B5, 0x1D7B5, idnaMapped, "\u03bc"},
+	{0x1D7B6, 0x1D7B6, idnaMapped, "\u03bd"},
+	{0x1D7B7, 0x1D7B7, idnaMapped, "\u03be"},
+	{0x1D7B8, 0x1D7B8, idnaMapped, "\u03bf"},
+	{0x1D7B9, 0x1D7B9, idnaMapped, "\u03c0"},
+	{0x1D7BA, 0x1D7BA, idnaMapped, "\u03c1"},
+	{0x1D7BB, 0x1D7BB, idnaMapped, "\u03c3"},
+	{0x1D7BC, 0x1D7BC, idnaMapped, "\u03c3"},
+	{0x1D7BD, 0x1D7BD, idnaMapped, "\u03c4"},
+	{0x1D7BE, 0x1D7BE, idnaMapped, "\u03c5"},
+	{0x1D7BF, 0x1D7BF, idnaMapped, "\u03c6"},
+	{0x1D7C0, 0x1D7C0, idnaMapped, "\u03c7"},
+	{0x1D7C1, 0x1D7C1, idnaMapped, "\u03c8"},
+	{0x1D7C2, 0x1D7C2, idnaMapped, "\u03c9"},
+	{0x1D7C3, 0x1D7C3, idnaMapped, "\u2202"},
+	{0x1D7C4, 0x1D7C4, idnaMapped, "\u03b5"},
+	{0x1D7C5, 0x1D7C5, idnaMapped, "\u03b8"},
+	{0x1D7C6, 0x1D7C6, idnaMapped, "\u03ba"},
+	{0x1D7C7, 0x1D7C7, idnaMapped, "\u03c6"},
+	{0x1D7C8, 0x1D7C8, idnaMapped, "\u03c1"},
+	{0x1D7C9, 0x1D7C9, idnaMapped, "\u03c0"},
+	{0x1D7CA, 0x1D7CA, idnaMapped, "\u03dd"},
+	{0x1D7CB, 0x1D7CB, idnaMapped, "\u03dd"},
+	{0x1D7CC, 0x1D7CD, idnaDisallowed, ""},
+	{0x1D7CE, 0x1D7CE, idnaMapped, "0"},
+	{0x1D7CF, 0x1D7CF, idnaMapped, "1"},
+	{0x1D7D0, 0x1D7D0, idnaMapped, "2"},
+	{0x1D7D1, 0x1D7D1, idnaMapped, "3"},
+	{0x1D7D2, 0x1D7D2, idnaMapped, "4"},
+	{0x1D7D3, 0x1D7D3, idnaMapped, "5"},
+	{0x1D7D4, 0x1D7D4, idnaMapped, "6"},
+	{0x1D7D5, 0x1D7D5, idnaMapped, "7"},
+	{0x1D7D6, 0x1D7D6, idnaMapped, "8"},
+	{0x1D7D7, 0x1D7D7, idnaMapped, "9"},
+	{0x1D7D8, 0x1D7D8, idnaMapped, "0"},
+	{0x1D7D9, 0x1D7D9, idnaMapped, "1"},
+	{0x1D7DA, 0x1D7DA, idnaMapped, "2"},
+	{0x1D7DB, 0x1D7DB, idnaMapped, "3"},
+	{0x1D7DC, 0x1D7DC, idnaMapped, "4"},
+	{0x1D7DD, 0x1D7DD, idnaMapped, "5"},
+	{0x1D7DE, 0x1D7DE, idnaMapped, "6"},
+	{0x1D7DF, 0x1D7DF, idnaMapped, "7"},
+	{0x1D7E0, 0x1D7E0, idnaMapped, "8"},
+	{0x1D7E1, 0x1D7E1, idnaMapped, "9"},
+	{0x1D7E2, 0x1D7E2, idnaMapped, "0"},
+	{0x1D7E3, 0x1D7E3, idnaMapped, "1"},
+	{0x1D7E4, 0x1D7E4, idnaMapped, "2"},
+	{0x1D7E5, 0x1D7E5, idnaMapped, "3"},
+	{0x1D7E6, 0x1D7E6, idnaMapped, "4"},
+	{0x1D7E7, 0x1D7E7, idnaMapped, "5"},
+	{0x1D7E8, 0x1D7E8, idnaMapped, "6"},
+	{0x1D7E9, 0x1D7E9, idnaMapped, "7"},
+	{0x1D7EA, 0x1D7EA, idnaMapped, "8"},
+	{0x1D7EB, 0x1D7EB, idnaMapped, "9"},
+	{0x1D7EC, 0x1D7EC, idnaMapped, "0"},
+	{0x1D7ED, 0x1D7ED, idnaMapped, "1"},
+	{0x1D7EE, 0x1D7EE, idnaMapped, "2"},
+	{0x1D7EF, 0x1D7EF, idnaMapped, "3"},
+	{0x1D7F0, 0x1D7F0, idnaMapped, "4"},
+	{0x1D7F1, 0x1D7F1, idnaMapped, "5"},
+	{0x1D7F2, 0x1D7F2, idnaMapped, "6"},
+	{0x1D7F3, 0x1D7F3, idnaMapped, "7"},
+	{0x1D7F4, 0x1D7F4, idnaMapped, "8"},
+	{0x1D7F5, 0x1D7F5, idnaMapped, "9"},
+	{0x1D7F6, 0x1D7F6, idnaMapped, "0"},
+	{0x1D7F7, 0x1D7F7, idnaMapped, "1"},
+	{0x1D7F8, 0x1D7F8, idnaMapped, "2"},
+	{0x1D7F9, 0x1D7F9, idnaMapped, "3"},
+	{0x1D7FA, 0x1D7FA, idnaMapped, "4"},
+	{0x1D7FB, 0x1D7FB, idnaMapped, "5"},
+	{0x1D7FC, 0x1D7FC, idnaMapped, "6"},
+	{0x1D7FD, 0x1D7FD, idnaMapped, "7"},
+	{0x1D7FE, 0x1D7FE, idnaMapped, "8"},
+	{0x1D7FF, 0x1D7FF, idnaMapped, "9"},
+	{0x1D800, 0x1DA8B, idnaValid, ""},
+	{0x1DA8C, 0x1DA9A, idnaDisallowed, ""},
+	{0x1DA9B, 0x1DA9F, idnaValid, ""},
+	{0x1DAA0, 0x1DAA0, idnaDisallowed, ""},
+	{0x1DAA1, 0x1DAAF, idnaValid, ""},
+	{0x1DAB0, 0x1DEFF, idnaDisallowed, ""},
+	{0x1DF00, 0x1DF1E, idnaValid, ""},
+	{0x1DF1F, 0x1DF24, idnaDisallowed, ""},
+	{0x1DF25, 0x1DF2A, idnaValid, ""},
+	{0x1DF2B, 0x1DFFF, idnaDisallowed, ""},
+	{0x1E000, 0x1E006, idnaValid, ""},
+	{0x1E007, 0x1E007, idnaDisallowed, ""},
+	{0x1E008, 0x1E018, idnaValid, ""},
+	{0x1E019, 0x1E01A, idnaDisallowed, ""},
+	{0x1E01B, 0x1E021, idnaValid, ""},
+	{0x1E022, 0x1E022, idnaDisallowed, ""},
+	{0x1E023, 0x1E024, idnaValid, ""},
+	{0x1E025, 0x1E025, idnaDisallowed, ""},
+	{0x1E026, 0x1E02A, idnaValid, ""},
+	{0x1E02B, 0x1E02F, idnaDisallowed, ""},
+	{0x1E030, 0x1E030, idnaMapped, "\u0430"},
+	{0x1E031, 0x1E031, idnaMapped, "\u0431"},
+	{0x1E032, 0x1E032, idnaMapped, "\u0432"},
+	{0x1E033, 0x1E033, idnaMapped, "\u0433"},
+	{0x1E034, 0x1E034, idnaMapped, "\u0434"},
+	{0x1E035, 0x1E035, idnaMapped, "\u0435"},
+	{0x1E036, 0x1E036, idnaMapped, "\u0436"},
+	{0x1E037, 0x1E037, idnaMapped, "\u0437"},
+	{0x1E038, 0x1E038, idnaMapped, "\u0438"},
+	{0x1E039, 0x1E039, idnaMapped, "\u043a"},
+	{0x1E03A, 0x1E03A, idnaMapped, "\u043b"},
+	{0x1E03B, 0x1E03B, idnaMapped, "\u043c"},
+	{0x1E03C, 0x1E03C, idnaMapped, "\u043e"},
+	{0x1E03D, 0x1E03D, idnaMapped, "\u043f"},
+	{0x1E03E, 0x1E03E, idnaMapped, "\u0440"},
+	{0x1E03F, 0x1E03F, idnaMapped, "\u0441"},
+	{0x1E040, 0x1E040, idnaMapped, "\u0442"},
+	{0x1E041, 0x1E041, idnaMapped, "\u0443"},
+	{0x1E042, 0x1E042, idnaMapped, "\u0444"},
+	{0x1E043, 0x1E043, idnaMapped, "\u0445"},
+	{0x1E044, 0x1E044, idnaMapped, "\u0446"},
+	{0x1E045, 0x1E045, idnaMapped, "\u0447"},
+	{0x1E046, 0x1E046, idnaMapped, "\u0448"},
+	{0x1E047, 0x1E047, idnaMapped, "\u044b"},
+	{0x1E048, 0x1E048, idnaMapped, "\u044d"},
+	{0x1E049, 0x1E049, idnaMapped, "\u044e"},
+	{0x1E04A, 0x1E04A, idnaMapped, "\ua689"},
+	{0x1E04B, 0x1E04B, idnaMapped, "\u04d9"},
+	{0x1E04C, 0x1E04C, idnaMapped, "\u0456"},
+	{0x1E04D, 0x1E04D, idnaMapped, "\u0458"},
+	{0x1E04E, 0x1E04E, idnaMapped, "\u04e9"},
+	{0x1E04F, 0x1E04F, idnaMapped, "\u04af"},
+	{0x1E050, 0x1E050, idnaMapped, "\u04cf"},
+	{0x1E051, 0x1E051, idnaMapped, "\u0430"},
+	{0x1E052, 0x1E052, idnaMapped, "\u0431"},
+	{0x1E053, 0x1E053, idnaMapped, "\u0432"},
+	{0x1E054, 0x1E054, idnaMapped, "\u0433"},
+	{0x1E055, 0x1E055, idnaMapped, "\u0434"},
+	{0x1E056, 0x1E056, idnaMapped, "\u0435"},
+	{0x1E057, 0x1E057, idnaMapped, "\u0436"},
+	{0x1E058, 0x1E058, idnaMapped, "\u0437"},
+	{0x1E059, 0x1E059, idnaMapped, "\u0438"},
+	{0x1E05A, 0x1E05A, idnaMapped, "\u043a"},
+	{0x1E05B, 0x1E05B, idnaMapped, "\u043b"},
+	{0x1E05C, 0x1E05C, idnaMapped, "\u043e"},
+	{0x1E05D, 0x1E05D, idnaMapped, "\u043f"},
+	{0x1E05E, 0x1E05E, idnaMapped, "\u0441"},
+	{0x1E05F, 0x1E05F, idnaMapped, "\u0443"},
+	{0x1E060, 0x1E060, idnaMapped, "\u0444"},
+	{0x1E061, 0x1E061, idnaMapped, "\u0445"},
+	{0x1E062, 0x1E062, idnaMapped, "\u0446"},
+	{0x1E063, 0x1E063, idnaMapped, "\u0447"},
+	{0x1E064, 0x1E064, idnaMapped, "\u0448"},
+	{0x1E065, 0x1E065, idnaMapped, "\u044a"},
+	{0x1E066, 0x1E066, idnaMapped, "\u044b"},
+	{0x1E067, 0x1E067, idnaMapped, "\u0491"},
+	{0x1E068, 0x1E068, idnaMapped, "\u0456"},
+	{0x1E069, 0x1E069, idnaMapped, "\u0455"},
+	{0x1E06A, 0x1E06A, idnaMapped, "\u045f"},
+	{0x1E06B, 0x1E06B, idnaMapped, "\u04ab"},
+	{0x1E06C, 0x1E06C, idnaMapped, "\ua651"},
+	{0x1E06D, 0x1E06D, idnaMapped, "\u04b1"},
+	{0x1E06E, 0x1E08E, idnaDisallowed, ""},
+	{0x1E08F, 0x1E08F, idnaValid, ""},
+	{0x1E090, 0x1E0FF, idnaDisallowed, ""},
+	{0x1E100, 0x1E12C, idnaValid, ""},
+	{0x1E12D, 0x1E12F, idnaDisallowed, ""},
+	{0x1E130, 0x1E13D, idnaValid, ""},
+	{0x1E13E, 0x1E13F, idnaDisallowed, ""},
+	{0x1E140, 0x1E149, idnaValid, ""},
+	{0x1E14A, 0x1E14D, idnaDisallowed, ""},
+	{0x1E14E, 0x1E14F, idnaValid, ""},
+	{0x1E150, 0x1E28F, idnaDisallowed, ""},
+	{0x1E290, 0x1E2AE, idnaValid, ""},
+	{0x1E2AF, 0x1E2BF, idnaDisallowed, ""},
+	{0x1E2C0, 0x1E2F9, idnaValid, ""},
+	{0x1E2FA, 0x1E2FE, idnaDisallowed, ""},
+	{0x1E2FF, 0x1E2FF, idnaValid, ""},
+	{0x1E300, 0x1E4CF, idnaDisallowed, ""},
+	{0x1E4D0, 0x1E4F9, idnaValid, ""},
+	{0x1E4FA, 0x1E5CF, idnaDisallowed, ""},
+	{0x1E5D0, 0x1E5FA, idnaValid, ""},
+	{0x1E5FB, 0x1E5FE, idnaDisallowed, ""},
+	{0x1E5FF, 0x1E5FF, idnaValid, ""},
+	{0x1E600, 0x1E6BF, idnaDisallowed, ""},
+	{0x1E6C0, 0x1E6DE, idnaValid, ""},
+	{0x1E6DF, 0x1E6DF, idnaDisallowed, ""},
+	{0x1E6E0, 0x1E6F5, idnaValid, ""},
+	{0x1E6F6, 0x1E6FD, idnaDisallowed, ""},
+	{0x1E6FE, 0x1E6FF, idnaValid, ""},
+	{0x1E700, 0x1E7DF, idnaDisallowed, ""},
+	{0x1E7E0, 0x1E7E6, idnaValid, ""},
+	{0x1E7E7, 0x1E7E7, idnaDisallowed, ""},
+	{0x1E7E8, 0x1E7EB, idnaValid, ""},
+	{0x1E7EC, 0x1E7EC, idnaDisallowed, ""},
+	{0x1E7ED, 0x1E7EE, idnaValid, ""},
+	{0x1E7EF, 0x1E7EF, idnaDisallowed, ""},
+	{0x1E7F0, 0x1E7FE, idnaValid, ""},
+	{0x1E7FF, 0x1E7FF, idnaDisallowed, ""},
+	{0x1E800, 0x1E8C4, idnaValid, ""},
+	{0x1E8C5, 0x1E8C6, idnaDisallowed, ""},
+	{0x1E8C7, 0x1E8D6, idnaValid, ""},
+	{0x1E8D7, 0x1E8FF, idnaDisallowed, ""},
+	{0x1E900, 0x1E900, idnaMapped, "\U0001e922"},
+	{0x1E901, 0x1E901, idnaMapped, "\U0001e923"},
+	{0x1E902, 0x1E902, idnaMapped, "\U0001e924"},
+	{0x1E903, 0x1E903, idnaMapped, "\U0001e925"},
+	{0x1E904, 0x1E904, idnaMapped, "\U0001e926"},
+	{0x1E905, 0x1E905, idnaMapped, "\U0001e927"},
+	{0x1E906, 0x1E906, idnaMapped, "\U0001e928"},
+	{0x1E907, 0x1E907, idnaMapped, "\U0001e929"},
+	{0x1E908, 0x1E908, idnaMapped, "\U0001e92a"},
+	{0x1E909, 0x1E909, idnaMapped, "\U0001e92b"},
+	{0x1E90A, 0x1E90A, idnaMapped, "\U0001e92c"},
+	{0x1E90B, 0x1E90B, idnaMapped, "\U0001e92d"},
+	{0x1E90C, 0x1E90C, idnaMapped, "\U0001e92e"},
+	{0x1E90D, 0x1E90D, idnaMapped, "\U0001e92f"},
+	{0x1E90E, 0x1E90E, idnaMapped, "\U0001e930"},
+	{0x1E90F, 0x1E90F, idnaMapped, "\U0001e931"},
+	{0x1E910, 0x1E910, idnaMapped, "\U0001e932"},
+	{0x1E911, 0x1E911, idnaMapped, "\U0001e933"},
+	{0x1E912, 0x1E912, idnaMapped, "\U0001e934"},
+	{0x1E913, 0x1E913, idnaMapped, "\U0001e935"},
+	{0x1E914, 0x1E914, idnaMapped, "\U0001e936"},
+	{0x1E915, 0x1E915, idnaMapped, "\U0001e937"},
+	{0x1E916, 0x1E916, idnaMapped, "\U0001e938"},
+	{0x1E917, 0x1E917, idnaMapped, "\U0001e939"},
+	{0x1E918, 0x1E918, idnaMapped, "\U0001e93a"},
+	{0x1E919, 0x1E919, idnaMapped, "\U0001e93b"},
+	{0x1E91A, 0x1E91A, idnaMapped, "\U0001e93c"},
+	{0x1E91B, 0x1E91B, idnaMapped, "\U0001e93d"},
+	{0x1E91C, 0x1E91C, idnaMapped, "\U0001e93e"},
+	{0x1E91D, 0x1E91D, idnaMapped, "\U0001e93f"},
+	{0x1E91E, 0x1E91E, idnaMapped, "\U0001e940"},
+	{0x1E91F, 0x1E91F, idnaMapped, "\U0001e941"},
+	{0x1E920, 0x1E920, idnaMapped, "\U0001e942"},
+	{0x1E921, 0x1E921, idnaMapped, "\U0001e943"},
+	{0x1E922, 0x1E94B, idnaValid, ""},
+	{0x1E94C, 0x1E94F, idnaDisallowed, ""},
+	{0x1E950, 0x1E959, idnaValid, ""},
+	{0x1E95A, 0x1E95D, idnaDisallowed, ""},
+	{0x1E95E, 0x1E95F, idnaValid, ""},
+	{0x1E960, 0x1EC70, idnaDisallowed, ""},
+	{0x1EC71, 0x1ECB4, idnaValid, ""},
+	{0x1ECB5, 0x1ED00, idnaDisallowed, ""},
+	{0x1ED01, 0x1ED3D, idnaValid, ""},
+	{0x1ED3E, 0x1EDFF, idnaDisallowed, ""},
+	{0x1EE00, 0x1EE00, idnaMapped, "\u0627"},
+	{0x1EE01, 0x1EE01, idnaMapped, "\u0628"},
+	{0x1EE02, 0x1EE02, idnaMapped, "\u062c"},
+	{0x1EE03, 0x1EE03, idnaMapped, "\u062f"},
+	{0x1EE04, 0x1EE04, idnaDisallowed, ""},
+	{0x1EE05, 0x1EE05, idnaMapped, "\u0648"},
+	{0x1EE06, 0x1EE06, idnaMapped, "\u0632"},
+	{0x1EE07, 0x1EE07, idnaMapped, "\u062d"},
+	{0x1EE08, 0x1EE08, idnaMapped, "\u0637"},
+	{0x1EE09, 0x1EE09, idnaMapped, "\u064a"},
+	{0x1EE0A, 0x1EE0A, idnaMapped, "\u0643"},
+	{0x1EE0B, 0x1EE0B, idnaMapped, "\u0644"},
+	{0x1EE0C, 0x1EE0C, idnaMapped, "\u0645"},
+	{0x1EE0D, 0x1EE0D, idnaMapped, "\u0646"},
+	{0x1EE0E, 0x1EE0E, idnaMapped, "\u0633"},
+	{0x1EE0F, 0x1EE0F, idnaMapped, "\u0639"},
+	{0x1EE10, 0x1EE10, idnaMapped, "\u0641"},
+	{0x1EE11, 0x1EE11, idnaMapped, "\u0635"},
+	{0x1EE12, 0x1EE12, idnaMapped, "\u0642"},
+	{0x1EE13, 0x1EE13, idnaMapped, "\u0631"},
+	{0x1EE14, 0x1EE14, idnaMapped, "\u0634"},
+	{0x1EE15, 0x1EE15, idnaMapped, "\u062a"},
+	{0x1EE16, 0x1EE16, idnaMapped, "\u062b"},
+	{0x1EE17, 0x1EE17, idnaMapped, "\u062e"},
+	{0x1EE18, 0x1EE18, idnaMapped, "\u0630"},
+	{0x1EE19, 0x1EE19, idnaMapped, "\u0636"},
+	{0x1EE1A, 0x1EE1A, idnaMapped, "\u0638"},
+	{0x1EE1B, 0x1EE1B, idnaMapped, "\u063a"},
+	{0x1EE1C, 0x1EE1C, idnaMapped, "\u066e"},
+	{0x1EE1D, 0x1EE1D, idnaMapped, "\u06ba"},
+	{0x1EE1E, 0x1EE1E, idnaMapped, "\u06a1"},
+	{0x1EE1F, 0x1EE1F, idnaMapped, "\u066f"},
+	{0x1EE20, 0x1EE20, idnaDisallowed, ""},
+	{0x1EE21, 0x1EE21, idnaMapped, "\u0628"},
+	{0x1EE22, 0x1EE22, idnaMapped, "\u062c"},
+	{0x1EE23, 0x1EE23, idnaDisallowed, ""},
+	{0x1EE24, 0x1EE24, idnaMapped, "\u0647"},
+	{0x1EE25, 0x1EE26, idnaDisallowed, ""},
+	{0x1EE27, 0x1EE27, idnaMapped, "\u062d"},
+	{0x1EE28, 0x1EE28, idnaDisallowed, ""},
+	{0x1EE29, 0x1EE29, idnaMapped, "\u064a"},
+	{0x1EE2A, 0x1EE2A, idnaMapped, "\u0643"},
+	{0x1EE2B, 0x1EE2B, idnaMapped, "\u0644"},
+	{0x1EE2C, 0x1EE2C, idnaMapped, "\u0645"},
+	{0x1EE2D, 0x1EE2D, idnaMapped, "\u0646"},
+	{0x1EE2E, 0x1EE2E, idnaMapped, "\u0633"},
+	{0x1EE2F, 0x1EE2F, idnaMapped, "\u0639"},
+	{0x1EE30, 0x1EE30, idnaMapped, "\u0641"},
+	{0x1EE31, 0x1EE31, idnaMapped, "\u0635"},
+	{0x1EE32, 0x1EE32, idnaMapped, "\u0642"},
+	{0x1EE33, 0x1EE33, idnaDisallowed, ""},
+	{0x1EE34, 0x1EE34, idnaMapped, "\u0634"},
+	{0x1EE35, 0x1EE35, idnaMapped, "\u062a"},
+	{0x1EE36, 0x1EE36, idnaMapped, "\u062b"},
+	{0x1EE37, 0x1EE37, idnaMapped, "\u062e"},
+	{0x1EE38, 0x1EE38, idnaDisallowed, ""},
+	{0x1EE39, 0x1EE39, idnaMapped, "\u0636"},
+	{0x1EE3A, 0x1EE3A, idnaDisallowed, ""},
+	{0x1EE3B, 0x1EE3B, idnaMapped, "\u063a"},
+	{0x1EE3C, 0x1EE41, idnaDisallowed, ""},
+	{0x1EE42, 0x1EE42, idnaMapped, "\u062c"},
+	{0x1EE43, 0x1EE46, idnaDisallowed, ""},
+	{0x1EE47, 0x1EE47, idnaMapped, "\u062d"},
+	{0x1EE48, 0x1EE48, idnaDisallowed, ""},
+	{0x1EE49, 0x1EE49, idnaMapped, "\u064a"},
+	{0x1EE4A, 0x1EE4A, idnaDisallowed, ""},
+	{0x1EE4B, 0x1EE4B, idnaMapped, "\u0644"},
+	{0x1EE4C, 0x1EE4C, idnaDisallowed, ""},
+	{0x1EE4D, 0x1EE4D, idnaMapped, "\u0646"},
+	{0x1EE4E, 0x1EE4E, idnaMapped, "\u0633"},
+	{0x1EE4F, 0x1EE4F, idnaMapped, "\u0639"},
+	{0x1EE50, 0x1EE50, idnaDisallowed, ""},
+	{0x1EE51, 0x1EE51, idnaMapped, "\u0635"},
+	{0x1EE52, 0x1EE52, idnaMapped, "\u0642"},
+	{0x1EE53, 0x1EE53, idnaDisallowed, ""},
+	{0x1EE54, 0x1EE54, idnaMapped, "\u0634"},
+	{0x1EE55, 0x1EE56, idnaDisallowed, ""},
+	{0x1EE57, 0x1EE57, idnaMapped, "\u062e"},
+	{0x1EE58, 0x1EE58, idnaDisallowed, ""},
+	{0x1EE59, 0x1EE59, idnaMapped, "\u0636"},
+	{0x1EE5A, 0x1EE5A, idnaDisallowed, ""},
+	{0x1EE5B, 0x1EE5B, idnaMapped, "\u063a"},
+	{0x1EE5C, 0x1EE5C, idnaDisallowed, ""},
+	{0x1EE5D, 0x1EE5D, idnaMapped, "\u06ba"},
+	{0x1EE5E, 0x1EE5E, idnaDisallowed, ""},
+	{0x1EE5F, 0x1EE5F, idnaMapped, "\u066f"},
+	{0x1EE60, 0x1EE60, idnaDisallowed, ""},
+	{0x1EE61, 0x1EE61, idnaMapped, "\u0628"},
+	{0x1EE62, 0x1EE62, idnaMapped, "\u062c"},
+	{0x1EE63, 0x1EE63, idnaDisallowed, ""},
+	{0x1EE64, 0x1EE64, idnaMapped, "\u0647"},
+	{0x1EE65, 0x1EE66, idnaDisallowed, ""},
+	{0x1EE67, 0x1EE67, idnaMapped, "\u062d"},
+	{0x1EE68, 0x1EE68, idnaMapped, "\u0637"},
+	{0x1EE69, 0x1EE69, idnaMapped, "\u064a"},
+	{0x1EE6A, 0x1EE6A, idnaMapped, "\u0643"},
+	{0x1EE6B, 0x1EE6B, idnaDisallowed, ""},
+	{0x1EE6C, 0x1EE6C, idnaMapped, "\u0645"},
+	{0x1EE6D, 0x1EE6D, idnaMapped, "\u0646"},
+	{0x1EE6E, 0x1EE6E, idnaMapped, "\u0633"},
+	{0x1EE6F, 0x1EE6F, idnaMapped, "\u0639"},
+	{0x1EE70, 0x1EE70, idnaMapped, "\u0641"},
+	{0x1EE71, 0x1EE71, idnaMapped, "\u0635"},
+	{0x1EE72, 0x1EE72, idnaMapped, "\u0642"},
+	{0x1EE73, 0x1EE73, idnaDisallowed, ""},
+	{0x1EE74, 0x1EE74, idnaMapped, "\u0634"},
+	{0x1EE75, 0x1EE75, idnaMapped, "\u062a"},
+	{0x1EE76, 0x1EE76, idnaMapped, "\u062b"},
+	{0x1EE77, 0x1EE77, idnaMapped, "\u062e"},
+	{0x1EE78, 0x1EE78, idnaDisallowed, ""},
+	{0x1EE79, 0x1EE79, idnaMapped, "\u0636"},
+	{0x1EE7A, 0x1EE7A, idnaMapped, "\u0638"},
+	{0x1EE7B, 0x1EE7B, idnaMapped, "\u063a"},
+	{0x1EE7C, 0x1EE7C, idnaMapped, "\u066e"},
+	{0x1EE7D, 0x1EE7D, idnaDisallowed, ""},
+	{0x1EE7E, 0x1EE7E, idnaMapped, "\u06a1"},
+	{0x1EE7F, 0x1EE7F, idnaDisallowed, ""},
+	{0x1EE80, 0x1EE80, idnaMapped, "\u0627"},
+	{0x1EE81, 0x1EE81, idnaMapped, "\u0628"},
+	{0x1EE82, 0x1EE82, idnaMapped, "\u062c"},
+	{0x1EE83, 0x1EE83, idnaMapped, "\u062f"},
+	{0x1EE84, 0x1EE84, idnaMapped, "\u0647"},
+	{0x1EE85, 0x1EE85, idnaMapped, "\u0648"},
+	{0x1EE86, 0x1EE86, idnaMapped, "\u0632"},
+	{0x1EE87, 0x1EE87, idnaMapped, "\u062d"},
+	{0x1EE88, 0x1EE88, idnaMapped, "\u0637"},
+	{0x1EE89, 0x1EE89, idnaMapped, "\u064a"},
+	{0x1EE8A, 0x1EE8A, idnaDisallowed, ""},
+	{0x1EE8B, 0x1EE8B, idnaMapped, "\u0644"},
+	{0x1EE8C, 0x1EE8C, idnaMapped, "\u0645"},
+	{0x1EE8D, 0x1EE8D, idnaMapped, "\u0646"},
+	{0x1EE8E, 0x1EE8E, idnaMapped, "\u0633"},
+	{0x1EE8F, 0x1EE8F, idnaMapped, "\u0639"},
+	{0x1EE90, 0x1EE90, idnaMapped, "\u0641"},
+	{0x1EE91, 0x1EE91, idnaMapped, "\u0635"},
+	{0x1EE92, 0x1EE92, idnaMapped, "\u0642"},
+	{0x1EE93, 0x1EE93, idnaMapped, "\u0631"},
+	{0x1EE94, 0x1EE94, idnaMapped, "\u0634"},
+	{0x1EE95, 0x1EE95, idnaMapped, "\u062a"},
+	{0x1EE96, 0x1EE96, idnaMapped, "\u062b"},
+	{0x1EE97, 0x1EE97, idnaMapped, "\u062e"},
+	{0x1EE98, 0x1EE98, idnaMapped, "\u0630"},
+	{0x1EE99, 0x1EE99, idnaMapped, "\u0636"},
+	{0x1EE9A, 0x1EE9A, idnaMapped, "\u0638"},
+	{0x1EE9B, 0x1EE9B, idnaMapped, "\u063a"},
+	{0x1EE9C, 0x1EEA0, idnaDisallowed, ""},
+	{0x1EEA1, 0x1EEA1, idnaMapped, "\u0628"},
+	{0x1EEA2, 0x1EEA2, idnaMapped, "\u062c"},
+	{0x1EEA3, 0x1EEA3, idnaMapped, "\u062f"},
+	{0x1EEA4, 0x1EEA4, idnaDisallowed, ""},
+	{0x1EEA5, 0x1EEA5, idnaMapped, "\u0648"},
+	{0x1EEA6, 0x1EEA6, idnaMapped, "\u0632"},
+	{0x1EEA7, 0x1EEA7, idnaMapped, "\u062d"},
+	{0x1EEA8, 0x1EEA8, idnaMapped, "\u0637"},
+	{0x1EEA9, 0x1EEA9, idnaMapped, "\u064a"},
+	{0x1EEAA, 0x1EEAA, idnaDisallowed, ""},
+	{0x1EEAB, 0x1EEAB, idnaMapped, "\u0644"},
+	{0x1EEAC, 0x1EEAC, idnaMapped, "\u0645"},
+	{0x1EEAD, 0x1EEAD, idnaMapped, "\u0646"},
+	{0x1EEAE, 0x1EEAE, idnaMapped, "\u0633"},
+	{0x1EEAF, 0x1EEAF, idnaMapped, "\u0639"},
+	{0x1EEB0, 0x1EEB0, idnaMapped, "\u0641"},
+	{0x1EEB1, 0x1EEB1, idnaMapped, "\u0635"},
+	{0x1EEB2, 0x1EEB2, idnaMapped, "\u0642"},
+	{0x1EEB3, 0x1EEB3, idnaMapped, "\u0631"},
+	{0x1EEB4, 0x1EEB4, idnaMapped, "\u0634"},
+	{0x1EEB5, 0x1EEB5, idnaMapped, "\u062a"},
+	{0x1EEB6, 0x1EEB6, idnaMapped, "\u062b"},
+	{0x1EEB7, 0x1EEB7, idnaMapped, "\u062e"},
+	{0x1EEB8, 0x1EEB8, idnaMapped, "\u0630"},
+	{0x1EEB9, 0x1EEB9, idnaMapped, "\u0636"},
+	{0x1EEBA, 0x1EEBA, idnaMapped, "\u0638"},
+	{0x1EEBB, 0x1EEBB, idnaMapped, "\u063a"},
+	{0x1EEBC, 0x1EEEF, idnaDisallowed, ""},
+	{0x1EEF0, 0x1EEF1, idnaValid, ""},
+	{0x1EEF2, 0x1EFFF, idnaDisallowed, ""},
+	{0x1F000, 0x1F02B, idnaValid, ""},
+	{0x1F02C, 0x1F02F, idnaDisallowed, ""},
+	{0x1F030, 0x1F093, idnaValid, ""},
+	{0x1F094, 0x1F09F, idnaDisallowed, ""},
+	{0x1F0A0, 0x1F0AE, idnaValid, ""},
+	{0x1F0AF, 0x1F0B0, idnaDisallowed, ""},
+	{0x1F0B1, 0x1F0BF, idnaValid, ""},
+	{0x1F0C0, 0x1F0C0, idnaDisallowed, ""},
+	{0x1F0C1, 0x1F0CF, idnaValid, ""},
+	{0x1F0D0, 0x1F0D0, idnaDisallowed, ""},
+	{0x1F0D1, 0x1F0F5, idnaValid, ""},
+	{0x1F0F6, 0x1F100, idnaDisallowed, ""},
+	{0x1F101, 0x1F101, idnaMapped, "0,"},
+	{0x1F102, 0x1F102, idnaMapped, "1,"},
+	{0x1F103, 0x1F103, idnaMapped, "2,"},
+	{0x1F104, 0x1F104, idnaMapped, "3,"},
+	{0x1F105, 0x1F105, idnaMapped, "4,"},
+	{0x1F106, 0x1F106, idnaMapped, "5,"},
+	{0x1F107, 0x1F107, idnaMapped, "6,"},
+	{0x1F108, 0x1F108, idnaMapped, "7,"},
+	{0x1F109, 0x1F109, idnaMapped, "8,"},
+	{0x1F10A, 0x1F10A, idnaMapped, "9,"},
+	{0x1F10B, 0x1F10F, idnaValid, ""},
+	{0x1F110, 0x1F110, idnaMapped, "(a)"},
+	{0x1F111, 0x1F111, idnaMapped, "(b)"},
+	{0x1F112, 0x1F112, idnaMapped, "(c)"},
+	{0x1F113, 0x1F113, idnaMapped, "(d)"},
+	{0x1F114, 0x1F114, idnaMapped, "(e)"},
+	{0x1F115, 0x1F115, idnaMapped, "(f)"},
+	{0x1F116, 0x1F116, idnaMapped, "(g)"},
+	{0x1F117, 0x1F117, idnaMapped, "(h)"},
+	{0x1F118, 0x1F118, idnaMapped, "(i)"},
+	{0x1F119, 0x1F119, idnaMapped, "(j)"},
+	{0x1F11A, 0x1F11A, idnaMapped, "(k)"},
+	{0x1F11B, 0x1F11B, idnaMapped, "(l)"},
+	{0x1F11C, 0x1F11C, idnaMapped, "(m)"},
+	{0x1F11D, 0x1F11D, idnaMapped, "(n)"},
+	{0x1F11E, 0x1F11E, idnaMapped, "(o)"},
+	{0x1F11F, 0x1F11F, idnaMapped, "(p)"},
+	{0x1F120, 0x1F120, idnaMapped, "(q)"},
+	{0x1F121, 0x1F121, idnaMapped, "(r)"},
+	{0x1F122, 0x1F122, idnaMapped, "(s)"},
+	{0x1F123, 0x1F123, idnaMapped, "(t)"},
+	{0x1F124, 0x1F124, idnaMapped, "(u)"},
+	{0x1F125, 0x1F125, idnaMapped, "(v)"},
+	{0x1F126, 0x1F126, idnaMapped, "(w)"},
+	{0x1F127, 0x1F127, idnaMapped, "(x)"},
+	{0x1F128, 0x1F128, idnaMapped, "(y)"},
+	{0x1F129, 0x1F129, idnaMapped, "(z)"},
+	{0x1F12A, 0x1F12A, idnaMapped, "\u3014s\u3015"},
+	{0x1F12B, 0x1F12B, idnaMapped, "c"},
+	{0x1F12C, 0x1F12C, idnaMapped, "r"},
+	{0x1F12D, 0x1F12D, idnaMapped, "cd"},
+	{0x1F12E, 0x1F12E, idnaMapped, "wz"},
+	{0x1F12F, 0x1F12F, idnaValid, ""},
+	{0x1F130, 0x1F130, idnaMapped, "a"},
+	{0x1F131, 0x1F131, idnaMapped, "b"},
+	{0x1F132, 0x1F132, idnaMapped, "c"},
+	{0x1F133, 0x1F133, idnaMapped, "d"},
+	{0x1F134, 0x1F134, idnaMapped, "e"},
+	{0x1F135, 0x1F135, idnaMapped, "f"},
+	{0x1F136, 0x1F136, idnaMapped, "g"},
+	{0x1F137, 0x1F137, idnaMapped, "h"},
+	{0x1F138, 0x1F138, idnaMapped, "i"},
+	{0x1F139, 0x1F139, idnaMapped, "j"},
+	{0x1F13A, 0x1F13A, idnaMapped, "k"},
+	{0x1F13B, 0x1F13B, idnaMapped, "l"},
+	{0x1F13C, 0x1F13C, idnaMapped, "m"},
+	{0x1F13D, 0x1F13D, idnaMapped, "n"},
+	{0x1F13E, 0x1F13E, idnaMapped, "o"},
+	{0x1F13F, 0x1F13F, idnaMapped, "p"},
+	{0x1F140, 0x1F140, idnaMapped, "q"},
+	{0x1F141, 0x1F141, idnaMapped, "r"},
+	{0x1F142, 0x1F142, idnaMapped, "s"},
+	{0x1F143, 0x1F143, idnaMapped, "t"},
+	{0x1F144, 0x1F144, idnaMapped, "u"},
+	{0x1F145, 0x1F145, idnaMapped, "v"},
+	{0x1F146, 0x1F146, idnaMapped, "w"},
+	{0x1F147, 0x1F147, idnaMapped, "x"},
+	{0x1F148, 0x1F148, idnaMapped, "y"},
+	{0x1F149, 0x1F149, idnaMapped, "z"},
+	{0x1F14A, 0x1F14A, idnaMapped, "hv"},
+	{0x1F14B, 0x1F14B, idnaMapped, "mv"},
+	{0x1F14C, 0x1F14C, idnaMapped, "sd"},
+	{0x1F14D, 0x1F14D, idnaMapped, "ss"},
+	{0x1F14E, 0x1F14E, idnaMapped, "ppv"},
+	{0x1F14F, 0x1F14F, idnaMapped, "wc"},
+	{0x1F150, 0x1F169, idnaValid, ""},
+	{0x1F16A, 0x1F16A, idnaMapped, "mc"},
+	{0x1F16B, 0x1F16B, idnaMapped, "md"},
+	{0x1F16C, 0x1F16C, idnaMapped, "mr"},
+	{0x1F16D, 0x1F18F, idnaValid, ""},
+	{0x1F190, 0x1F190, idnaMapped, "dj"},
+	{0x1F191, 0x1F1AD, idnaValid, ""},
+	{0x1F1AE, 0x1F1E5, idnaDisallowed, ""},
+	{0x1F1E6, 0x1F1FF, idnaValid, ""},
+	{0x1F200, 0x1F200, idnaMapped, "\u307b\u304b"},
+	{0x1F201, 0x1F201, idnaMapped, "\u30b3\u30b3"},
+	{0x1F202, 0x1F202, idnaMapped, "\u30b5"},
+	{0x1F203, 0x1F20F, idnaDisallowed, ""},
+	{0x1F210, 0x1F210, idnaMapped, "\u624b"},
+	{0x1F211, 0x1F211, idnaMapped, "\u5b57"},
+	{0x1F212, 0x1F212, idnaMapped, "\u53cc"},
+	{0x1F213, 0x1F213, idnaMapped, "\u30c7"},
+	{0x1F214, 0x1F214, idnaMapped, "\u4e8c"},
+	{0x1F215, 0x1F215, idnaMapped, "\u591a"},
+	{0x1F216, 0x1F216, idnaMapped, "\u89e3"},
+	{0x1F217, 0x1F217, idnaMapped, "\u5929"},
+	{0x1F218, 0x1F218, idnaMapped, "\u4ea4"},
+	{0x1F219, 0x1F219, idnaMapped, "\u6620"},
+	{0x1F21A, 0x1F21A, idnaMapped, "\u7121"},
+	{0x1F21B, 0x1F21B, idnaMapped, "\u6599"},
+	{0x1F21C, 0x1F21C, idnaMapped, "\u524d"},
+	{0x1F21D, 0x1F21D, idnaMapped, "\u5f8c"},
+	{0x1F21E, 0x1F21E, idnaMapped, "\u518d"},
+	{0x1F21F, 0x1F21F, idnaMapped, "\u65b0"},
+	{0x1F220, 0x1F220, idnaMapped, "\u521d"},
+	{0x1F221, 0x1F221, idnaMapped, "\u7d42"},
+	{0x1F222, 0x1F222, idnaMapped, "\u751f"},
+	{0x1F223, 0x1F223, idnaMapped, "\u8ca9"},
+	{0x1F224, 0x1F224, idnaMapped, "\u58f0"},
+	{0x1F225, 0x1F225, idnaMapped, "\u5439"},
+	{0x1F226, 0x1F226, idnaMapped, "\u6f14"},
+	{0x1F227, 0x1F227, idnaMapped, "\u6295"},
+	{0x1F228, 0x1F228, idnaMapped, "\u6355"},
+	{0x1F229, 0x1F229, idnaMapped, "\u4e00"},
+	{0x1F22A, 0x1F22A, idnaMapped, "\u4e09"},
+	{0x1F22B, 0x1F22B, idnaMapped, "\u904a"},
+	{0x1F22C, 0x1F22C, idnaMapped, "\u5de6"},
+	{0x1F22D, 0x1F22D, idnaMapped, "\u4e2d"},
+	{0x1F22E, 0x1F22E, idnaMapped, "\u53f3"},
+	{0x1F22F, 0x1F22F, idnaMapped, "\u6307"},
+	{0x1F230, 0x1F230, idnaMapped, "\u8d70"},
+	{0x1F231, 0x1F231, idnaMapped, "\u6253"},
+	{0x1F232, 0x1F232, idnaMapped, "\u7981"},
+	{0x1F233, 0x1F233, idnaMapped, "\u7a7a"},
+	{0x1F234, 0x1F234, idnaMapped, "\u5408"},
+	{0x1F235, 0x1F235, idnaMapped, "\u6e80"},
+	{0x1F236, 0x1F236, idnaMapped, "\u6709"},
+	{0x1F237, 0x1F237, idnaMapped, "\u6708"},
+	{0x1F238, 0x1F238, idnaMapped, "\u7533"},
+	{0x1F239, 0x1F239, idnaMapped, "\u5272"},
+	{0x1F23A, 0x1F23A, idnaMapped, "\u55b6"},
+	{0x1F23B, 0x1F23B, idnaMapped, "\u914d"},
+	{0x1F23C, 0x1F23F, idnaDisallowed, ""},
+	{0x1F240, 0x1F240, idnaMapped, "\u3014\u672c\u3015"},
+	{0x1F241, 0x1F241, idnaMapped, "\u3014\u4e09\u3015"},
+	{0x1F242, 0x1F242, idnaMapped, "\u3014\u4e8c\u3015"},
+	{0x1F243, 0x1F243, idnaMapped, "\u3014\u5b89\u3015"},
+	{0x1F244, 0x1F244, idnaMapped, "\u3014\u70b9\u3015"},
+	{0x1F245, 0x1F245, idnaMapped, "\u3014\u6253\u3015"},
+	{0x1F246, 0x1F246, idnaMapped, "\u3014\u76d7\u3015"},
+	{0x1F247, 0x1F247, idnaMapped, "\u3014\u52dd\u3015"},
+	{0x1F248, 0x1F248, idnaMapped, "\u3014\u6557\u3015"},
+	{0x1F249, 0x1F24F, idnaDisallowed, ""},
+	{0x1F250, 0x1F250, idnaMapped, "\u5f97"},
+	{0x1F251, 0x1F251, idnaMapped, "\u53ef"},
+	{0x1F252, 0x1F25F, idnaDisallowed, ""},
+	{0x1F260, 0x1F265, idnaValid, ""},
+	{0x1F266, 0x1F2FF, idnaDisallowed, ""},
+	{0x1F300, 0x1F6D8, idnaValid, ""},
+	{0x1F6D9, 0x1F6DB, idnaDisallowed, ""},
+	{0x1F6DC, 0x1F6EC, idnaValid, ""},
+	{0x1F6ED, 0x1F6EF, idnaDisallowed, ""},
+	{0x1F6F0, 0x1F6FC, idnaValid, ""},
+	{0x1F6FD, 0x1F6FF, idnaDisallowed, ""},
+	{0x1F700, 0x1F7D9, idnaValid, ""},
+	{0x1F7DA, 0x1F7DF, idnaDisallowed, ""},
+	{0x1F7E0, 0x1F7EB, idnaValid, ""},
+	{0x1F7EC, 0x1F7EF, idnaDisallowed, ""},
+	{0x1F7F0, 0x1F7F0, idnaValid, ""},
+	{0x1F7F1, 0x1F7FF, idnaDisallowed, ""},
+	{0x1F800, 0x1F80B, idnaValid, ""},
+	{0x1F80C, 0x1F80F, idnaDisallowed, ""},
+	{0x1F810, 0x1F847, idnaValid, ""},
+	{0x1F848, 0x1F84F, idnaDisallowed, ""},
+	{0x1F850, 0x1F859, idnaValid, ""},
+	{0x1F85A, 0x1F85F, idnaDisallowed, ""},
+	{0x1F860, 0x1F887, idnaValid, ""},
+	{0x1F888, 0x1F88F, idnaDisallowed, ""},
+	{0x1F890, 0x1F8AD, idnaValid, ""},
+	{0x1F8AE, 0x1F8AF, idnaDisallowed, ""},
+	{0x1F8B0, 0x1F8BB, idnaValid, ""},
+	{0x1F8BC, 0x1F8BF, idnaDisallowed, ""},
+	{0x1F8C0, 0x1F8C1, idnaValid, ""},
+	{0x1F8C2, 0x1F8CF, idnaDisallowed, ""},
+	{0x1F8D0, 0x1F8D8, idnaValid, ""},
+	{0x1F8D9, 0x1F8FF, idnaDisallowed, ""},
+	{0x1F900, 0x1FA57, idnaValid, ""},
+	{0x1FA58, 0x1FA5F, idnaDisallowed, ""},
+	{0x1FA60, 0x1FA6D, idnaValid, ""},
+	{0x1FA6E, 0x1FA6F, idnaDisallowed, ""},
+	{0x1FA70, 0x1FA7C, idnaValid, ""},
+	{0x1FA7D, 0x1FA7F, idnaDisallowed, ""},
+	{0x1FA80, 0x1FA8A, idnaValid, ""},
+	{0x1FA8B, 0x1FA8D, idnaDisallowed, ""},
+	{0x1FA8E, 0x1FAC6, idnaValid, ""},
+	{0x1FAC7, 0x1FAC7, idnaDisallowed, ""},
+	{0x1FAC8, 0x1FAC8, idnaValid, ""},
+	{0x1FAC9, 0x1FACC, idnaDisallowed, ""},
+	{0x1FACD, 0x1FADC, idnaValid, ""},
+	{0x1FADD, 0x1FADE, idnaDisallowed, ""},
+	{0x1FADF, 0x1FAEA, idnaValid, ""},
+	{0x1FAEB, 0x1FAEE, idnaDisallowed, ""},
+	{0x1FAEF, 0x1FAF8, idnaValid, ""},
+	{0x1FAF9, 0x1FAFF, idnaDisallowed, ""},
+	{0x1FB00, 0x1FB92, idnaValid, ""},
+	{0x1FB93, 0x1FB93, idnaDisallowed, ""},
+	{0x1FB94, 0x1FBEF, idnaValid, ""},
+	{0x1FBF0, 0x1FBF0, idnaMapped, "0"},
+	{0x1FBF1, 0x1FBF1, idnaMapped, "1"},
+	{0x1FBF2, 0x1FBF2, idnaMapped, "2"},
+	{0x1FBF3, 0x1FBF3, idnaMapped, "3"},
+	{0x1FBF4, 0x1FBF4, idnaMapped, "4"},
+	{0x1FBF5, 0x1FBF5, idnaMapped, "5"},
+	{0x1FBF6, 0x1FBF6, idnaMapped, "6"},
+	{0x1FBF7, 0x1FBF7, idnaMapped, "7"},
+	{0x1FBF8, 0x1FBF8, idnaMapped, "8"},
+	{0x1FBF9, 0x1FBF9, idnaMapped, "9"},
+	{0x1FBFA, 0x1FBFA, idnaValid, ""},
+	{0x1FBFB, 0x1FFFF, idnaDisallowed, ""},
+	{0x20000, 0x2A6DF, idnaValid, ""},
+	{0x2A6E0, 0x2A6FF, idnaDisallowed, ""},
+	{0x2A700, 0x2B81D, idnaValid, ""},
+	{0x2B81E, 0x2B81F, idnaDisallowed, ""},
+	{0x2B820, 0x2CEAD, idnaValid, ""},
+	{0x2CEAE, 0x2CEAF, idnaDisallowed, ""},
+	{0x2CEB0, 0x2EBE0, idnaValid, ""},
+	{0x2EBE1, 0x2EBEF, idnaDisallowed, ""},
+	{0x2EBF0, 0x2EE5D, idnaValid, ""},
+	{0x2EE5E, 0x2F7FF, idnaDisallowed, ""},
+	{0x2F800, 0x2F800, idnaMapped, "\u4e3d"},
+	{0x2F801, 0x2F801, idnaMapped, "\u4e38"},
+	{0x2F802, 0x2F802, idnaMapped, "\u4e41"},
+	{0x2F803, 0x2F803, idnaMapped, "\U00020122"},
+	{0x2F804, 0x2F804, idnaMapped, "\u4f60"},
+	{0x2F805, 0x2F805, idnaMapped, "\u4fae"},
+	{0x2F806, 0x2F806, idnaMapped, "\u4fbb"},
+	{0x2F807, 0x2F807, idnaMapped, "\u5002"},
+	{0x2F808, 0x2F808, idnaMapped, "\u507a"},
+	{0x2F809, 0x2F809, idnaMapped, "\u5099"},
+	{0x2F80A, 0x2F80A, idnaMapped, "\u50e7"},
+	{0x2F80B, 0x2F80B, idnaMapped, "\u50cf"},
+	{0x2F80C, 0x2F80C, idnaMapped, "\u349e"},
+	{0x2F80D, 0x2F80D, idnaMapped, "\U0002063a"},
+	{0x2F80E, 0x2F80E, idnaMapped, "\u514d"},
+	{0x2F80F, 0x2F80F, idnaMapped, "\u5154"},
+	{0x2F810, 0x2F810, idnaMapped, "\u5164"},
+	{0x2F811, 0x2F811, idnaMapped, "\u5177"},
+	{0x2F812, 0x2F812, idnaMapped, "\U0002051c"},
+	{0x2F813, 0x2F813, idnaMapped, "\u34b9"},
+	{0x2F814, 0x2F814, idnaMapped, "\u5167"},
+	{0x2F815, 0x2F815, idnaMapped, "\u518d"},
+	{0x2F816, 0x2F816, idnaMapped, "\U0002054b"},
+	{0x2F817, 0x2F817, idnaMapped, "\u5197"},
+	{0x2F818, 0x2F818, idnaMapped, "\u51a4"},
+	{0x2F819, 0x2F819, idnaMapped, "\u4ecc"},
+	{0x2F81A, 0x2F81A, idnaMapped, "\u51ac"},
+	{0x2F81B, 0x2F81B, idnaMapped, "\u51b5"},
+	{0x2F81C, 0x2F81C, idnaMapped, "\U000291df"},
+	{0x2F81D, 0x2F81D, idnaMapped, "\u51f5"},
+	{0x2F81E, 0x2F81E, idnaMapped, "\u5203"},
+	{0x2F81F, 0x2F81F, idnaMapped, "\u34df"},
+	{0x2F820, 0x2F820, idnaMapped, "\u523b"},
+	{0x2F821, 0x2F821, idnaMapped, "\u5246"},
+	{0x2F822, 0x2F822, idnaMapped, "\u5272"},
+	{0x2F823, 0x2F823, idnaMapped, "\u5277"},
+	{0x2F824, 0x2F824, idnaMapped, "\u3515"},
+	{0x2F825, 0x2F825, idnaMapped, "\u52c7"},
+	{0x2F826, 0x2F826, idnaMapped, "\u52c9"},
+	{0x2F827, 0x2F827, idnaMapped, "\u52e4"},
+	{0x2F828, 0x2F828, idnaMapped, "\u52fa"},
+	{0x2F829, 0x2F829, idnaMapped, "\u5305"},
+	{0x2F82A, 0x2F82A, idnaMapped, "\u5306"},
+	{0x2F82B, 0x2F82B, idnaMapped, "\u5317"},
+	{0x2F82C, 0x2F82C, idnaMapped, "\u5349"},
+	{0x2F82D, 0x2F82D, idnaMapped, "\u5351"},
+	{0x2F82E, 0x2F82E, idnaMapped, "\u535a"},
+	{0x2F82F, 0x2F82F, idnaMapped, "\u5373"},
+	{0x2F830, 0x2F830, idnaMapped, "\u537d"},
+	{0x2F831, 0x2F831, idnaMapped, "\u537f"},
+	{0x2F832, 0x2F832, idnaMapped, "\u537f"},
+	{0x2F833, 0x2F833, idnaMapped, "\u537f"},
+	{0x2F834, 0x2F834, idnaMapped, "\U00020a2c"},
+	{0x2F835, 0x2F835, idnaMapped, "\u7070"},
+	{0x2F836, 0x2F836, idnaMapped, "\u53ca"},
+	{0x2F837, 0x2F837, idnaMapped, "\u53df"},
+	{0x2F838, 0x2F838, idnaMapped, "\U00020b63"},
+	{0x2F839, 0x2F839, idnaMapped, "\u53eb"},
+	{0x2F83A, 0x2F83A, idnaMapped, "\u53f1"},
+	{0x2F83B, 0x2F83B, idnaMapped, "\u5406"},
+	{0x2F83C, 0x2F83C, idnaMapped, "\u549e"},
+	{0x2F83D, 0x2F83D, idnaMapped, "\u5438"},
+	{0x2F83E, 0x2F83E, idnaMapped, "\u5448"},
+	{0x2F83F, 0x2F83F, idnaMapped, "\u5468"},
+	{0x2F840, 0x2F840, idnaMapped, "\u54a2"},
+	{0x2F841, 0x2F841, idnaMapped, "\u54f6"},
+	{0x2F842, 0x2F842, idnaMapped, "\u5510"},
+	{0x2F843, 0x2F843, idnaMapped, "\u5553"},
+	{0x2F844, 0x2F844, idnaMapped, "\u5563"},
+	{0x2F845, 0x2F845, idnaMapped, "\u5584"},
+	{0x2F846, 0x2F846, idnaMapped, "\u5584"},
+	{0x2F847, 0x2F847, idnaMapped, "\u5599"},
+	{0x2F848, 0x2F848, idnaMapped, "\u55ab"},
+	{0x2F849, 0x2F849, idnaMapped, "\u55b3"},
+	{0x2F84A, 0x2F84A, idnaMapped, "\u55c2"},
+	{0x2F84B, 0x2F84B, idnaMapped, "\u5716"},
+	{0x2F84C, 0x2F84C, idnaMapped, "\u5606"},
+	{0x2F84D, 0x2F84D, idnaMapped, "\u5717"},
+	{0x2F84E, 0x2F84E, idnaMapped, "\u5651"},
+	{0x2F84F, 0x2F84F, idnaMapped, "\u5674"},
+	{0x2F850, 0x2F850, idnaMapped, "\u5207"},
+	{0x2F851, 0x2F851, idnaMapped, "\u58ee"},
+	{0x2F852, 0x2F852, idnaMapped, "\u57ce"},
+	{0x2F853, 0x2F853, idnaMapped, "\u57f4"},
+	{0x2F854, 0x2F854, idnaMapped, "\u580d"},
+	{0x2F855, 0x2F855, idnaMapped, "\u578b"},
+	{0x2F856, 0x2F856, idnaMapped, "\u5832"},
+	{0x2F857, 0x2F857, idnaMapped, "\u5831"},
+	{0x2F858, 0x2F858, idnaMapped, "\u58ac"},
+	{0x2F859, 0x2F859, idnaMapped, "\U000214e4"},
+	{0x2F85A, 0x2F85A, idnaMapped, "\u58f2"},
+	{0x2F85B, 0x2F85B, idnaMapped, "\u58f7"},
+	{0x2F85C, 0x2F85C, idnaMapped, "\u5906"},
+	{0x2F85D, 0x2F85D, idnaMapped, "\u591a"},
+	{0x2F85E, 0x2F85E, idnaMapped, "\u5922"},
+	{0x2F85F, 0x2F85F, idnaMapped, "\u5962"},
+	{0x2F860, 0x2F860, idnaMapped, "\U000216a8"},
+	{0x2F861, 0x2F861, idnaMapped, "\U000216ea"},
+	{0x2F862, 0x2F862, idnaMapped, "\u59ec"},
+	{0x2F863, 0x2F863, idnaMapped, "\u5a1b"},
+	{0x2F864, 0x2F864, idnaMapped, "\u5a27"},
+	{0x2F865, 0x2F865, idnaMapped, "\u59d8"},
+	{0x2F866, 0x2F866, idnaMapped, "\u5a66"},
+	{0x2F867, 0x2F867, idnaMapped, "\u36ee"},
+	{0x2F868, 0x2F868, idnaMapped, "\u36fc"},
+	{0x2F869, 0x2F869, idnaMapped, "\u5b08"},
+	{0x2F86A, 0x2F86A, idnaMapped, "\u5b3e"},
+	{0x2F86B, 0x2F86B, idnaMapped, "\u5b3e"},
+	{0x2F86C, 0x2F86C, idnaMapped, "\U000219c8"},
+	{0x2F86D, 0x2F86D, idnaMapped, "\u5bc3"},
+	{0x2F86E, 0x2F86E, idnaMapped, "\u5bd8"},
+	{0x2F86F, 0x2F86F, idnaMapped, "\u5be7"},
+	{0x2F870, 0x2F870, idnaMapped, "\u5bf3"},
+	{0x2F871, 0x2F871, idnaMapped, "\U00021b18"},
+	{0x2F872, 0x2F872, idnaMapped, "\u5bff"},
+	{0x2F873, 0x2F873, idnaMapped, "\u5c06"},
+	{0x2F874, 0x2F874, idnaMapped, "\u5f53"},
+	{0x2F875, 0x2F875, idnaMapped, "\u5c22"},
+	{0x2F876, 0x2F876, idnaMapped, "\u3781"},
+	{0x2F877, 0x2F877, idnaMapped, "\u5c60"},
+	{0x2F878, 0x2F878, idnaMapped, "\u5c6e"},
+	{0x2F879, 0x2F879, idnaMapped, "\u5cc0"},
+	{0x2F87A, 0x2F87A, idnaMapped, "\u5c8d"},
+	{0x2F87B, 0x2F87B, idnaMapped, "\U00021de4"},
+	{0x2F87C, 0x2F87C, idnaMapped, "\u5d43"},
+	{0x2F87D, 0x2F87D, idnaMapped, "\U00021de6"},
+	{0x2F87E, 0x2F87E, idnaMapped, "\u5d6e"},
+	{0x2F87F, 0x2F87F, idnaMapped, "\u5d6b"},
+	{0x2F880, 0x2F880, idnaMapped, "\u5d7c"},
+	{0x2F881, 0x2F881, idnaMapped, "\u5de1"},
+	{0x2F882, 0x2F882, idnaMapped, "\u5de2"},
+	{0x2F883, 0x2F883, idnaMapped, "\u382f"},
+	{0x2F884, 0x2F884, idnaMapped, "\u5dfd"},
+	{0x2F885, 0x2F885, idnaMapped, "\u5e28"},
+	{0x2F886, 0x2F886, idnaMapped, "\u5e3d"},
+	{0x2F887, 0x2F887, idnaMapped, "\u5e69"},
+	{0x2F888, 0x2F888, idnaMapped, "\u3862"},
+	{0x2F889, 0x2F889, idnaMapped, "\U00022183"},
+	{0x2F88A, 0x2F88A, idnaMapped, "\u387c"},
+	{0x2F88B, 0x2F88B, idnaMapped, "\u5eb0"},
+	{0x2F88C, 0x2F88C, idnaMapped, "\u5eb3"},
+	{0x2F88D, 0x2F88D, idnaMapped, "\u5eb6"},
+	{0x2F88E, 0x2F88E, idnaMapped, "\u5eca"},
+	{0x2F88F, 0x2F88F, idnaMapped, "\U0002a392"},
+	{0x2F890, 0x2F890, idnaMapped, "\u5efe"},
+	{0x2F891, 0x2F891, idnaMapped, "\U00022331"},
+	{0x2F892, 0x2F892, idnaMapped, "\U00022331"},
+	{0x2F893, 0x2F893, idnaMapped, "\u8201"},
+	{0x2F894, 0x2F894, idnaMapped, "\u5f22"},
+	{0x2F895, 0x2F895, idnaMapped, "\u5f22"},
+	{0x2F896, 0x2F896, idnaMapped, "\u38c7"},
+	{0x2F897, 0x2F897, idnaMapped, "\U000232b8"},
+	{0x2F898, 0x2F898, idnaMapped, "\U000261da"},
+	{0x2F899, 0x2F899, idnaMapped, "\u5f62"},
+	{0x2F89A, 0x2F89A, idnaMapped, "\u5f6b"},
+	{0x2F89B, 0x2F89B, idnaMapped, "\u38e3"},
+	{0x2F89C, 0x2F89C, idnaMapped, "\u5f9a"},
+	{0x2F89D, 0x2F89D, idnaMapped, "\u5fcd"},
+	{0x2F89E, 0x2F89E, idnaMapped, "\u5fd7"},
+	{0x2F89F, 0x2F89F, idnaMapped, "\u5ff9"},
+	{0x2F8A0, 0x2F8A0, idnaMapped, "\u6081"},
+	{0x2F8A1, 0x2F8A1, idnaMapped, "\u393a"},
+	{0x2F8A2, 0x2F8A2, idnaMapped, "\u391c"},
+	{0x2F8A3, 0x2F8A3, idnaMapped, "\u6094"},
+	{0x2F8A4, 0x2F8A4, idnaMapped, "\U000226d4"},
+	{0x2F8A5, 0x2F8A5, idnaMapped, "\u60c7"},
+	{0x2F8A6, 0x2F8A6, idnaMapped, "\u6148"},
+	{0x2F8A7, 0x2F8A7, idnaMapped, "\u614c"},
+	{0x2F8A8, 0x2F8A8, idnaMapped, "\u614e"},
+	{0x2F8A9, 0x2F8A9, idnaMapped, "\u614c"},
+	{0x2F8AA, 0x2F8AA, idnaMapped, "\u617a"},
+	{0x2F8AB, 0x2F8AB, idnaMapped, "\u618e"},
+	{0x2F8AC, 0x2F8AC, idnaMapped, "\u61b2"},
+	{0x2F8AD, 0x2F8AD, idnaMapped, "\u61a4"},
+	{0x2F8AE, 0x2F8AE, idnaMapped, "\u61af"},
+	{0x2F8AF, 0x2F8AF, idnaMapped, "\u61de"},
+	{0x2F8B0, 0x2F8B0, idnaMapped, "\u61f2"},
+	{0x2F8B1, 0x2F8B1, idnaMapped, "\u61f6"},
+	{0x2F8B2, 0x2F8B2, idnaMapped, "\u6210"},
+	{0x2F8B3, 0x2F8B3, idnaMapped, "\u621b"},
+	{0x2F8B4, 0x2F8B4, idnaMapped, "\u625d"},
+	{0x2F8B5, 0x2F8B5, idnaMapped, "\u62b1"},
+	{0x2F8B6, 0x2F8B6, idnaMapped, "\u62d4"},
+	{0x2F8B7, 0x2F8B7, idnaMapped, "\u6350"},
+	{0x2F8B8, 0x2F8B8, idnaMapped, "\U00022b0c"},
+	{0x2F8B9, 0x2F8B9, idnaMapped, "\u633d"},
+	{0x2F8BA, 0x2F8BA, idnaMapped, "\u62fc"},
+	{0x2F8BB, 0x2F8BB, idnaMapped, "\u6368"},
+	{0x2F8BC, 0x2F8BC, idnaMapped, "\u6383"},
+	{0x2F8BD, 0x2F8BD, idnaMapped, "\u63e4"},
+	{0x2F8BE, 0x2F8BE, idnaMapped, "\U00022bf1"},
+	{0x2F8BF, 0x2F8BF, idnaMapped, "\u6422"},
+	{0x2F8C0, 0x2F8C0, idnaMapped, "\u63c5"},
+	{0x2F8C1, 0x2F8C1, idnaMapped, "\u63a9"},
+	{0x2F8C2, 0x2F8C2, idnaMapped, "\u3a2e"},
+	{0x2F8C3, 0x2F8C3, idnaMapped, "\u6469"},
+	{0x2F8C4, 0x2F8C4, idnaMapped, "\u647e"},
+	{0x2F8C5, 0x2F8C5, idnaMapped, "\u649d"},
+	{0x2F8C6, 0x2F8C6, idnaMapped, "\u6477"},
+	{0x2F8C7, 0x2F8C7, idnaMapped, "\u3a6c"},
+	{0x2F8C8, 0x2F8C8, idnaMapped, "\u654f"},
+	{0x2F8C9, 0x2F8C9, idnaMapped, "\u656c"},
+	{0x2F8CA, 0x2F8CA, idnaMapped, "\U0002300a"},
+	{0x2F8CB, 0x2F8CB, idnaMapped, "\u65e3"},
+	{0x2F8CC, 0x2F8CC, idnaMapped, "\u66f8"},
+	{0x2F8CD, 0x2F8CD, idnaMapped, "\u6649"},
+	{0x2F8CE, 0x2F8CE, idnaMapped, "\u3b19"},
+	{0x2F8CF, 0x2F8CF, idnaMapped, "\u6691"},
+	{0x2F8D0, 0x2F8D0, idnaMapped, "\u3b08"},
+	{0x2F8D1, 0x2F8D1, idnaMapped, "\u3ae4"},
+	{0x2F8D2, 0x2F8D2, idnaMapped, "\u5192"},
+	{0x2F8D3, 0x2F8D3, idnaMapped, "\u5195"},
+	{0x2F8D4, 0x2F8D4, idnaMapped, "\u6700"},
+	{0x2F8D5, 0x2F8D5, idnaMapped, "\u669c"},
+	{0x2F8D6, 0x2F8D6, idnaMapped, "\u80ad"},
+	{0x2F8D7, 0x2F8D7, idnaMapped, "\u43d9"},
+	{0x2F8D8, 0x2F8D8, idnaMapped, "\u6717"},
+	{0x2F8D9, 0x2F8D9, idnaMapped, "\u671b"},
+	{0x2F8DA, 0x2F8DA, idnaMapped, "\u6721"},
+	{0x2F8DB, 0x2F8DB, idnaMapped, "\u675e"},
+	{0x2F8DC, 0x2F8DC, idnaMapped, "\u6753"},
+	{0x2F8DD, 0x2F8DD, idnaMapped, "\U000233c3"},
+	{0x2F8DE, 0x2F8DE, idnaMapped, "\u3b49"},
+	{0x2F8DF, 0x2F8DF, idnaMapped, "\u67fa"},
+	{0x2F8E0, 0x2F8E0, idnaMapped, "\u6785"},
+	{0x2F8E1, 0x2F8E1, idnaMapped, "\u6852"},
+	{0x2F8E2, 0x2F8E2, idnaMapped, "\u6885"},
+	{0x2F8E3, 0x2F8E3, idnaMapped, "\U0002346d"},
+	{0x2F8E4, 0x2F8E4, idnaMapped, "\u688e"},
+	{0x2F8E5, 0x2F8E5, idnaMapped, "\u681f"},
+	{0x2F8E6, 0x2F8E6, idnaMapped, "\u6914"},
+	{0x2F8E7, 0x2F8E7, idnaMapped, "\u3b9d"},
+	{0x2F8E8, 0x2F8E8, idnaMapped, "\u6942"},
+	{0x2F8E9, 0x2F8E9, idnaMapped, "\u69a3"},
+	{0x2F8EA, 0x2F8EA, idnaMapped, "\u69ea"},
+	{0x2F8EB, 0x2F8EB, idnaMapped, "\u6aa8"},
+	{0x2F8EC, 0x2F8EC, idnaMapped, "\U000236a3"},
+	{0x2F8ED, 0x2F8ED, idnaMapped, "\u6adb"},
+	{0x2F8EE, 0x2F8EE, idnaMapped, "\u3c18"},
+	{0x2F8EF, 0x2F8EF, idnaMapped, "\u6b21"},
+	{0x2F8F0, 0x2F8F0, idnaMapped, "\U000238a7"},
+	{0x2F8F1, 0x2F8F1, idnaMapped, "\u6b54"},
+	{0x2F8F2, 0x2F8F2, idnaMapped, "\u3c4e"},
+	{0x2F8F3, 0x2F8F3, idnaMapped, "\u6b72"},
+	{0x2F8F4, 0x2F8F4, idnaMapped, "\u6b9f"},
+	{0x2F8F5, 0x2F8F5, idnaMapped, "\u6bba"},
+	{0x2F8F6, 0x2F8F6, idnaMapped, "\u6bbb"},
+	{0x2F8F7, 0x2F8F7, idnaMapped, "\U00023a8d"},
+	{0x2F8F8, 0x2F8F8, idnaMapped, "\U00021d0b"},
+	{0x2F8F9, 0x2F8F9, idnaMapped, "\U00023afa"},
+	{0x2F8FA, 0x2F8FA, idnaMapped, "\u6c4e"},
+	{0x2F8FB, 0x2F8FB, idnaMapped, "\U00023cbc"},
+	{0x2F8FC, 0x2F8FC, idnaMapped, "\u6cbf"},
+	{0x2F8FD, 0x2F8FD, idnaMapped, "\u6ccd"},
+	{0x2F8FE, 0x2F8FE, idnaMapped, "\u6c67"},
+	{0x2F8FF, 0x2F8FF, idnaMapped, "\u6d16"},
+	{0x2F900, 0x2F900, idnaMapped, "\u6d3e"},
+	{0x2F901, 0x2F901, idnaMapped, "\u6d77"},
+	{0x2F902, 0x2F902, idnaMapped, "\u6d41"},
+	{0x2F903, 0x2F903, idnaMapped, "\u6d69"},
+	{0x2F904, 0x2F904, idnaMapped, "\u6d78"},
+	{0x2F905, 0x2F905, idnaMapped, "\u6d85"},
+	{0x2F906, 0x2F906, idnaMapped, "\U00023d1e"},
+	{0x2F907, 0x2F907, idnaMapped, "\u6d34"},
+	{0x2F908, 0x2F908, idnaMapped, "\u6e2f"},
+	{0x2F909, 0x2F909, idnaMapped, "\u6e6e"},
+	{0x2F90A, 0x2F90A, idnaMapped, "\u3d33"},
+	{0x2F90B, 0x2F90B, idnaMapped, "\u6ecb"},
+	{0x2F90C, 0x2F90C, idnaMapped, "\u6ec7"},
+	{0x2F90D, 0x2F90D, idnaMapped, "\U00023ed1"},
+	{0x2F90E, 0x2F90E, idnaMapped, "\u6df9"},
+	{0x2F90F, 0x2F90F, idnaMapped, "\u6f6e"},
+	{0x2F910, 0x2F910, idnaMapped, "\U00023f5e"},
+	{0x2F911, 0x2F911, idnaMapped, "\U00023f8e"},
+	{0x2F912, 0x2F912, idnaMapped, "\u6fc6"},
+	{0x2F913, 0x2F913, idnaMapped, "\u7039"},
+	{0x2F914, 0x2F914, idnaMapped, "\u701e"},
+	{0x2F915, 0x2F915, idnaMapped, "\u701b"},
+	{0x2F916, 0x2F916, idnaMapped, "\u3d96"},
+	{0x2F917, 0x2F917, idnaMapped, "\u704a"},
+	{0x2F918, 0x2F918, idnaMapped, "\u707d"},
+	{0x2F919, 0x2F919, idnaMapped, "\u7077"},
+	{0x2F91A, 0x2F91A, idnaMapped, "\u70ad"},
+	{0x2F91B, 0x2F91B, idnaMapped, "\U00020525"},
+	{0x2F91C, 0x2F91C, idnaMapped, "\u7145"},
+	{0x2F91D, 0x2F91D, idnaMapped, "\U00024263"},
+	{0x2F91E, 0x2F91E, idnaMapped, "\u719c"},
+	{0x2F91F, 0x2F91F, idnaMapped, "\U000243ab"},
+	{0x2F920, 0x2F920, idnaMapped, "\u7228"},
+	{0x2F921, 0x2F921, idnaMapped, "\u7235"},
+	{0x2F922, 0x2F922, idnaMapped, "\u7250"},
+	{0x2F923, 0x2F923, idnaMapped, "\U00024608"},
+	{0x2F924, 0x2F924, idnaMapped, "\u7280"},
+	{0x2F925, 0x2F925, idnaMapped, "\u7295"},
+	{0x2F926, 0x2F926, idnaMapped, "\U00024735"},
+	{0x2F927, 0x2F927, idnaMapped, "\U00024814"},
+	{0x2F928, 0x2F928, idnaMapped, "\u737a"},
+	{0x2F929, 0x2F929, idnaMapped, "\u738b"},
+	{0x2F92A, 0x2F92A, idnaMapped, "\u3eac"},
+	{0x2F92B, 0x2F92B, idnaMapped, "\u73a5"},
+	{0x2F92C, 0x2F92C, idnaMapped, "\u3eb8"},
+	{0x2F92D, 0x2F92D, idnaMapped, "\u3eb8"},
+	{0x2F92E, 0x2F92E, idnaMapped, "\u7447"},
+	{0x2F92F, 0x2F92F, idnaMapped, "\u745c"},
+	{0x2F930, 0x2F930, idnaMapped, "\u7471"},
+	{0x2F931, 0x2F931, idnaMapped, "\u7485"},
+	{0x2F932, 0x2F932, idnaMapped, "\u74ca"},
+	{0x2F933, 0x2F933, idnaMapped, "\u3f1b"},
+	{0x2F934, 0x2F934, idnaMapped, "\u7524"},
+	{0x2F935, 0x2F935, idnaMapped, "\U00024c36"},
+	{0x2F936, 0x2F936, idnaMapped, "\u753e"},
+	{0x2F937, 0x2F937, idnaMapped, "\U00024c92"},
+	{0x2F938, 0x2F938, idnaMapped, "\u7570"},
+	{0x2F939, 0x2F939, idnaMapped, "\U0002219f"},
+	{0x2F93A, 0x2F93A, idnaMapped, "\u7610"},
+	{0x2F93B, 0x2F93B, idnaMapped, "\U00024fa1"},
+	{0x2F93C, 0x2F93C, idnaMapped, "\U00024fb8"},
+	{0x2F93D, 0x2F93D, idnaMapped, "\U00025044"},
+	{0x2F93E, 0x2F93E, idnaMapped, "\u3ffc"},
+	{0x2F93F, 0x2F93F, idnaMapped, "\u4008"},
+	{0x2F940, 0x2F940, idnaMapped, "\u76f4"},
+	{0x2F941, 0x2F941, idnaMapped, "\U000250f3"},
+	{0x2F942, 0x2F942, idnaMapped, "\U000250f2"},
+	{0x2F943, 0x2F943, idnaMapped, "\U00025119"},
+	{0x2F944, 0x2F944, idnaMapped, "\U00025133"},
+	{0x2F945, 0x2F945, idnaMapped, "\u771e"},
+	{0x2F946, 0x2F946, idnaMapped, "\u771f"},
+	{0x2F947, 0x2F947, idnaMapped, "\u771f"},
+	{0x2F948, 0x2F948, idnaMapped, "\u774a"},
+	{0x2F949, 0x2F949, idnaMapped, "\u4039"},
+	{0x2F94A, 0x2F94A, idnaMapped, "\u778b"},
+	{0x2F94B, 0x2F94B, idnaMapped, "\u4046"},
+	{0x2F94C, 0x2F94C, idnaMapped, "\u4096"},
+	{0x2F94D, 0x2F94D, idnaMapped, "\U0002541d"},
+	{0x2F94E, 0x2F94E, idnaMapped, "\u784e"},
+	{0x2F94F, 0x2F94F, idnaMapped, "\u788c"},
+	{0x2F950, 0x2F950, idnaMapped, "\u78cc"},
+	{0x2F951, 0x2F951, idnaMapped, "\u40e3"},
+	{0x2F952, 0x2F952, idnaMapped, "\U00025626"},
+	{0x2F953, 0x2F953, idnaMapped, "\u7956"},
+	{0x2F954, 0x2F954, idnaMapped, "\U0002569a"},
+	{0x2F955, 0x2F955, idnaMapped, "\U000256c5"},
+	{0x2F956, 0x2F956, idnaMapped, "\u798f"},
+	{0x2F957, 0x2F957, idnaMapped, "\u79eb"},
+	{0x2F958, 0x2F958, idnaMapped, "\u412f"},
+	{0x2F959, 0x2F959, idnaMapped, "\u7a40"},
+	{0x2F95A, 0x2F95A, idnaMapped, "\u7a4a"},
+	{0x2F95B, 0x2F95B, idnaMapped, "\u7a4f"},
+	{0x2F95C, 0x2F95C, idnaMapped, "\U0002597c"},
+	{0x2F95D, 0x2F95D, idnaMapped, "\U00025aa7"},
+	{0x2F95E, 0x2F95E, idnaMapped, "\U00025aa7"},
+	{0x2F95F, 0x2F95F, idnaMapped, "\u7aee"},
+	{0x2F960, 0x2F960, idnaMapped, "\u4202"},
+	{0x2F961, 0x2F961, idnaMapped, "\U00025bab"},
+	{0x2F962, 0x2F962, idnaMapped, "\u7bc6"},
+	{0x2F963, 0x2F963, idnaMapped, "\u7bc9"},
+	{0x2F964, 0x2F964, idnaMapped, "\u4227"},
+	{0x2F965, 0x2F965, idnaMapped, "\U00025c80"},
+	{0x2F966, 0x2F966, idnaMapped, "\u7cd2"},
+	{0x2F967, 0x2F967, idnaMapped, "\u42a0"},
+	{0x2F968, 0x2F968, idnaMapped, "\u7ce8"},
+	{0x2F969, 0x2F969, idnaMapped, "\u7ce3"},
+	{0x2F96A, 0x2F96A, idnaMapped, "\u7d00"},
+	{0x2F96B, 0x2F96B, idnaMapped, "\U00025f86"},
+	{0x2F96C, 0x2F96C, idnaMapped, "\u7d63"},
+	{0x2F96D, 0x2F96D, idnaMapped, "\u4301"},
+	{0x2F96E, 0x2F96E, idnaMapped, "\u7dc7"},
+	{0x2F96F, 0x2F96F, idnaMapped, "\u7e02"},
+	{0x2F970, 0x2F970, idnaMapped, "\u7e45"},
+	{0x2F971, 0x2F971, idnaMapped, "\u4334"},
+	{0x2F972, 0x2F972, idnaMapped, "\U00026228"},
+	{0x2F973, 0x2F973, idnaMapped, "\U00026247"},
+	{0x2F974, 0x2F974, idnaMapped, "\u4359"},
+	{0x2F975, 0x2F975, idnaMapped, "\U000262d9"},
+	{0x2F976, 0x2F976, idnaMapped, "\u7f7a"},
+	{0x2F977, 0x2F977, idnaMapped, "\U0002633e"},
+	{0x2F978, 0x2F978, idnaMapped, "\u7f95"},
+	{0x2F979, 0x2F979, idnaMapped, "\u7ffa"},
+	{0x2F97A, 0x2F97A, idnaMapped, "\u8005"},
+	{0x2F97B, 0x2F97B, idnaMapped, "\U000264da"},
+	{0x2F97C, 0x2F97C, idnaMapped, "\U00026523"},
+	{0x2F97D, 0x2F97D, idnaMapped, "\u8060"},
+	{0x2F97E, 0x2F97E, idnaMapped, "\U000265a8"},
+	{0x2F97F, 0x2F97F, idnaMapped, "\u8070"},
+	{0x2F980, 0x2F980, idnaMapped, "\U0002335f"},
+	{0x2F981, 0x2F981, idnaMapped, "\u43d5"},
+	{0x2F982, 0x2F982, idnaMapped, "\u80b2"},
+	{0x2F983, 0x2F983, idnaMapped, "\u8103"},
+	{0x2F984, 0x2F984, idnaMapped, "\u440b"},
+	{0x2F985, 0x2F985, idnaMapped, "\u813e"},
+	{0x2F986, 0x2F986, idnaMapped, "\u5ab5"},
+	{0x2F987, 0x2F987, idnaMapped, "\U000267a7"},
+	{0x2F988, 0x2F988, idnaMapped, "\U000267b5"},
+	{0x2F989, 0x2F989, idnaMapped, "\U00023393"},
+	{0x2F98A, 0x2F98A, idnaMapped, "\U0002339c"},
+	{0x2F98B, 0x2F98B, idnaMapped, "\u8201"},
+	{0x2F98C, 0x2F98C, idnaMapped, "\u8204"},
+	{0x2F98D, 0x2F98D, idnaMapped, "\u8f9e"},
+	{0x2F98E, 0x2F98E, idnaMapped, "\u446b"},
+	{0x2F98F, 0x2F98F, idnaMapped, "\u8291"},
+	{0x2F990, 0x2F990, idnaMapped, "\u828b"},
+	{0x2F991, 0x2F991, idnaMapped, "\u829d"},
+	{0x2F992, 0x2F992, idnaMapped, "\u52b3"},
+	{0x2F993, 0x2F993, idnaMapped, "\u82b1"},
+	{0x2F994, 0x2F994, idnaMapped, "\u82b3"},
+	{0x2F995, 0x2F995, idnaMapped, "\u82bd"},
+	{0x2F996, 0x2F996, idnaMapped, "\u82e6"},
+	{0x2F997, 0x2F997, idnaMapped, "\U00026b3c"},
+	{0x2F998, 0x2F998, idnaMapped, "\u82e5"},
+	{0x2F999, 0x2F999, idnaMapped, "\u831d"},
+	{0x2F99A, 0x2F99A, idnaMapped, "\u8363"},
+	{0x2F99B, 0x2F99B, idnaMapped, "\u83ad"},
+	{0x2F99C, 0x2F99C, idnaMapped, "\u8323"},
+	{0x2F99D, 0x2F99D, idnaMapped, "\u83bd"},
+	{0x2F99E, 0x2F99E, idnaMapped, "\u83e7"},
+	{0x2F99F, 0x2F99F, idnaMapped, "\u8457"},
+	{0x2F9A0, 0x2F9A0, idnaMapped, "\u8353"},
+	{0x2F9A1, 0x2F9A1, idnaMapped, "\u83ca"},
+	{0x2F9A2, 0x2F9A2, idnaMapped, "\u83cc"},
+	{0x2F9A3, 0x2F9A3, idnaMapped, "\u83dc"},
+	{0x2F9A4, 0x2F9A4, idnaMapped, "\U00026c36"},
+	{0x2F9A5, 0x2F9A5, idnaMapped, "\U00026d6b"},
+	{0x2F9A6, 0x2F9A6, idnaMapped, "\U00026cd5"},
+	{0x2F9A7, 0x2F9A7, idnaMapped, "\u452b"},
+	{0x2F9A8, 0x2F9A8, idnaMapped, "\u84f1"},
+	{0x2F9A9, 0x2F9A9, idnaMapped, "\u84f3"},
+	{0x2F9AA, 0x2F9AA, idnaMapped, "\u8516"},
+	{0x2F9AB, 0x2F9AB, idnaMapped, "\U000273ca"},
+	{0x2F9AC, 0x2F9AC, idnaMapped, "\u8564"},
+	{0x2F9AD, 0x2F9AD, idnaMapped, "\U00026f2c"},
+	{0x2F9AE, 0x2F9AE, idnaMapped, "\u455d"},
+	{0x2F9AF, 0x2F9AF, idnaMapped, "\u4561"},
+	{0x2F9B0, 0x2F9B0, idnaMapped, "\U00026fb1"},
+	{0x2F9B1, 0x2F9B1, idnaMapped, "\U000270d2"},
+	{0x2F9B2, 0x2F9B2, idnaMapped, "\u456b"},
+	{0x2F9B3, 0x2F9B3, idnaMapped, "\u8650"},
+	{0x2F9B4, 0x2F9B4, idnaMapped, "\u865c"},
+	{0x2F9B5, 0x2F9B5, idnaMapped, "\u8667"},
+	{0x2F9B6, 0x2F9B6, idnaMapped, "\u8669"},
+	{0x2F9B7, 0x2F9B7, idnaMapped, "\u86a9"},
+	{0x2F9B8, 0x2F9B8, idnaMapped, "\u8688"},
+	{0x2F9B9, 0x2F9B9, idnaMapped, "\u870e"},
+	{0x2F9BA, 0x2F9BA, idnaMapped, "\u86e2"},
+	{0x2F9BB, 0x2F9BB, idnaMapped, "\u8779"},
+	{0x2F9BC, 0x2F9BC, idnaMapped, "\u8728"},
+	{0x2F9BD, 0x2F9BD, idnaMapped, "\u876b"},
+	{0x2F9BE, 0x2F9BE, idnaMapped, "\u8786"},
+	{0x2F9BF, 0x2F9BF, idnaMapped, "\u45d7"},
+	{0x2F9C0, 0x2F9C0, idnaMapped, "\u87e1"},
+	{0x2F9C1, 0x2F9C1, idnaMapped, "\u8801"},
+	{0x2F9C2, 0x2F9C2, idnaMapped, "\u45f9"},
+	{0x2F9C3, 0x2F9C3, idnaMapped, "\u8860"},
+	{0x2F9C4, 0x2F9C4, idnaMapped, "\u8863"},
+	{0x2F9C5, 0x2F9C5, idnaMapped, "\U00027667"},
+	{0x2F9C6, 0x2F9C6, idnaMapped, "\u88d7"},
+	{0x2F9C7, 0x2F9C7, idnaMapped, "\u88de"},
+	{0x2F9C8, 0x2F9C8, idnaMapped, "\u4635"},
+	{0x2F9C9, 0x2F9C9, idnaMapped, "\u88fa"},
+	{0x2F9CA, 0x2F9CA, idnaMapped, "\u34bb"},
+	{0x2F9CB, 0x2F9CB, idnaMapped, "\U000278ae"},
+	{0x2F9CC, 0x2F9CC, idnaMapped, "\U00027966"},
+	{0x2F9CD, 0x2F9CD, idnaMapped, "\u46be"},
+	{0x2F9CE, 0x2F9CE, idnaMapped, "\u46c7"},
+	{0x2F9CF, 0x2F9CF, idnaMapped, "\u8aa0"},
+	{0x2F9D0, 0x2F9D0, idnaMapped, "\u8aed"},
+	{0x2F9D1, 0x2F9D1, idnaMapped, "\u8b8a"},
+	{0x2F9D2, 0x2F9D2, idnaMapped, "\u8c55"},
+	{0x2F9D3, 0x2F9D3, idnaMapped, "\U00027ca8"},
+	{0x2F9D4, 0x2F9D4, idnaMapped, "\u8cab"},
+	{0x2F9D5, 0x2F9D5, idnaMapped, "\u8cc1"},
+	{0x2F9D6, 0x2F9D6, idnaMapped, "\u8d1b"},
+	{0x2F9D7, 0x2F9D7, idnaMapped, "\u8d77"},
+	{0x2F9D8, 0x2F9D8, idnaMapped, "\U00027f2f"},
+	{0x2F9D9, 0x2F9D9, idnaMapped, "\U00020804"},
+	{0x2F9DA, 0x2F9DA, idnaMapped, "\u8dcb"},
+	{0x2F9DB, 0x2F9DB, idnaMapped, "\u8dbc"},
+	{0x2F9DC, 0x2F9DC, idnaMapped, "\u8df0"},
+	{0x2F9DD, 0x2F9DD, idnaMapped, "\U000208de"},
+	{0x2F9DE, 0x2F9DE, idnaMapped, "\u8ed4"},
+	{0x2F9DF, 0x2F9DF, idnaMapped, "\u8f38"},
+	{0x2F9E0, 0x2F9E0, idnaMapped, "\U000285d2"},
+	{0x2F9E1, 0x2F9E1, idnaMapped, "\U000285ed"},
+	{0x2F9E2, 0x2F9E2, idnaMapped, "\u9094"},
+	{0x2F9E3, 0x2F9E3, idnaMapped, "\u90f1"},
+	{0x2F9E4, 0x2F9E4, idnaMapped, "\u9111"},
+	{0x2F9E5, 0x2F9E5, idnaMapped, "\U0002872e"},
+	{0x2F9E6, 0x2F9E6, idnaMapped, "\u911b"},
+	{0x2F9E7, 0x2F9E7, idnaMapped, "\u9238"},
+	{0x2F9E8, 0x2F9E8, idnaMapped, "\u92d7"},
+	{0x2F9E9, 0x2F9E9, idnaMapped, "\u92d8"},
+	{0x2F9EA, 0x2F9EA, idnaMapped, "\u927c"},
+	{0x2F9EB, 0x2F9EB, idnaMapped, "\u93f9"},
+	{0x2F9EC, 0x2F9EC, idnaMapped, "\u9415"},
+	{0x2F9ED, 0x2F9ED, idnaMapped, "\U00028bfa"},
+	{0x2F9EE, 0x2F9EE, idnaMapped, "\u958b"},
+	{0x2F9EF, 0x2F9EF, idnaMapped, "\u4995"},
+	{0x2F9F0, 0x2F9F0, idnaMapped, "\u95b7"},
+	{0x2F9F1, 0x2F9F1, idnaMapped, "\U00028d77"},
+	{0x2F9F2, 0x2F9F2, idnaMapped, "\u49e6"},
+	{0x2F9F3, 0x2F9F3, idnaMapped, "\u96c3"},
+	{0x2F9F4, 0x2F9F4, idnaMapped, "\u5db2"},
+	{0x2F9F5, 0x2F9F5, idnaMapped, "\u9723"},
+	{0x2F9F6, 0x2F9F6, idnaMapped, "\U00029145"},
+	{0x2F9F7, 0x2F9F7, idnaMapped, "\U0002921a"},
+	{0x2F9F8, 0x2F9F8, idnaMapped, "\u4a6e"},
+	{0x2F9F9, 0x2F9F9, idnaMapped, "\u4a76"},
+	{0x2F9FA, 0x2F9FA, idnaMapped, "\u97e0"},
+	{0x2F9FB, 0x2F9FB, idnaMapped, "\U0002940a"},
+	{0x2F9FC, 0x2F9FC, idnaMapped, "\u4ab2"},
+	{0x2F9FD, 0x2F9FD, idnaMapped, "\U00029496"},
+	{0x2F9FE, 0x2F9FE, idnaMapped, "\u980b"},
+	{0x2F9FF, 0x2F9FF, idnaMapped, "\u980b"},
+	{0x2FA00, 0x2FA00, idnaMapped, "\u9829"},
+	{0x2FA01, 0x2FA01, idnaMapped, "\U000295b6"},
+	{0x2FA02, 0x2FA02, idnaMapped, "\u98e2"},
+	{0x2FA03, 0x2FA03, idnaMapped, "\u4b33"},
+	{0x2FA04, 0x2FA04, idnaMapped, "\u9929"},
+	{0x2FA05, 0x2FA05, idnaMapped, "\u99a7"},
+	{0x2FA06, 0x2FA06, idnaMapped, "\u99c2"},
+	{0x2FA07, 0x2FA07, idnaMapped, "\u99fe"},
+	{0x2FA08, 0x2FA08, idnaMapped, "\u4bce"},
+	{0x2FA09, 0x2FA09, idnaMapped, "\U00029b30"},
+	{0x2FA0A, 0x2FA0A, idnaMapped, "\u9b12"},
+	{0x2FA0B, 0x2FA0B, idnaMapped, "\u9c40"},
+	{0x2FA0C, 0x2FA0C, idnaMapped, "\u9cfd"},
+	{0x2FA0D, 0x2FA0D, idnaMapped, "\u4cce"},
+	{0x2FA0E, 0x2FA0E, idnaMapped, "\u4ced"},
+	{0x2FA0F, 0x2FA0F, idnaMapped, "\u9d67"},
+	{0x2FA10, 0x2FA10, idnaMapped, "\U0002a0ce"},
+	{0x2FA11, 0x2FA11, idnaMapped, "\u4cf8"},
+	{0x2FA12, 0x2FA12, idnaMapped, "\U0002a105"},
+	{0x2FA13, 0x2FA13, idnaMapped, "\U0002a20e"},
+	{0x2FA14, 0x2FA14, idnaMapped, "\U0002a291"},
+	{0x2FA15, 0x2FA15, idnaMapped, "\u9ebb"},
+	{0x2FA16, 0x2FA16, idnaMapped, "\u4d56"},
+	{0x2FA17, 0x2FA17, idnaMapped, "\u9ef9"},
+	{0x2FA18, 0x2FA18, idnaMapped, "\u9efe"},
+	{0x2FA19, 0x2FA19, idnaMapped, "\u9f05"},
+	{0x2FA1A, 0x2FA1A, idnaMapped, "\u9f0f"},
+	{0x2FA1B, 0x2FA1B, idnaMapped, "\u9f16"},
+	{0x2FA1C, 0x2FA1C, idnaMapped, "\u9f3b"},
+	{0x2FA1D, 0x2FA1D, idnaMapped, "\U0002a600"},
+	{0x2FA1E, 0x2FFFF, idnaDisallowed, ""},
+	{0x30000, 0x3134A, idnaValid, ""},
+	{0x3134B, 0x3134F, idnaDisallowed, ""},
+	{0x31350, 0x33479, idnaValid, ""},
+	{0x3347A, 0xE00FF, idnaDisallowed, ""},
+	{0xE0100, 0xE01EF, idnaIgnored, ""},
+	{0xE01F0, 0x10FFFF, idnaDisallowed, ""},
+}
+
+var cccTable = []runeRange{
+	{0x0300, 0x0314, 230},
+	{0x0315, 0x0315, 232},
+	{0x0316, 0x0319, 220},
+	{0x031A, 0x031A, 232},
+	{0x031B, 0x031B, 216},
+	{0x031C, 0x0320, 220},
+	{0x0321, 0x0322, 202},
+	{0x0323, 0x0326, 220},
+	{0x0327, 0x0328, 202},
+	{0x0329, 0x0333, 220},
+	{0x0334, 0x0338, 1},
+	{0x0339, 0x033C, 220},
+	{0x033D, 0x0344, 230},
+	{0x0345, 0x0345, 240},
+	{0x0346, 0x0346, 230},
+	{0x0347, 0x0349, 220},
+	{0x034A, 0x034C, 230},
+	{0x034D, 0x034E, 220},
+	{0x0350, 0x0352, 230},
+	{0x0353, 0x0356, 220},
+	{0x0357, 0x0357, 230},
+	{0x0358, 0x0358, 232},
+	{0x0359, 0x035A, 220},
+	{0x035B, 0x035B, 230},
+	{0x035C, 0x035C, 233},
+	{0x035D, 0x035E, 234},
+	{0x035F, 0x035F, 233},
+	{0x0360, 0x0361, 234},
+	{0x0362, 0x0362, 233},
+	{0x0363, 0x036F, 230},
+	{0x0483, 0x0487, 230},
+	{0x0591, 0x0591, 220},
+	{0x0592, 0x0595, 230},
+	{0x0596, 0x0596, 220},
+	{0x0597, 0x0599, 230},
+	{0x059A, 0x059A, 222},
+	{0x059B, 0x059B, 220},
+	{0x059C, 0x05A1, 230},
+	{0x05A2, 0x05A7, 220},
+	{0x05A8, 0x05A9, 230},
+	{0x05AA, 0x05AA, 220},
+	{0x05AB, 0x05AC, 230},
+	{0x05AD, 0x05AD, 222},
+	{0x05AE, 0x05AE, 228},
+	{0x05AF, 0x05AF, 230},
+	{0x05B0, 0x05B0, 10},
+	{0x05B1, 0x05B1, 11},
+	{0x05B2, 0x05B2, 12},
+	{0x05B3, 0x05B3, 13},
+	{0x05B4, 0x05B4, 14},
+	{0x05B5, 0x05B5, 15},
+	{0x05B6, 0x05B6, 16},
+	{0x05B7, 0x05B7, 17},
+	{0x05B8, 0x05B8, 18},
+	{0x05B9, 0x05BA, 19},
+	{0x05BB, 0x05BB, 20},
+	{0x05BC, 0x05BC, 21},
+	{0x05BD, 0x05BD, 22},
+	{0x05BF, 0x05BF, 23},
+	{0x05C1, 0x05C1, 24},
+	{0x05C2, 0x05C2, 25},
+	{0x05C4, 0x05C4, 230},
+	{0x05C5, 0x05C5, 220},
+	{0x05C7, 0x05C7, 18},
+	{0x0610, 0x0617, 230},
+	{0x0618, 0x0618, 30},
+	{0x0619, 0x0619, 31},
+	{0x061A, 0x061A, 32},
+	{0x064B, 0x064B, 27},
+	{0x064C, 0x064C, 28},
+	{0x064D, 0x064D, 29},
+	{0x064E, 0x064E, 30},
+	{0x064F, 0x064F, 31},
+	{0x0650, 0x0650, 32},
+	{0x0651, 0x0651, 33},
+	{0x0652, 0x0652, 34},
+	{0x0653, 0x0654, 230},
+	{0x0655, 0x0656, 220},
+	{0x0657, 0x065B, 230},
+	{0x065C, 0x065C, 220},
+	{0x065D, 0x065E, 230},
+	{0x065F, 0x065F, 220},
+	{0x0670, 0x0670, 35},
+	{0x06D6, 0x06DC, 230},
+	{0x06DF, 0x06E2, 230},
+	{0x06E3, 0x06E3, 220},
+	{0x06E4, 0x06E4, 230},
+	{0x06E7, 0x06E8, 230},
+	{0x06EA, 0x06EA, 220},
+	{0x06EB, 0x06EC, 230},
+	{0x06ED, 0x06ED, 220},
+	{0x0711, 0x0711, 36},
+	{0x0730, 0x0730, 230},
+	{0x0731, 0x0731, 220},
+	{0x0732, 0x0733, 230},
+	{0x0734, 0x0734, 220},
+	{0x0735, 0x0736, 230},
+	{0x0737, 0x0739, 220},
+	{0x073A, 0x073A, 230},
+	{0x073B, 0x073C, 220},
+	{0x073D, 0x073D, 230},
+	{0x073E, 0x073E, 220},
+	{0x073F, 0x0741, 230},
+	{0x0742, 0x0742, 220},
+	{0x0743, 0x0743, 230},
+	{0x0744, 0x0744, 220},
+	{0x0745, 0x0745, 230},
+	{0x0746, 0x0746, 220},
+	{0x0747, 0x0747, 230},
+	{0x0748, 0x0748, 220},
+	{0x0749, 0x074A, 230},
+	{0x07EB, 0x07F1, 230},
+	{0x07F2, 0x07F2, 220},
+	{0x07F3, 0x07F3, 230},
+	{0x07FD, 0x07FD, 220},
+	{0x0816, 0x0819, 230},
+	{0x081B, 0x0823, 230},
+	{0x0825, 0x0827, 230},
+	{0x0829, 0x082D, 230},
+	{0x0859, 0x085B, 220},
+	{0x0897, 0x0898, 230},
+	{0x0899, 0x089B, 220},
+	{0x089C, 0x089F, 230},
+	{0x08CA, 0x08CE, 230},
+	{0x08CF, 0x08D3, 220},
+	{0x08D4, 0x08E1, 230},
+	{0x08E3, 0x08E3, 220},
+	{0x08E4, 0x08E5, 230},
+	{0x08E6, 0x08E6, 220},
+	{0x08E7, 0x08E8, 230},
+	{0x08E9, 0x08E9, 220},
+	{0x08EA, 0x08EC, 230},
+	{0x08ED, 0x08EF, 220},
+	{0x08F0, 0x08F0, 27},
+	{0x08F1, 0x08F1, 28},
+	{0x08F2, 0x08F2, 29},
+	{0x08F3, 0x08F5, 230},
+	{0x08F6, 0x08F6, 220},
+	{0x08F7, 0x08F8, 230},
+	{0x08F9, 0x08FA, 220},
+	{0x08FB, 0x08FF, 230},
+	{0x093C, 0x093C, 7},
+	{0x094D, 0x094D, 9},
+	{0x0951, 0x0951, 230},
+	{0x0952, 0x0952, 220},
+	{0x0953, 0x0954, 230},
+	{0x09BC, 0x09BC, 7},
+	{0x09CD, 0x09CD, 9},
+	{0x09FE, 0x09FE, 230},
+	{0x0A3C, 0x0A3C, 7},
+	{0x0A4D, 0x0A4D, 9},
+	{0x0ABC, 0x0ABC, 7},
+	{0x0ACD, 0x0ACD, 9},
+	{0x0B3C, 0x0B3C, 7},
+	{0x0B4D, 0x0B4D, 9},
+	{0x0BCD, 0x0BCD, 9},
+	{0x0C3C, 0x0C3C, 7},
+	{0x0C4D, 0x0C4D, 9},
+	{0x0C55, 0x0C55, 84},
+	{0x0C56, 0x0C56, 91},
+	{0x0CBC, 0x0CBC, 7},
+	{0x0CCD, 0x0CCD, 9},
+	{0x0D3B, 0x0D3C, 9},
+	{0x0D4D, 0x0D4D, 9},
+	{0x0DCA, 0x0DCA, 9},
+	{0x0E38, 0x0E39, 103},
+	{0x0E3A, 0x0E3A, 9},
+	{0x0E48, 0x0E4B, 107},
+	{0x0EB8, 0x0EB9, 118},
+	{0x0EBA, 0x0EBA, 9},
+	{0x0EC8, 0x0ECB, 122},
+	{0x0F18, 0x0F19, 220},
+	{0x0F35, 0x0F35, 220},
+	{0x0F37, 0x0F37, 220},
+	{0x0F39, 0x0F39, 216},
+	{0x0F71, 0x0F71, 129},
+	{0x0F72, 0x0F72, 130},
+	{0x0F74, 0x0F74, 132},
+	{0x0F7A, 0x0F7D, 130},
+	{0x0F80, 0x0F80, 130},
+	{0x0F82, 0x0F83, 230},
+	{0x0F84, 0x0F84, 9},
+	{0x0F86, 0x0F87, 230},
+	{0x0FC6, 0x0FC6, 220},
+	{0x1037, 0x1037, 7},
+	{0x1039, 0x103A, 9},
+	{0x108D, 0x108D, 220},
+	{0x135D, 0x135F, 230},
+	{0x1714, 0x1715, 9},
+	{0x1734, 0x1734, 9},
+	{0x17D2, 0x17D2, 9},
+	{0x17DD, 0x17DD, 230},
+	{0x18A9, 0x18A9, 228},
+	{0x1939, 0x1939, 222},
+	{0x193A, 0x193A, 230},
+	{0x193B, 0x193B, 220},
+	{0x1A17, 0x1A17, 230},
+	{0x1A18, 0x1A18, 220},
+	{0x1A60, 0x1A60, 9},
+	{0x1A75, 0x1A7C, 230},
+	{0x1A7F, 0x1A7F, 220},
+	{0x1AB0, 0x1AB4, 230},
+	{0x1AB5, 0x1ABA, 220},
+	{0x1ABB, 0x1ABC, 230},
+	{0x1ABD, 0x1ABD, 220},
+	{0x1ABF, 0x1AC0, 220},
+	{0x1AC1, 0x1AC2, 230},
+	{0x1AC3, 0x1AC4, 220},
+	{0x1AC5, 0x1AC9, 230},
+	{0x1ACA, 0x1ACA, 220},
+	{0x1ACB, 0x1ADC, 230},
+	{0x1ADD, 0x1ADD, 220},
+	{0x1AE0, 0x1AE5, 230},
+	{0x1AE6, 0x1AE6, 220},
+	{0x1AE7, 0x1AEA, 230},
+	{0x1AEB, 0x1AEB, 234},
+	{0x1B34, 0x1B34, 7},
+	{0x1B44, 0x1B44, 9},
+	{0x1B6B, 0x1B6B, 230},
+	{0x1B6C, 0x1B6C, 220},
+	{0x1B6D, 0x1B73, 230},
+	{0x1BAA, 0x1BAB, 9},
+	{0x1BE6, 0x1BE6, 7},
+	{0x1BF2, 0x1BF3, 9},
+	{0x1C37, 0x1C37, 7},
+	{0x1CD0, 0x1CD2, 230},
+	{0x1CD4, 0x1CD4, 1},
+	{0x1CD5, 0x1CD9, 220},
+	{0x1CDA, 0x1CDB, 230},
+	{0x1CDC, 0x1CDF, 220},
+	{0x1CE0, 0x1CE0, 230},
+	{0x1CE2, 0x1CE8, 1},
+	{0x1CED, 0x1CED, 220},
+	{0x1CF4, 0x1CF4, 230},
+	{0x1CF8, 0x1CF9, 230},
+	{0x1DC0, 0x1DC1, 230},
+	{0x1DC2, 0x1DC2, 220},
+	{0x1DC3, 0x1DC9, 230},
+	{0x1DCA, 0x1DCA, 220},
+	{0x1DCB, 0x1DCC, 230},
+	{0x1DCD, 0x1DCD, 234},
+	{0x1DCE, 0x1DCE, 214},
+	{0x1DCF, 0x1DCF, 220},
+	{0x1DD0, 0x1DD0, 202},
+	{0x1DD1, 0x1DF5, 230},
+	{0x1DF6, 0x1DF6, 232},
+	{0x1DF7, 0x1DF8, 228},
+	{0x1DF9, 0x1DF9, 220},
+	{0x1DFA, 0x1DFA, 218},
+	{0x1DFB, 0x1DFB, 230},
+	{0x1DFC, 0x1DFC, 233},
+	{0x1DFD, 0x1DFD, 220},
+	{0x1DFE, 0x1DFE, 230},
+	{0x1DFF, 0x1DFF, 220},
+	{0x20D0, 0x20D1, 230},
+	{0x20D2, 0x20D3, 1},
+	{0x20D4, 0x20D7, 230},
+	{0x20D8, 0x20DA, 1},
+	{0x20DB, 0x20DC, 230},
+	{0x20E1, 0x20E1, 230},
+	{0x20E5, 0x20E6, 1},
+	{0x20E7, 0x20E7, 230},
+	{0x20E8, 0x20E8, 220},
+	{0x20E9, 0x20E9, 230},
+	{0x20EA, 0x20EB, 1},
+	{0x20EC, 0x20EF, 220},
+	{0x20F0, 0x20F0, 230},
+	{0x2CEF, 0x2CF1, 230},
+	{0x2D7F, 0x2D7F, 9},
+	{0x2DE0, 0x2DFF, 230},
+	{0x302A, 0x302A, 218},
+	{0x302B, 0x302B, 228},
+	{0x302C, 0x302C, 232},
+	{0x302D, 0x302D, 222},
+	{0x302E, 0x302F, 224},
+	{0x3099, 0x309A, 8},
+	{0xA66F, 0xA66F, 230},
+	{0xA674, 0xA67D, 230},
+	{0xA69E, 0xA69F, 230},
+	{0xA6F0, 0xA6F1, 230},
+	{0xA806, 0xA806, 9},
+	{0xA82C, 0xA82C, 9},
+	{0xA8C4, 0xA8C4, 9},
+	{0xA8E0, 0xA8F1, 230},
+	{0xA92B, 0xA92D, 220},
+	{0xA953, 0xA953, 9},
+	{0xA9B3, 0xA9B3, 7},
+	{0xA9C0, 0xA9C0, 9},
+	{0xAAB0, 0xAAB0, 230},
+	{0xAAB2, 0xAAB3, 230},
+	{0xAAB4, 0xAAB4, 220},
+	{0xAAB7, 0xAAB8, 230},
+	{0xAABE, 0xAABF, 230},
+	{0xAAC1, 0xAAC1, 230},
+	{0xAAF6, 0xAAF6, 9},
+	{0xABED, 0xABED, 9},
+	{0xFB1E, 0xFB1E, 26},
+	{0xFE20, 0xFE26, 230},
+	{0xFE27, 0xFE2D, 220},
+	{0xFE2E, 0xFE2F, 230},
+	{0x101FD, 0x101FD, 220},
+	{0x102E0, 0x102E0, 220},
+	{0x10376, 0x1037A, 230},
+	{0x10A0D, 0x10A0D, 220},
+	{0x10A0F, 0x10A0F, 230},
+	{0x10A38, 0x10A38, 230},
+	{0x10A39, 0x10A39, 1},
+	{0x10A3A, 0x10A3A, 220},
+	{0x10A3F, 0x10A3F, 9},
+	{0x10AE5, 0x10AE5, 230},
+	{0x10AE6, 0x10AE6, 220},
+	{0x10D24, 0x10D27, 230},
+	{0x10D69, 0x10D6D, 230},
+	{0x10EAB, 0x10EAC, 230},
+	{0x10EFA, 0x10EFB, 220},
+	{0x10EFD, 0x10EFF, 220},
+	{0x10F46, 0x10F47, 220},
+	{0x10F48, 0x10F4A, 230},
+	{0x10F4B, 0x10F4B, 220},
+	{0x10F4C, 0x10F4C, 230},
+	{0x10F4D, 0x10F50, 220},
+	{0x10F82, 0x10F82, 230},
+	{0x10F83, 0x10F83, 220},
+	{0x10F84, 0x10F84, 230},
+	{0x10F85, 0x10F85, 220},
+	{0x11046, 0x11046, 9},
+	{0x11070, 0x11070, 9},
+	{0x1107F, 0x1107F, 9},
+	{0x110B9, 0x110B9, 9},
+	{0x110BA, 0x110BA, 7},
+	{0x11100, 0x11102, 230},
+	{0x11133, 0x11134, 9},
+	{0x11173, 0x11173, 7},
+	{0x111C0, 0x111C0, 9},
+	{0x111CA, 0x111CA, 7},
+	{0x11235, 0x11235, 9},
+	{0x11236, 0x11236, 7},
+	{0x112E9, 0x112E9, 7},
+	{0x112EA, 0x112EA, 9},
+	{0x1133B, 0x1133C, 7},
+	{0x1134D, 0x1134D, 9},
+	{0x11366, 0x1136C, 230},
+	{0x11370, 0x11374, 230},
+	{0x113CE, 0x113D0, 9},
+	{0x11442, 0x11442, 9},
+	{0x11446, 0x11446, 7},
+	{0x1145E, 0x1145E, 230},
+	{0x114C2, 0x114C2, 9},
+	{0x114C3, 0x114C3, 7},
+	{0x115BF, 0x115BF, 9},
+	{0x115C0, 0x115C0, 7},
+	{0x1163F, 0x1163F, 9},
+	{0x116B6, 0x116B6, 9},
+	{0x116B7, 0x116B7, 7},
+	{0x1172B, 0x1172B, 9},
+	{0x11839, 0x11839, 9},
+	{0x1183A, 0x1183A, 7},
+	{0x1193D, 0x1193E, 9},
+	{0x11943, 0x11943, 7},
+	{0x119E0, 0x119E0, 9},
+	{0x11A34, 0x11A34, 9},
+	{0x11A47, 0x11A47, 9},
+	{0x11A99, 0x11A99, 9},
+	{0x11C3F, 0x11C3F, 9},
+	{0x11D42, 0x11D42, 7},
+	{0x11D44, 0x11D45, 9},
+	{0x11D97, 0x11D97, 9},
+	{0x11F41, 0x11F42, 9},
+	{0x1612F, 0x1612F, 9},
+	{0x16AF0, 0x16AF4, 1},
+	{0x16B30, 0x16B36, 230},
+	{0x16FF0, 0x16FF1, 6},
+	{0x1BC9E, 0x1BC9E, 1},
+	{0x1D165, 0x1D166, 216},
+	{0x1D167, 0x1D169, 1},
+	{0x1D16D, 0x1D16D, 226},
+	{0x1D16E, 0x1D172, 216},
+	{0x1D17B, 0x1D182, 220},
+	{0x1D185, 0x1D189, 230},
+	{0x1D18A, 0x1D18B, 220},
+	{0x1D1AA, 0x1D1AD, 230},
+	{0x1D242, 0x1D244, 230},
+	{0x1E000, 0x1E006, 230},
+	{0x1E008, 0x1E018, 230},
+	{0x1E01B, 0x1E021, 230},
+	{0x1E023, 0x1E024, 230},
+	{0x1E026, 0x1E02A, 230},
+	{0x1E08F, 0x1E08F, 230},
+	{0x1E130, 0x1E136, 230},
+	{0x1E2AE, 0x1E2AE, 230},
+	{0x1E2EC, 0x1E2EF, 230},
+	{0x1E4EC, 0x1E4ED, 232},
+	{0x1E4EE, 0x1E4EE, 220},
+	{0x1E4EF, 0x1E4EF, 230},
+	{0x1E5EE, 0x1E5EE, 230},
+	{0x1E5EF, 0x1E5EF, 220},
+	{0x1E6E3, 0x1E6E3, 230},
+	{0x1E6E6, 0x1E6E6, 230},
+	{0x1E6EE, 0x1E6EF, 230},
+	{0x1E6F5, 0x1E6F5, 230},
+	{0x1E8D0, 0x1E8D6, 220},
+	{0x1E944, 0x1E949, 230},
+	{0x1E94A, 0x1E94A, 7},
+}
+
+var bidiTable = []runeRange{
+	{0x0000, 0x0008, bidiBN},
+	{0x0009, 0x000D, bidiOther},
+	{0x000E, 0x001B, bidiBN},
+	{0x001C, 0x0020, bidiOther},
+	{0x0021, 0x0022, bidiON},
+	{0x0023, 0x0025, bidiET},
+	{0x0026, 0x002A, bidiON},
+	{0x002B, 0x002B, bidiES},
+	{0x002C, 0x002C, bidiCS},
+	{0x002D, 0x002D, bidiES},
+	{0x002E, 0x002F, bidiCS},
+	{0x0030, 0x0039, bidiEN},
+	{0x003A, 0x003A, bidiCS},
+	{0x003B, 0x0040, bidiON},
+	{0x005B, 0x0060, bidiON},
+	{0x007B, 0x007E, bidiON},
+	{0x007F, 0x0084, bidiBN},
+	{0x0085, 0x0085, bidiOther},
+	{0x0086, 0x009F, bidiBN},
+	{0x00A0, 0x00A0, bidiCS},
+	{0x00A1, 0x00A1, bidiON},
+	{0x00A2, 0x00A5, bidiET},
+	{0x00A6, 0x00A9, bidiON},
+	{0x00AB, 0x00AC, bidiON},
+	{0x00AD, 0x00AD, bidiBN},
+	{0x00AE, 0x00AF, bidiON},
+	{0x00B0, 0x00B1, bidiET},
+	{0x00B2, 0x00B3, bidiEN},
+	{0x00B4, 0x00B4, bidiON},
+	{0x00B6, 0x00B8, bidiON},
+	{0x00B9, 0x00B9, bidiEN},
+	{0x00BB, 0x00BF, bidiON},
+	{0x00D7, 0x00D7, bidiON},
+	{0x00F7, 0x00F7, bidiON},
+	{0x02B9, 0x02BA, bidiON},
+	{0x02C2, 0x02CF, bidiON},
+	{0x02D2, 0x02DF, bidiON},
+	{0x02E5, 0x02ED, bidiON},
+	{0x02EF, 0x02FF, bidiON},
+	{0x0300, 0x036F, bidiNSM},
+	{0x0374, 0x0375, bidiON},
+	{0x037E, 0x037E, bidiON},
+	{0x0384, 0x0385, bidiON},
+	{0x0387, 0x0387, bidiON},
+	{0x03F6, 0x03F6, bidiON},
+	{0x0483, 0x0489, bidiNSM},
+	{0x058A, 0x058A, bidiON},
+	{0x058D, 0x058E, bidiON},
+	{0x058F, 0x058F, bidiET},
+	{0x0591, 0x05BD, bidiNSM},
+	{0x05BE, 0x05BE, bidiR},
+	{0x05BF, 0x05BF, bidiNSM},
+	{0x05C0, 0x05C0, bidiR},
+	{0x05C1, 0x05C2, bidiNSM},
+	{0x05C3, 0x05C3, bidiR},
+	{0x05C4, 0x05C5, bidiNSM},
+	{0x05C6, 0x05C6, bidiR},
+	{0x05C7, 0x05C7, bidiNSM},
+	{0x05D0, 0x05EA, bidiR},
+	{0x05EF, 0x05F4, bidiR},
+	{0x0600, 0x0605, bidiAN},
+	{0x0606, 0x0607, bidiON},
+	{0x0608, 0x0608, bidiAL},
+	{0x0609, 0x060A, bidiET},
+	{0x060B, 0x060B, bidiAL},
+	{0x060C, 0x060C, bidiCS},
+	{0x060D, 0x060D, bidiAL},
+	{0x060E, 0x060F, bidiON},
+	{0x0610, 0x061A, bidiNSM},
+	{0x061B, 0x064A, bidiAL},
+	{0x064B, 0x065F, bidiNSM},
+	{0x0660, 0x0669, bidiAN},
+	{0x066A, 0x066A, bidiET},
+	{0x066B, 0x066C, bidiAN},
+	{0x066D, 0x066F, bidiAL},
+	{0x0670, 0x0670, bidiNSM},
+	{0x0671, 0x06D5, bidiAL},
+	{0x06D6, 0x06DC, bidiNSM},
+	{0x06DD, 0x06DD, bidiAN},
+	{0x06DE, 0x06DE, bidiON},
+	{0x06DF, 0x06E4, bidiNSM},
+	{0x06E5, 0x06E6, bidiAL},
+	{0x06E7, 0x06E8, bidiNSM},
+	{0x06E9, 0x06E9, bidiON},
+	{0x06EA, 0x06ED, bidiNSM},
+	{0x06EE, 0x06EF, bidiAL},
+	{0x06F0, 0x06F9, bidiEN},
+	{0x06FA, 0x070D, bidiAL},
+	{0x070F, 0x0710, bidiAL},
+	{0x0711, 0x0711, bidiNSM},
+	{0x0712, 0x072F, bidiAL},
+	{0x0730, 0x074A, bidiNSM},
+	{0x074D, 0x07A5, bidiAL},
+	{0x07A6, 0x07B0, bidiNSM},
+	{0x07B1, 0x07B1, bidiAL},
+	{0x07C0, 0x07EA, bidiR},
+	{0x07EB, 0x07F3, bidiNSM},
+	{0x07F4, 0x07F5, bidiR},
+	{0x07F6, 0x07F9, bidiON},
+	{0x07FA, 0x07FA, bidiR},
+	{0x07FD, 0x07FD, bidiNSM},
+	{0x07FE, 0x0815, bidiR},
+	{0x0816, 0x0819, bidiNSM},
+	{0x081A, 0x081A, bidiR},
+	{0x081B, 0x0823, bidiNSM},
+	{0x0824, 0x0824, bidiR},
+	{0x0825, 0x0827, bidiNSM},
+	{0x0828, 0x0828, bidiR},
+	{0x0829, 0x082D, bidiNSM},
+	{0x0830, 0x083E, bidiR},
+	{0x0840, 0x0858, bidiR},
+	{0x0859, 0x085B, bidiNSM},
+	{0x085E, 0x085E, bidiR},
+	{0x0860, 0x086A, bidiAL},
+	{0x0870, 0x088F, bidiAL},
+	{0x0890, 0x0891, bidiAN},
+	{0x0897, 0x089F, bidiNSM},
+	{0x08A0, 0x08C9, bidiAL},
+	{0x08CA, 0x08E1, bidiNSM},
+	{0x08E2, 0x08E2, bidiAN},
+	{0x08E3, 0x0902, bidiNSM},
+	{0x093A, 0x093A, bidiNSM},
+	{0x093C, 0x093C, bidiNSM},
+	{0x0941, 0x0948, bidiNSM},
+	{0x094D, 0x094D, bidiNSM},
+	{0x0951, 0x0957, bidiNSM},
+	{0x0962, 0x0963, bidiNSM},
+	{0x0981, 0x0981, bidiNSM},
+	{0x09BC, 0x09BC, bidiNSM},
+	{0x09C1, 0x09C4, bidiNSM},
+	{0x09CD, 0x09CD, bidiNSM},
+	{0x09E2, 0x09E3, bidiNSM},
+	{0x09F2, 0x09F3, bidiET},
+	{0x09FB, 0x09FB, bidiET},
+	{0x09FE, 0x09FE, bidiNSM},
+	{0x0A01, 0x0A02, bidiNSM},
+	{0x0A3C, 0x0A3C, bidiNSM},
+	{0x0A41, 0x0A42, bidiNSM},
+	{0x0A47, 0x0A48, bidiNSM},
+	{0x0A4B, 0x0A4D, bidiNSM},
+	{0x0A51, 0x0A51, bidiNSM},
+	{0x0A70, 0x0A71, bidiNSM},
+	{0x0A75, 0x0A75, bidiNSM},
+	{0x0A81, 0x0A82, bidiNSM},
+	{0x0ABC, 0x0ABC, bidiNSM},
+	{0x0AC1, 0x0AC5, bidiNSM},
+	{0x0AC7, 0x0AC8, bidiNSM},
+	{0x0ACD, 0x0ACD, bidiNSM},
+	{0x0AE2, 0x0AE3, bidiNSM},
+	{0x0AF1, 0x0AF1, bidiET},
+	{0x0AFA, 0x0AFF, bidiNSM},
+	{0x0B01, 0x0B01, bidiNSM},
+	{0x0B3C, 0x0B3C, bidiNSM},
+	{0x0B3F, 0x0B3F, bidiNSM},
+	{0x0B41, 0x0B44, bidiNSM},
+	{0x0B4D, 0x0B4D, bidiNSM},
+	{0x0B55, 0x0B56, bidiNSM},
+	{0x0B62, 0x0B63, bidiNSM},
+	{0x0B82, 0x0B82, bidiNSM},
+	{0x0BC0, 0x0BC0, bidiNSM},
+	{0x0BCD, 0x0BCD, bidiNSM},
+	{0x0BF3, 0x0BF8, bidiON},
+	{0x0BF9, 0x0BF9, bidiET},
+	{0x0BFA, 0x0BFA, bidiON},
+	{0x0C00, 0x0C00, bidiNSM},
+	{0x0C04, 0x0C04, bidiNSM},
+	{0x0C3C, 0x0C3C, bidiNSM},
+	{0x0C3E, 0x0C40, bidiNSM},
+	{0x0C46, 0x0C48, bidiNSM},
+	{0x0C4A, 0x0C4D, bidiNSM},
+	{0x0C55, 0x0C56, bidiNSM},
+	{0x0C62, 0x0C63, bidiNSM},
+	{0x0C78, 0x0C7E, bidiON},
+	{0x0C81, 0x0C81, bidiNSM},
+	{0x0CBC, 0x0CBC, bidiNSM},
+	{0x0CCC, 0x0CCD, bidiNSM},
+	{0x0CE2, 0x0CE3, bidiNSM},
+	{0x0D00, 0x0D01, bidiNSM},
+	{0x0D3B, 0x0D3C, bidiNSM},
+	{0x0D41, 0x0D44, bidiNSM},
+	{0x0D4D, 0x0D4D, bidiNSM},
+	{0x0D62, 0x0D63, bidiNSM},
+	{0x0D81, 0x0D81, bidiNSM},
+	{0x0DCA, 0x0DCA, bidiNSM},
+	{0x0DD2, 0x0DD4, bidiNSM},
+	{0x0DD6, 0x0DD6, bidiNSM},
+	{0x0E31, 0x0E31, bidiNSM},
+	{0x0E34, 0x0E3A, bidiNSM},
+	{0x0E3F, 0x0E3F, bidiET},
+	{0x0E47, 0x0E4E, bidiNSM},
+	{0x0EB1, 0x0EB1, bidiNSM},
+	{0x0EB4, 0x0EBC, bidiNSM},
+	{0x0EC8, 0x0ECE, bidiNSM},
+	{0x0F18, 0x0F19, bidiNSM},
+	{0x0F35, 0x0F35, bidiNSM},
+	{0x0F37, 0x0F37, bidiNSM},
+	{0x0F39, 0x0F39, bidiNSM},
+	{0x0F3A, 0x0F3D, bidiON},
+	{0x0F71, 0x0F7E, bidiNSM},
+	{0x0F80, 0x0F84, bidiNSM},
+	{0x0F86, 0x0F87, bidiNSM},
+	{0x0F8D, 0x0F97, bidiNSM},
+	{0x0F99, 0x0FBC, bidiNSM},
+	{0x0FC6, 0x0FC6, bidiNSM},
+	{0x102D, 0x1030, bidiNSM},
+	{0x1032, 0x1037, bidiNSM},
+	{0x1039, 0x103A, bidiNSM},
+	{0x103D, 0x103E, bidiNSM},
+	{0x1058, 0x1059, bidiNSM},
+	{0x105E, 0x1060, bidiNSM},
+	{0x1071, 0x1074, bidiNSM},
+	{0x1082, 0x1082, bidiNSM},
+	{0x1085, 0x1086, bidiNSM},
+	{0x108D, 0x108D, bidiNSM},
+	{0x109D, 0x109D, bidiNSM},
+	{0x135D, 0x135F, bidiNSM},
+	{0x1390, 0x1399, bidiON},
+	{0x1400, 0x1400, bidiON},
+	{0x1680, 0x1680, bidiOther},
+	{0x169B, 0x169C, bidiON},
+	{0x1712, 0x1714, bidiNSM},
+	{0x1732, 0x1733, bidiNSM},
+	{0x1752, 0x1753, bidiNSM},
+	{0x1772, 0x1773, bidiNSM},
+	{0x17B4, 0x17B5, bidiNSM},
+	{0x17B7, 0x17BD, bidiNSM},
+	{0x17C6, 0x17C6, bidiNSM},
+	{0x17C9, 0x17D3, bidiNSM},
+	{0x17DB, 0x17DB, bidiET},
+	{0x17DD, 0x17DD, bidiNSM},
+	{0x17F0, 0x17F9, bidiON},
+	{0x1800, 0x180A, bidiON},
+	{0x180B, 0x180D, bidiNSM},
+	{0x180E, 0x180E, bidiBN},
+	{0x180F, 0x180F, bidiNSM},
+	{0x1885, 0x1886, bidiNSM},
+	{0x18A9, 0x18A9, bidiNSM},
+	{0x1920, 0x1922, bidiNSM},
+	{0x1927, 0x1928, bidiNSM},
+	{0x1932, 0x1932, bidiNSM},
+	{0x1939, 0x193B, bidiNSM},
+	{0x1940, 0x1940, bidiON},
+	{0x1944, 0x1945, bidiON},
+	{0x19DE, 0x19FF, bidiON},
+	{0x1A17, 0x1A18, bidiNSM},
+	{0x1A1B, 0x1A1B, bidiNSM},
+	{0x1A56, 0x1A56, bidiNSM},
+	{0x1A58, 0x1A5E, bidiNSM},
+	{0x1A60, 0x1A60, bidiNSM},
+	{0x1A62, 0x1A62, bidiNSM},
+	{0x1A65, 0x1A6C, bidiNSM},
+	{0x1A73, 0x1A7C, bidiNSM},
+	{0x1A7F, 0x1A7F, bidiNSM},
+	{0x1AB0, 0x1ADD, bidiNSM},
+	{0x1AE0, 0x1AEB, bidiNSM},
+	{0x1B00, 0x1B03, bidiNSM},
+	{0x1B34, 0x1B34, bidiNSM},
+	{0x1B36, 0x1B3A, bidiNSM},
+	{0x1B3C, 0x1B3C, bidiNSM},
+	{0x1B42, 0x1B42, bidiNSM},
+	{0x1B6B, 0x1B73, bidiNSM},
+	{0x1B80, 0x1B81, bidiNSM},
+	{0x1BA2, 0x1BA5, bidiNSM},
+	{0x1BA8, 0x1BA9, bidiNSM},
+	{0x1BAB, 0x1BAD, bidiNSM},
+	{0x1BE6, 0x1BE6, bidiNSM},
+	{0x1BE8, 0x1BE9, bidiNSM},
+	{0x1BED, 0x1BED, bidiNSM},
+	{0x1BEF, 0x1BF1, bidiNSM},
+	{0x1C2C, 0x1C33, bidiNSM},
+	{0x1C36, 0x1C37, bidiNSM},
+	{0x1CD0, 0x1CD2, bidiNSM},
+	{0x1CD4, 0x1CE0, bidiNSM},
+	{0x1CE2, 0x1CE8, bidiNSM},
+	{0x1CED, 0x1CED, bidiNSM},
+	{0x1CF4, 0x1CF4, bidiNSM},
+	{0x1CF8, 0x1CF9, bidiNSM},
+	{0x1DC0, 0x1DFF, bidiNSM},
+	{0x1FBD, 0x1FBD, bidiON},
+	{0x1FBF, 0x1FC1, bidiON},
+	{0x1FCD, 0x1FCF, bidiON},
+	{0x1FDD, 0x1FDF, bidiON},
+	{0x1FED, 0x1FEF, bidiON},
+	{0x1FFD, 0x1FFE, bidiON},
+	{0x2000, 0x200A, bidiOther},
+	{0x200B, 0x200D, bidiBN},
+	{0x200F, 0x200F, bidiR},
+	{0x2010, 0x2027, bidiON},
+	{0x2028, 0x202E, bidiOther},
+	{0x202F, 0x202F, bidiCS},
+	{0x2030, 0x2034, bidiET},
+	{0x2035, 0x2043, bidiON},
+	{0x2044, 0x2044, bidiCS},
+	{0x2045, 0x205E, bidiON},
+	{0x205F, 0x205F, bidiOther},
+	{0x2060, 0x2064, bidiBN},
+	{0x2066, 0x2069, bidiOther},
+	{0x206A, 0x206F, bidiBN},
+	{0x2070, 0x2070, bidiEN},
+	{0x2074, 0x2079, bidiEN},
+	{0x207A, 0x207B, bidiES},
+	{0x207C, 0x207E, bidiON},
+	{0x2080, 0x2089, bidiEN},
+	{0x208A, 0x208B, bidiES},
+	{0x208C, 0x208E, bidiON},
+	{0x20A0, 0x20C1, bidiET},
+	{0x20D0, 0x20F0, bidiNSM},
+	{0x2100, 0x2101, bidiON},
+	{0x2103, 0x2106, bidiON},
+	{0x2108, 0x2109, bidiON},
+	{0x2114, 0x2114, bidiON},
+	{0x2116, 0x2118, bidiON},
+	{0x211E, 0x2123, bidiON},
+	{0x2125, 0x2125, bidiON},
+	{0x2127, 0x2127, bidiON},
+	{0x2129, 0x2129, bidiON},
+	{0x212E, 0x212E, bidiET},
+	{0x213A, 0x213B, bidiON},
+	{0x2140, 0x2144, bidiON},
+	{0x214A, 0x214D, bidiON},
+	{0x2150, 0x215F, bidiON},
+	{0x2189, 0x218B, bidiON},
+	{0x2190, 0x2211, bidiON},
+	{0x2212, 0x2212, bidiES},
+	{0x2213, 0x2213, bidiET},
+	{0x2214, 0x2335, bidiON},
+	{0x237B, 0x2394, bidiON},
+	{0x2396, 0x2429, bidiON},
+	{0x2440, 0x244A, bidiON},
+	{0x2460, 0x2487, bidiON},
+	{0x2488, 0x249B, bidiEN},
+	{0x24EA, 0x26AB, bidiON},
+	{0x26AD, 0x27FF, bidiON},
+	{0x2900, 0x2B73, bidiON},
+	{0x2B76, 0x2BFF, bidiON},
+	{0x2CE5, 0x2CEA, bidiON},
+	{0x2CEF, 0x2CF1, bidiNSM},
+	{0x2CF9, 0x2CFF, bidiON},
+	{0x2D7F, 0x2D7F, bidiNSM},
+	{0x2DE0, 0x2DFF, bidiNSM},
+	{0x2E00, 0x2E5D, bidiON},
+	{0x2E80, 0x2E99, bidiON},
+	{0x2E9B, 0x2EF3, bidiON},
+	{0x2F00, 0x2FD5, bidiON},
+	{0x2FF0, 0x2FFF, bidiON},
+	{0x3000, 0x3000, bidiOther},
+	{0x3001, 0x3004, bidiON},
+	{0x3008, 0x3020, bidiON},
+	{0x302A, 0x302D, bidiNSM},
+	{0x3030, 0x3030, bidiON},
+	{0x3036, 0x3037, bidiON},
+	{0x303D, 0x303F, bidiON},
+	{0x3099, 0x309A, bidiNSM},
+	{0x309B, 0x309C, bidiON},
+	{0x30A0, 0x30A0, bidiON},
+	{0x30FB, 0x30FB, bidiON},
+	{0x31C0, 0x31E5, bidiON},
+	{0x31EF, 0x31EF, bidiON},
+	{0x321D, 0x321E, bidiON},
+	{0x3250, 0x325F, bidiON},
+	{0x327C, 0x327E, bidiON},
+	{0x32B1, 0x32BF, bidiON},
+	{0x32CC, 0x32CF, bidiON},
+	{0x3377, 0x337A, bidiON},
+	{0x33DE, 0x33DF, bidiON},
+	{0x33FF, 0x33FF, bidiON},
+	{0x4DC0, 0x4DFF, bidiON},
+	{0xA490, 0xA4C6, bidiON},
+	{0xA60D, 0xA60F, bidiON},
+	{0xA66F, 0xA672, bidiNSM},
+	{0xA673, 0xA673, bidiON},
+	{0xA674, 0xA67D, bidiNSM},
+	{0xA67E, 0xA67F, bidiON},
+	{0xA69E, 0xA69F, bidiNSM},
+	{0xA6F0, 0xA6F1, bidiNSM},
+	{0xA700, 0xA721, bidiON},
+	{0xA788, 0xA788, bidiON},
+	{0xA802, 0xA802, bidiNSM},
+	{0xA806, 0xA806, bidiNSM},
+	{0xA80B, 0xA80B, bidiNSM},
+	{0xA825, 0xA826, bidiNSM},
+	{0xA828, 0xA82B, bidiON},
+	{0xA82C, 0xA82C, bidiNSM},
+	{0xA838, 0xA839, bidiET},
+	{0xA874, 0xA877, bidiON},
+	{0xA8C4, 0xA8C5, bidiNSM},
+	{0xA8E0, 0xA8F1, bidiNSM},
+	{0xA8FF, 0xA8FF, bidiNSM},
+	{0xA926, 0xA92D, bidiNSM},
+	{0xA947, 0xA951, bidiNSM},
+	{0xA980, 0xA982, bidiNSM},
+	{0xA9B3, 0xA9B3, bidiNSM},
+	{0xA9B6, 0xA9B9, bidiNSM},
+	{0xA9BC, 0xA9BD, bidiNSM},
+	{0xA9E5, 0xA9E5, bidiNSM},
+	{0xAA29, 0xAA2E, bidiNSM},
+	{0xAA31, 0xAA32, bidiNSM},
+	{0xAA35, 0xAA36, bidiNSM},
+	{0xAA43, 0xAA43, bidiNSM},
+	{0xAA4C, 0xAA4C, bidiNSM},
+	{0xAA7C, 0xAA7C, bidiNSM},
+	{0xAAB0, 0xAAB0, bidiNSM},
+	{0xAAB2, 0xAAB4, bidiNSM},
+	{0xAAB7, 0xAAB8, bidiNSM},
+	{0xAABE, 0xAABF, bidiNSM},
+	{0xAAC1, 0xAAC1, bidiNSM},
+	{0xAAEC, 0xAAED, bidiNSM},
+	{0xAAF6, 0xAAF6, bidiNSM},
+	{0xAB6A, 0xAB6B, bidiON},
+	{0xABE5, 0xABE5, bidiNSM},
+	{0xABE8, 0xABE8, bidiNSM},
+	{0xABED, 0xABED, bidiNSM},
+	{0xFB1D, 0xFB1D, bidiR},
+	{0xFB1E, 0xFB1E, bidiNSM},
+	{0xFB1F, 0xFB28, bidiR},
+	{0xFB29, 0xFB29, bidiES},
+	{0xFB2A, 0xFB36, bidiR},
+	{0xFB38, 0xFB3C, bidiR},
+	{0xFB3E, 0xFB3E, bidiR},
+	{0xFB40, 0xFB41, bidiR},
+	{0xFB43, 0xFB44, bidiR},
+	{0xFB46, 0xFB4F, bidiR},
+	{0xFB50, 0xFBC2, bidiAL},
+	{0xFBC3, 0xFBD2, bidiON},
+	{0xFBD3, 0xFD3D, bidiAL},
+	{0xFD3E, 0xFD4F, bidiON},
+	{0xFD50, 0xFD8F, bidiAL},
+	{0xFD90, 0xFD91, bidiON},
+	{0xFD92, 0xFDC7, bidiAL},
+	{0xFDC8, 0xFDCF, bidiON},
+	{0xFDF0, 0xFDFC, bidiAL},
+	{0xFDFD, 0xFDFF, bidiON},
+	{0xFE00, 0xFE0F, bidiNSM},
+	{0xFE10, 0xFE19, bidiON},
+	{0xFE20, 0xFE2F, bidiNSM},
+	{0xFE30, 0xFE4F, bidiON},
+	{0xFE50, 0xFE50, bidiCS},
+	{0xFE51, 0xFE51, bidiON},
+	{0xFE52, 0xFE52, bidiCS},
+	{0xFE54, 0xFE54, bidiON},
+	{0xFE55, 0xFE55, bidiCS},
+	{0xFE56, 0xFE5E, bidiON},
+	{0xFE5F, 0xFE5F, bidiET},
+	{0xFE60, 0xFE61, bidiON},
+	{0xFE62, 0xFE63, bidiES},
+	{0xFE64, 0xFE66, bidiON},
+	{0xFE68, 0xFE68, bidiON},
+	{0xFE69, 0xFE6A, bidiET},
+	{0xFE6B, 0xFE6B, bidiON},
+	{0xFE70, 0xFE74, bidiAL},
+	{0xFE76, 0xFEFC, bidiAL},
+	{0xFEFF, 0xFEFF, bidiBN},
+	{0xFF01, 0xFF02, bidiON},
+	{0xFF03, 0xFF05, bidiET},
+	{0xFF06, 0xFF0A, bidiON},
+	{0xFF0B, 0xFF0B, bidiES},
+	{0xFF0C, 0xFF0C, bidiCS},
+	{0xFF0D, 0xFF0D, bidiES},
+	{0xFF0E, 0xFF0F, bidiCS},
+	{0xFF10, 0xFF19, bidiEN},
+	{0xFF1A, 0xFF1A, bidiCS},
+	{0xFF1B, 0xFF20, bidiON},
+	{0xFF3B, 0xFF40, bidiON},
+	{0xFF5B, 0xFF65, bidiON},
+	{0xFFE0, 0xFFE1, bidiET},
+	{0xFFE2, 0xFFE4, bidiON},
+	{0xFFE5, 0xFFE6, bidiET},
+	{0xFFE8, 0xFFEE, bidiON},
+	{0xFFF9, 0xFFFD, bidiON},
+	{0x10101, 0x10101, bidiON},
+	{0x10140, 0x1018C, bidiON},
+	{0x10190, 0x1019C, bidiON},
+	{0x101A0, 0x101A0, bidiON},
+	{0x101FD, 0x101FD, bidiNSM},
+	{0x102E0, 0x102E0, bidiNSM},
+	{0x102E1, 0x102FB, bidiEN},
+	{0x10376, 0x1037A, bidiNSM},
+	{0x10800, 0x10805, bidiR},
+	{0x10808, 0x10808, bidiR},
+	{0x1080A, 0x10835, bidiR},
+	{0x10837, 0x10838, bidiR},
+	{0x1083C, 0x1083C, bidiR},
+	{0x1083F, 0x10855, bidiR},
+	{0x10857, 0x1089E, bidiR},
+	{0x108A7, 0x108AF, bidiR},
+	{0x108E0, 0x108F2, bidiR},
+	{0x108F4, 0x108F5, bidiR},
+	{0x108FB, 0x1091B, bidiR},
+	{0x1091F, 0x1091F, bidiON},
+	{0x10920, 0x10939, bidiR},
+	{0x1093F, 0x10959, bidiR},
+	{0x10980, 0x109B7, bidiR},
+	{0x109BC, 0x109CF, bidiR},
+	{0x109D2, 0x10A00, bidiR},
+	{0x10A01, 0x10A03, bidiNSM},
+	{0x10A05, 0x10A06, bidiNSM},
+	{0x10A0C, 0x10A0F, bidiNSM},
+	{0x10A10, 0x10A13, bidiR},
+	{0x10A15, 0x10A17, bidiR},
+	{0x10A19, 0x10A35, bidiR},
+	{0x10A38, 0x10A3A, bidiNSM},
+	{0x10A3F, 0x10A3F, bidiNSM},
+	{0x10A40, 0x10A48, bidiR},
+	{0x10A50, 0x10A58, bidiR},
+	{0x10A60, 0x10A9F, bidiR},
+	{0x10AC0, 0x10AE4, bidiR},
+	{0x10AE5, 0x10AE6, bidiNSM},
+	{0x10AEB, 0x10AF6, bidiR},
+	{0x10B00, 0x10B35, bidiR},
+	{0x10B39, 0x10B3F, bidiON},
+	{0x10B40, 0x10B55, bidiR},
+	{0x10B58, 0x10B72, bidiR},
+	{0x10B78, 0x10B91, bidiR},
+	{0x10B99, 0x10B9C, bidiR},
+	{0x10BA9, 0x10BAF, bidiR},
+	{0x10C00, 0x10C48, bidiR},
+	{0x10C80, 0x10CB2, bidiR},
+	{0x10CC0, 0x10CF2, bidiR},
+	{0x10CFA, 0x10CFF, bidiR},
+	{0x10D00, 0x10D23, bidiAL},
+	{0x10D24, 0x10D27, bidiNSM},
+	{0x10D30, 0x10D39, bidiAN},
+	{0x10D40, 0x10D49, bidiAN},
+	{0x10D4A, 0x10D65, bidiR},
+	{0x10D69, 0x10D6D, bidiNSM},
+	{0x10D6E, 0x10D6E, bidiON},
+	{0x10D6F, 0x10D85, bidiR},
+	{0x10D8E, 0x10D8F, bidiR},
+	{0x10E60, 0x10E7E, bidiAN},
+	{0x10E80, 0x10EA9, bidiR},
+	{0x10EAB, 0x10EAC, bidiNSM},
+	{0x10EAD, 0x10EAD, bidiR},
+	{0x10EB0, 0x10EB1, bidiR},
+	{0x10EC2, 0x10EC7, bidiAL},
+	{0x10ED0, 0x10ED8, bidiON},
+	{0x10EFA, 0x10EFF, bidiNSM},
+	{0x10F00, 0x10F27, bidiR},
+	{0x10F30, 0x10F45, bidiAL},
+	{0x10F46, 0x10F50, bidiNSM},
+	{0x10F51, 0x10F59, bidiAL},
+	{0x10F70, 0x10F81, bidiR},
+	{0x10F82, 0x10F85, bidiNSM},
+	{0x10F86, 0x10F89, bidiR},
+	{0x10FB0, 0x10FCB, bidiR},
+	{0x10FE0, 0x10FF6, bidiR},
+	{0x11001, 0x11001, bidiNSM},
+	{0x11038, 0x11046, bidiNSM},
+	{0x11052, 0x11065, bidiON},
+	{0x11070, 0x11070, bidiNSM},
+	{0x11073, 0x11074, bidiNSM},
+	{0x1107F, 0x11081, bidiNSM},
+	{0x110B3, 0x110B6, bidiNSM},
+	{0x110B9, 0x110BA, bidiNSM},
+	{0x110C2, 0x110C2, bidiNSM},
+	{0x11100, 0x11102, bidiNSM},
+	{0x11127, 0x1112B, bidiNSM},
+	{0x1112D, 0x11134, bidiNSM},
+	{0x11173, 0x11173, bidiNSM},
+	{0x11180, 0x11181, bidiNSM},
+	{0x111B6, 0x111BE, bidiNSM},
+	{0x111C9, 0x111CC, bidiNSM},
+	{0x111CF, 0x111CF, bidiNSM},
+	{0x1122F, 0x11231, bidiNSM},
+	{0x11234, 0x11234, bidiNSM},
+	{0x11236, 0x11237, bidiNSM},
+	{0x1123E, 0x1123E, bidiNSM},
+	{0x11241, 0x11241, bidiNSM},
+	{0x112DF, 0x112DF, bidiNSM},
+	{0x112E3, 0x112EA, bidiNSM},
+	{0x11300, 0x11301, bidiNSM},
+	{0x1133B, 0x1133C, bidiNSM},
+	{0x11340, 0x11340, bidiNSM},
+	{0x11366, 0x1136C, bidiNSM},
+	{0x11370, 0x11374, bidiNSM},
+	{0x113BB, 0x113C0, bidiNSM},
+	{0x113CE, 0x113CE, bidiNSM},
+	{0x113D0, 0x113D0, bidiNSM},
+	{0x113D2, 0x113D2, bidiNSM},
+	{0x113E1, 0x113E2, bidiNSM},
+	{0x11438, 0x1143F, bidiNSM},
+	{0x11442, 0x11444, bidiNSM},
+	{0x11446, 0x11446, bidiNSM},
+	{0x1145E, 0x1145E, bidiNSM},
+	{0x114B3, 0x114B8, bidiNSM},
+	{0x114BA, 0x114BA, bidiNSM},
+	{0x114BF, 0x114C0, bidiNSM},
+	{0x114C2, 0x114C3, bidiNSM},
+	{0x115B2, 0x115B5, bidiNSM},
+	{0x115BC, 0x115BD, bidiNSM},
+	{0x115BF, 0x115C0, bidiNSM},
+	{0x115DC, 0x115DD, bidiNSM},
+	{0x11633, 0x1163A, bidiNSM},
+	{0x1163D, 0x1163D, bidiNSM},
+	{0x1163F, 0x11640, bidiNSM},
+	{0x11660, 0x1166C, bidiON},
+	{0x116AB, 0x116AB, bidiNSM},
+	{0x116AD, 0x116AD, bidiNSM},
+	{0x116B0, 0x116B5, bidiNSM},
+	{0x116B7, 0x116B7, bidiNSM},
+	{0x1171D, 0x1171D, bidiNSM},
+	{0x1171F, 0x1171F, bidiNSM},
+	{0x11722, 0x11725, bidiNSM},
+	{0x11727, 0x1172B, bidiNSM},
+	{0x1182F, 0x11837, bidiNSM},
+	{0x11839, 0x1183A, bidiNSM},
+	{0x1193B, 0x1193C, bidiNSM},
+	{0x1193E, 0x1193E, bidiNSM},
+	{0x11943, 0x11943, bidiNSM},
+	{0x119D4, 0x119D7, bidiNSM},
+	{0x119DA, 0x119DB, bidiNSM},
+	{0x119E0, 0x119E0, bidiNSM},
+	{0x11A01, 0x11A06, bidiNSM},
+	{0x11A09, 0x11A0A, bidiNSM},
+	{0x11A33, 0x11A38, bidiNSM},
+	{0x11A3B, 0x11A3E, bidiNSM},
+	{0x11A47, 0x11A47, bidiNSM},
+	{0x11A51, 0x11A56, bidiNSM},
+	{0x11A59, 0x11A5B, bidiNSM},
+	{0x11A8A, 0x11A96, bidiNSM},
+	{0x11A98, 0x11A99, bidiNSM},
+	{0x11B60, 0x11B60, bidiNSM},
+	{0x11B62, 0x11B64, bidiNSM},
+	{0x11B66, 0x11B66, bidiNSM},
+	{0x11C30, 0x11C36, bidiNSM},
+	{0x11C38, 0x11C3D, bidiNSM},
+	{0x11C92, 0x11CA7, bidiNSM},
+	{0x11CAA, 0x11CB0, bidiNSM},
+	{0x11CB2, 0x11CB3, bidiNSM},
+	{0x11CB5, 0x11CB6, bidiNSM},
+	{0x11D31, 0x11D36, bidiNSM},
+	{0x11D3A, 0x11D3A, bidiNSM},
+	{0x11D3C, 0x11D3D, bidiNSM},
+	{0x11D3F, 0x11D45, bidiNSM},
+	{0x11D47, 0x11D47, bidiNSM},
+	{0x11D90, 0x11D91, bidiNSM},
+	{0x11D95, 0x11D95, bidiNSM},
+	{0x11D97, 0x11D97, bidiNSM},
+	{0x11EF3, 0x11EF4, bidiNSM},
+	{0x11F00, 0x11F01, bidiNSM},
+	{0x11F36, 0x11F3A, bidiNSM},
+	{0x11F40, 0x11F40, bidiNSM},
+	{0x11F42, 0x11F42, bidiNSM},
+	{0x11F5A, 0x11F5A, bidiNSM},
+	{0x11FD5, 0x11FDC, bidiON},
+	{0x11FDD, 0x11FE0, bidiET},
+	{0x11FE1, 0x11FF1, bidiON},
+	{0x13440, 0x13440, bidiNSM},
+	{0x13447, 0x13455, bidiNSM},
+	{0x1611E, 0x16129, bidiNSM},
+	{0x1612D, 0x1612F, bidiNSM},
+	{0x16AF0, 0x16AF4, bidiNSM},
+	{0x16B30, 0x16B36, bidiNSM},
+	{0x16F4F, 0x16F4F, bidiNSM},
+	{0x16F8F, 0x16F92, bidiNSM},
+	{0x16FE2, 0x16FE2, bidiON},
+	{0x16FE4, 0x16FE4, bidiNSM},
+	{0x1BC9D, 0x1BC9E, bidiNSM},
+	{0x1BCA0, 0x1BCA3, bidiBN},
+	{0x1CC00, 0x1CCD5, bidiON},
+	{0x1CCF0, 0x1CCF9, bidiEN},
+	{0x1CCFA, 0x1CCFC, bidiON},
+	{0x1CD00, 0x1CEB3, bidiON},
+	{0x1CEBA, 0x1CED0, bidiON},
+	{0x1CEE0, 0x1CEF0, bidiON},
+	{0x1CF00, 0x1CF2D, bidiNSM},
+	{0x1CF30, 0x1CF46, bidiNSM},
+	{0x1D167, 0x1D169, bidiNSM},
+	{0x1D173, 0x1D17A, bidiBN},
+	{0x1D17B, 0x1D182, bidiNSM},
+	{0x1D185, 0x1D18B, bidiNSM},
+	{0x1D1AA, 0x1D1AD, bidiNSM},
+	{0x1D1E9, 0x1D1EA, bidiON},
+	{0x1D200, 0x1D241, bidiON},
+	{0x1D242, 0x1D244, bidiNSM},
+	{0x1D245, 0x1D245, bidiON},
+	{0x1D300, 0x1D356, bidiON},
+	{0x1D6C1, 0x1D6C1, bidiON},
+	{0x1D6DB, 0x1D6DB, bidiON},
+	{0x1D6FB, 0x1D6FB, bidiON},
+	{0x1D715, 0x1D715, bidiON},
+	{0x1D735, 0x1D735, bidiON},
+	{0x1D74F, 0x1D74F, bidiON},
+	{0x1D76F, 0x1D76F, bidiON},
+	{0x1D789, 0x1D789, bidiON},
+	{0x1D7A9, 0x1D7A9, bidiON},
+	{0x1D7C3, 0x1D7C3, bidiON},
+	{0x1D7CE, 0x1D7FF, bidiEN},
+	{0x1DA00, 0x1DA36, bidiNSM},
+	{0x1DA3B, 0x1DA6C, bidiNSM},
+	{0x1DA75, 0x1DA75, bidiNSM},
+	{0x1DA84, 0x1DA84, bidiNSM},
+	{0x1DA9B, 0x1DA9F, bidiNSM},
+	{0x1DAA1, 0x1DAAF, bidiNSM},
+	{0x1E000, 0x1E006, bidiNSM},
+	{0x1E008, 0x1E018, bidiNSM},
+	{0x1E01B, 0x1E021, bidiNSM},
+	{0x1E023, 0x1E024, bidiNSM},
+	{0x1E026, 0x1E02A, bidiNSM},
+	{0x1E08F, 0x1E08F, bidiNSM},
+	{0x1E130, 0x1E136, bidiNSM},
+	{0x1E2AE, 0x1E2AE, bidiNSM},
+	{0x1E2EC, 0x1E2EF, bidiNSM},
+	{0x1E2FF, 0x1E2FF, bidiET},
+	{0x1E4EC, 0x1E4EF, bidiNSM},
+	{0x1E5EE, 0x1E5EF, bidiNSM},
+	{0x1E6E3, 0x1E6E3, bidiNSM},
+	{0x1E6E6, 0x1E6E6, bidiNSM},
+	{0x1E6EE, 0x1E6EF, bidiNSM},
+	{0x1E6F5, 0x1E6F5, bidiNSM},
+	{0x1E800, 0x1E8C4, bidiR},
+	{0x1E8C7, 0x1E8CF, bidiR},
+	{0x1E8D0, 0x1E8D6, bidiNSM},
+	{0x1E900, 0x1E943, bidiR},
+	{0x1E944, 0x1E94A, bidiNSM},
+	{0x1E94B, 0x1E94B, bidiR},
+	{0x1E950, 0x1E959, bidiR},
+	{0x1E95E, 0x1E95F, bidiR},
+	{0x1EC71, 0x1ECB4, bidiAL},
+	{0x1ED01, 0x1ED3D, bidiAL},
+	{0x1EE00, 0x1EE03, bidiAL},
+	{0x1EE05, 0x1EE1F, bidiAL},
+	{0x1EE21, 0x1EE22, bidiAL},
+	{0x1EE24, 0x1EE24, bidiAL},
+	{0x1EE27, 0x1EE27, bidiAL},
+	{0x1EE29, 0x1EE32, bidiAL},
+	{0x1EE34, 0x1EE37, bidiAL},
+	{0x1EE39, 0x1EE39, bidiAL},
+	{0x1EE3B, 0x1EE3B, bidiAL},
+	{0x1EE42, 0x1EE42, bidiAL},
+	{0x1EE47, 0x1EE47, bidiAL},
+	{0x1EE49, 0x1EE49, bidiAL},
+	{0x1EE4B, 0x1EE4B, bidiAL},
+	{0x1EE4D, 0x1EE4F, bidiAL},
+	{0x1EE51, 0x1EE52, bidiAL},
+	{0x1EE54, 0x1EE54, bidiAL},
+	{0x1EE57, 0x1EE57, bidiAL},
+	{0x1EE59, 0x1EE59, bidiAL},
+	{0x1EE5B, 0x1EE5B, bidiAL},
+	{0x1EE5D, 0x1EE5D, bidiAL},
+	{0x1EE5F, 0x1EE5F, bidiAL},
+	{0x1EE61, 0x1EE62, bidiAL},
+	{0x1EE64, 0x1EE64, bidiAL},
+	{0x1EE67, 0x1EE6A, bidiAL},
+	{0x1EE6C, 0x1EE72, bidiAL},
+	{0x1EE74, 0x1EE77, bidiAL},
+	{0x1EE79, 0x1EE7C, bidiAL},
+	{0x1EE7E, 0x1EE7E, bidiAL},
+	{0x1EE80, 0x1EE89, bidiAL},
+	{0x1EE8B, 0x1EE9B, bidiAL},
+	{0x1EEA1, 0x1EEA3, bidiAL},
+	{0x1EEA5, 0x1EEA9, bidiAL},
+	{0x1EEAB, 0x1EEBB, bidiAL},
+	{0x1EEF0, 0x1EEF1, bidiON},
+	{0x1F000, 0x1F02B, bidiON},
+	{0x1F030, 0x1F093, bidiON},
+	{0x1F0A0, 0x1F0AE, bidiON},
+	{0x1F0B1, 0x1F0BF, bidiON},
+	{0x1F0C1, 0x1F0CF, bidiON},
+	{0x1F0D1, 0x1F0F5, bidiON},
+	{0x1F100, 0x1F10A, bidiEN},
+	{0x1F10B, 0x1F10F, bidiON},
+	{0x1F12F, 0x1F12F, bidiON},
+	{0x1F16A, 0x1F16F, bidiON},
+	{0x1F1AD, 0x1F1AD, bidiON},
+	{0x1F260, 0x1F265, bidiON},
+	{0x1F300, 0x1F6D8, bidiON},
+	{0x1F6DC, 0x1F6EC, bidiON},
+	{0x1F6F0, 0x1F6FC, bidiON},
+	{0x1F700, 0x1F7D9, bidiON},
+	{0x1F7E0, 0x1F7EB, bidiON},
+	{0x1F7F0, 0x1F7F0, bidiON},
+	{0x1F800, 0x1F80B, bidiON},
+	{0x1F810, 0x1F847, bidiON},
+	{0x1F850, 0x1F859, bidiON},
+	{0x1F860, 0x1F887, bidiON},
+	{0x1F890, 0x1F8AD, bidiON},
+	{0x1F8B0, 0x1F8BB, bidiON},
+	{0x1F8C0, 0x1F8C1, bidiON},
+	{0x1F8D0, 0x1F8D8, bidiON},
+	{0x1F900, 0x1FA57, bidiON},
+	{0x1FA60, 0x1FA6D, bidiON},
+	{0x1FA70, 0x1FA7C, bidiON},
+	{0x1FA80, 0x1FA8A, bidiON},
+	{0x1FA8E, 0x1FAC6, bidiON},
+	{0x1FAC8, 0x1FAC8, bidiON},
+	{0x1FACD, 0x1FADC, bidiON},
+	{0x1FADF, 0x1FAEA, bidiON},
+	{0x1FAEF, 0x1FAF8, bidiON},
+	{0x1FB00, 0x1FB92, bidiON},
+	{0x1FB94, 0x1FBEF, bidiON},
+	{0x1FBF0, 0x1FBF9, bidiEN},
+	{0x1FBFA, 0x1FBFA, bidiON},
+	{0xE0001, 0xE0001, bidiBN},
+	{0xE0020, 0xE007F, bidiBN},
+	{0xE0100, 0xE01EF, bidiNSM},
+}
+
+var joiningTable = []runeRange{
+	{0x00AD, 0x00AD, joiningT},
+	{0x0300, 0x033F, joiningT},
+	{0x0342, 0x0342, joiningT},
+	{0x0346, 0x036F, joiningT},
+	{0x0483, 0x0489, joiningT},
+	{0x0591, 0x05BD, joiningT},
+	{0x05BF, 0x05BF, joiningT},
+	{0x05C1, 0x05C2, joiningT},
+	{0x05C4, 0x05C5, joiningT},
+	{0x05C7, 0x05C7, joiningT},
+	{0x0610, 0x061A, joiningT},
+	{0x061C, 0x061C, joiningT},
+	{0x0620, 0x0620, joiningD},
+	{0x0622, 0x0625, joiningR},
+	{0x0626, 0x0626, joiningD},
+	{0x0627, 0x0627, joiningR},
+	{0x0628, 0x0628, joiningD},
+	{0x0629, 0x0629, joiningR},
+	{0x062A, 0x062E, joiningD},
+	{0x062F, 0x0632, joiningR},
+	{0x0633, 0x063F, joiningD},
+	{0x0641, 0x0647, joiningD},
+	{0x0648, 0x0648, joiningR},
+	{0x0649, 0x064A, joiningD},
+	{0x064B, 0x065F, joiningT},
+	{0x066E, 0x066F, joiningD},
+	{0x0670, 0x0670, joiningT},
+	{0x0671, 0x0673, joiningR},
+	{0x0679, 0x0687, joiningD},
+	{0x0688, 0x0699, joiningR},
+	{0x069A, 0x06BF, joiningD},
+	{0x06C0, 0x06C0, joiningR},
+	{0x06C1, 0x06C2, joiningD},
+	{0x06C3, 0x06CB, joiningR},
+	{0x06CC, 0x06CC, joiningD},
+	{0x06CD, 0x06CD, joiningR},
+	{0x06CE, 0x06CE, joiningD},
+	{0x06CF, 0x06CF, joiningR},
+	{0x06D0, 0x06D1, joiningD},
+	{0x06D2, 0x06D3, joiningR},
+	{0x06D5, 0x06D5, joiningR},
+	{0x06D6, 0x06DC, joiningT},
+	{0x06DF, 0x06E4, joiningT},
+	{0x06E7, 0x06E8, joiningT},
+	{0x06EA, 0x06ED, joiningT},
+	{0x06EE, 0x06EF, joiningR},
+	{0x06FA, 0x06FC, joiningD},
+	{0x06FF, 0x06FF, joiningD},
+	{0x070F, 0x070F, joiningT},
+	{0x0710, 0x0710, joiningR},
+	{0x0711, 0x0711, joiningT},
+	{0x0712, 0x0714, joiningD},
+	{0x0715, 0x0719, joiningR},
+	{0x071A, 0x071D, joiningD},
+	{0x071E, 0x071E, joiningR},
+	{0x071F, 0x0727, joiningD},
+	{0x0728, 0x0728, joiningR},
+	{0x0729, 0x0729, joiningD},
+	{0x072A, 0x072A, joiningR},
+	{0x072B, 0x072B, joiningD},
+	{0x072C, 0x072C, joiningR},
+	{0x072D, 0x072E, joiningD},
+	{0x072F, 0x072F, joiningR},
+	{0x0730, 0x074A, joiningT},
+	{0x074D, 0x074D, joiningR},
+	{0x074E, 0x0758, joiningD},
+	{0x0759, 0x075B, joiningR},
+	{0x075C, 0x076A, joiningD},
+	{0x076B, 0x076C, joiningR},
+	{0x076D, 0x0770, joiningD},
+	{0x0771, 0x0771, joiningR},
+	{0x0772, 0x0772, joiningD},
+	{0x0773, 0x0774, joiningR},
+	{0x0775, 0x0777, joiningD},
+	{0x0778, 0x0779, joiningR},
+	{0x077A, 0x077F, joiningD},
+	{0x07A6, 0x07B0, joiningT},
+	{0x07CA, 0x07EA, joiningD},
+	{0x07EB, 0x07F3, joiningT},
+	{0x07FD, 0x07FD, joiningT},
+	{0x0816, 0x0819, joiningT},
+	{0x081B, 0x0823, joiningT},
+	{0x0825, 0x0827, joiningT},
+	{0x0829, 0x082D, joiningT},
+	{0x0840, 0x0840, joiningR},
+	{0x0841, 0x0845, joiningD},
+	{0x0846, 0x0847, joiningR},
+	{0x0848, 0x0848, joiningD},
+	{0x0849, 0x0849, joiningR},
+	{0x084A, 0x0853, joiningD},
+	{0x0854, 0x0854, joiningR},
+	{0x0855, 0x0855, joiningD},
+	{0x0856, 0x0858, joiningR},
+	{0x0859, 0x085B, joiningT},
+	{0x0860, 0x0860, joiningD},
+	{0x0862, 0x0865, joiningD},
+	{0x0867, 0x0867, joiningR},
+	{0x0868, 0x0868, joiningD},
+	{0x0869, 0x086A, joiningR},
+	{0x0870, 0x0882, joiningR},
+	{0x0886, 0x0886, joiningD},
+	{0x0889, 0x088D, joiningD},
+	{0x088E, 0x088E, joiningR},
+	{0x088F, 0x088F, joiningD},
+	{0x0897, 0x089F, joiningT},
+	{0x08A0, 0x08A9, joiningD},
+	{0x08AA, 0x08AC, joiningR},
+	{0x08AE, 0x08AE, joiningR},
+	{0x08AF, 0x08B0, joiningD},
+	{0x08B1, 0x08B2, joiningR},
+	{0x08B3, 0x08B8, joiningD},
+	{0x08B9, 0x08B9, joiningR},
+	{0x08BA, 0x08C8, joiningD},
+	{0x08CA, 0x08E1, joiningT},
+	{0x08E3, 0x0902, joiningT},
+	{0x093A, 0x093A, joiningT},
+	{0x093C, 0x093C, joiningT},
+	{0x0941, 0x0948, joiningT},
+	{0x094D, 0x094D, joiningT},
+	{0x0951, 0x0957, joiningT},
+	{0x0962, 0x0963, joiningT},
+	{0x0981, 0x0981, joiningT},
+	{0x09BC, 0x09BC, joiningT},
+	{0x09C1, 0x09C4, joiningT},
+	{0x09CD, 0x09CD, joiningT},
+	{0x09E2, 0x09E3, joiningT},
+	{0x09FE, 0x09FE, joiningT},
+	{0x0A01, 0x0A02, joiningT},
+	{0x0A3C, 0x0A3C, joiningT},
+	{0x0A41, 0x0A42, joiningT},
+	{0x0A47, 0x0A48, joiningT},
+	{0x0A4B, 0x0A4D, joiningT},
+	{0x0A51, 0x0A51, joiningT},
+	{0x0A70, 0x0A71, joiningT},
+	{0x0A75, 0x0A75, joiningT},
+	{0x0A81, 0x0A82, joiningT},
+	{0x0ABC, 0x0ABC, joiningT},
+	{0x0AC1, 0x0AC5, joiningT},
+	{0x0AC7, 0x0AC8, joiningT},
+	{0x0ACD, 0x0ACD, joiningT},
+	{0x0AE2, 0x0AE3, joiningT},
+	{0x0AFA, 0x0AFF, joiningT},
+	{0x0B01, 0x0B01, joiningT},
+	{0x0B3C, 0x0B3C, joiningT},
+	{0x0B3F, 0x0B3F, joiningT},
+	{0x0B41, 0x0B44, joiningT},
+	{0x0B4D, 0x0B4D, joiningT},
+	{0x0B55, 0x0B56, joiningT},
+	{0x0B62, 0x0B63, joiningT},
+	{0x0B82, 0x0B82, joiningT},
+	{0x0BC0, 0x0BC0, joiningT},
+	{0x0BCD, 0x0BCD, joiningT},
+	{0x0C00, 0x0C00, joiningT},
+	{0x0C04, 0x0C04, joiningT},
+	{0x0C3C, 0x0C3C, joiningT},
+	{0x0C3E, 0x0C40, joiningT},
+	{0x0C46, 0x0C48, joiningT},
+	{0x0C4A, 0x0C4D, joiningT},
+	{0x0C55, 0x0C56, joiningT},
+	{0x0C62, 0x0C63, joiningT},
+	{0x0C81, 0x0C81, joiningT},
+	{0x0CBC, 0x0CBC, joiningT},
+	{0x0CBF, 0x0CBF, joiningT},
+	{0x0CC6, 0x0CC6, joiningT},
+	{0x0CCC, 0x0CCD, joiningT},
+	{0x0CE2, 0x0CE3, joiningT},
+	{0x0D00, 0x0D01, joiningT},
+	{0x0D3B, 0x0D3C, joiningT},
+	{0x0D41, 0x0D44, joiningT},
+	{0x0D4D, 0x0D4D, joiningT},
+	{0x0D62, 0x0D63, joiningT},
+	{0x0D81, 0x0D81, joiningT},
+	{0x0DCA, 0x0DCA, joiningT},
+	{0x0DD2, 0x0DD4, joiningT},
+	{0x0DD6, 0x0DD6, joiningT},
+	{0x0E31, 0x0E31, joiningT},
+	{0x0E34, 0x0E3A, joiningT},
+	{0x0E47, 0x0E4E, joiningT},
+	{0x0EB1, 0x0EB1, joiningT},
+	{0x0EB4, 0x0EBC, joiningT},
+	{0x0EC8, 0x0ECE, joiningT},
+	{0x0F18, 0x0F19, joiningT},
+	{0x0F35, 0x0F35, joiningT},
+	{0x0F37, 0x0F37, joiningT},
+	{0x0F39, 0x0F39, joiningT},
+	{0x0F71, 0x0F72, joiningT},
+	{0x0F74, 0x0F74, joiningT},
+	{0x0F7A, 0x0F7E, joiningT},
+	{0x0F80, 0x0F80, joiningT},
+	{0x0F82, 0x0F84, joiningT},
+	{0x0F86, 0x0F87, joiningT},
+	{0x0F8D, 0x0F92, joiningT},
+	{0x0F94, 0x0F97, joiningT},
+	{0x0F99, 0x0F9C, joiningT},
+	{0x0F9E, 0x0FA1, joiningT},
+	{0x0FA3, 0x0FA6, joiningT},
+	{0x0FA8, 0x0FAB, joiningT},
+	{0x0FAD, 0x0FB8, joiningT},
+	{0x0FBA, 0x0FBC, joiningT},
+	{0x0FC6, 0x0FC6, joiningT},
+	{0x102D, 0x1030, joiningT},
+	{0x1032, 0x1037, joiningT},
+	{0x1039, 0x103A, joiningT},
+	{0x103D, 0x103E, joiningT},
+	{0x1058, 0x1059, joiningT},
+	{0x105E, 0x1060, joiningT},
+	{0x1071, 0x1074, joiningT},
+	{0x1082, 0x1082, joiningT},
+	{0x1085, 0x1086, joiningT},
+	{0x108D, 0x108D, joiningT},
+	{0x109D, 0x109D, joiningT},
+	{0x135D, 0x135F, joiningT},
+	{0x1712, 0x1714, joiningT},
+	{0x1732, 0x1733, joiningT},
+	{0x1752, 0x1753, joiningT},
+	{0x1772, 0x1773, joiningT},
+	{0x17B4, 0x17B5, joiningT},
+	{0x17B7, 0x17BD, joiningT},
+	{0x17C6, 0x17C6, joiningT},
+	{0x17C9, 0x17D3, joiningT},
+	{0x17DD, 0x17DD, joiningT},
+	{0x1807, 0x1807, joiningD},
+	{0x180B, 0x180D, joiningT},
+	{0x180F, 0x180F, joiningT},
+	{0x1820, 0x1878, joiningD},
+	{0x1885, 0x1886, joiningT},
+	{0x1887, 0x18A8, joiningD},
+	{0x18A9, 0x18A9, joiningT},
+	{0x18AA, 0x18AA, joiningD},
+	{0x1920, 0x1922, joiningT},
+	{0x1927, 0x1928, joiningT},
+	{0x1932, 0x1932, joiningT},
+	{0x1939, 0x193B, joiningT},
+	{0x1A17, 0x1A18, joiningT},
+	{0x1A1B, 0x1A1B, joiningT},
+	{0x1A56, 0x1A56, joiningT},
+	{0x1A58, 0x1A5E, joiningT},
+	{0x1A60, 0x1A60, joiningT},
+	{0x1A62, 0x1A62, joiningT},
+	{0x1A65, 0x1A6C, joiningT},
+	{0x1A73, 0x1A7C, joiningT},
+	{0x1A7F, 0x1A7F, joiningT},
+	{0x1AB0, 0x1ADD, joiningT},
+	{0x1AE0, 0x1AEB, joiningT},
+	{0x1B00, 0x1B03, joiningT},
+	{0x1B34, 0x1B34, joiningT},
+	{0x1B36, 0x1B3A, joiningT},
+	{0x1B3C, 0x1B3C, joiningT},
+	{0x1B42, 0x1B42, joiningT},
+	{0x1B6B, 0x1B73, joiningT},
+	{0x1B80, 0x1B81, joiningT},
+	{0x1BA2, 0x1BA5, joiningT},
+	{0x1BA8, 0x1BA9, joiningT},
+	{0x1BAB, 0x1BAD, joiningT},
+	{0x1BE6, 0x1BE6, joiningT},
+	{0x1BE8, 0x1BE9, joiningT},
+	{0x1BED, 0x1BED, joiningT},
+	{0x1BEF, 0x1BF1, joiningT},
+	{0x1C2C, 0x1C33, joiningT},
+	{0x1C36, 0x1C37, joiningT},
+	{0x1CD0, 0x1CD2, joiningT},
+	{0x1CD4, 0x1CE0, joiningT},
+	{0x1CE2, 0x1CE8, joiningT},
+	{0x1CED, 0x1CED, joiningT},
+	{0x1CF4, 0x1CF4, joiningT},
+	{0x1CF8, 0x1CF9, joiningT},
+	{0x1DC0, 0x1DFF, joiningT},
+	{0x200B, 0x200B, joiningT},
+	{0x200E, 0x200F, joiningT},
+	{0x202A, 0x202E, joiningT},
+	{0x2060, 0x2064, joiningT},
+	{0x206A, 0x206F, joiningT},
+	{0x20D0, 0x20F0, joiningT},
+	{0x2CEF, 0x2CF1, joiningT},
+	{0x2D7F, 0x2D7F, joiningT},
+	{0x2DE0, 0x2DFF, joiningT},
+	{0x302A, 0x302D, joiningT},
+	{0x3099, 0x309A, joiningT},
+	{0xA66F, 0xA672, joiningT},
+	{0xA674, 0xA67D, joiningT},
+	{0xA69E, 0xA69F, joiningT},
+	{0xA6F0, 0xA6F1, joiningT},
+	{0xA802, 0xA802, joiningT},
+	{0xA806, 0xA806, joiningT},
+	{0xA80B, 0xA80B, joiningT},
+	{0xA825, 0xA826, joiningT},
+	{0xA82C, 0xA82C, joiningT},
+	{0xA840, 0xA871, joiningD},
+	{0xA872, 0xA872, joiningL},
+	{0xA8C4, 0xA8C5, joiningT},
+	{0xA8E0, 0xA8F1, joiningT},
+	{0xA8FF, 0xA8FF, joiningT},
+	{0xA926, 0xA92D, joiningT},
+	{0xA947, 0xA951, joiningT},
+	{0xA980, 0xA982, joiningT},
+	{0xA9B3, 0xA9B3, joiningT},
+	{0xA9B6, 0xA9B9, joiningT},
+	{0xA9BC, 0xA9BD, joiningT},
+	{0xA9E5, 0xA9E5, joiningT},
+	{0xAA29, 0xAA2E, joiningT},
+	{0xAA31, 0xAA32, joiningT},
+	{0xAA35, 0xAA36, joiningT},
+	{0xAA43, 0xAA43, joiningT},
+	{0xAA4C, 0xAA4C, joiningT},
+	{0xAA7C, 0xAA7C, joiningT},
+	{0xAAB0, 0xAAB0, joiningT},
+	{0xAAB2, 0xAAB4, joiningT},
+	{0xAAB7, 0xAAB8, joiningT},
+	{0xAABE, 0xAABF, joiningT},
+	{0xAAC1, 0xAAC1, joiningT},
+	{0xAAEC, 0xAAED, joiningT},
+	{0xAAF6, 0xAAF6, joiningT},
+	{0xABE5, 0xABE5, joiningT},
+	{0xABE8, 0xABE8, joiningT},
+	{0xABED, 0xABED, joiningT},
+	{0xFB1E, 0xFB1E, joiningT},
+	{0xFE00, 0xFE0F, joiningT},
+	{0xFE20, 0xFE2F, joiningT},
+	{0xFEFF, 0xFEFF, joiningT},
+	{0xFFF9, 0xFFFB, joiningT},
+	{0x101FD, 0x101FD, joiningT},
+	{0x102E0, 0x102E0, joiningT},
+	{0x10376, 0x1037A, joiningT},
+	{0x10A01, 0x10A03, joiningT},
+	{0x10A05, 0x10A06, joiningT},
+	{0x10A0C, 0x10A0F, joiningT},
+	{0x10A38, 0x10A3A, joiningT},
+	{0x10A3F, 0x10A3F, joiningT},
+	{0x10AC0, 0x10AC4, joiningD},
+	{0x10AC5, 0x10AC5, joiningR},
+	{0x10AC7, 0x10AC7, joiningR},
+	{0x10AC9, 0x10ACA, joiningR},
+	{0x10ACD, 0x10ACD, joiningL},
+	{0x10ACE, 0x10AD2, joiningR},
+	{0x10AD3, 0x10AD6, joiningD},
+	{0x10AD7, 0x10AD7, joiningL},
+	{0x10AD8, 0x10ADC, joiningD},
+	{0x10ADD, 0x10ADD, joiningR},
+	{0x10ADE, 0x10AE0, joiningD},
+	{0x10AE1, 0x10AE1, joiningR},
+	{0x10AE4, 0x10AE4, joiningR},
+	{0x10AE5, 0x10AE6, joiningT},
+	{0x10AEB, 0x10AEE, joiningD},
+	{0x10AEF, 0x10AEF, joiningR},
+	{0x10B80, 0x10B80, joiningD},
+	{0x10B81, 0x10B81, joiningR},
+	{0x10B82, 0x10B82, joiningD},
+	{0x10B83, 0x10B85, joiningR},
+	{0x10B86, 0x10B88, joiningD},
+	{0x10B89, 0x10B89, joiningR},
+	{0x10B8A, 0x10B8B, joiningD},
+	{0x10B8C, 0x10B8C, joiningR},
+	{0x10B8D, 0x10B8D, joiningD},
+	{0x10B8E, 0x10B8F, joiningR},
+	{0x10B90, 0x10B90, joiningD},
+	{0x10B91, 0x10B91, joiningR},
+	{0x10BA9, 0x10BAC, joiningR},
+	{0x10BAD, 0x10BAE, joiningD},
+	{0x10D00, 0x10D00, joiningL},
+	{0x10D01, 0x10D21, joiningD},
+	{0x10D22, 0x10D22, joiningR},
+	{0x10D23, 0x10D23, joiningD},
+	{0x10D24, 0x10D27, joiningT},
+	{0x10D69, 0x10D6D, joiningT},
+	{0x10EAB, 0x10EAC, joiningT},
+	{0x10EC2, 0x10EC2, joiningR},
+	{0x10EC3, 0x10EC4, joiningD},
+	{0x10EC6, 0x10EC7, joiningD},
+	{0x10EFA, 0x10EFF, joiningT},
+	{0x10F30, 0x10F32, joiningD},
+	{0x10F33, 0x10F33, joiningR},
+	{0x10F34, 0x10F44, joiningD},
+	{0x10F46, 0x10F50, joiningT},
+	{0x10F51, 0x10F53, joiningD},
+	{0x10F54, 0x10F54, joiningR},
+	{0x10F70, 0x10F73, joiningD},
+	{0x10F74, 0x10F75, joiningR},
+	{0x10F76, 0x10F81, joiningD},
+	{0x10F82, 0x10F85, joiningT},
+	{0x10FB0, 0x10FB0, joiningD},
+	{0x10FB2, 0x10FB3, joiningD},
+	{0x10FB4, 0x10FB6, joiningR},
+	{0x10FB8, 0x10FB8, joiningD},
+	{0x10FB9, 0x10FBA, joiningR},
+	{0x10FBB, 0x10FBC, joiningD},
+	{0x10FBD, 0x10FBD, joiningR},
+	{0x10FBE, 0x10FBF, joiningD},
+	{0x10FC1, 0x10FC1, joiningD},
+	{0x10FC2, 0x10FC3, joiningR},
+	{0x10FC4, 0x10FC4, joiningD},
+	{0x10FC9, 0x10FC9, joiningR},
+	{0x10FCA, 0x10FCA, joiningD},
+	{0x10FCB, 0x10FCB, joiningL},
+	{0x11001, 0x11001, joiningT},
+	{0x11038, 0x11046, joiningT},
+	{0x11070, 0x11070, joiningT},
+	{0x11073, 0x11074, joiningT},
+	{0x1107F, 0x11081, joiningT},
+	{0x110B3, 0x110B6, joiningT},
+	{0x110B9, 0x110BA, joiningT},
+	{0x110C2, 0x110C2, joiningT},
+	{0x11100, 0x11102, joiningT},
+	{0x11127, 0x1112B, joiningT},
+	{0x1112D, 0x11134, joiningT},
+	{0x11173, 0x11173, joiningT},
+	{0x11180, 0x11181, joiningT},
+	{0x111B6, 0x111BE, joiningT},
+	{0x111C9, 0x111CC, joiningT},
+	{0x111CF, 0x111CF, joiningT},
+	{0x1122F, 0x11231, joiningT},
+	{0x11234, 0x11234, joiningT},
+	{0x11236, 0x11237, joiningT},
+	{0x1123E, 0x1123E, joiningT},
+	{0x11241, 0x11241, joiningT},
+	{0x112DF, 0x112DF, joiningT},
+	{0x112E3, 0x112EA, joiningT},
+	{0x11300, 0x11301, joiningT},
+	{0x1133B, 0x1133C, joiningT},
+	{0x11340, 0x11340, joiningT},
+	{0x11366, 0x1136C, joiningT},
+	{0x11370, 0x11374, joiningT},
+	{0x113BB, 0x113C0, joiningT},
+	{0x113CE, 0x113CE, joiningT},
+	{0x113D0, 0x113D0, joiningT},
+	{0x113D2, 0x113D2, joiningT},
+	{0x113E1, 0x113E2, joiningT},
+	{0x11438, 0x1143F, joiningT},
+	{0x11442, 0x11444, joiningT},
+	{0x11446, 0x11446, joiningT},
+	{0x1145E, 0x1145E, joiningT},
+	{0x114B3, 0x114B8, joiningT},
+	{0x114BA, 0x114BA, joiningT},
+	{0x114BF, 0x114C0, joiningT},
+	{0x114C2, 0x114C3, joiningT},
+	{0x115B2, 0x115B5, joiningT},
+	{0x115BC, 0x115BD, joiningT},
+	{0x115BF, 0x115C0, joiningT},
+	{0x115DC, 0x115DD, joiningT},
+	{0x11633, 0x1163A, joiningT},
+	{0x1163D, 0x1163D, joiningT},
+	{0x1163F, 0x11640, joiningT},
+	{0x116AB, 0x116AB, joiningT},
+	{0x116AD, 0x116AD, joiningT},
+	{0x116B0, 0x116B5, joiningT},
+	{0x116B7, 0x116B7, joiningT},
+	{0x1171D, 0x1171D, joiningT},
+	{0x1171F, 0x1171F, joiningT},
+	{0x11722, 0x11725, joiningT},
+	{0x11727, 0x1172B, joiningT},
+	{0x1182F, 0x11837, joiningT},
+	{0x11839, 0x1183A, joiningT},
+	{0x1193B, 0x1193C, joiningT},
+	{0x1193E, 0x1193E, joiningT},
+	{0x11943, 0x11943, joiningT},
+	{0x119D4, 0x119D7, joiningT},
+	{0x119DA, 0x119DB, joiningT},
+	{0x119E0, 0x119E0, joiningT},
+	{0x11A01, 0x11A0A, joiningT},
+	{0x11A33, 0x11A38, joiningT},
+	{0x11A3B, 0x11A3E, joiningT},
+	{0x11A47, 0x11A47, joiningT},
+	{0x11A51, 0x11A56, joiningT},
+	{0x11A59, 0x11A5B, joiningT},
+	{0x11A8A, 0x11A96, joiningT},
+	{0x11A98, 0x11A99, joiningT},
+	{0x11B60, 0x11B60, joiningT},
+	{0x11B62, 0x11B64, joiningT},
+	{0x11B66, 0x11B66, joiningT},
+	{0x11C30, 0x11C36, joiningT},
+	{0x11C38, 0x11C3D, joiningT},
+	{0x11C3F, 0x11C3F, joiningT},
+	{0x11C92, 0x11CA7, joiningT},
+	{0x11CAA, 0x11CB0, joiningT},
+	{0x11CB2, 0x11CB3, joiningT},
+	{0x11CB5, 0x11CB6, joiningT},
+	{0x11D31, 0x11D36, joiningT},
+	{0x11D3A, 0x11D3A, joiningT},
+	{0x11D3C, 0x11D3D, joiningT},
+	{0x11D3F, 0x11D45, joiningT},
+	{0x11D47, 0x11D47, joiningT},
+	{0x11D90, 0x11D91, joiningT},
+	{0x11D95, 0x11D95, joiningT},
+	{0x11D97, 0x11D97, joiningT},
+	{0x11EF3, 0x11EF4, joiningT},
+	{0x11F00, 0x11F01, joiningT},
+	{0x11F36, 0x11F3A, joiningT},
+	{0x11F40, 0x11F40, joiningT},
+	{0x11F42, 0x11F42, joiningT},
+	{0x11F5A, 0x11F5A, joiningT},
+	{0x13430, 0x13440, joiningT},
+	{0x13447, 0x13455, joiningT},
+	{0x1611E, 0x16129, joiningT},
+	{0x1612D, 0x1612F, joiningT},
+	{0x16AF0, 0x16AF4, joiningT},
+	{0x16B30, 0x16B36, joiningT},
+	{0x16F4F, 0x16F4F, joiningT},
+	{0x16F8F, 0x16F92, joiningT},
+	{0x16FE4, 0x16FE4, joiningT},
+	{0x1BC9D, 0x1BC9E, joiningT},
+	{0x1BCA0, 0x1BCA3, joiningT},
+	{0x1CF00, 0x1CF2D, joiningT},
+	{0x1CF30, 0x1CF46, joiningT},
+	{0x1D167, 0x1D169, joiningT},
+	{0x1D173, 0x1D182, joiningT},
+	{0x1D185, 0x1D18B, joiningT},
+	{0x1D1AA, 0x1D1AD, joiningT},
+	{0x1D242, 0x1D244, joiningT},
+	{0x1DA00, 0x1DA36, joiningT},
+	{0x1DA3B, 0x1DA6C, joiningT},
+	{0x1DA75, 0x1DA75, joiningT},
+	{0x1DA84, 0x1DA84, joiningT},
+	{0x1DA9B, 0x1DA9F, joiningT},
+	{0x1DAA1, 0x1DAAF, joiningT},
+	{0x1E000, 0x1E006, joiningT},
+	{0x1E008, 0x1E018, joiningT},
+	{0x1E01B, 0x1E021, joiningT},
+	{0x1E023, 0x1E024, joiningT},
+	{0x1E026, 0x1E02A, joiningT},
+	{0x1E08F, 0x1E08F, joiningT},
+	{0x1E130, 0x1E136, joiningT},
+	{0x1E2AE, 0x1E2AE, joiningT},
+	{0x1E2EC, 0x1E2EF, joiningT},
+	{0x1E4EC, 0x1E4EF, joiningT},
+	{0x1E5EE, 0x1E5EF, joiningT},
+	{0x1E6E3, 0x1E6E3, joiningT},
+	{0x1E6E6, 0x1E6E6, joiningT},
+	{0x1E6EE, 0x1E6EF, joiningT},
+	{0x1E6F5, 0x1E6F5, joiningT},
+	{0x1E8D0, 0x1E8D6, joiningT},
+	{0x1E922, 0x1E943, joiningD},
+	{0x1E944, 0x1E94B, joiningT},
+	{0xE0001, 0xE0001, joiningT},
+	{0xE0020, 0xE007F, joiningT},
+	{0xE0100, 0xE01EF, joiningT},
+}
+
+var markTable = []runeRange{
+	{0x0300, 0x036F, 1},
+	{0x0483, 0x0489, 1},
+	{0x0591, 0x05BD, 1},
+	{0x05BF, 0x05BF, 1},
+	{0x05C1, 0x05C2, 1},
+	{0x05C4, 0x05C5, 1},
+	{0x05C7, 0x05C7, 1},
+	{0x0610, 0x061A, 1},
+	{0x064B, 0x065F, 1},
+	{0x0670, 0x0670, 1},
+	{0x06D6, 0x06DC, 1},
+	{0x06DF, 0x06E4, 1},
+	{0x06E7, 0x06E8, 1},
+	{0x06EA, 0x06ED, 1},
+	{0x0711, 0x0711, 1},
+	{0x0730, 0x074A, 1},
+	{0x07A6, 0x07B0, 1},
+	{0x07EB, 0x07F3, 1},
+	{0x07FD, 0x07FD, 1},
+	{0x0816, 0x0819, 1},
+	{0x081B, 0x0823, 1},
+	{0x0825, 0x0827, 1},
+	{0x0829, 0x082D, 1},
+	{0x0859, 0x085B, 1},
+	{0x0897, 0x089F, 1},
+	{0x08CA, 0x08E1, 1},
+	{0x08E3, 0x0903, 1},
+	{0x093A, 0x093C, 1},
+	{0x093E, 0x094F, 1},
+	{0x0951, 0x0957, 1},
+	{0x0962, 0x0963, 1},
+	{0x0981, 0x0983, 1},
+	{0x09BC, 0x09BC, 1},
+	{0x09BE, 0x09C4, 1},
+	{0x09C7, 0x09C8, 1},
+	{0x09CB, 0x09CD, 1},
+	{0x09D7, 0x09D7, 1},
+	{0x09E2, 0x09E3, 1},
+	{0x09FE, 0x09FE, 1},
+	{0x0A01, 0x0A03, 1},
+	{0x0A3C, 0x0A3C, 1},
+	{0x0A3E, 0x0A42, 1},
+	{0x0A47, 0x0A48, 1},
+	{0x0A4B, 0x0A4D, 1},
+	{0x0A51, 0x0A51, 1},
+	{0x0A70, 0x0A71, 1},
+	{0x0A75, 0x0A75, 1},
+	{0x0A81, 0x0A83, 1},
+	{0x0ABC, 0x0ABC, 1},
+	{0x0ABE, 0x0AC5, 1},
+	{0x0AC7, 0x0AC9, 1},
+	{0x0ACB, 0x0ACD, 1},
+	{0x0AE2, 0x0AE3, 1},
+	{0x0AFA, 0x0AFF, 1},
+	{0x0B01, 0x0B03, 1},
+	{0x0B3C, 0x0B3C, 1},
+	{0x0B3E, 0x0B44, 1},
+	{0x0B47, 0x0B48, 1},
+	{0x0B4B, 0x0B4D, 1},
+	{0x0B55, 0x0B57, 1},
+	{0x0B62, 0x0B63, 1},
+	{0x0B82, 0x0B82, 1},
+	{0x0BBE, 0x0BC2, 1},
+	{0x0BC6, 0x0BC8, 1},
+	{0x0BCA, 0x0BCD, 1},
+	{0x0BD7, 0x0BD7, 1},
+	{0x0C00, 0x0C04, 1},
+	{0x0C3C, 0x0C3C, 1},
+	{0x0C3E, 0x0C44, 1},
+	{0x0C46, 0x0C48, 1},
+	{0x0C4A, 0x0C4D, 1},
+	{0x0C55, 0x0C56, 1},
+	{0x0C62, 0x0C63, 1},
+	{0x0C81, 0x0C83, 1},
+	{0x0CBC, 0x0CBC, 1},
+	{0x0CBE, 0x0CC4, 1},
+	{0x0CC6, 0x0CC8, 1},
+	{0x0CCA, 0x0CCD, 1},
+	{0x0CD5, 0x0CD6, 1},
+	{0x0CE2, 0x0CE3, 1},
+	{0x0CF3, 0x0CF3, 1},
+	{0x0D00, 0x0D03, 1},
+	{0x0D3B, 0x0D3C, 1},
+	{0x0D3E, 0x0D44, 1},
+	{0x0D46, 0x0D48, 1},
+	{0x0D4A, 0x0D4D, 1},
+	{0x0D57, 0x0D57, 1},
+	{0x0D62, 0x0D63, 1},
+	{0x0D81, 0x0D83, 1},
+	{0x0DCA, 0x0DCA, 1},
+	{0x0DCF, 0x0DD4, 1},
+	{0x0DD6, 0x0DD6, 1},
+	{0x0DD8, 0x0DDF, 1},
+	{0x0DF2, 0x0DF3, 1},
+	{0x0E31, 0x0E31, 1},
+	{0x0E34, 0x0E3A, 1},
+	{0x0E47, 0x0E4E, 1},
+	{0x0EB1, 0x0EB1, 1},
+	{0x0EB4, 0x0EBC, 1},
+	{0x0EC8, 0x0ECE, 1},
+	{0x0F18, 0x0F19, 1},
+	{0x0F35, 0x0F35, 1},
+	{0x0F37, 0x0F37, 1},
+	{0x0F39, 0x0F39, 1},
+	{0x0F3E, 0x0F3F, 1},
+	{0x0F71, 0x0F84, 1},
+	{0x0F86, 0x0F87, 1},
+	{0x0F8D, 0x0F97, 1},
+	{0x0F99, 0x0FBC, 1},
+	{0x0FC6, 0x0FC6, 1},
+	{0x102B, 0x103E, 1},
+	{0x1056, 0x1059, 1},
+	{0x105E, 0x1060, 1},
+	{0x1062, 0x1064, 1},
+	{0x1067, 0x106D, 1},
+	{0x1071, 0x1074, 1},
+	{0x1082, 0x108D, 1},
+	{0x108F, 0x108F, 1},
+	{0x109A, 0x109D, 1},
+	{0x135D, 0x135F, 1},
+	{0x1712, 0x1715, 1},
+	{0x1732, 0x1734, 1},
+	{0x1752, 0x1753, 1},
+	{0x1772, 0x1773, 1},
+	{0x17B4, 0x17D3, 1},
+	{0x17DD, 0x17DD, 1},
+	{0x180B, 0x180D, 1},
+	{0x180F, 0x180F, 1},
+	{0x1885, 0x1886, 1},
+	{0x18A9, 0x18A9, 1},
+	{0x1920, 0x192B, 1},
+	{0x1930, 0x193B, 1},
+	{0x1A17, 0x1A1B, 1},
+	{0x1A55, 0x1A5E, 1},
+	{0x1A60, 0x1A7C, 1},
+	{0x1A7F, 0x1A7F, 1},
+	{0x1AB0, 0x1ADD, 1},
+	{0x1AE0, 0x1AEB, 1},
+	{0x1B00, 0x1B04, 1},
+	{0x1B34, 0x1B44, 1},
+	{0x1B6B, 0x1B73, 1},
+	{0x1B80, 0x1B82, 1},
+	{0x1BA1, 0x1BAD, 1},
+	{0x1BE6, 0x1BF3, 1},
+	{0x1C24, 0x1C37, 1},
+	{0x1CD0, 0x1CD2, 1},
+	{0x1CD4, 0x1CE8, 1},
+	{0x1CED, 0x1CED, 1},
+	{0x1CF4, 0x1CF4, 1},
+	{0x1CF7, 0x1CF9, 1},
+	{0x1DC0, 0x1DFF, 1},
+	{0x20D0, 0x20F0, 1},
+	{0x2CEF, 0x2CF1, 1},
+	{0x2D7F, 0x2D7F, 1},
+	{0x2DE0, 0x2DFF, 1},
+	{0x302A, 0x302F, 1},
+	{0x3099, 0x309A, 1},
+	{0xA66F, 0xA672, 1},
+	{0xA674, 0xA67D, 1},
+	{0xA69E, 0xA69F, 1},
+	{0xA6F0, 0xA6F1, 1},
+	{0xA802, 0xA802, 1},
+	{0xA806, 0xA806, 1},
+	{0xA80B, 0xA80B, 1},
+	{0xA823, 0xA827, 1},
+	{0xA82C, 0xA82C, 1},
+	{0xA880, 0xA881, 1},
+	{0xA8B4, 0xA8C5, 1},
+	{0xA8E0, 0xA8F1, 1},
+	{0xA8FF, 0xA8FF, 1},
+	{0xA926, 0xA92D, 1},
+	{0xA947, 0xA953, 1},
+	{0xA980, 0xA983, 1},
+	{0xA9B3, 0xA9C0, 1},
+	{0xA9E5, 0xA9E5, 1},
+	{0xAA29, 0xAA36, 1},
+	{0xAA43, 0xAA43, 1},
+	{0xAA4C, 0xAA4D, 1},
+	{0xAA7B, 0xAA7D, 1},
+	{0xAAB0, 0xAAB0, 1},
+	{0xAAB2, 0xAAB4, 1},
+	{0xAAB7, 0xAAB8, 1},
+	{0xAABE, 0xAABF, 1},
+	{0xAAC1, 0xAAC1, 1},
+	{0xAAEB, 0xAAEF, 1},
+	{0xAAF5, 0xAAF6, 1},
+	{0xABE3, 0xABEA, 1},
+	{0xABEC, 0xABED, 1},
+	{0xFB1E, 0xFB1E, 1},
+	{0xFE00, 0xFE0F, 1},
+	{0xFE20, 0xFE2F, 1},
+	{0x101FD, 0x101FD, 1},
+	{0x102E0, 0x102E0, 1},
+	{0x10376, 0x1037A, 1},
+	{0x10A01, 0x10A03, 1},
+	{0x10A05, 0x10A06, 1},
+	{0x10A0C, 0x10A0F, 1},
+	{0x10A38, 0x10A3A, 1},
+	{0x10A3F, 0x10A3F, 1},
+	{0x10AE5, 0x10AE6, 1},
+	{0x10D24, 0x10D27, 1},
+	{0x10D69, 0x10D6D, 1},
+	{0x10EAB, 0x10EAC, 1},
+	{0x10EFA, 0x10EFF, 1},
+	{0x10F46, 0x10F50, 1},
+	{0x10F82, 0x10F85, 1},
+	{0x11000, 0x11002, 1},
+	{0x11038, 0x11046, 1},
+	{0x11070, 0x11070, 1},
+	{0x11073, 0x11074, 1},
+	{0x1107F, 0x11082, 1},
+	{0x110B0, 0x110BA, 1},
+	{0x110C2, 0x110C2, 1},
+	{0x11100, 0x11102, 1},
+	{0x11127, 0x11134, 1},
+	{0x11145, 0x11146, 1},
+	{0x11173, 0x11173, 1},
+	{0x11180, 0x11182, 1},
+	{0x111B3, 0x111C0, 1},
+	{0x111C9, 0x111CC, 1},
+	{0x111CE, 0x111CF, 1},
+	{0x1122C, 0x11237, 1},
+	{0x1123E, 0x1123E, 1},
+	{0x11241, 0x11241, 1},
+	{0x112DF, 0x112EA, 1},
+	{0x11300, 0x11303, 1},
+	{0x1133B, 0x1133C, 1},
+	{0x1133E, 0x11344, 1},
+	{0x11347, 0x11348, 1},
+	{0x1134B, 0x1134D, 1},
+	{0x11357, 0x11357, 1},
+	{0x11362, 0x11363, 1},
+	{0x11366, 0x1136C, 1},
+	{0x11370, 0x11374, 1},
+	{0x113B8, 0x113C0, 1},
+	{0x113C2, 0x113C2, 1},
+	{0x113C5, 0x113C5, 1},
+	{0x113C7, 0x113CA, 1},
+	{0x113CC, 0x113D0, 1},
+	{0x113D2, 0x113D2, 1},
+	{0x113E1, 0x113E2, 1},
+	{0x11435, 0x11446, 1},
+	{0x1145E, 0x1145E, 1},
+	{0x114B0, 0x114C3, 1},
+	{0x115AF, 0x115B5, 1},
+	{0x115B8, 0x115C0, 1},
+	{0x115DC, 0x115DD, 1},
+	{0x11630, 0x11640, 1},
+	{0x116AB, 0x116B7, 1},
+	{0x1171D, 0x1172B, 1},
+	{0x1182C, 0x1183A, 1},
+	{0x11930, 0x11935, 1},
+	{0x11937, 0x11938, 1},
+	{0x1193B, 0x1193E, 1},
+	{0x11940, 0x11940, 1},
+	{0x11942, 0x11943, 1},
+	{0x119D1, 0x119D7, 1},
+	{0x119DA, 0x119E0, 1},
+	{0x119E4, 0x119E4, 1},
+	{0x11A01, 0x11A0A, 1},
+	{0x11A33, 0x11A39, 1},
+	{0x11A3B, 0x11A3E, 1},
+	{0x11A47, 0x11A47, 1},
+	{0x11A51, 0x11A5B, 1},
+	{0x11A8A, 0x11A99, 1},
+	{0x11B60, 0x11B67, 1},
+	{0x11C2F, 0x11C36, 1},
+	{0x11C38, 0x11C3F, 1},
+	{0x11C92, 0x11CA7, 1},
+	{0x11CA9, 0x11CB6, 1},
+	{0x11D31, 0x11D36, 1},
+	{0x11D3A, 0x11D3A, 1},
+	{0x11D3C, 0x11D3D, 1},
+	{0x11D3F, 0x11D45, 1},
+	{0x11D47, 0x11D47, 1},
+	{0x11D8A, 0x11D8E, 1},
+	{0x11D90, 0x11D91, 1},
+	{0x11D93, 0x11D97, 1},
+	{0x11EF3, 0x11EF6, 1},
+	{0x11F00, 0x11F01, 1},
+	{0x11F03, 0x11F03, 1},
+	{0x11F34, 0x11F3A, 1},
+	{0x11F3E, 0x11F42, 1},
+	{0x11F5A, 0x11F5A, 1},
+	{0x13440, 0x13440, 1},
+	{0x13447, 0x13455, 1},
+	{0x1611E, 0x1612F, 1},
+	{0x16AF0, 0x16AF4, 1},
+	{0x16B30, 0x16B36, 1},
+	{0x16F4F, 0x16F4F, 1},
+	{0x16F51, 0x16F87, 1},
+	{0x16F8F, 0x16F92, 1},
+	{0x16FE4, 0x16FE4, 1},
+	{0x16FF0, 0x16FF1, 1},
+	{0x1BC9D, 0x1BC9E, 1},
+	{0x1CF00, 0x1CF2D, 1},
+	{0x1CF30, 0x1CF46, 1},
+	{0x1D165, 0x1D169, 1},
+	{0x1D16D, 0x1D172, 1},
+	{0x1D17B, 0x1D182, 1},
+	{0x1D185, 0x1D18B, 1},
+	{0x1D1AA, 0x1D1AD, 1},
+	{0x1D242, 0x1D244, 1},
+	{0x1DA00, 0x1DA36, 1},
+	{0x1DA3B, 0x1DA6C, 1},
+	{0x1DA75, 0x1DA75, 1},
+	{0x1DA84, 0x1DA84, 1},
+	{0x1DA9B, 0x1DA9F, 1},
+	{0x1DAA1, 0x1DAAF, 1},
+	{0x1E000, 0x1E006, 1},
+	{0x1E008, 0x1E018, 1},
+	{0x1E01B, 0x1E021, 1},
+	{0x1E023, 0x1E024, 1},
+	{0x1E026, 0x1E02A, 1},
+	{0x1E08F, 0x1E08F, 1},
+	{0x1E130, 0x1E136, 1},
+	{0x1E2AE, 0x1E2AE, 1},
+	{0x1E2EC, 0x1E2EF, 1},
+	{0x1E4EC, 0x1E4EF, 1},
+	{0x1E5EE, 0x1E5EF, 1},
+	{0x1E6E3, 0x1E6E3, 1},
+	{0x1E6E6, 0x1E6E6, 1},
+	{0x1E6EE, 0x1E6EF, 1},
+	{0x1E6F5, 0x1E6F5, 1},
+	{0x1E8D0, 0x1E8D6, 1},
+	{0x1E944, 0x1E94A, 1},
+	{0xE0100, 0xE01EF, 1},
+}
+
+var decompositionTable = []decomposition{
+	{0x00C0, "A\u0300"},
+	{0x00C1, "A\u0301"},
+	{0x00C2, "A\u0302"},
+	{0x00C3, "A\u0303"},
+	{0x00C4, "A\u0308"},
+	{0x00C5, "A\u030a"},
+	{0x00C7, "C\u0327"},
+	{0x00C8, "E\u0300"},
+	{0x00C9, "E\u0301"},
+	{0x00CA, "E\u0302"},
+	{0x00CB, "E\u0308"},
+	{0x00CC, "I\u0300"},
+	{0x00CD, "I\u0301"},
+	{0x00CE, "I\u0302"},
+	{0x00CF, "I\u0308"},
+	{0x00D1, "N\u0303"},
+	{0x00D2, "O\u0300"},
+	{0x00D3, "O\u0301"},
+	{0x00D4, "O\u0302"},
+	{0x00D5, "O\u0303"},
+	{0x00D6, "O\u0308"},
+	{0x00D9, "U\u0300"},
+	{0x00DA, "U\u0301"},
+	{0x00DB, "U\u0302"},
+	{0x00DC, "U\u0308"},
+	{0x00DD, "Y\u0301"},
+	{0x00E0, "a\u0300"},
+	{0x00E1, "a\u0301"},
+	{0x00E2, "a\u0302"},
+	{0x00E3, "a\u0303"},
+	{0x00E4, "a\u0308"},
+	{0x00E5, "a\u030a"},
+	{0x00E7, "c\u0327"},
+	{0x00E8, "e\u0300"},
+	{0x00E9, "e\u0301"},
+	{0x00EA, "e\u0302"},
+	{0x00EB, "e\u0308"},
+	{0x00EC, "i\u0300"},
+	{0x00ED, "i\u0301"},
+	{0x00EE, "i\u0302"},
+	{0x00EF, "i\u0308"},
+	{0x00F1, "n\u0303"},
+	{0x00F2, "o\u0300"},
+	{0x00F3, "o\u0301"},
+	{0x00F4, "o\u0302"},
+	{0x00F5, "o\u0303"},
+	{0x00F6, "o\u0308"},
+	{0x00F9, "u\u0300"},
+	{0x00FA, "u\u0301"},
+	{0x00FB, "u\u0302"},
+	{0x00FC, "u\u0308"},
+	{0x00FD, "y\u0301"},
+	{0x00FF, "y\u0308"},
+	{0x0100, "A\u0304"},
+	{0x0101, "a\u0304"},
+	{0x0102, "A\u0306"},
+	{0x0103, "a\u0306"},
+	{0x0104, "A\u0328"},
+	{0x0105, "a\u0328"},
+	{0x0106, "C\u0301"},
+	{0x0107, "c\u0301"},
+	{0x0108, "C\u0302"},
+	{0x0109, "c\u0302"},
+	{0x010A, "C\u0307"},
+	{0x010B, "c\u0307"},
+	{0x010C, "C\u030c"},
+	{0x010D, "c\u030c"},
+	{0x010E, "D\u030c"},
+	{0x010F, "d\u030c"},
+	{0x0112, "E\u0304"},
+	{0x0113, "e\u0304"},
+	{0x0114, "E\u0306"},
+	{0x0115, "e\u0306"},
+	{0x0116, "E\u0307"},
+	{0x0117, "e\u0307"},
+	{0x0118, "E\u0328"},
+	{0x0119, "e\u0328"},
+	{0x011A, "E\u030c"},
+	{0x011B, "e\u030c"},
+	{0x011C, "G\u0302"},
+	{0x011D, "g\u0302"},
+	{0x011E, "G\u0306"},
+	{0x011F, "g\u0306"},
+	{0x0120, "G\u0307"},
+	{0x0121, "g\u0307"},
+	{0x0122, "G\u0327"},
+	{0x0123, "g\u0327"},
+	{0x0124, "H\u0302"},
+	{0x0125, "h\u0302"},
+	{0x0128, "I\u0303"},
+	{0x0129, "i\u0303"},
+	{0x012A, "I\u0304"},
+	{0x012B, "i\u0304"},
+	{0x012C, "I\u0306"},
+	{0x012D, "i\u0306"},
+	{0x012E, "I\u0328"},
+	{0x012F, "i\u0328"},
+	{0x0130, "I\u0307"},
+	{0x0134, "J\u0302"},
+	{0x0135, "j\u0302"},
+	{0x0136, "K\u0327"},
+	{0x0137, "k\u0327"},
+	{0x0139, "L\u0301"},
+	{0x013A, "l\u0301"},
+	{0x013B, "L\u0327"},
+	{0x013C, "l\u0327"},
+	{0x013D, "L\u030c"},
+	{0x013E, "l\u030c"},
+	{0x0143, "N\u0301"},
+	{0x0144, "n\u0301"},
+	{0x0145, "N\u0327"},
+	{0x0146, "n\u0327"},
+	{0x0147, "N\u030c"},
+	{0x0148, "n\u030c"},
+	{0x014C, "O\u0304"},
+	{0x014D, "o\u0304"},
+	{0x014E, "O\u0306"},
+	{0x014F, "o\u0306"},
+	{0x0150, "O\u030b"},
+	{0x0151, "o\u030b"},
+	{0x0154, "R\u0301"},
+	{0x0155, "r\u0301"},
+	{0x0156, "R\u0327"},
+	{0x0157, "r\u0327"},
+	{0x0158, "R\u030c"},
+	{0x0159, "r\u030c"},
+	{0x015A, "S\u0301"},
+	{0x015B, "s\u0301"},
+	{0x015C, "S\u0302"},
+	{0x015D, "s\u0302"},
+	{0x015E, "S\u0327"},
+	{0x015F, "s\u0327"},
+	{0x0160, "S\u030c"},
+	{0x0161, "s\u030c"},
+	{0x0162, "T\u0327"},
+	{0x0163, "t\u0327"},
+	{0x0164, "T\u030c"},
+	{0x0165, "t\u030c"},
+	{0x0168, "U\u0303"},
+	{0x0169, "u\u0303"},
+	{0x016A, "U\u0304"},
+	{0x016B, "u\u0304"},
+	{0x016C, "U\u0306"},
+	{0x016D, "u\u0306"},
+	{0x016E, "U\u030a"},
+	{0x016F, "u\u030a"},
+	{0x0170, "U\u030b"},
+	{0x0171, "u\u030b"},
+	{0x0172, "U\u0328"},
+	{0x0173, "u\u0328"},
+	{0x0174, "W\u0302"},
+	{0x0175, "w\u0302"},
+	{0x0176, "Y\u0302"},
+	{0x0177, "y\u0302"},
+	{0x0178, "Y\u0308"},
+	{0x0179, "Z\u0301"},
+	{0x017A, "z\u0301"},
+	{0x017B, "Z\u0307"},
+	{0x017C, "z\u0307"},
+	{0x017D, "Z\u030c"},
+	{0x017E, "z\u030c"},
+	{0x01A0, "O\u031b"},
+	{0x01A1, "o\u031b"},
+	{0x01AF, "U\u031b"},
+	{0x01B0, "u\u031b"},
+	{0x01CD, "A\u030c"},
+	{0x01CE, "a\u030c"},
+	{0x01CF, "I\u030c"},
+	{0x01D0, "i\u030c"},
+	{0x01D1, "O\u030c"},
+	{0x01D2, "o\u030c"},
+	{0x01D3, "U\u030c"},
+	{0x01D4, "u\u030c"},
+	{0x01D5, "U\u0308\u0304"},
+	{0x01D6, "u\u0308\u0304"},
+	{0x01D7, "U\u0308\u0301"},
+	{0x01D8, "u\u0308\u0301"},
+	{0x01D9, "U\u0308\u030c"},
+	{0x01DA, "u\u0308\u030c"},
+	{0x01DB, "U\u0308\u0300"},
+	{0x01DC, "u\u0308\u0300"},
+	{0x01DE, "A\u0308\u0304"},
+	{0x01DF, "a\u0308\u0304"},
+	{0x01E0, "A\u0307\u0304"},
+	{0x01E1, "a\u0307\u0304"},
+	{0x01E2, "\u00c6\u0304"},
+	{0x01E3, "\u00e6\u0304"},
+	{0x01E6, "G\u030c"},
+	{0x01E7, "g\u030c"},
+	{0x01E8, "K\u030c"},
+	{0x01E9, "k\u030c"},
+	{0x01EA, "O\u0328"},
+	{0x01EB, "o\u0328"},
+	{0x01EC, "O\u0328\u0304"},
+	{0x01ED, "o\u0328\u0304"},
+	{0x01EE, "\u01b7\u030c"},
+	{0x01EF, "\u0292\u030c"},
+	{0x01F0, "j\u030c"},
+	{0x01F4, "G\u0301"},
+	{0x01F5, "g\u0301"},
+	{0x01F8, "N\u0300"},
+	{0x01F9, "n\u0300"},
+	{0x01FA, "A\u030a\u0301"},
+	{0x01FB, "a\u030a\u0301"},
+	{0x01FC, "\u00c6\u0301"},
+	{0x01FD, "\u00e6\u0301"},
+	{0x01FE, "\u00d8\u0301"},
+	{0x01FF, "\u00f8\u0301"},
+	{0x0200, "A\u030f"},
+	{0x0201, "a\u030f"},
+	{0x0202, "A\u0311"},
+	{0x0203, "a\u0311"},
+	{0x0204, "E\u030f"},
+	{0x0205, "e\u030f"},
+	{0x0206, "E\u0311"},
+	{0x0207, "e\u0311"},
+	{0x0208, "I\u030f"},
+	{0x0209, "i\u030f"},
+	{0x020A, "I\u0311"},
+	{0x020B, "i\u0311"},
+	{0x020C, "O\u030f"},
+	{0x020D, "o\u030f"},
+	{0x020E, "O\u0311"},
+	{0x020F, "o\u0311"},
+	{0x0210, "R\u030f"},
+	{0x0211, "r\u030f"},
+	{0x0212, "R\u0311"},
+	{0x0213, "r\u0311"},
+	{0x0214, "U\u030f"},
+	{0x0215, "u\u030f"},
+	{0x0216, "U\u0311"},
+	{0x0217, "u\u0311"},
+	{0x0218, "S\u0326"},
+	{0x0219, "s\u0326"},
+	{0x021A, "T\u0326"},
+	{0x021B, "t\u0326"},
+	{0x021E, "H\u030c"},
+	{0x021F, "h\u030c"},
+	{0x0226, "A\u0307"},
+	{0x0227, "a\u0307"},
+	{0x0228, "E\u0327"},
+	{0x0229, "e\u0327"},
+	{0x022A, "O\u0308\u0304"},
+	{0x022B, "o\u0308\u0304"},
+	{0x022C, "O\u0303\u0304"},
+	{0x022D, "o\u0303\u0304"},
+	{0x022E, "O\u0307"},
+	{0x022F, "o\u0307"},
+	{0x0230, "O\u0307\u0304"},
+	{0x0231, "o\u0307\u0304"},
+	{0x0232, "Y\u0304"},
+	{0x0233, "y\u0304"},
+	{0x0340, "\u0300"},
+	{0x0341, "\u0301"},
+	{0x0343, "\u0313"},
+	{0x0344, "\u0308\u0301"},
+	{0x0374, "\u02b9"},
+	{0x037E, ";"},
+	{0x0385, "\u00a8\u0301"},
+	{0x0386, "\u0391\u0301"},
+	{0x0387, "\u00b7"},
+	{0x0388, "\u0395\u0301"},
+	{0x0389, "\u0397\u0301"},
+	{0x038A, "\u0399\u0301"},
+	{0x038C, "\u039f\u0301"},
+	{0x038E, "\u03a5\u0301"},
+	{0x038F, "\u03a9\u0301"},
+	{0x0390, "\u03b9\u0308\u0301"},
+	{0x03AA, "\u0399\u0308"},
+	{0x03AB, "\u03a5\u0308"},
+	{0x03AC, "\u03b1\u0301"},
+	{0x03AD, "\u03b5\u0301"},
+	{0x03AE, "\u03b7\u0301"},
+	{0x03AF, "\u03b9\u0301"},
+	{0x03B0, "\u03c5\u0308\u0301"},
+	{0x03CA, "\u03b9\u0308"},
+	{0x03CB, "\u03c5\u0308"},
+	{0x03CC, "\u03bf\u0301"},
+	{0x03CD, "\u03c5\u0301"},
+	{0x03CE, "\u03c9\u0301"},
+	{0x03D3, "\u03d2\u0301"},
+	{0x03D4, "\u03d2\u0308"},
+	{0x0400, "\u0415\u0300"},
+	{0x0401, "\u0415\u0308"},
+	{0x0403, "\u0413\u0301"},
+	{0x0407, "\u0406\u0308"},
+	{0x040C, "\u041a\u0301"},
+	{0x040D, "\u0418\u0300"},
+	{0x040E, "\u0423\u0306"},
+	{0x0419, "\u0418\u0306"},
+	{0x0439, "\u0438\u0306"},
+	{0x0450, "\u0435\u0300"},
+	{0x0451, "\u0435\u0308"},
+	{0x0453, "\u0433\u0301"},
+	{0x0457, "\u0456\u0308"},
+	{0x045C, "\u043a\u0301"},
+	{0x045D, "\u0438\u0300"},
+	{0x045E, "\u0443\u0306"},
+	{0x0476, "\u0474\u030f"},
+	{0x0477, "\u0475\u030f"},
+	{0x04C1, "\u0416\u0306"},
+	{0x04C2, "\u0436\u0306"},
+	{0x04D0, "\u0410\u0306"},
+	{0x04D1, "\u0430\u0306"},
+	{0x04D2, "\u0410\u0308"},
+	{0x04D3, "\u0430\u0308"},
+	{0x04D6, "\u0415\u0306"},
+	{0x04D7, "\u0435\u0306"},
+	{0x04DA, "\u04d8\u0308"},
+	{0x04DB, "\u04d9\u0308"},
+	{0x04DC, "\u0416\u0308"},
+	{0x04DD, "\u0436\u0308"},
+	{0x04DE, "\u0417\u0308"},
+	{0x04DF, "\u0437\u0308"},
+	{0x04E2, "\u0418\u0304"},
+	{0x04E3, "\u0438\u0304"},
+	{0x04E4, "\u0418\u0308"},
+	{0x04E5, "\u0438\u0308"},
+	{0x04E6, "\u041e\u0308"},
+	{0x04E7, "\u043e\u0308"},
+	{0x04EA, "\u04e8\u0308"},
+	{0x04EB, "\u04e9\u0308"},
+	{0x04EC, "\u042d\u0308"},
+	{0x04ED, "\u044d\u0308"},
+	{0x04EE, "\u0423\u0304"},
+	{0x04EF, "\u0443\u0304"},
+	{0x04F0, "\u0423\u0308"},
+	{0x04F1, "\u0443\u0308"},
+	{0x04F2, "\u0423\u030b"},
+	{0x04F3, "\u0443\u030b"},
+	{0x04F4, "\u0427\u0308"},
+	{0x04F5, "\u0447\u0308"},
+	{0x04F8, "\u042b\u0308"},
+	{0x04F9, "\u044b\u0308"},
+	{0x0622, "\u0627\u0653"},
+	{0x0623, "\u0627\u0654"},
+	{0x0624, "\u0648\u0654"},
+	{0x0625, "\u0627\u0655"},
+	{0x0626, "\u064a\u0654"},
+	{0x06C0, "\u06d5\u0654"},
+	{0x06C2, "\u06c1\u0654"},
+	{0x06D3, "\u06d2\u0654"},
+	{0x0929, "\u0928\u093c"},
+	{0x0931, "\u0930\u093c"},
+	{0x0934, "\u0933\u093c"},
+	{0x0958, "\u0915\u093c"},
+	{0x0959, "\u0916\u093c"},
+	{0x095A, "\u0917\u093c"},
+	{0x095B, "\u091c\u093c"},
+	{0x095C, "\u0921\u093c"},
+	{0x095D, "\u0922\u093c"},
+	{0x095E, "\u092b\u093c"},
+	{0x095F, "\u092f\u093c"},
+	{0x09CB, "\u09c7\u09be"},
+	{0x09CC, "\u09c7\u09d7"},
+	{0x09DC, "\u09a1\u09bc"},
+	{0x09DD, "\u09a2\u09bc"},
+	{0x09DF, "\u09af\u09bc"},
+	{0x0A33, "\u0a32\u0a3c"},
+	{0x0A36, "\u0a38\u0a3c"},
+	{0x0A59, "\u0a16\u0a3c"},
+	{0x0A5A, "\u0a17\u0a3c"},
+	{0x0A5B, "\u0a1c\u0a3c"},
+	{0x0A5E, "\u0a2b\u0a3c"},
+	{0x0B48, "\u0b47\u0b56"},
+	{0x0B4B, "\u0b47\u0b3e"},
+	{0x0B4C, "\u0b47\u0b57"},
+	{0x0B5C, "\u0b21\u0b3c"},
+	{0x0B5D, "\u0b22\u0b3c"},
+	{0x0B94, "\u0b92\u0bd7"},
+	{0x0BCA, "\u0bc6\u0bbe"},
+	{0x0BCB, "\u0bc7\u0bbe"},
+	{0x0BCC, "\u0bc6\u0bd7"},
+	{0x0C48, "\u0c46\u0c56"},
+	{0x0CC0, "\u0cbf\u0cd5"},
+	{0x0CC7, "\u0cc6\u0cd5"},
+	{0x0CC8, "\u0cc6\u0cd6"},
+	{0x0CCA, "\u0cc6\u0cc2"},
+	{0x0CCB, "\u0cc6\u0cc2\u0cd5"},
+	{0x0D4A, "\u0d46\u0d3e"},
+	{0x0D4B, "\u0d47\u0d3e"},
+	{0x0D4C, "\u0d46\u0d57"},
+	{0x0DDA, "\u0dd9\u0dca"},
+	{0x0DDC, "\u0dd9\u0dcf"},
+	{0x0DDD, "\u0dd9\u0dcf\u0dca"},
+	{0x0DDE, "\u0dd9\u0ddf"},
+	{0x0F43, "\u0f42\u0fb7"},
+	{0x0F4D, "\u0f4c\u0fb7"},
+	{0x0F52, "\u0f51\u0fb7"},
+	{0x0F57, "\u0f56\u0fb7"},
+	{0x0F5C, "\u0f5b\u0fb7"},
+	{0x0F69, "\u0f40\u0fb5"},
+	{0x0F73, "\u0f71\u0f72"},
+	{0x0F75, "\u0f71\u0f74"},
+	{0x0F76, "\u0fb2\u0f80"},
+	{0x0F78, "\u0fb3\u0f80"},
+	{0x0F81, "\u0f71\u0f80"},
+	{0x0F93, "\u0f92\u0fb7"},
+	{0x0F9D, "\u0f9c\u0fb7"},
+	{0x0FA2, "\u0fa1\u0fb7"},
+	{0x0FA7, "\u0fa6\u0fb7"},
+	{0x0FAC, "\u0fab\u0fb7"},
+	{0x0FB9, "\u0f90\u0fb5"},
+	{0x1026, "\u1025\u102e"},
+	{0x1B06, "\u1b05\u1b35"},
+	{0x1B08, "\u1b07\u1b35"},
+	{0x1B0A, "\u1b09\u1b35"},
+	{0x1B0C, "\u1b0b\u1b35"},
+	{0x1B0E, "\u1b0d\u1b35"},
+	{0x1B12, "\u1b11\u1b35"},
+	{0x1B3B, "\u1b3a\u1b35"},
+	{0x1B3D, "\u1b3c\u1b35"},
+	{0x1B40, "\u1b3e\u1b35"},
+	{0x1B41, "\u1b3f\u1b35"},
+	{0x1B43, "\u1b42\u1b35"},
+	{0x1E00, "A\u0325"},
+	{0x1E01, "a\u0325"},
+	{0x1E02, "B\u0307"},
+	{0x1E03, "b\u0307"},
+	{0x1E04, "B\u0323"},
+	{0x1E05, "b\u0323"},
+	{0x1E06, "B\u0331"},
+	{0x1E07, "b\u0331"},
+	{0x1E08, "C\u0327\u0301"},
+	{0x1E09, "c\u0327\u0301"},
+	{0x1E0A, "D\u0307"},
+	{0x1E0B, "d\u0307"},
+	{0x1E0C, "D\u0323"},
+	{0x1E0D, "d\u0323"},
+	{0x1E0E, "D\u0331"},
+	{0x1E0F, "d\u0331"},
+	{0x1E10, "D\u0327"},
+	{0x1E11, "d\u0327"},
+	{0x1E12, "D\u032d"},
+	{0x1E13, "d\u032d"},
+	{0x1E14, "E\u0304\u0300"},
+	{0x1E15, "e\u0304\u0300"},
+	{0x1E16, "E\u0304\u0301"},
+	{0x1E17, "e\u0304\u0301"},
+	{0x1E18, "E\u032d"},
+	{0x1E19, "e\u032d"},
+	{0x1E1A, "E\u0330"},
+	{0x1E1B, "e\u0330"},
+	{0x1E1C, "E\u0327\u0306"},
+	{0x1E1D, "e\u0327\u0306"},
+	{0x1E1E, "F\u0307"},
+	{0x1E1F, "f\u0307"},
+	{0x1E20, "G\u0304"},
+	{0x1E21, "g\u0304"},
+	{0x1E22, "H\u0307"},
+	{0x1E23, "h\u0307"},
+	{0x1E24, "H\u0323"},
+	{0x1E25, "h\u0323"},
+	{0x1E26, "H\u0308"},
+	{0x1E27, "h\u0308"},
+	{0x1E28, "H\u0327"},
+	{0x1E29, "h\u0327"},
+	{0x1E2A, "H\u032e"},
+	{0x1E2B, "h\u032e"},
+	{0x1E2C, "I\u0330"},
+	{0x1E2D, "i\u0330"},
+	{0x1E2E, "I\u0308\u0301"},
+	{0x1E2F, "i\u0308\u0301"},
+	{0x1E30, "K\u0301"},
+	{0x1E31, "k\u0301"},
+	{0x1E32, "K\u0323"},
+	{0x1E33, "k\u0323"},
+	{0x1E34, "K\u0331"},
+	{0x1E35, "k\u0331"},
+	{0x1E36, "L\u0323"},
+	{0x1E37, "l\u0323"},
+	{0x1E38, "L\u0323\u0304"},
+	{0x1E39, "l\u0323\u0304"},
+	{0x1E3A, "L\u0331"},
+	{0x1E3B, "l\u0331"},
+	{0x1E3C, "L\u032d"},
+	{0x1E3D, "l\u032d"},
+	{0x1E3E, "M\u0301"},
+	{0x1E3F, "m\u0301"},
+	{0x1E40, "M\u0307"},
+	{0x1E41, "m\u0307"},
+	{0x1E42, "M\u0323"},
+	{0x1E43, "m\u0323"},
+	{0x1E44, "N\u0307"},
+	{0x1E45, "n\u0307"},
+	{0x1E46, "N\u0323"},
+	{0x1E47, "n\u0323"},
+	{0x1E48, "N\u0331"},
+	{0x1E49, "n\u0331"},
+	{0x1E4A, "N\u032d"},
+	{0x1E4B, "n\u032d"},
+	{0x1E4C, "O\u0303\u0301"},
+	{0x1E4D, "o\u0303\u0301"},
+	{0x1E4E, "O\u0303\u0308"},
+	{0x1E4F, "o\u0303\u0308"},
+	{0x1E50, "O\u0304\u0300"},
+	{0x1E51, "o\u0304\u0300"},
+	{0x1E52, "O\u0304\u0301"},
+	{0x1E53, "o\u0304\u0301"},
+	{0x1E54, "P\u0301"},
+	{0x1E55, "p\u0301"},
+	{0x1E56, "P\u0307"},
+	{0x1E57, "p\u0307"},
+	{0x1E58, "R\u0307"},
+	{0x1E59, "r\u0307"},
+	{0x1E5A, "R\u0323"},
+	{0x1E5B, "r\u0323"},
+	{0x1E5C, "R\u0323\u0304"},
+	{0x1E5D, "r\u0323\u0304"},
+	{0x1E5E, "R\u0331"},
+	{0x1E5F, "r\u0331"},
+	{0x1E60, "S\u0307"},
+	{0x1E61, "s\u0307"},
+	{0x1E62, "S\u0323"},
+	{0x1E63, "s\u0323"},
+	{0x1E64, "S\u0301\u0307"},
+	{0x1E65, "s\u0301\u0307"},
+	{0x1E66, "S\u030c\u0307"},
+	{0x1E67, "s\u030c\u0307"},
+	{0x1E68, "S\u0323\u0307"},
+	{0x1E69, "s\u0323\u0307"},
+	{0x1E6A, "T\u0307"},
+	{0x1E6B, "t\u0307"},
+	{0x1E6C, "T\u0323"},
+	{0x1E6D, "t\u0323"},
+	{0x1E6E, "T\u0331"},
+	{0x1E6F, "t\u0331"},
+	{0x1E70, "T\u032d"},
+	{0x1E71, "t\u032d"},
+	{0x1E72, "U\u0324"},
+	{0x1E73, "u\u0324"},
+	{0x1E74, "U\u0330"},
+	{0x1E75, "u\u0330"},
+	{0x1E76, "U\u032d"},
+	{0x1E77, "u\u032d"},
+	{0x1E78, "U\u0303\u0301"},
+	{0x1E79, "u\u0303\u0301"},
+	{0x1E7A, "U\u0304\u0308"},
+	{0x1E7B, "u\u0304\u0308"},
+	{0x1E7C, "V\u0303"},
+	{0x1E7D, "v\u0303"},
+	{0x1E7E, "V\u0323"},
+	{0x1E7F, "v\u0323"},
+	{0x1E80, "W\u0300"},
+	{0x1E81, "w\u0300"},
+	{0x1E82, "W\u0301"},
+	{0x1E83, "w\u0301"},
+	{0x1E84, "W\u0308"},
+	{0x1E85, "w\u0308"},
+	{0x1E86, "W\u0307"},
+	{0x1E87, "w\u0307"},
+	{0x1E88, "W\u0323"},
+	{0x1E89, "w\u0323"},
+	{0x1E8A, "X\u0307"},
+	{0x1E8B, "x\u0307"},
+	{0x1E8C, "X\u0308"},
+	{0x1E8D, "x\u0308"},
+	{0x1E8E, "Y\u0307"},
+	{0x1E8F, "y\u0307"},
+	{0x1E90, "Z\u0302"},
+	{0x1E91, "z\u0302"},
+	{0x1E92, "Z\u0323"},
+	{0x1E93, "z\u0323"},
+	{0x1E94, "Z\u0331"},
+	{0x1E95, "z\u0331"},
+	{0x1E96, "h\u0331"},
+	{0x1E97, "t\u0308"},
+	{0x1E98, "w\u030a"},
+	{0x1E99, "y\u030a"},
+	{0x1E9B, "\u017f\u0307"},
+	{0x1EA0, "A\u0323"},
+	{0x1EA1, "a\u0323"},
+	{0x1EA2, "A\u0309"},
+	{0x1EA3, "a\u0309"},
+	{0x1EA4, "A\u0302\u0301"},
+	{0x1EA5, "a\u0302\u0301"},
+	{0x1EA6, "A\u0302\u0300"},
+	{0x1EA7, "a\u0302\u0300"},
+	{0x1EA8, "A\u0302\u0309"},
+	{0x1EA9, "a\u0302\u0309"},
+	{0x1EAA, "A\u0302\u0303"},
+	{0x1EAB, "a\u0302\u0303"},
+	{0x1EAC, "A\u0323\u0302"},
+	{0x1EAD, "a\u0323\u0302"},
+	{0x1EAE, "A\u0306\u0301"},
+	{0x1EAF, "a\u0306\u0301"},
+	{0x1EB0, "A\u0306\u0300"},
+	{0x1EB1, "a\u0306\u0300"},
+	{0x1EB2, "A\u0306\u0309"},
+	{0x1EB3, "a\u0306\u0309"},
+	{0x1EB4, "A\u0306\u0303"},
+	{0x1EB5, "a\u0306\u0303"},
+	{0x1EB6, "A\u0323\u0306"},
+	{0x1EB7, "a\u0323\u0306"},
+	{0x1EB8, "E\u0323"},
+	{0x1EB9, "e\u0323"},
+	{0x1EBA, "E\u0309"},
+	{0x1EBB, "e\u0309"},
+	{0x1EBC, "E\u0303"},
+	{0x1EBD, "e\u0303"},
+	{0x1EBE, "E\u0302\u0301"},
+	{0x1EBF, "e\u0302\u0301"},
+	{0x1EC0, "E\u0302\u0300"},
+	{0x1EC1, "e\u0302\u0300"},
+	{0x1EC2, "E\u0302\u0309"},
+	{0x1EC3, "e\u0302\u0309"},
+	{0x1EC4, "E\u0302\u0303"},
+	{0x1EC5, "e\u0302\u0303"},
+	{0x1EC6, "E\u0323\u0302"},
+	{0x1EC7, "e\u0323\u0302"},
+	{0x1EC8, "I\u0309"},
+	{0x1EC9, "i\u0309"},
+	{0x1ECA, "I\u0323"},
+	{0x1ECB, "i\u0323"},
+	{0x1ECC, "O\u0323"},
+	{0x1ECD, "o\u0323"},
+	{0x1ECE, "O\u0309"},
+	{0x1ECF, "o\u0309"},
+	{0x1ED0, "O\u0302\u0301"},
+	{0x1ED1, "o\u0302\u0301"},
+	{0x1ED2, "O\u0302\u0300"},
+	{0x1ED3, "o\u0302\u0300"},
+	{0x1ED4, "O\u0302\u0309"},
+	{0x1ED5, "o\u0302\u0309"},
+	{0x1ED6, "O\u0302\u0303"},
+	{0x1ED7, "o\u0302\u0303"},
+	{0x1ED8, "O\u0323\u0302"},
+	{0x1ED9, "o\u0323\u0302"},
+	{0x1EDA, "O\u031b\u0301"},
+	{0x1EDB, "o\u031b\u0301"},
+	{0x1EDC, "O\u031b\u0300"},
+	{0x1EDD, "o\u031b\u0300"},
+	{0x1EDE, "O\u031b\u0309"},
+	{0x1EDF, "o\u031b\u0309"},
+	{0x1EE0, "O\u031b\u0303"},
+	{0x1EE1, "o\u031b\u0303"},
+	{0x1EE2, "O\u031b\u0323"},
+	{0x1EE3, "o\u031b\u0323"},
+	{0x1EE4, "U\u0323"},
+	{0x1EE5, "u\u0323"},
+	{0x1EE6, "U\u0309"},
+	{0x1EE7, "u\u0309"},
+	{0x1EE8, "U\u031b\u0301"},
+	{0x1EE9, "u\u031b\u0301"},
+	{0x1EEA, "U\u031b\u0300"},
+	{0x1EEB, "u\u031b\u0300"},
+	{0x1EEC, "U\u031b\u0309"},
+	{0x1EED, "u\u031b\u0309"},
+	{0x1EEE, "U\u031b\u0303"},
+	{0x1EEF, "u\u031b\u0303"},
+	{0x1EF0, "U\u031b\u0323"},
+	{0x1EF1, "u\u031b\u0323"},
+	{0x1EF2, "Y\u0300"},
+	{0x1EF3, "y\u0300"},
+	{0x1EF4, "Y\u0323"},
+	{0x1EF5, "y\u0323"},
+	{0x1EF6, "Y\u0309"},
+	{0x1EF7, "y\u0309"},
+	{0x1EF8, "Y\u0303"},
+	{0x1EF9, "y\u0303"},
+	{0x1F00, "\u03b1\u0313"},
+	{0x1F01, "\u03b1\u0314"},
+	{0x1F02, "\u03b1\u0313\u0300"},
+	{0x1F03, "\u03b1\u0314\u0300"},
+	{0x1F04, "\u03b1\u0313\u0301"},
+	{0x1F05, "\u03b1\u0314\u0301"},
+	{0x1F06, "\u03b1\u0313\u0342"},
+	{0x1F07, "\u03b1\u0314\u0342"},
+	{0x1F08, "\u0391\u0313"},
+	{0x1F09, "\u0391\u0314"},
+	{0x1F0A, "\u0391\u0313\u0300"},
+	{0x1F0B, "\u0391\u0314\u0300"},
+	{0x1F0C, "\u0391\u0313\u0301"},
+	{0x1F0D, "\u0391\u0314\u0301"},
+	{0x1F0E, "\u0391\u0313\u0342"},
+	{0x1F0F, "\u0391\u0314\u0342"},
+	{0x1F10, "\u03b5\u0313"},
+	{0x1F11, "\u03b5\u0314"},
+	{0x1F12, "\u03b5\u0313\u0300"},
+	{0x1F13, "\u03b5\u0314\u0300"},
+	{0x1F14, "\u03b5\u0313\u0301"},
+	{0x1F15, "\u03b5\u0314\u0301"},
+	{0x1F18, "\u0395\u0313"},
+	{0x1F19, "\u0395\u0314"},
+	{0x1F1A, "\u0395\u0313\u0300"},
+	{0x1F1B, "\u0395\u0314\u0300"},
+	{0x1F1C, "\u0395\u0313\u0301"},
+	{0x1F1D, "\u0395\u0314\u0301"},
+	{0x1F20, "\u03b7\u0313"},
+	{0x1F21, "\u03b7\u0314"},
+	{0x1F22, "\u03b7\u0313\u0300"},
+	{0x1F23, "\u03b7\u0314\u0300"},
+	{0x1F24, "\u03b7\u0313\u0301"},
+	{0x1F25, "\u03b7\u0314\u0301"},
+	{0x1F26, "\u03b7\u0313\u0342"},
+	{0x1F27, "\u03b7\u0314\u0342"},
+	{0x1F28, "\u0397\u0313"},
+	{0x1F29, "\u0397\u0314"},
+	{0x1F2A, "\u0397\u0313\u0300"},
+	{0x1F2B, "\u0397\u0314\u0300"},
+	{0x1F2C, "\u0397\u0313\u0301"},
+	{0x1F2D, "\u0397\u0314\u0301"},
+	{0x1F2E, "\u0397\u0313\u0342"},
+	{0x1F2F, "\u0397\u0314\u0342"},
+	{0x1F30, "\u03b9\u0313"},
+	{0x1F31, "\u03b9\u0314"},
+	{0x1F32, "\u03b9\u0313\u0300"},
+	{0x1F33, "\u03b9\u0314\u0300"},
+	{0x1F34, "\u03b9\u0313\u0301"},
+	{0x1F35, "\u03b9\u0314\u0301"},
+	{0x1F36, "\u03b9\u0313\u0342"},
+	{0x1F37, "\u03b9\u0314\u0342"},
+	{0x1F38, "\u0399\u0313"},
+	{0x1F39, "\u0399\u0314"},
+	{0x1F3A, "\u0399\u0313\u0300"},
+	{0x1F3B, "\u0399\u0314\u0300"},
+	{0x1F3C, "\u0399\u0313\u0301"},
+	{0x1F3D, "\u0399\u0314\u0301"},
+	{0x1F3E, "\u0399\u0313\u0342"},
+	{0x1F3F, "\u0399\u0314\u0342"},
+	{0x1F40, "\u03bf\u0313"},
+	{0x1F41, "\u03bf\u0314"},
+	{0x1F42, "\u03bf\u0313\u0300"},
+	{0x1F43, "\u03bf\u0314\u0300"},
+	{0x1F44, "\u03bf\u0313\u0301"},
+	{0x1F45, "\u03bf\u0314\u0301"},
+	{0x1F48, "\u039f\u0313"},
+	{0x1F49, "\u039f\u0314"},
+	{0x1F4A, "\u039f\u0313\u0300"},
+	{0x1F4B, "\u039f\u0314\u0300"},
+	{0x1F4C, "\u039f\u0313\u0301"},
+	{0x1F4D, "\u039f\u0314\u0301"},
+	{0x1F50, "\u03c5\u0313"},
+	{0x1F51, "\u03c5\u0314"},
+	{0x1F52, "\u03c5\u0313\u0300"},
+	{0x1F53, "\u03c5\u0314\u0300"},
+	{0x1F54, "\u03c5\u0313\u0301"},
+	{0x1F55, "\u03c5\u0314\u0301"},
+	{0x1F56, "\u03c5\u0313\u0342"},
+	{0x1F57, "\u03c5\u0314\u0342"},
+	{0x1F59, "\u03a5\u0314"},
+	{0x1F5B, "\u03a5\u0314\u0300"},
+	{0x1F5D, "\u03a5\u0314\u0301"},
+	{0x1F5F, "\u03a5\u0314\u0342"},
+	{0x1F60, "\u03c9\u0313"},
+	{0x1F61, "\u03c9\u0314"},
+	{0x1F62, "\u03c9\u0313\u0300"},
+	{0x1F63, "\u03c9\u0314\u0300"},
+	{0x1F64, "\u03c9\u0313\u0301"},
+	{0x1F65, "\u03c9\u0314\u0301"},
+	{0x1F66, "\u03c9\u0313\u0342"},
+	{0x1F67, "\u03c9\u0314\u0342"},
+	{0x1F68, "\u03a9\u0313"},
+	{0x1F69, "\u03a9\u0314"},
+	{0x1F6A, "\u03a9\u0313\u0300"},
+	{0x1F6B, "\u03a9\u0314\u0300"},
+	{0x1F6C, "\u03a9\u0313\u0301"},
+	{0x1F6D, "\u03a9\u0314\u0301"},
+	{0x1F6E, "\u03a9\u0313\u0342"},
+	{0x1F6F, "\u03a9\u0314\u0342"},
+	{0x1F70, "\u03b1\u0300"},
+	{0x1F71, "\u03b1\u0301"},
+	{0x1F72, "\u03b5\u0300"},
+	{0x1F73, "\u03b5\u0301"},
+	{0x1F74, "\u03b7\u0300"},
+	{0x1F75, "\u03b7\u0301"},
+	{0x1F76, "\u03b9\u0300"},
+	{0x1F77, "\u03b9\u0301"},
+	{0x1F78, "\u03bf\u0300"},
+	{0x1F79, "\u03bf\u0301"},
+	{0x1F7A, "\u03c5\u0300"},
+	{0x1F7B, "\u03c5\u0301"},
+	{0x1F7C, "\u03c9\u0300"},
+	{0x1F7D, "\u03c9\u0301"},
+	{0x1F80, "\u03b1\u0313\u0345"},
+	{0x1F81, "\u03b1\u0314\u0345"},
+	{0x1F82, "\u03b1\u0313\u0300\u0345"},
+	{0x1F83, "\u03b1\u0314\u0300\u0345"},
+	{0x1F84, "\u03b1\u0313\u0301\u0345"},
+	{0x1F85, "\u03b1\u0314\u0301\u0345"},
+	{0x1F86, "\u03b1\u0313\u0342\u0345"},
+	{0x1F87, "\u03b1\u0314\u0342\u0345"},
+	{0x1F88, "\u0391\u0313\u0345"},
+	{0x1F89, "\u0391\u0314\u0345"},
+	{0x1F8A, "\u0391\u0313\u0300\u0345"},
+	{0x1F8B, "\u0391\u0314\u0300\u0345"},
+	{0x1F8C, "\u0391\u0313\u0301\u0345"},
+	{0x1F8D, "\u0391\u0314\u0301\u0345"},
+	{0x1F8E, "\u0391\u0313\u0342\u0345"},
+	{0x1F8F, "\u0391\u0314\u0342\u0345"},
+	{0x1F90, "\u03b7\u0313\u0345"},
+	{0x1F91, "\u03b7\u0314\u0345"},
+	{0x1F92, "\u03b7\u0313\u0300\u0345"},
+	{0x1F93, "\u03b7\u0314\u0300\u0345"},
+	{0x1F94, "\u03b7\u0313\u0301\u0345"},
+	{0x1F95, "\u03b7\u0314\u0301\u0345"},
+	{0x1F96, "\u03b7\u0313\u0342\u0345"},
+	{0x1F97, "\u03b7\u0314\u0342\u0345"},
+	{0x1F98, "\u0397\u0313\u0345"},
+	{0x1F99, "\u0397\u0314\u0345"},
+	{0x1F9A, "\u0397\u0313\u0300\u0345"},
+	{0x1F9B, "\u0397\u0314\u0300\u0345"},
+	{0x1F9C, "\u0397\u0313\u0301\u0345"},
+	{0x1F9D, "\u0397\u0314\u0301\u0345"},
+	{0x1F9E, "\u0397\u0313\u0342\u0345"},
+	{0x1F9F, "\u0397\u0314\u0342\u0345"},
+	{0x1FA0, "\u03c9\u0313\u0345"},
+	{0x1FA1, "\u03c9\u0314\u0345"},
+	{0x1FA2, "\u03c9\u0313\u0300\u0345"},
+	{0x1FA3, "\u03c9\u0314\u0300\u0345"},
+	{0x1FA4, "\u03c9\u0313\u0301\u0345"},
+	{0x1FA5, "\u03c9\u0314\u0301\u0345"},
+	{0x1FA6, "\u03c9\u0313\u0342\u0345"},
+	{0x1FA7, "\u03c9\u0314\u0342\u0345"},
+	{0x1FA8, "\u03a9\u0313\u0345"},
+	{0x1FA9, "\u03a9\u0314\u0345"},
+	{0x1FAA, "\u03a9\u0313\u0300\u0345"},
+	{0x1FAB, "\u03a9\u0314\u0300\u0345"},
+	{0x1FAC, "\u03a9\u0313\u0301\u0345"},
+	{0x1FAD, "\u03a9\u0314\u0301\u0345"},
+	{0x1FAE, "\u03a9\u0313\u0342\u0345"},
+	{0x1FAF, "\u03a9\u0314\u0342\u0345"},
+	{0x1FB0, "\u03b1\u0306"},
+	{0x1FB1, "\u03b1\u0304"},
+	{0x1FB2, "\u03b1\u0300\u0345"},
+	{0x1FB3, "\u03b1\u0345"},
+	{0x1FB4, "\u03b1\u0301\u0345"},
+	{0x1FB6, "\u03b1\u0342"},
+	{0x1FB7, "\u03b1\u0342\u0345"},
+	{0x1FB8, "\u0391\u0306"},
+	{0x1FB9, "\u0391\u0304"},
+	{0x1FBA, "\u0391\u0300"},
+	{0x1FBB, "\u0391\u0301"},
+	{0x1FBC, "\u0391\u0345"},
+	{0x1FBE, "\u03b9"},
+	{0x1FC1, "\u00a8\u0342"},
+	{0x1FC2, "\u03b7\u0300\u0345"},
+	{0x1FC3, "\u03b7\u0345"},
+	{0x1FC4, "\u03b7\u0301\u0345"},
+	{0x1FC6, "\u03b7\u0342"},
+	{0x1FC7, "\u03b7\u0342\u0345"},
+	{0x1FC8, "\u0395\u0300"},
+	{0x1FC9, "\u0395\u0301"},
+	{0x1FCA, "\u0397\u0300"},
+	{0x1FCB, "\u0397\u0301"},
+	{0x1FCC, "\u0397\u0345"},
+	{0x1FCD, "\u1fbf\u0300"},
+	{0x1FCE, "\u1fbf\u0301"},
+	{0x1FCF, "\u1fbf\u0342"},
+	{0x1FD0, "\u03b9\u0306"},
+	{0x1FD1, "\u03b9\u0304"},
+	{0x1FD2, "\u03b9\u0308\u0300"},
+	{0x1FD3, "\u03b9\u0308\u0301"},
+	{0x1FD6, "\u03b9\u0342"},
+	{0x1FD7, "\u03b9\u0308\u0342"},
+	{0x1FD8, "\u0399\u0306"},
+	{0x1FD9, "\u0399\u0304"},
+	{0x1FDA, "\u0399\u0300"},
+	{0x1FDB, "\u0399\u0301"},
+	{0x1FDD, "\u1ffe\u0300"},
+	{0x1FDE, "\u1ffe\u0301"},
+	{0x1FDF, "\u1ffe\u0342"},
+	{0x1FE0, "\u03c5\u0306"},
+	{0x1FE1, "\u03c5\u0304"},
+	{0x1FE2, "\u03c5\u0308\u0300"},
+	{0x1FE3, "\u03c5\u0308\u0301"},
+	{0x1FE4, "\u03c1\u0313"},
+	{0x1FE5, "\u03c1\u0314"},
+	{0x1FE6, "\u03c5\u0342"},
+	{0x1FE7, "\u03c5\u0308\u0342"},
+	{0x1FE8, "\u03a5\u0306"},
+	{0x1FE9, "\u03a5\u0304"},
+	{0x1FEA, "\u03a5\u0300"},
+	{0x1FEB, "\u03a5\u0301"},
+	{0x1FEC, "\u03a1\u0314"},
+	{0x1FED, "\u00a8\u0300"},
+	{0x1FEE, "\u00a8\u0301"},
+	{0x1FEF, "`"},
+	{0x1FF2, "\u03c9\u0300\u0345"},
+	{0x1FF3, "\u03c9\u0345"},
+	{0x1FF4, "\u03c9\u0301\u0345"},
+	{0x1FF6, "\u03c9\u0342"},
+	{0x1FF7, "\u03c9\u0342\u0345"},
+	{0x1FF8, "\u039f\u0300"},
+	{0x1FF9, "\u039f\u0301"},
+	{0x1FFA, "\u03a9\u0300"},
+	{0x1FFB, "\u03a9\u0301"},
+	{0x1FFC, "\u03a9\u0345"},
+	{0x1FFD, "\u00b4"},
+	{0x2000, "\u2002"},
+	{0x2001, "\u2003"},
+	{0x2126, "\u03a9"},
+	{0x212A, "K"},
+	{0x212B, "A\u030a"},
+	{0x219A, "\u2190\u0338"},
+	{0x219B, "\u2192\u0338"},
+	{0x21AE, "\u2194\u0338"},
+	{0x21CD, "\u21d0\u0338"},
+	{0x21CE, "\u21d4\u0338"},
+	{0x21CF, "\u21d2\u0338"},
+	{0x2204, "\u2203\u0338"},
+	{0x2209, "\u2208\u0338"},
+	{0x220C, "\u220b\u0338"},
+	{0x2224, "\u2223\u0338"},
+	{0x2226, "\u2225\u0338"},
+	{0x2241, "\u223c\u0338"},
+	{0x2244, "\u2243\u0338"},
+	{0x2247, "\u2245\u0338"},
+	{0x2249, "\u2248\u0338"},
+	{0x2260, "=\u0338"},
+	{0x2262, "\u2261\u0338"},
+	{0x226D, "\u224d\u0338"},
+	{0x226E, "<\u0338"},
+	{0x226F, ">\u0338"},
+	{0x2270, "\u2264\u0338"},
+	{0x2271, "\u2265\u0338"},
+	{0x2274, "\u2272\u0338"},
+	{0x2275, "\u2273\u0338"},
+	{0x2278, "\u2276\u0338"},
+	{0x2279, "\u2277\u0338"},
+	{0x2280, "\u227a\u0338"},
+	{0x2281, "\u227b\u0338"},
+	{0x2284, "\u2282\u0338"},
+	{0x2285, "\u2283\u0338"},
+	{0x2288, "\u2286\u0338"},
+	{0x2289, "\u2287\u0338"},
+	{0x22AC, "\u22a2\u0338"},
+	{0x22AD, "\u22a8\u0338"},
+	{0x22AE, "\u22a9\u0338"},
+	{0x22AF, "\u22ab\u0338"},
+	{0x22E0, "\u227c\u0338"},
+	{0x22E1, "\u227d\u0338"},
+	{0x22E2, "\u2291\u0338"},
+	{0x22E3, "\u2292\u0338"},
+	{0x22EA, "\u22b2\u0338"},
+	{0x22EB, "\u22b3\u0338"},
+	{0x22EC, "\u22b4\u0338"},
+	{0x22ED, "\u22b5\u0338"},
+	{0x2329, "\u3008"},
+	{0x232A, "\u3009"},
+	{0x2ADC, "\u2add\u0338"},
+	{0x304C, "\u304b\u3099"},
+	{0x304E, "\u304d\u3099"},
+	{0x3050, "\u304f\u3099"},
+	{0x3052, "\u3051\u3099"},
+	{0x3054, "\u3053\u3099"},
+	{0x3056, "\u3055\u3099"},
+	{0x3058, "\u3057\u3099"},
+	{0x305A, "\u3059\u3099"},
+	{0x305C, "\u305b\u3099"},
+	{0x305E, "\u305d\u3099"},
+	{0x3060, "\u305f\u3099"},
+	{0x3062, "\u3061\u3099"},
+	{0x3065, "\u3064\u3099"},
+	{0x3067, "\u3066\u3099"},
+	{0x3069, "\u3068\u3099"},
+	{0x3070, "\u306f\u3099"},
+	{0x3071, "\u306f\u309a"},
+	{0x3073, "\u3072\u3099"},
+	{0x3074, "\u3072\u309a"},
+	{0x3076, "\u3075\u3099"},
+	{0x3077, "\u3075\u309a"},
+	{0x3079, "\u3078\u3099"},
+	{0x307A, "\u3078\u309a"},
+	{0x307C, "\u307b\u3099"},
+	{0x307D, "\u307b\u309a"},
+	{0x3094, "\u3046\u3099"},
+	{0x309E, "\u309d\u3099"},
+	{0x30AC, "\u30ab\u3099"},
+	{0x30AE, "\u30ad\u3099"},
+	{0x30B0, "\u30af\u3099"},
+	{0x30B2, "\u30b1\u3099"},
+	{0x30B4, "\u30b3\u3099"},
+	{0x30B6, "\u30b5\u3099"},
+	{0x30B8, "\u30b7\u3099"},
+	{0x30BA, "\u30b9\u3099"},
+	{0x30BC, "\u30bb\u3099"},
+	{0x30BE, "\u30bd\u3099"},
+	{0x30C0, "\u30bf\u3099"},
+	{0x30C2, "\u30c1\u3099"},
+	{0x30C5, "\u30c4\u3099"},
+	{0x30C7, "\u30c6\u3099"},
+	{0x30C9, "\u30c8\u3099"},
+	{0x30D0, "\u30cf\u3099"},
+	{0x30D1, "\u30cf\u309a"},
+	{0x30D3, "\u30d2\u3099"},
+	{0x30D4, "\u30d2\u309a"},
+	{0x30D6, "\u30d5\u3099"},
+	{0x30D7, "\u30d5\u309a"},
+	{0x30D9, "\u30d8\u3099"},
+	{0x30DA, "\u30d8\u309a"},
+	{0x30DC, "\u30db\u3099"},
+	{0x30DD, "\u30db\u309a"},
+	{0x30F4, "\u30a6\u3099"},
+	{0x30F7, "\u30ef\u3099"},
+	{0x30F8, "\u30f0\u3099"},
+	{0x30F9, "\u30f1\u3099"},
+	{0x30FA, "\u30f2\u3099"},
+	{0x30FE, "\u30fd\u3099"},
+	{0xF900, "\u8c48"},
+	{0xF901, "\u66f4"},
+	{0xF902, "\u8eca"},
+	{0xF903, "\u8cc8"},
+	{0xF904, "\u6ed1"},
+	{0xF905, "\u4e32"},
+	{0xF906, "\u53e5"},
+	{0xF907, "\u9f9c"},
+	{0xF908, "\u9f9c"},
+	{0xF909, "\u5951"},
+	{0xF90A, "\u91d1"},
+	{0xF90B, "\u5587"},
+	{0xF90C, "\u5948"},
+	{0xF90D, "\u61f6"},
+	{0xF90E, "\u7669"},
+	{0xF90F, "\u7f85"},
+	{0xF910, "\u863f"},
+	{0xF911, "\u87ba"},
+	{0xF912, "\u88f8"},
+	{0xF913, "\u908f"},
+	{0xF914, "\u6a02"},
+	{0xF915, "\u6d1b"},
+	{0xF916, "\u70d9"},
+	{0xF917, "\u73de"},
+	{0xF918, "\u843d"},
+	{0xF919, "\u916a"},
+	{0xF91A, "\u99f1"},
+	{0xF91B, "\u4e82"},
+	{0xF91C, "\u5375"},
+	{0xF91D, "\u6b04"},
+	{0xF91E, "\u721b"},
+	{0xF91F, "\u862d"},
+	{0xF920, "\u9e1e"},
+	{0xF921, "\u5d50"},
+	{0xF922, "\u6feb"},
+	{0xF923, "\u85cd"},
+	{0xF924, "\u8964"},
+	{0xF925, "\u62c9"},
+	{0xF926, "\u81d8"},
+	{0xF927, "\u881f"},
+	{0xF928, "\u5eca"},
+	{0xF929, "\u6717"},
+	{0xF92A, "\u6d6a"},
+	{0xF92B, "\u72fc"},
+	{0xF92C, "\u90ce"},
+	{0xF92D, "\u4f86"},
+	{0xF92E, "\u51b7"},
+	{0xF92F, "\u52de"},
+	{0xF930, "\u64c4"},
+	{0xF931, "\u6ad3"},
+	{0xF932, "\u7210"},
+	{0xF933, "\u76e7"},
+	{0xF934, "\u8001"},
+	{0xF935, "\u8606"},
+	{0xF936, "\u865c"},
+	{0xF937, "\u8def"},
+	{0xF938, "\u9732"},
+	{0xF939, "\u9b6f"},
+	{0xF93A, "\u9dfa"},
+	{0xF93B, "\u788c"},
+	{0xF93C, "\u797f"},
+	{0xF93D, "\u7da0"},
+	{0xF93E, "\u83c9"},
+	{0xF93F, "\u9304"},
+	{0xF940, "\u9e7f"},
+	{0xF941, "\u8ad6"},
+	{0xF942, "\u58df"},
+	{0xF943, "\u5f04"},
+	{0xF944, "\u7c60"},
+	{0xF945, "\u807e"},
+	{0xF946, "\u7262"},
+	{0xF947, "\u78ca"},
+	{0xF948, "\u8cc2"},
+	{0xF949, "\u96f7"},
+	{0xF94A, "\u58d8"},
+	{0xF94B, "\u5c62"},
+	{0xF94C, "\u6a13"},
+	{0xF94D, "\u6dda"},
+	{0xF94E, "\u6f0f"},
+	{0xF94F, "\u7d2f"},
+	{0xF950, "\u7e37"},
+	{0xF951, "\u964b"},
+	{0xF952, "\u52d2"},
+	{0xF953, "\u808b"},
+	{0xF954, "\u51dc"},
+	{0xF955, "\u51cc"},
+	{0xF956, "\u7a1c"},
+	{0xF957, "\u7dbe"},
+	{0xF958, "\u83f1"},
+	{0xF959, "\u9675"},
+	{0xF95A, "\u8b80"},
+	{0xF95B, "\u62cf"},
+	{0xF95C, "\u6a02"},
+	{0xF95D, "\u8afe"},
+	{0xF95E, "\u4e39"},
+	{0xF95F, "\u5be7"},
+	{0xF960, "\u6012"},
+	{0xF961, "\u7387"},
+	{0xF962, "\u7570"},
+	{0xF963, "\u5317"},
+	{0xF964, "\u78fb"},
+	{0xF965, "\u4fbf"},
+	{0xF966, "\u5fa9"},
+	{0xF967, "\u4e0d"},
+	{0xF968, "\u6ccc"},
+	{0xF969, "\u6578"},
+	{0xF96A, "\u7d22"},
+	{0xF96B, "\u53c3"},
+	{0xF96C, "\u585e"},
+	{0xF96D, "\u7701"},
+	{0xF96E, "\u8449"},
+	{0xF96F, "\u8aaa"},
+	{0xF970, "\u6bba"},
+	{0xF971, "\u8fb0"},
+	{0xF972, "\u6c88"},
+	{0xF973, "\u62fe"},
+	{0xF974, "\u82e5"},
+	{0xF975, "\u63a0"},
+	{0xF976, "\u7565"},
+	{0xF977, "\u4eae"},
+	{0xF978, "\u5169"},
+	{0xF979, "\u51c9"},
+	{0xF97A, "\u6881"},
+	{0xF97B, "\u7ce7"},
+	{0xF97C, "\u826f"},
+	{0xF97D, "\u8ad2"},
+	{0xF97E, "\u91cf"},
+	{0xF97F, "\u52f5"},
+	{0xF980, "\u5442"},
+	{0xF981, "\u5973"},
+	{0xF982, "\u5eec"},
+	{0xF983, "\u65c5"},
+	{0xF984, "\u6ffe"},
+	{0xF985, "\u792a"},
+	{0xF986, "\u95ad"},
+	{0xF987, "\u9a6a"},
+	{0xF988, "\u9e97"},
+	{0xF989, "\u9ece"},
+	{0xF98A, "\u529b"},
+	{0xF98B, "\u66c6"},
+	{0xF98C, "\u6b77"},
+	{0xF98D, "\u8f62"},
+	{0xF98E, "\u5e74"},
+	{0xF98F, "\u6190"},
+	{0xF990, "\u6200"},
+	{0xF991, "\u649a"},
+	{0xF992, "\u6f23"},
+	{0xF993, "\u7149"},
+	{0xF994, "\u7489"},
+	{0xF995, "\u79ca"},
+	{0xF996, "\u7df4"},
+	{0xF997, "\u806f"},
+	{0xF998, "\u8f26"},
+	{0xF999, "\u84ee"},
+	{0xF99A, "\u9023"},
+	{0xF99B, "\u934a"},
+	{0xF99C, "\u5217"},
+	{0xF99D, "\u52a3"},
+	{0xF99E, "\u54bd"},
+	{0xF99F, "\u70c8"},
+	{0xF9A0, "\u88c2"},
+	{0xF9A1, "\u8aaa"},
+	{0xF9A2, "\u5ec9"},
+	{0xF9A3, "\u5ff5"},
+	{0xF9A4, "\u637b"},
+	{0xF9A5, "\u6bae"},
+	{0xF9A6, "\u7c3e"},
+	{0xF9A7, "\u7375"},
+	{0xF9A8, "\u4ee4"},
+	{0xF9A9, "\u56f9"},
+	{0xF9AA, "\u5be7"},
+	{0xF9AB, "\u5dba"},
+	{0xF9AC, "\u601c"},
+	{0xF9AD, "\u73b2"},
+	{0xF9AE, "\u7469"},
+	{0xF9AF, "\u7f9a"},
+	{0xF9B0, "\u8046"},
+	{0xF9B1, "\u9234"},
+	{0xF9B2, "\u96f6"},
+	{0xF9B3, "\u9748"},
+	{0xF9B4, "\u9818"},
+	{0xF9B5, "\u4f8b"},
+	{0xF9B6, "\u79ae"},
+	{0xF9B7, "\u91b4"},
+	{0xF9B8, "\u96b8"},
+	{0xF9B9, "\u60e1"},
+	{0xF9BA, "\u4e86"},
+	{0xF9BB, "\u50da"},
+	{0xF9BC, "\u5bee"},
+	{0xF9BD, "\u5c3f"},
+	{0xF9BE, "\u6599"},
+	{0xF9BF, "\u6a02"},
+	{0xF9C0, "\u71ce"},
+	{0xF9C1, "\u7642"},
+	{0xF9C2, "\u84fc"},
+	{0xF9C3, "\u907c"},
+	{0xF9C4, "\u9f8d"},
+	{0xF9C5, "\u6688"},
+	{0xF9C6, "\u962e"},
+	{0xF9C7, "\u5289"},
+	{0xF9C8, "\u677b"},
+	{0xF9C9, "\u67f3"},
+	{0xF9CA, "\u6d41"},
+	{0xF9CB, "\u6e9c"},
+	{0xF9CC, "\u7409"},
+	{0xF9CD, "\u7559"},
+	{0xF9CE, "\u786b"},
+	{0xF9CF, "\u7d10"},
+	{0xF9D0, "\u985e"},
+	{0xF9D1, "\u516d"},
+	{0xF9D2, "\u622e"},
+	{0xF9D3, "\u9678"},
+	{0xF9D4, "\u502b"},
+	{0xF9D5, "\u5d19"},
+	{0xF9D6, "\u6dea"},
+	{0xF9D7, "\u8f2a"},
+	{0xF9D8, "\u5f8b"},
+	{0xF9D9, "\u6144"},
+	{0xF9DA, "\u6817"},
+	{0xF9DB, "\u7387"},
+	{0xF9DC, "\u9686"},
+	{0xF9DD, "\u5229"},
+	{0xF9DE, "\u540f"},
+	{0xF9DF, "\u5c65"},
+	{0xF9E0, "\u6613"},
+	{0xF9E1, "\u674e"},
+	{0xF9E2, "\u68a8"},
+	{0xF9E3, "\u6ce5"},
+	{0xF9E4, "\u7406"},
+	{0xF9E5, "\u75e2"},
+	{0xF9E6, "\u7f79"},
+	{0xF9E7, "\u88cf"},
+	{0xF9E8, "\u88e1"},
+	{0xF9E9, "\u91cc"},
+	{0xF9EA, "\u96e2"},
+	{0xF9EB, "\u533f"},
+	{0xF9EC, "\u6eba"},
+	{0xF9ED, "\u541d"},
+	{0xF9EE, "\u71d0"},
+	{0xF9EF, "\u7498"},
+	{0xF9F0, "\u85fa"},
+	{0xF9F1, "\u96a3"},
+	{0xF9F2, "\u9c57"},
+	{0xF9F3, "\u9e9f"},
+	{0xF9F4, "\u6797"},
+	{0xF9F5, "\u6dcb"},
+	{0xF9F6, "\u81e8"},
+	{0xF9F7, "\u7acb"},
+	{0xF9F8, "\u7b20"},
+	{0xF9F9, "\u7c92"},
+	{0xF9FA, "\u72c0"},
+	{0xF9FB, "\u7099"},
+	{0xF9FC, "\u8b58"},
+	{0xF9FD, "\u4ec0"},
+	{0xF9FE, "\u8336"},
+	{0xF9FF, "\u523a"},
+	{0xFA00, "\u5207"},
+	{0xFA01, "\u5ea6"},
+	{0xFA02, "\u62d3"},
+	{0xFA03, "\u7cd6"},
+	{0xFA04, "\u5b85"},
+	{0xFA05, "\u6d1e"},
+	{0xFA06, "\u66b4"},
+	{0xFA07, "\u8f3b"},
+	{0xFA08, "\u884c"},
+	{0xFA09, "\u964d"},
+	{0xFA0A, "\u898b"},
+	{0xFA0B, "\u5ed3"},
+	{0xFA0C, "\u5140"},
+	{0xFA0D, "\u55c0"},
+	{0xFA10, "\u585a"},
+	{0xFA12, "\u6674"},
+	{0xFA15, "\u51de"},
+	{0xFA16, "\u732a"},
+	{0xFA17, "\u76ca"},
+	{0xFA18, "\u793c"},
+	{0xFA19, "\u795e"},
+	{0xFA1A, "\u7965"},
+	{0xFA1B, "\u798f"},
+	{0xFA1C, "\u9756"},
+	{0xFA1D, "\u7cbe"},
+	{0xFA1E, "\u7fbd"},
+	{0xFA20, "\u8612"},
+	{0xFA22, "\u8af8"},
+	{0xFA25, "\u9038"},
+	{0xFA26, "\u90fd"},
+	{0xFA2A, "\u98ef"},
+	{0xFA2B, "\u98fc"},
+	{0xFA2C, "\u9928"},
+	{0xFA2D, "\u9db4"},
+	{0xFA2E, "\u90de"},
+	{0xFA2F, "\u96b7"},
+	{0xFA30, "\u4fae"},
+	{0xFA31, "\u50e7"},
+	{0xFA32, "\u514d"},
+	{0xFA33, "\u52c9"},
+	{0xFA34, "\u52e4"},
+	{0xFA35, "\u5351"},
+	{0xFA36, "\u559d"},
+	{0xFA37, "\u5606"},
+	{0xFA38, "\u5668"},
+	{0xFA39, "\u5840"},
+	{0xFA3A, "\u58a8"},
+	{0xFA3B, "\u5c64"},
+	{0xFA3C, "\u5c6e"},
+	{0xFA3D, "\u6094"},
+	{0xFA3E, "\u6168"},
+	{0xFA3F, "\u618e"},
+	{0xFA40, "\u61f2"},
+	{0xFA41, "\u654f"},
+	{0xFA42, "\u65e2"},
+	{0xFA43, "\u6691"},
+	{0xFA44, "\u6885"},
+	{0xFA45, "\u6d77"},
+	{0xFA46, "\u6e1a"},
+	{0xFA47, "\u6f22"},
+	{0xFA48, "\u716e"},
+	{0xFA49, "\u722b"},
+	{0xFA4A, "\u7422"},
+	{0xFA4B, "\u7891"},
+	{0xFA4C, "\u793e"},
+	{0xFA4D, "\u7949"},
+	{0xFA4E, "\u7948"},
+	{0xFA4F, "\u7950"},
+	{0xFA50, "\u7956"},
+	{0xFA51, "\u795d"},
+	{0xFA52, "\u798d"},
+	{0xFA53, "\u798e"},
+	{0xFA54, "\u7a40"},
+	{0xFA55, "\u7a81"},
+	{0xFA56, "\u7bc0"},
+	{0xFA57, "\u7df4"},
+	{0xFA58, "\u7e09"},
+	{0xFA59, "\u7e41"},
+	{0xFA5A, "\u7f72"},
+	{0xFA5B, "\u8005"},
+	{0xFA5C, "\u81ed"},
+	{0xFA5D, "\u8279"},
+	{0xFA5E, "\u8279"},
+	{0xFA5F, "\u8457"},
+	{0xFA60, "\u8910"},
+	{0xFA61, "\u8996"},
+	{0xFA62, "\u8b01"},
+	{0xFA63, "\u8b39"},
+	{0xFA64, "\u8cd3"},
+	{0xFA65, "\u8d08"},
+	{0xFA66, "\u8fb6"},
+	{0xFA67, "\u9038"},
+	{0xFA68, "\u96e3"},
+	{0xFA69, "\u97ff"},
+	{0xFA6A, "\u983b"},
+	{0xFA6B, "\u6075"},
+	{0xFA6C, "\U000242ee"},
+	{0xFA6D, "\u8218"},
+	{0xFA70, "\u4e26"},
+	{0xFA71, "\u51b5"},
+	{0xFA72, "\u5168"},
+	{0xFA73, "\u4f80"},
+	{0xFA74, "\u5145"},
+	{0xFA75, "\u5180"},
+	{0xFA76, "\u52c7"},
+	{0xFA77, "\u52fa"},
+	{0xFA78, "\u559d"},
+	{0xFA79, "\u5555"},
+	{0xFA7A, "\u5599"},
+	{0xFA7B, "\u55e2"},
+	{0xFA7C, "\u585a"},
+	{0xFA7D, "\u58b3"},
+	{0xFA7E, "\u5944"},
+	{0xFA7F, "\u5954"},
+	{0xFA80, "\u5a62"},
+	{0xFA81, "\u5b28"},
+	{0xFA82, "\u5ed2"},
+	{0xFA83, "\u5ed9"},
+	{0xFA84, "\u5f69"},
+	{0xFA85, "\u5fad"},
+	{0xFA86, "\u60d8"},
+	{0xFA87, "\u614e"},
+	{0xFA88, "\u6108"},
+	{0xFA89, "\u618e"},
+	{0xFA8A, "\u6160"},
+	{0xFA8B, "\u61f2"},
+	{0xFA8C, "\u6234"},
+	{0xFA8D, "\u63c4"},
+	{0xFA8E, "\u641c"},
+	{0xFA8F, "\u6452"},
+	{0xFA90, "\u6556"},
+	{0xFA91, "\u6674"},
+	{0xFA92, "\u6717"},
+	{0xFA93, "\u671b"},
+	{0xFA94, "\u6756"},
+	{0xFA95, "\u6b79"},
+	{0xFA96, "\u6bba"},
+	{0xFA97, "\u6d41"},
+	{0xFA98, "\u6edb"},
+	{0xFA99, "\u6ecb"},
+	{0xFA9A, "\u6f22"},
+	{0xFA9B, "\u701e"},
+	{0xFA9C, "\u716e"},
+	{0xFA9D, "\u77a7"},
+	{0xFA9E, "\u7235"},
+	{0xFA9F, "\u72af"},
+	{0xFAA0, "\u732a"},
+	{0xFAA1, "\u7471"},
+	{0xFAA2, "\u7506"},
+	{0xFAA3, "\u753b"},
+	{0xFAA4, "\u761d"},
+	{0xFAA5, "\u761f"},
+	{0xFAA6, "\u76ca"},
+	{0xFAA7, "\u76db"},
+	{0xFAA8, "\u76f4"},
+	{0xFAA9, "\u774a"},
+	{0xFAAA, "\u7740"},
+	{0xFAAB, "\u78cc"},
+	{0xFAAC, "\u7ab1"},
+	{0xFAAD, "\u7bc0"},
+	{0xFAAE, "\u7c7b"},
+	{0xFAAF, "\u7d5b"},
+	{0xFAB0, "\u7df4"},
+	{0xFAB1, "\u7f3e"},
+	{0xFAB2, "\u8005"},
+	{0xFAB3, "\u8352"},
+	{0xFAB4, "\u83ef"},
+	{0xFAB5, "\u8779"},
+	{0xFAB6, "\u8941"},
+	{0xFAB7, "\u8986"},
+	{0xFAB8, "\u8996"},
+	{0xFAB9, "\u8abf"},
+	{0xFABA, "\u8af8"},
+	{0xFABB, "\u8acb"},
+	{0xFABC, "\u8b01"},
+	{0xFABD, "\u8afe"},
+	{0xFABE, "\u8aed"},
+	{0xFABF, "\u8b39"},
+	{0xFAC0, "\u8b8a"},
+	{0xFAC1, "\u8d08"},
+	{0xFAC2, "\u8f38"},
+	{0xFAC3, "\u9072"},
+	{0xFAC4, "\u9199"},
+	{0xFAC5, "\u9276"},
+	{0xFAC6, "\u967c"},
+	{0xFAC7, "\u96e3"},
+	{0xFAC8, "\u9756"},
+	{0xFAC9, "\u97db"},
+	{0xFACA, "\u97ff"},
+	{0xFACB, "\u980b"},
+	{0xFACC, "\u983b"},
+	{0xFACD, "\u9b12"},
+	{0xFACE, "\u9f9c"},
+	{0xFACF, "\U0002284a"},
+	{0xFAD0, "\U00022844"},
+	{0xFAD1, "\U000233d5"},
+	{0xFAD2, "\u3b9d"},
+	{0xFAD3, "\u4018"},
+	{0xFAD4, "\u4039"},
+	{0xFAD5, "\U00025249"},
+	{0xFAD6, "\U00025cd0"},
+	{0xFAD7, "\U00027ed3"},
+	{0xFAD8, "\u9f43"},
+	{0xFAD9, "\u9f8e"},
+	{0xFB1D, "\u05d9\u05b4"},
+	{0xFB1F, "\u05f2\u05b7"},
+	{0xFB2A, "\u05e9\u05c1"},
+	{0xFB2B, "\u05e9\u05c2"},
+	{0xFB2C, "\u05e9\u05bc\u05c1"},
+	{0xFB2D, "\u05e9\u05bc\u05c2"},
+	{0xFB2E, "\u05d0\u05b7"},
+	{0xFB2F, "\u05d0\u05b8"},
+	{0xFB30, "\u05d0\u05bc"},
+	{0xFB31, "\u05d1\u05bc"},
+	{0xFB32, "\u05d2\u05bc"},
+	{0xFB33, "\u05d3\u05bc"},
+	{0xFB34, "\u05d4\u05bc"},
+	{0xFB35, "\u05d5\u05bc"},
+	{0xFB36, "\u05d6\u05bc"},
+	{0xFB38, "\u05d8\u05bc"},
+	{0xFB39, "\u05d9\u05bc"},
+	{0xFB3A, "\u05da\u05bc"},
+	{0xFB3B, "\u05db\u05bc"},
+	{0xFB3C, "\u05dc\u05bc"},
+	{0xFB3E, "\u05de\u05bc"},
+	{0xFB40, "\u05e0\u05bc"},
+	{0xFB41, "\u05e1\u05bc"},
+	{0xFB43, "\u05e3\u05bc"},
+	{0xFB44, "\u05e4\u05bc"},
+	{0xFB46, "\u05e6\u05bc"},
+	{0xFB47, "\u05e7\u05bc"},
+	{0xFB48, "\u05e8\u05bc"},
+	{0xFB49, "\u05e9\u05bc"},
+	{0xFB4A, "\u05ea\u05bc"},
+	{0xFB4B, "\u05d5\u05b9"},
+	{0xFB4C, "\u05d1\u05bf"},
+	{0xFB4D, "\u05db\u05bf"},
+	{0xFB4E, "\u05e4\u05bf"},
+	{0x105C9, "\U000105d2\u0307"},
+	{0x105E4, "\U000105da\u0307"},
+	{0x1109A, "\U00011099\U000110ba"},
+	{0x1109C, "\U0001109b\U000110ba"},
+	{0x110AB, "\U000110a5\U000110ba"},
+	{0x1112E, "\U00011131\U00011127"},
+	{0x1112F, "\U00011132\U00011127"},
+	{0x1134B, "\U00011347\U0001133e"},
+	{0x1134C, "\U00011347\U00011357"},
+	{0x11383, "\U00011382\U000113c9"},
+	{0x11385, "\U00011384\U000113bb"},
+	{0x1138E, "\U0001138b\U000113c2"},
+	{0x11391, "\U00011390\U000113c9"},
+	{0x113C5, "\U000113c2\U000113c2"},
+	{0x113C7, "\U000113c2\U000113b8"},
+	{0x113C8, "\U000113c2\U000113c9"},
+	{0x114BB, "\U000114b9\U000114ba"},
+	{0x114BC, "\U000114b9\U000114b0"},
+	{0x114BE, "\U000114b9\U000114bd"},
+	{0x115BA, "\U000115b8\U000115af"},
+	{0x115BB, "\U000115b9\U000115af"},
+	{0x11938, "\U00011935\U00011930"},
+	{0x16121, "\U0001611e\U0001611e"},
+	{0x16122, "\U0001611e\U00016129"},
+	{0x16123, "\U0001611e\U0001611f"},
+	{0x16124, "\U00016129\U0001611f"},
+	{0x16125, "\U0001611e\U00016120"},
+	{0x16126, "\U0001611e\U0001611e\U0001611f"},
+	{0x16127, "\U0001611e\U00016129\U0001611f"},
+	{0x16128, "\U0001611e\U0001611e\U00016120"},
+	{0x16D68, "\U00016d67\U00016d67"},
+	{0x16D69, "\U00016d63\U00016d67"},
+	{0x16D6A, "\U00016d63\U00016d67\U00016d67"},
+	{0x1D15E, "\U0001d157\U0001d165"},
+	{0x1D15F, "\U0001d158\U0001d165"},
+	{0x1D160, "\U0001d158\U0001d165\U0001d16e"},
+	{0x1D161, "\U0001d158\U0001d165\U0001d16f"},
+	{0x1D162, "\U0001d158\U0001d165\U0001d170"},
+	{0x1D163, "\U0001d158\U0001d165\U0001d171"},
+	{0x1D164, "\U0001d158\U0001d165\U0001d172"},
+	{0x1D1BB, "\U0001d1b9\U0001d165"},
+	{0x1D1BC, "\U0001d1ba\U0001d165"},
+	{0x1D1BD, "\U0001d1b9\U0001d165\U0001d16e"},
+	{0x1D1BE, "\U0001d1ba\U0001d165\U0001d16e"},
+	{0x1D1BF, "\U0001d1b9\U0001d165\U0001d16f"},
+	{0x1D1C0, "\U0001d1ba\U0001d165\U0001d16f"},
+	{0x2F800, "\u4e3d"},
+	{0x2F801, "\u4e38"},
+	{0x2F802, "\u4e41"},
+	{0x2F803, "\U00020122"},
+	{0x2F804, "\u4f60"},
+	{0x2F805, "\u4fae"},
+	{0x2F806, "\u4fbb"},
+	{0x2F807, "\u5002"},
+	{0x2F808, "\u507a"},
+	{0x2F809, "\u5099"},
+	{0x2F80A, "\u50e7"},
+	{0x2F80B, "\u50cf"},
+	{0x2F80C, "\u349e"},
+	{0x2F80D, "\U0002063a"},
+	{0x2F80E, "\u514d"},
+	{0x2F80F, "\u5154"},
+	{0x2F810, "\u5164"},
+	{0x2F811, "\u5177"},
+	{0x2F812, "\U0002051c"},
+	{0x2F813, "\u34b9"},
+	{0x2F814, "\u5167"},
+	{0x2F815, "\u518d"},
+	{0x2F816, "\U0002054b"},
+	{0x2F817, "\u5197"},
+	{0x2F818, "\u51a4"},
+	{0x2F819, "\u4ecc"},
+	{0x2F81A, "\u51ac"},
+	{0x2F81B, "\u51b5"},
+	{0x2F81C, "\U000291df"},
+	{0x2F81D, "\u51f5"},
+	{0x2F81E, "\u5203"},
+	{0x2F81F, "\u34df"},
+	{0x2F820, "\u523b"},
+	{0x2F821, "\u5246"},
+	{0x2F822, "\u5272"},
+	{0x2F823, "\u5277"},
+	{0x2F824, "\u3515"},
+	{0x2F825, "\u52c7"},
+	{0x2F826, "\u52c9"},
+	{0x2F827, "\u52e4"},
+	{0x2F828, "\u52fa"},
+	{0x2F829, "\u5305"},
+	{0x2F82A, "\u5306"},
+	{0x2F82B, "\u5317"},
+	{0x2F82C, "\u5349"},
+	{0x2F82D, "\u5351"},
+	{0x2F82E, "\u535a"},
+	{0x2F82F, "\u5373"},
+	{0x2F830, "\u537d"},
+	{0x2F831, "\u537f"},
+	{0x2F832, "\u537f"},
+	{0x2F833, "\u537f"},
+	{0x2F834, "\U00020a2c"},
+	{0x2F835, "\u7070"},
+	{0x2F836, "\u53ca"},
+	{0x2F837, "\u53df"},
+	{0x2F838, "\U00020b63"},
+	{0x2F839, "\u53eb"},
+	{0x2F83A, "\u53f1"},
+	{0x2F83B, "\u5406"},
+	{0x2F83C, "\u549e"},
+	{0x2F83D, "\u5438"},
+	{0x2F83E, "\u5448"},
+	{0x2F83F, "\u5468"},
+	{0x2F840, "\u54a2"},
+	{0x2F841, "\u54f6"},
+	{0x2F842, "\u5510"},
+	{0x2F843, "\u5553"},
+	{0x2F844, "\u5563"},
+	{0x2F845, "\u5584"},
+	{0x2F846, "\u5584"},
+	{0x2F847, "\u5599"},
+	{0x2F848, "\u55ab"},
+	{0x2F849, "\u55b3"},
+	{0x2F84A, "\u55c2"},
+	{0x2F84B, "\u5716"},
+	{0x2F84C, "\u5606"},
+	{0x2F84D, "\u5717"},
+	{0x2F84E, "\u5651"},
+	{0x2F84F, "\u5674"},
+	{0x2F850, "\u5207"},
+	{0x2F851, "\u58ee"},
+	{0x2F852, "\u57ce"},
+	{0x2F853, "\u57f4"},
+	{0x2F854, "\u580d"},
+	{0x2F855, "\u578b"},
+	{0x2F856, "\u5832"},
+	{0x2F857, "\u5831"},
+	{0x2F858, "\u58ac"},
+	{0x2F859, "\U000214e4"},
+	{0x2F85A, "\u58f2"},
+	{0x2F85B, "\u58f7"},
+	{0x2F85C, "\u5906"},
+	{0x2F85D, "\u591a"},
+	{0x2F85E, "\u5922"},
+	{0x2F85F, "\u5962"},
+	{0x2F860, "\U000216a8"},
+	{0x2F861, "\U000216ea"},
+	{0x2F862, "\u59ec"},
+	{0x2F863, "\u5a1b"},
+	{0x2F864, "\u5a27"},
+	{0x2F865, "\u59d8"},
+	{0x2F866, "\u5a66"},
+	{0x2F867, "\u36ee"},
+	{0x2F868, "\u36fc"},
+	{0x2F869, "\u5b08"},
+	{0x2F86A, "\u5b3e"},
+	{0x2F86B, "\u5b3e"},
+	{0x2F86C, "\U000219c8"},
+	{0x2F86D, "\u5bc3"},
+	{0x2F86E, "\u5bd8"},
+	{0x2F86F, "\u5be7"},
+	{0x2F870, "\u5bf3"},
+	{0x2F871, "\U00021b18"},
+	{0x2F872, "\u5bff"},
+	{0x2F873, "\u5c06"},
+	{0x2F874, "\u5f53"},
+	{0x2F875, "\u5c22"},
+	{0x2F876, "\u3781"},
+	{0x2F877, "\u5c60"},
+	{0x2F878, "\u5c6e"},
+	{0x2F879, "\u5cc0"},
+	{0x2F87A, "\u5c8d"},
+	{0x2F87B, "\U00021de4"},
+	{0x2F87C, "\u5d43"},
+	{0x2F87D, "\U00021de6"},
+	{0x2F87E, "\u5d6e"},
+	{0x2F87F, "\u5d6b"},
+	{0x2F880, "\u5d7c"},
+	{0x2F881, "\u5de1"},
+	{0x2F882, "\u5de2"},
+	{0x2F883, "\u382f"},
+	{0x2F884, "\u5dfd"},
+	{0x2F885, "\u5e28"},
+	{0x2F886, "\u5e3d"},
+	{0x2F887, "\u5e69"},
+	{0x2F888, "\u3862"},
+	{0x2F889, "\U00022183"},
+	{0x2F88A, "\u387c"},
+	{0x2F88B, "\u5eb0"},
+	{0x2F88C, "\u5eb3"},
+	{0x2F88D, "\u5eb6"},
+	{0x2F88E, "\u5eca"},
+	{0x2F88F, "\U0002a392"},
+	{0x2F890, "\u5efe"},
+	{0x2F891, "\U00022331"},
+	{0x2F892, "\U00022331"},
+	{0x2F893, "\u8201"},
+	{0x2F894, "\u5f22"},
+	{0x2F895, "\u5f22"},
+	{0x2F896, "\u38c7"},
+	{0x2F897, "\U000232b8"},
+	{0x2F898, "\U000261da"},
+	{0x2F899, "\u5f62"},
+	{0x2F89A, "\u5f6b"},
+	{0x2F89B, "\u38e3"},
+	{0x2F89C, "\u5f9a"},
+	{0x2F89D, "\u5fcd"},
+	{0x2F89E, "\u5fd7"},
+	{0x2F89F, "\u5ff9"},
+	{0x2F8A0, "\u6081"},
+	{0x2F8A1, "\u393a"},
+	{0x2F8A2, "\u391c"},
+	{0x2F8A3, "\u6094"},
+	{0x2F8A4, "\U000226d4"},
+	{0x2F8A5, "\u60c7"},
+	{0x2F8A6, "\u6148"},
+	{0x2F8A7, "\u614c"},
+	{0x2F8A8, "\u614e"},
+	{0x2F8A9, "\u614c"},
+	{0x2F8AA, "\u617a"},
+	{0x2F8AB, "\u618e"},
+	{0x2F8AC, "\u61b2"},
+	{0x2F8AD, "\u61a4"},
+	{0x2F8AE, "\u61af"},
+	{0x2F8AF, "\u61de"},
+	{0x2F8B0, "\u61f2"},
+	{0x2F8B1, "\u61f6"},
+	{0x2F8B2, "\u6210"},
+	{0x2F8B3, "\u621b"},
+	{0x2F8B4, "\u625d"},
+	{0x2F8B5, "\u62b1"},
+	{0x2F8B6, "\u62d4"},
+	{0x2F8B7, "\u6350"},
+	{0x2F8B8, "\U00022b0c"},
+	{0x2F8B9, "\u633d"},
+	{0x2F8BA, "\u62fc"},
+	{0x2F8BB, "\u6368"},
+	{0x2F8BC, "\u6383"},
+	{0x2F8BD, "\u63e4"},
+	{0x2F8BE, "\U00022bf1"},
+	{0x2F8BF, "\u6422"},
+	{0x2F8C0, "\u63c5"},
+	{0x2F8C1, "\u63a9"},
+	{0x2F8C2, "\u3a2e"},
+	{0x2F8C3, "\u6469"},
+	{0x2F8C4, "\u647e"},
+	{0x2F8C5, "\u649d"},
+	{0x2F8C6, "\u6477"},
+	{0x2F8C7, "\u3a6c"},
+	{0x2F8C8, "\u654f"},
+	{0x2F8C9, "\u656c"},
+	{0x2F8CA, "\U0002300a"},
+	{0x2F8CB, "\u65e3"},
+	{0x2F8CC, "\u66f8"},
+	{0x2F8CD, "\u6649"},
+	{0x2F8CE, "\u3b19"},
+	{0x2F8CF, "\u6691"},
+	{0x2F8D0, "\u3b08"},
+	{0x2F8D1, "\u3ae4"},
+	{0x2F8D2, "\u5192"},
+	{0x2F8D3, "\u5195"},
+	{0x2F8D4, "\u6700"},
+	{0x2F8D5, "\u669c"},
+	{0x2F8D6, "\u80ad"},
+	{0x2F8D7, "\u43d9"},
+	{0x2F8D8, "\u6717"},
+	{0x2F8D9, "\u671b"},
+	{0x2F8DA, "\u6721"},
+	{0x2F8DB, "\u675e"},
+	{0x2F8DC, "\u6753"},
+	{0x2F8DD, "\U000233c3"},
+	{0x2F8DE, "\u3b49"},
+	{0x2F8DF, "\u67fa"},
+	{0x2F8E0, "\u6785"},
+	{0x2F8E1, "\u6852"},
+	{0x2F8E2, "\u6885"},
+	{0x2F8E3, "\U0002346d"},
+	{0x2F8E4, "\u688e"},
+	{0x2F8E5, "\u681f"},
+	{0x2F8E6, "\u6914"},
+	{0x2F8E7, "\u3b9d"},
+	{0x2F8E8, "\u6942"},
+	{0x2F8E9, "\u69a3"},
+	{0x2F8EA, "\u69ea"},
+	{0x2F8EB, "\u6aa8"},
+	{0x2F8EC, "\U000236a3"},
+	{0x2F8ED, "\u6adb"},
+	{0x2F8EE, "\u3c18"},
+	{0x2F8EF, "\u6b21"},
+	{0x2F8F0, "\U000238a7"},
+	{0x2F8F1, "\u6b54"},
+	{0x2F8F2, "\u3c4e"},
+	{0x2F8F3, "\u6b72"},
+	{0x2F8F4, "\u6b9f"},
+	{0x2F8F5, "\u6bba"},
+	{0x2F8F6, "\u6bbb"},
+	{0x2F8F7, "\U00023a8d"},
+	{0x2F8F8, "\U00021d0b"},
+	{0x2F8F9, "\U00023afa"},
+	{0x2F8FA, "\u6c4e"},
+	{0x2F8FB, "\U00023cbc"},
+	{0x2F8FC, "\u6cbf"},
+	{0x2F8FD, "\u6ccd"},
+	{0x2F8FE, "\u6c67"},
+	{0x2F8FF, "\u6d16"},
+	{0x2F900, "\u6d3e"},
+	{0x2F901, "\u6d77"},
+	{0x2F902, "\u6d41"},
+	{0x2F903, "\u6d69"},
+	{0x2F904, "\u6d78"},
+	{0x2F905, "\u6d85"},
+	{0x2F906, "\U00023d1e"},
+	{0x2F907, "\u6d34"},
+	{0x2F908, "\u6e2f"},
+	{0x2F909, "\u6e6e"},
+	{0x2F90A, "\u3d33"},
+	{0x2F90B, "\u6ecb"},
+	{0x2F90C, "\u6ec7"},
+	{0x2F90D, "\U00023ed1"},
+	{0x2F90E, "\u6df9"},
+	{0x2F90F, "\u6f6e"},
+	{0x2F910, "\U00023f5e"},
+	{0x2F911, "\U00023f8e"},
+	{0x2F912, "\u6fc6"},
+	{0x2F913, "\u7039"},
+	{0x2F914, "\u701e"},
+	{0x2F915, "\u701b"},
+	{0x2F916, "\u3d96"},
+	{0x2F917, "\u704a"},
+	{0x2F918, "\u707d"},
+	{0x2F919, "\u7077"},
+	{0x2F91A, "\u70ad"},
+	{0x2F91B, "\U00020525"},
+	{0x2F91C, "\u7145"},
+	{0x2F91D, "\U00024263"},
+	{0x2F91E, "\u719c"},
+	{0x2F91F, "\U000243ab"},
+	{0x2F920, "\u7228"},
+	{0x2F921, "\u7235"},
+	{0x2F922, "\u7250"},
+	{0x2F923, "\U00024608"},
+	{0x2F924, "\u7280"},
+	{0x2F925, "\u7295"},
+	{0x2F926, "\U00024735"},
+	{0x2F927, "\U00024814"},
+	{0x2F928, "\u737a"},
+	{0x2F929, "\u738b"},
+	{0x2F92A, "\u3eac"},
+	{0x2F92B, "\u73a5"},
+	{0x2F92C, "\u3eb8"},
+	{0x2F92D, "\u3eb8"},
+	{0x2F92E, "\u7447"},
+	{0x2F92F, "\u745c"},
+	{0x2F930, "\u7471"},
+	{0x2F931, "\u7485"},
+	{0x2F932, "\u74ca"},
+	{0x2F933, "\u3f1b"},
+	{0x2F934, "\u7524"},
+	{0x2F935, "\U00024c36"},
+	{0x2F936, "\u753e"},
+	{0x2F937, "\U00024c92"},
+	{0x2F938, "\u7570"},
+	{0x2F939, "\U0002219f"},
+	{0x2F93A, "\u7610"},
+	{0x2F93B, "\U00024fa1"},
+	{0x2F93C, "\U00024fb8"},
+	{0x2F93D, "\U00025044"},
+	{0x2F93E, "\u3ffc"},
+	{0x2F93F, "\u4008"},
+	{0x2F940, "\u76f4"},
+	{0x2F941, "\U000250f3"},
+	{0x2F942, "\U000250f2"},
+	{0x2F943, "\U00025119"},
+	{0x2F944, "\U00025133"},
+	{0x2F945, "\u771e"},
+	{0x2F946, "\u771f"},
+	{0x2F947, "\u771f"},
+	{0x2F948, "\u774a"},
+	{0x2F949, "\u4039"},
+	{0x2F94A, "\u778b"},
+	{0x2F94B, "\u4046"},
+	{0x2F94C, "\u4096"},
+	{0x2F94D, "\U0002541d"},
+	{0x2F94E, "\u784e"},
+	{0x2F94F, "\u788c"},
+	{0x2F950, "\u78cc"},
+	{0x2F951, "\u40e3"},
+	{0x2F952, "\U00025626"},
+	{0x2F953, "\u7956"},
+	{0x2F954, "\U0002569a"},
+	{0x2F955, "\U000256c5"},
+	{0x2F956, "\u798f"},
+	{0x2F957, "\u79eb"},
+	{0x2F958, "\u412f"},
+	{0x2F959, "\u7a40"},
+	{0x2F95A, "\u7a4a"},
+	{0x2F95B, "\u7a4f"},
+	{0x2F95C, "\U0002597c"},
+	{0x2F95D, "\U00025aa7"},
+	{0x2F95E, "\U00025aa7"},
+	{0x2F95F, "\u7aee"},
+	{0x2F960, "\u4202"},
+	{0x2F961, "\U00025bab"},
+	{0x2F962, "\u7bc6"},
+	{0x2F963, "\u7bc9"},
+	{0x2F964, "\u4227"},
+	{0x2F965, "\U00025c80"},
+	{0x2F966, "\u7cd2"},
+	{0x2F967, "\u42a0"},
+	{0x2F968, "\u7ce8"},
+	{0x2F969, "\u7ce3"},
+	{0x2F96A, "\u7d00"},
+	{0x2F96B, "\U00025f86"},
+	{0x2F96C, "\u7d63"},
+	{0x2F96D, "\u4301"},
+	{0x2F96E, "\u7dc7"},
+	{0x2F96F, "\u7e02"},
+	{0x2F970, "\u7e45"},
+	{0x2F971, "\u4334"},
+	{0x2F972, "\U00026228"},
+	{0x2F973, "\U00026247"},
+	{0x2F974, "\u4359"},
+	{0x2F975, "\U000262d9"},
+	{0x2F976, "\u7f7a"},
+	{0x2F977, "\U0002633e"},
+	{0x2F978, "\u7f95"},
+	{0x2F979, "\u7ffa"},
+	{0x2F97A, "\u8005"},
+	{0x2F97B, "\U000264da"},
+	{0x2F97C, "\U00026523"},
+	{0x2F97D, "\u8060"},
+	{0x2F97E, "\U000265a8"},
+	{0x2F97F, "\u8070"},
+	{0x2F980, "\U0002335f"},
+	{0x2F981, "\u43d5"},
+	{0x2F982, "\u80b2"},
+	{0x2F983, "\u8103"},
+	{0x2F984, "\u440b"},
+	{0x2F985, "\u813e"},
+	{0x2F986, "\u5ab5"},
+	{0x2F987, "\U000267a7"},
+	{0x2F988, "\U000267b5"},
+	{0x2F989, "\U00023393"},
+	{0x2F98A, "\U0002339c"},
+	{0x2F98B, "\u8201"},
+	{0x2F98C, "\u8204"},
+	{0x2F98D, "\u8f9e"},
+	{0x2F98E, "\u446b"},
+	{0x2F98F, "\u8291"},
+	{0x2F990, "\u828b"},
+	{0x2F991, "\u829d"},
+	{0x2F992, "\u52b3"},
+	{0x2F993, "\u82b1"},
+	{0x2F994, "\u82b3"},
+	{0x2F995, "\u82bd"},
+	{0x2F996, "\u82e6"},
+	{0x2F997, "\U00026b3c"},
+	{0x2F998, "\u82e5"},
+	{0x2F999, "\u831d"},
+	{0x2F99A, "\u8363"},
+	{0x2F99B, "\u83ad"},
+	{0x2F99C, "\u8323"},
+	{0x2F99D, "\u83bd"},
+	{0x2F99E, "\u83e7"},
+	{0x2F99F, "\u8457"},
+	{0x2F9A0, "\u8353"},
+	{0x2F9A1, "\u83ca"},
+	{0x2F9A2, "\u83cc"},
+	{0x2F9A3, "\u83dc"},
+	{0x2F9A4, "\U00026c36"},
+	{0x2F9A5, "\U00026d6b"},
+	{0x2F9A6, "\U00026cd5"},
+	{0x2F9A7, "\u452b"},
+	{0x2F9A8, "\u84f1"},
+	{0x2F9A9, "\u84f3"},
+	{0x2F9AA, "\u8516"},
+	{0x2F9AB, "\U000273ca"},
+	{0x2F9AC, "\u8564"},
+	{0x2F9AD, "\U00026f2c"},
+	{0x2F9AE, "\u455d"},
+	{0x2F9AF, "\u4561"},
+	{0x2F9B0, "\U00026fb1"},
+	{0x2F9B1, "\U000270d2"},
+	{0x2F9B2, "\u456b"},
+	{0x2F9B3, "\u8650"},
+	{0x2F9B4, "\u865c"},
+	{0x2F9B5, "\u8667"},
+	{0x2F9B6, "\u8669"},
+	{0x2F9B7, "\u86a9"},
+	{0x2F9B8, "\u8688"},
+	{0x2F9B9, "\u870e"},
+	{0x2F9BA, "\u86e2"},
+	{0x2F9BB, "\u8779"},
+	{0x2F9BC, "\u8728"},
+	{0x2F9BD, "\u876b"},
+	{0x2F9BE, "\u8786"},
+	{0x2F9BF, "\u45d7"},
+	{0x2F9C0, "\u87e1"},
+	{0x2F9C1, "\u8801"},
+	{0x2F9C2, "\u45f9"},
+	{0x2F9C3, "\u8860"},
+	{0x2F9C4, "\u8863"},
+	{0x2F9C5, "\U00027667"},
+	{0x2F9C6, "\u88d7"},
+	{0x2F9C7, "\u88de"},
+	{0x2F9C8, "\u4635"},
+	{0x2F9C9, "\u88fa"},
+	{0x2F9CA, "\u34bb"},
+	{0x2F9CB, "\U000278ae"},
+	{0x2F9CC, "\U00027966"},
+	{0x2F9CD, "\u46be"},
+	{0x2F9CE, "\u46c7"},
+	{0x2F9CF, "\u8aa0"},
+	{0x2F9D0, "\u8aed"},
+	{0x2F9D1, "\u8b8a"},
+	{0x2F9D2, "\u8c55"},
+	{0x2F9D3, "\U00027ca8"},
+	{0x2F9D4, "\u8cab"},
+	{0x2F9D5, "\u8cc1"},
+	{0x2F9D6, "\u8d1b"},
+	{0x2F9D7, "\u8d77"},
+	{0x2F9D8, "\U00027f2f"},
+	{0x2F9D9, "\U00020804"},
+	{0x2F9DA, "\u8dcb"},
+	{0x2F9DB, "\u8dbc"},
+	{0x2F9DC, "\u8df0"},
+	{0x2F9DD, "\U000208de"},
+	{0x2F9DE, "\u8ed4"},
+	{0x2F9DF, "\u8f38"},
+	{0x2F9E0, "\U000285d2"},
+	{0x2F9E1, "\U000285ed"},
+	{0x2F9E2, "\u9094"},
+	{0x2F9E3, "\u90f1"},
+	{0x2F9E4, "\u9111"},
+	{0x2F9E5, "\U0002872e"},
+	{0x2F9E6, "\u911b"},
+	{0x2F9E7, "\u9238"},
+	{0x2F9E8, "\u92d7"},
+	{0x2F9E9, "\u92d8"},
+	{0x2F9EA, "\u927c"},
+	{0x2F9EB, "\u93f9"},
+	{0x2F9EC, "\u9415"},
+	{0x2F9ED, "\U00028bfa"},
+	{0x2F9EE, "\u958b"},
+	{0x2F9EF, "\u4995"},
+	{0x2F9F0, "\u95b7"},
+	{0x2F9F1, "\U00028d77"},
+	{0x2F9F2, "\u49e6"},
+	{0x2F9F3, "\u96c3"},
+	{0x2F9F4, "\u5db2"},
+	{0x2F9F5, "\u9723"},
+	{0x2F9F6, "\U00029145"},
+	{0x2F9F7, "\U0002921a"},
+	{0x2F9F8, "\u4a6e"},
+	{0x2F9F9, "\u4a76"},
+	{0x2F9FA, "\u97e0"},
+	{0x2F9FB, "\U0002940a"},
+	{0x2F9FC, "\u4ab2"},
+	{0x2F9FD, "\U00029496"},
+	{0x2F9FE, "\u980b"},
+	{0x2F9FF, "\u980b"},
+	{0x2FA00, "\u9829"},
+	{0x2FA01, "\U000295b6"},
+	{0x2FA02, "\u98e2"},
+	{0x2FA03, "\u4b33"},
+	{0x2FA04, "\u9929"},
+	{0x2FA05, "\u99a7"},
+	{0x2FA06, "\u99c2"},
+	{0x2FA07, "\u99fe"},
+	{0x2FA08, "\u4bce"},
+	{0x2FA09, "\U00029b30"},
+	{0x2FA0A, "\u9b12"},
+	{0x2FA0B, "\u9c40"},
+	{0x2FA0C, "\u9cfd"},
+	{0x2FA0D, "\u4cce"},
+	{0x2FA0E, "\u4ced"},
+	{0x2FA0F, "\u9d67"},
+	{0x2FA10, "\U0002a0ce"},
+	{0x2FA11, "\u4cf8"},
+	{0x2FA12, "\U0002a105"},
+	{0x2FA13, "\U0002a20e"},
+	{0x2FA14, "\U0002a291"},
+	{0x2FA15, "\u9ebb"},
+	{0x2FA16, "\u4d56"},
+	{0x2FA17, "\u9ef9"},
+	{0x2FA18, "\u9efe"},
+	{0x2FA19, "\u9f05"},
+	{0x2FA1A, "\u9f0f"},
+	{0x2FA1B, "\u9f16"},
+	{0x2FA1C, "\u9f3b"},
+	{0x2FA1D, "\U0002a600"},
+}
+
+var compositionTable = []composition{
+	{0x003C, 0x0338, 0x226E},
+	{0x003D, 0x0338, 0x2260},
+	{0x003E, 0x0338, 0x226F},
+	{0x0041, 0x0300, 0x00C0},
+	{0x0041, 0x0301, 0x00C1},
+	{0x0041, 0x0302, 0x00C2},
+	{0x0041, 0x0303, 0x00C3},
+	{0x0041, 0x0304, 0x0100},
+	{0x0041, 0x0306, 0x0102},
+	{0x0041, 0x0307, 0x0226},
+	{0x0041, 0x0308, 0x00C4},
+	{0x0041, 0x0309, 0x1EA2},
+	{0x0041, 0x030A, 0x00C5},
+	{0x0041, 0x030C, 0x01CD},
+	{0x0041, 0x030F, 0x0200},
+	{0x0041, 0x0311, 0x0202},
+	{0x0041, 0x0323, 0x1EA0},
+	{0x0041, 0x0325, 0x1E00},
+	{0x0041, 0x0328, 0x0104},
+	{0x0042, 0x0307, 0x1E02},
+	{0x0042, 0x0323, 0x1E04},
+	{0x0042, 0x0331, 0x1E06},
+	{0x0043, 0x0301, 0x0106},
+	{0x0043, 0x0302, 0x0108},
+	{0x0043, 0x0307, 0x010A},
+	{0x0043, 0x030C, 0x010C},
+	{0x0043, 0x0327, 0x00C7},
+	{0x0044, 0x0307, 0x1E0A},
+	{0x0044, 0x030C, 0x010E},
+	{0x0044, 0x0323, 0x1E0C},
+	{0x0044, 0x0327, 0x1E10},
+	{0x0044, 0x032D, 0x1E12},
+	{0x0044, 0x0331, 0x1E0E},
+	{0x0045, 0x0300, 0x00C8},
+	{0x0045, 0x0301, 0x00C9},
+	{0x0045, 0x0302, 0x00CA},
+	{0x0045, 0x0303, 0x1EBC},
+	{0x0045, 0x0304, 0x0112},
+	{0x0045, 0x0306, 0x0114},
+	{0x0045, 0x0307, 0x0116},
+	{0x0045, 0x0308, 0x00CB},
+	{0x0045, 0x0309, 0x1EBA},
+	{0x0045, 0x030C, 0x011A},
+	{0x0045, 0x030F, 0x0204},
+	{0x0045, 0x0311, 0x0206},
+	{0x0045, 0x0323, 0x1EB8},
+	{0x0045, 0x0327, 0x0228},
+	{0x0045, 0x0328, 0x0118},
+	{0x0045, 0x032D, 0x1E18},
+	{0x0045, 0x0330, 0x1E1A},
+	{0x0046, 0x0307, 0x1E1E},
+	{0x0047, 0x0301, 0x01F4},
+	{0x0047, 0x0302, 0x011C},
+	{0x0047, 0x0304, 0x1E20},
+	{0x0047, 0x0306, 0x011E},
+	{0x0047, 0x0307, 0x0120},
+	{0x0047, 0x030C, 0x01E6},
+	{0x0047, 0x0327, 0x0122},
+	{0x0048, 0x0302, 0x0124},
+	{0x0048, 0x0307, 0x1E22},
+	{0x0048, 0x0308, 0x1E26},
+	{0x0048, 0x030C, 0x021E},
+	{0x0048, 0x0323, 0x1E24},
+	{0x0048, 0x0327, 0x1E28},
+	{0x0048, 0x032E, 0x1E2A},
+	{0x0049, 0x0300, 0x00CC},
+	{0x0049, 0x0301, 0x00CD},
+	{0x0049, 0x0302, 0x00CE},
+	{0x0049, 0x0303, 0x0128},
+	{0x0049, 0x0304, 0x012A},
+	{0x0049, 0x0306, 0x012C},
+	{0x0049, 0x0307, 0x0130},
+	{0x0049, 0x0308, 0x00CF},
+	{0x0049, 0x0309, 0x1EC8},
+	{0x0049, 0x030C, 0x01CF},
+	{0x0049, 0x030F, 0x0208},
+	{0x0049, 0x0311, 0x020A},
+	{0x0049, 0x0323, 0x1ECA},
+	{0x0049, 0x0328, 0x012E},
+	{0x0049, 0x0330, 0x1E2C},
+	{0x004A, 0x0302, 0x0134},
+	{0x004B, 0x0301, 0x1E30},
+	{0x004B, 0x030C, 0x01E8},
+	{0x004B, 0x0323, 0x1E32},
+	{0x004B, 0x0327, 0x0136},
+	{0x004B, 0x0331, 0x1E34},
+	{0x004C, 0x0301, 0x0139},
+	{0x004C, 0x030C, 0x013D},
+	{0x004C, 0x0323, 0x1E36},
+	{0x004C, 0x0327, 0x013B},
+	{0x004C, 0x032D, 0x1E3C},
+	{0x004C, 0x0331, 0x1E3A},
+	{0x004D, 0x0301, 0x1E3E},
+	{0x004D, 0x0307, 0x1E40},
+	{0x004D, 0x0323, 0x1E42},
+	{0x004E, 0x0300, 0x01F8},
+	{0x004E, 0x0301, 0x0143},
+	{0x004E, 0x0303, 0x00D1},
+	{0x004E, 0x0307, 0x1E44},
+	{0x004E, 0x030C, 0x0147},
+	{0x004E, 0x0323, 0x1E46},
+	{0x004E, 0x0327, 0x0145},
+	{0x004E, 0x032D, 0x1E4A},
+	{0x004E, 0x0331, 0x1E48},
+	{0x004F, 0x0300, 0x00D2},
+	{0x004F, 0x0301, 0x00D3},
+	{0x004F, 0x0302, 0x00D4},
+	{0x004F, 0x0303, 0x00D5},
+	{0x004F, 0x0304, 0x014C},
+	{0x004F, 0x0306, 0x014E},
+	{0x004F, 0x0307, 0x022E},
+	{0x004F, 0x0308, 0x00D6},
+	{0x004F, 0x0309, 0x1ECE},
+	{0x004F, 0x030B, 0x0150},
+	{0x004F, 0x030C, 0x01D1},
+	{0x004F, 0x030F, 0x020C},
+	{0x004F, 0x0311, 0x020E},
+	{0x004F, 0x031B, 0x01A0},
+	{0x004F, 0x0323, 0x1ECC},
+	{0x004F, 0x0328, 0x01EA},
+	{0x0050, 0x0301, 0x1E54},
+	{0x0050, 0x0307, 0x1E56},
+	{0x0052, 0x0301, 0x0154},
+	{0x0052, 0x0307, 0x1E58},
+	{0x0052, 0x030C, 0x0158},
+	{0x0052, 0x030F, 0x0210},
+	{0x0052, 0x0311, 0x0212},
+	{0x0052, 0x0323, 0x1E5A},
+	{0x0052, 0x0327, 0x0156},
+	{0x0052, 0x0331, 0x1E5E},
+	{0x0053, 0x0301, 0x015A},
+	{0x0053, 0x0302, 0x015C},
+	{0x0053, 0x0307, 0x1E60},
+	{0x0053, 0x030C, 0x0160},
+	{0x0053, 0x0323, 0x1E62},
+	{0x0053, 0x0326, 0x0218},
+	{0x0053, 0x0327, 0x015E},
+	{0x0054, 0x0307, 0x1E6A},
+	{0x0054, 0x030C, 0x0164},
+	{0x0054, 0x0323, 0x1E6C},
+	{0x0054, 0x0326, 0x021A},
+	{0x0054, 0x0327, 0x0162},
+	{0x0054, 0x032D, 0x1E70},
+	{0x0054, 0x0331, 0x1E6E},
+	{0x0055, 0x0300, 0x00D9},
+	{0x0055, 0x0301, 0x00DA},
+	{0x0055, 0x0302, 0x00DB},
+	{0x0055, 0x0303, 0x0168},
+	{0x0055, 0x0304, 0x016A},
+	{0x0055, 0x0306, 0x016C},
+	{0x0055, 0x0308, 0x00DC},
+	{0x0055, 0x0309, 0x1EE6},
+	{0x0055, 0x030A, 0x016E},
+	{0x0055, 0x030B, 0x0170},
+	{0x0055, 0x030C, 0x01D3},
+	{0x0055, 0x030F, 0x0214},
+	{0x0055, 0x0311, 0x0216},
+	{0x0055, 0x031B, 0x01AF},
+	{0x0055, 0x0323, 0x1EE4},
+	{0x0055, 0x0324, 0x1E72},
+	{0x0055, 0x0328, 0x0172},
+	{0x0055, 0x032D, 0x1E76},
+	{0x0055, 0x0330, 0x1E74},
+	{0x0056, 0x0303, 0x1E7C},
+	{0x0056, 0x0323, 0x1E7E},
+	{0x0057, 0x0300, 0x1E80},
+	{0x0057, 0x0301, 0x1E82},
+	{0x0057, 0x0302, 0x0174},
+	{0x0057, 0x0307, 0x1E86},
+	{0x0057, 0x0308, 0x1E84},
+	{0x0057, 0x0323, 0x1E88},
+	{0x0058, 0x0307, 0x1E8A},
+	{0x0058, 0x0308, 0x1E8C},
+	{0x0059, 0x0300, 0x1EF2},
+	{0x0059, 0x0301, 0x00DD},
+	{0x0059, 0x0302, 0x0176},
+	{0x0059, 0x0303, 0x1EF8},
+	{0x0059, 0x0304, 0x0232},
+	{0x0059, 0x0307, 0x1E8E},
+	{0x0059, 0x0308, 0x0178},
+	{0x0059, 0x0309, 0x1EF6},
+	{0x0059, 0x0323, 0x1EF4},
+	{0x005A, 0x0301, 0x0179},
+	{0x005A, 0x0302, 0x1E90},
+	{0x005A, 0x0307, 0x017B},
+	{0x005A, 0x030C, 0x017D},
+	{0x005A, 0x0323, 0x1E92},
+	{0x005A, 0x0331, 0x1E94},
+	{0x0061, 0x0300, 0x00E0},
+	{0x0061, 0x0301, 0x00E1},
+	{0x0061, 0x0302, 0x00E2},
+	{0x0061, 0x0303, 0x00E3},
+	{0x0061, 0x0304, 0x0101},
+	{0x0061, 0x0306, 0x0103},
+	{0x0061, 0x0307, 0x0227},
+	{0x0061, 0x0308, 0x00E4},
+	{0x0061, 0x0309, 0x1EA3},
+	{0x0061, 0x030A, 0x00E5},
+	{0x0061, 0x030C, 0x01CE},
+	{0x0061, 0x030F, 0x0201},
+	{0x0061, 0x0311, 0x0203},
+	{0x0061, 0x0323, 0x1EA1},
+	{0x0061, 0x0325, 0x1E01},
+	{0x0061, 0x0328, 0x0105},
+	{0x0062, 0x0307, 0x1E03},
+	{0x0062, 0x0323, 0x1E05},
+	{0x0062, 0x0331, 0x1E07},
+	{0x0063, 0x0301, 0x0107},
+	{0x0063, 0x0302, 0x0109},
+	{0x0063, 0x0307, 0x010B},
+	{0x0063, 0x030C, 0x010D},
+	{0x0063, 0x0327, 0x00E7},
+	{0x0064, 0x0307, 0x1E0B},
+	{0x0064, 0x030C, 0x010F},
+	{0x0064, 0x0323, 0x1E0D},
+	{0x0064, 0x0327, 0x1E11},
+	{0x0064, 0x032D, 0x1E13},
+	{0x0064, 0x0331, 0x1E0F},
+	{0x0065, 0x0300, 0x00E8},
+	{0x0065, 0x0301, 0x00E9},
+	{0x0065, 0x0302, 0x00EA},
+	{0x0065, 0x0303, 0x1EBD},
+	{0x0065, 0x0304, 0x0113},
+	{0x0065, 0x0306, 0x0115},
+	{0x0065, 0x0307, 0x0117},
+	{0x0065, 0x0308, 0x00EB},
+	{0x0065, 0x0309, 0x1EBB},
+	{0x0065, 0x030C, 0x011B},
+	{0x0065, 0x030F, 0x0205},
+	{0x0065, 0x0311, 0x0207},
+	{0x0065, 0x0323, 0x1EB9},
+	{0x0065, 0x0327, 0x0229},
+	{0x0065, 0x0328, 0x0119},
+	{0x0065, 0x032D, 0x1E19},
+	{0x0065, 0x0330, 0x1E1B},
+	{0x0066, 0x0307, 0x1E1F},
+	{0x0067, 0x0301, 0x01F5},
+	{0x0067, 0x0302, 0x011D},
+	{0x0067, 0x0304, 0x1E21},
+	{0x0067, 0x0306, 0x011F},
+	{0x0067, 0x0307, 0x0121},
+	{0x0067, 0x030C, 0x01E7},
+	{0x0067, 0x0327, 0x0123},
+	{0x0068, 0x0302, 0x0125},
+	{0x0068, 0x0307, 0x1E23},
+	{0x0068, 0x0308, 0x1E27},
+	{0x0068, 0x030C, 0x021F},
+	{0x0068, 0x0323, 0x1E25},
+	{0x0068, 0x0327, 0x1E29},
+	{0x0068, 0x032E, 0x1E2B},
+	{0x0068, 0x0331, 0x1E96},
+	{0x0069, 0x0300, 0x00EC},
+	{0x0069, 0x0301, 0x00ED},
+	{0x0069, 0x0302, 0x00EE},
+	{0x0069, 0x0303, 0x0129},
+	{0x0069, 0x0304, 0x012B},
+	{0x0069, 0x0306, 0x012D},
+	{0x0069, 0x0308, 0x00EF},
+	{0x0069, 0x0309, 0x1EC9},
+	{0x0069, 0x030C, 0x01D0},
+	{0x0069, 0x030F, 0x0209},
+	{0x0069, 0x0311, 0x020B},
+	{0x0069, 0x0323, 0x1ECB},
+	{0x0069, 0x0328, 0x012F},
+	{0x0069, 0x0330, 0x1E2D},
+	{0x006A, 0x0302, 0x0135},
+	{0x006A, 0x030C, 0x01F0},
+	{0x006B, 0x0301, 0x1E31},
+	{0x006B, 0x030C, 0x01E9},
+	{0x006B, 0x0323, 0x1E33},
+	{0x006B, 0x0327, 0x0137},
+	{0x006B, 0x0331, 0x1E35},
+	{0x006C, 0x0301, 0x013A},
+	{0x006C, 0x030C, 0x013E},
+	{0x006C, 0x0323, 0x1E37},
+	{0x006C, 0x0327, 0x013C},
+	{0x006C, 0x032D, 0x1E3D},
+	{0x006C, 0x0331, 0x1E3B},
+	{0x006D, 0x0301, 0x1E3F},
+	{0x006D, 0x0307, 0x1E41},
+	{0x006D, 0x0323, 0x1E43},
+	{0x006E, 0x0300, 0x01F9},
+	{0x006E, 0x0301, 0x0144},
+	{0x006E, 0x0303, 0x00F1},
+	{0x006E, 0x0307, 0x1E45},
+	{0x006E, 0x030C, 0x0148},
+	{0x006E, 0x0323, 0x1E47},
+	{0x006E, 0x0327, 0x0146},
+	{0x006E, 0x032D, 0x1E4B},
+	{0x006E, 0x0331, 0x1E49},
+	{0x006F, 0x0300, 0x00F2},
+	{0x006F, 0x0301, 0x00F3},
+	{0x006F, 0x0302, 0x00F4},
+	{0x006F, 0x0303, 0x00F5},
+	{0x006F, 0x0304, 0x014D},
+	{0x006F, 0x0306, 0x014F},
+	{0x006F, 0x0307, 0x022F},
+	{0x006F, 0x0308, 0x00F6},
+	{0x006F, 0x0309, 0x1ECF},
+	{0x006F, 0x030B, 0x0151},
+	{0x006F, 0x030C, 0x01D2},
+	{0x006F, 0x030F, 0x020D},
+	{0x006F, 0x0311, 0x020F},
+	{0x006F, 0x031B, 0x01A1},
+	{0x006F, 0x0323, 0x1ECD},
+	{0x006F, 0x0328, 0x01EB},
+	{0x0070, 0x0301, 0x1E55},
+	{0x0070, 0x0307, 0x1E57},
+	{0x0072, 0x0301, 0x0155},
+	{0x0072, 0x0307, 0x1E59},
+	{0x0072, 0x030C, 0x0159},
+	{0x0072, 0x030F, 0x0211},
+	{0x0072, 0x0311, 0x0213},
+	{0x0072, 0x0323, 0x1E5B},
+	{0x0072, 0x0327, 0x0157},
+	{0x0072, 0x0331, 0x1E5F},
+	{0x0073, 0x0301, 0x015B},
+	{0x0073, 0x0302, 0x015D},
+	{0x0073, 0x0307, 0x1E61},
+	{0x0073, 0x030C, 0x0161},
+	{0x0073, 0x0323, 0x1E63},
+	{0x0073, 0x0326, 0x0219},
+	{0x0073, 0x0327, 0x015F},
+	{0x0074, 0x0307, 0x1E6B},
+	{0x0074, 0x0308, 0x1E97},
+	{0x0074, 0x030C, 0x0165},
+	{0x0074, 0x0323, 0x1E6D},
+	{0x0074, 0x0326, 0x021B},
+	{0x0074, 0x0327, 0x0163},
+	{0x0074, 0x032D, 0x1E71},
+	{0x0074, 0x0331, 0x1E6F},
+	{0x0075, 0x0300, 0x00F9},
+	{0x0075, 0x0301, 0x00FA},
+	{0x0075, 0x0302, 0x00FB},
+	{0x0075, 0x0303, 0x0169},
+	{0x0075, 0x0304, 0x016B},
+	{0x0075, 0x0306, 0x016D},
+	{0x0075, 0x0308, 0x00FC},
+	{0x0075, 0x0309, 0x1EE7},
+	{0x0075, 0x030A, 0x016F},
+	{0x0075, 0x030B, 0x0171},
+	{0x0075, 0x030C, 0x01D4},
+	{0x0075, 0x030F, 0x0215},
+	{0x0075, 0x0311, 0x0217},
+	{0x0075, 0x031B, 0x01B0},
+	{0x0075, 0x0323, 0x1EE5},
+	{0x0075, 0x0324, 0x1E73},
+	{0x0075, 0x0328, 0x0173},
+	{0x0075, 0x032D, 0x1E77},
+	{0x0075, 0x0330, 0x1E75},
+	{0x0076, 0x0303, 0x1E7D},
+	{0x0076, 0x0323, 0x1E7F},
+	{0x0077, 0x0300, 0x1E81},
+	{0x0077, 0x0301, 0x1E83},
+	{0x0077, 0x0302, 0x0175},
+	{0x0077, 0x0307, 0x1E87},
+	{0x0077, 0x0308, 0x1E85},
+	{0x0077, 0x030A, 0x1E98},
+	{0x0077, 0x0323, 0x1E89},
+	{0x0078, 0x0307, 0x1E8B},
+	{0x0078, 0x0308, 0x1E8D},
+	{0x0079, 0x0300, 0x1EF3},
+	{0x0079, 0x0301, 0x00FD},
+	{0x0079, 0x0302, 0x0177},
+	{0x0079, 0x0303, 0x1EF9},
+	{0x0079, 0x0304, 0x0233},
+	{0x0079, 0x0307, 0x1E8F},
+	{0x0079, 0x0308, 0x00FF},
+	{0x0079, 0x0309, 0x1EF7},
+	{0x0079, 0x030A, 0x1E99},
+	{0x0079, 0x0323, 0x1EF5},
+	{0x007A, 0x0301, 0x017A},
+	{0x007A, 0x0302, 0x1E91},
+	{0x007A, 0x0307, 0x017C},
+	{0x007A, 0x030C, 0x017E},
+	{0x007A, 0x0323, 0x1E93},
+	{0x007A, 0x0331, 0x1E95},
+	{0x00A8, 0x0300, 0x1FED},
+	{0x00A8, 0x0301, 0x0385},
+	{0x00A8, 0x0342, 0x1FC1},
+	{0x00C2, 0x0300, 0x1EA6},
+	{0x00C2, 0x0301, 0x1EA4},
+	{0x00C2, 0x0303, 0x1EAA},
+	{0x00C2, 0x0309, 0x1EA8},
+	{0x00C4, 0x0304, 0x01DE},
+	{0x00C5, 0x0301, 0x01FA},
+	{0x00C6, 0x0301, 0x01FC},
+	{0x00C6, 0x0304, 0x01E2},
+	{0x00C7, 0x0301, 0x1E08},
+	{0x00CA, 0x0300, 0x1EC0},
+	{0x00CA, 0x0301, 0x1EBE},
+	{0x00CA, 0x0303, 0x1EC4},
+	{0x00CA, 0x0309, 0x1EC2},
+	{0x00CF, 0x0301, 0x1E2E},
+	{0x00D4, 0x0300, 0x1ED2},
+	{0x00D4, 0x0301, 0x1ED0},
+	{0x00D4, 0x0303, 0x1ED6},
+	{0x00D4, 0x0309, 0x1ED4},
+	{0x00D5, 0x0301, 0x1E4C},
+	{0x00D5, 0x0304, 0x022C},
+	{0x00D5, 0x0308, 0x1E4E},
+	{0x00D6, 0x0304, 0x022A},
+	{0x00D8, 0x0301, 0x01FE},
+	{0x00DC, 0x0300, 0x01DB},
+	{0x00DC, 0x0301, 0x01D7},
+	{0x00DC, 0x0304, 0x01D5},
+	{0x00DC, 0x030C, 0x01D9},
+	{0x00E2, 0x0300, 0x1EA7},
+	{0x00E2, 0x0301, 0x1EA5},
+	{0x00E2, 0x0303, 0x1EAB},
+	{0x00E2, 0x0309, 0x1EA9},
+	{0x00E4, 0x0304, 0x01DF},
+	{0x00E5, 0x0301, 0x01FB},
+	{0x00E6, 0x0301, 0x01FD},
+	{0x00E6, 0x0304, 0x01E3},
+	{0x00E7, 0x0301, 0x1E09},
+	{0x00EA, 0x0300, 0x1EC1},
+	{0x00EA, 0x0301, 0x1EBF},
+	{0x00EA, 0x0303, 0x1EC5},
+	{0x00EA, 0x0309, 0x1EC3},
+	{0x00EF, 0x0301, 0x1E2F},
+	{0x00F4, 0x0300, 0x1ED3},
+	{0x00F4, 0x0301, 0x1ED1},
+	{0x00F4, 0x0303, 0x1ED7},
+	{0x00F4, 0x0309, 0x1ED5},
+	{0x00F5, 0x0301, 0x1E4D},
+	{0x00F5, 0x0304, 0x022D},
+	{0x00F5, 0x0308, 0x1E4F},
+	{0x00F6, 0x0304, 0x022B},
+	{0x00F8, 0x0301, 0x01FF},
+	{0x00FC, 0x0300, 0x01DC},
+	{0x00FC, 0x0301, 0x01D8},
+	{0x00FC, 0x0304, 0x01D6},
+	{0x00FC, 0x030C, 0x01DA},
+	{0x0102, 0x0300, 0x1EB0},
+	{0x0102, 0x0301, 0x1EAE},
+	{0x0102, 0x0303, 0x1EB4},
+	{0x0102, 0x0309, 0x1EB2},
+	{0x0103, 0x0300, 0x1EB1},
+	{0x0103, 0x0301, 0x1EAF},
+	{0x0103, 0x0303, 0x1EB5},
+	{0x0103, 0x0309, 0x1EB3},
+	{0x0112, 0x0300, 0x1E14},
+	{0x0112, 0x0301, 0x1E16},
+	{0x0113, 0x0300, 0x1E15},
+	{0x0113, 0x0301, 0x1E17},
+	{0x014C, 0x0300, 0x1E50},
+	{0x014C, 0x0301, 0x1E52},
+	{0x014D, 0x0300, 0x1E51},
+	{0x014D, 0x0301, 0x1E53},
+	{0x015A, 0x0307, 0x1E64},
+	{0x015B, 0x0307, 0x1E65},
+	{0x0160, 0x0307, 0x1E66},
+	{0x0161, 0x0307, 0x1E67},
+	{0x0168, 0x0301, 0x1E78},
+	{0x0169, 0x0301, 0x1E79},
+	{0x016A, 0x0308, 0x1E7A},
+	{0x016B, 0x0308, 0x1E7B},
+	{0x017F, 0x0307, 0x1E9B},
+	{0x01A0, 0x0300, 0x1EDC},
+	{0x01A0, 0x0301, 0x1EDA},
+	{0x01A0, 0x0303, 0x1EE0},
+	{0x01A0, 0x0309, 0x1EDE},
+	{0x01A0, 0x0323, 0x1EE2},
+	{0x01A1, 0x0300, 0x1EDD},
+	{0x01A1, 0x0301, 0x1EDB},
+	{0x01A1, 0x0303, 0x1EE1},
+	{0x01A1, 0x0309, 0x1EDF},
+	{0x01A1, 0x0323, 0x1EE3},
+	{0x01AF, 0x0300, 0x1EEA},
+	{0x01AF, 0x0301, 0x1EE8},
+	{0x01AF, 0x0303, 0x1EEE},
+	{0x01AF, 0x0309, 0x1EEC},
+	{0x01AF, 0x0323, 0x1EF0},
+	{0x01B0, 0x0300, 0x1EEB},
+	{0x01B0, 0x0301, 0x1EE9},
+	{0x01B0, 0x0303, 0x1EEF},
+	{0x01B0, 0x0309, 0x1EED},
+	{0x01B0, 0x0323, 0x1EF1},
+	{0x01B7, 0x030C, 0x01EE},
+	{0x01EA, 0x0304, 0x01EC},
+	{0x01EB, 0x0304, 0x01ED},
+	{0x0226, 0x0304, 0x01E0},
+	{0x0227, 0x0304, 0x01E1},
+	{0x0228, 0x0306, 0x1E1C},
+	{0x0229, 0x0306, 0x1E1D},
+	{0x022E, 0x0304, 0x0230},
+	{0x022F, 0x0304, 0x0231},
+	{0x0292, 0x030C, 0x01EF},
+	{0x0391, 0x0300, 0x1FBA},
+	{0x0391, 0x0301, 0x0386},
+	{0x0391, 0x0304, 0x1FB9},
+	{0x0391, 0x0306, 0x1FB8},
+	{0x0391, 0x0313, 0x1F08},
+	{0x0391, 0x0314, 0x1F09},
+	{0x0391, 0x0345, 0x1FBC},
+	{0x0395, 0x0300, 0x1FC8},
+	{0x0395, 0x0301, 0x0388},
+	{0x0395, 0x0313, 0x1F18},
+	{0x0395, 0x0314, 0x1F19},
+	{0x0397, 0x0300, 0x1FCA},
+	{0x0397, 0x0301, 0x0389},
+	{0x0397, 0x0313, 0x1F28},
+	{0x0397, 0x0314, 0x1F29},
+	{0x0397, 0x0345, 0x1FCC},
+	{0x0399, 0x0300, 0x1FDA},
+	{0x0399, 0x0301, 0x038A},
+	{0x0399, 0x0304, 0x1FD9},
+	{0x0399, 0x0306, 0x1FD8},
+	{0x0399, 0x0308, 0x03AA},
+	{0x0399, 0x0313, 0x1F38},
+	{0x0399, 0x0314, 0x1F39},
+	{0x039F, 0x0300, 0x1FF8},
+	{0x039F, 0x0301, 0x038C},
+	{0x039F, 0x0313, 0x1F48},
+	{0x039F, 0x0314, 0x1F49},
+	{0x03A1, 0x0314, 0x1FEC},
+	{0x03A5, 0x0300, 0x1FEA},
+	{0x03A5, 0x0301, 0x038E},
+	{0x03A5, 0x0304, 0x1FE9},
+	{0x03A5, 0x0306, 0x1FE8},
+	{0x03A5, 0x0308, 0x03AB},
+	{0x03A5, 0x0314, 0x1F59},
+	{0x03A9, 0x0300, 0x1FFA},
+	{0x03A9, 0x0301, 0x038F},
+	{0x03A9, 0x0313, 0x1F68},
+	{0x03A9, 0x0314, 0x1F69},
+	{0x03A9, 0x0345, 0x1FFC},
+	{0x03AC, 0x0345, 0x1FB4},
+	{0x03AE, 0x0345, 0x1FC4},
+	{0x03B1, 0x0300, 0x1F70},
+	{0x03B1, 0x0301, 0x03AC},
+	{0x03B1, 0x0304, 0x1FB1},
+	{0x03B1, 0x0306, 0x1FB0},
+	{0x03B1, 0x0313, 0x1F00},
+	{0x03B1, 0x0314, 0x1F01},
+	{0x03B1, 0x0342, 0x1FB6},
+	{0x03B1, 0x0345, 0x1FB3},
+	{0x03B5, 0x0300, 0x1F72},
+	{0x03B5, 0x0301, 0x03AD},
+	{0x03B5, 0x0313, 0x1F10},
+	{0x03B5, 0x0314, 0x1F11},
+	{0x03B7, 0x0300, 0x1F74},
+	{0x03B7, 0x0301, 0x03AE},
+	{0x03B7, 0x0313, 0x1F20},
+	{0x03B7, 0x0314, 0x1F21},
+	{0x03B7, 0x0342, 0x1FC6},
+	{0x03B7, 0x0345, 0x1FC3},
+	{0x03B9, 0x0300, 0x1F76},
+	{0x03B9, 0x0301, 0x03AF},
+	{0x03B9, 0x0304, 0x1FD1},
+	{0x03B9, 0x0306, 0x1FD0},
+	{0x03B9, 0x0308, 0x03CA},
+	{0x03B9, 0x0313, 0x1F30},
+	{0x03B9, 0x0314, 0x1F31},
+	{0x03B9, 0x0342, 0x1FD6},
+	{0x03BF, 0x0300, 0x1F78},
+	{0x03BF, 0x0301, 0x03CC},
+	{0x03BF, 0x0313, 0x1F40},
+	{0x03BF, 0x0314, 0x1F41},
+	{0x03C1, 0x0313, 0x1FE4},
+	{0x03C1, 0x0314, 0x1FE5},
+	{0x03C5, 0x0300, 0x1F7A},
+	{0x03C5, 0x0301, 0x03CD},
+	{0x03C5, 0x0304, 0x1FE1},
+	{0x03C5, 0x0306, 0x1FE0},
+	{0x03C5, 0x0308, 0x03CB},
+	{0x03C5, 0x0313, 0x1F50},
+	{0x03C5, 0x0314, 0x1F51},
+	{0x03C5, 0x0342, 0x1FE6},
+	{0x03C9, 0x0300, 0x1F7C},
+	{0x03C9, 0x0301, 0x03CE},
+	{0x03C9, 0x0313, 0x1F60},
+	{0x03C9, 0x0314, 0x1F61},
+	{0x03C9, 0x0342, 0x1FF6},
+	{0x03C9, 0x0345, 0x1FF3},
+	{0x03CA, 0x0300, 0x1FD2},
+	{0x03CA, 0x0301, 0x0390},
+	{0x03CA, 0x0342, 0x1FD7},
+	{0x03CB, 0x0300, 0x1FE2},
+	{0x03CB, 0x0301, 0x03B0},
+	{0x03CB, 0x0342, 0x1FE7},
+	{0x03CE, 0x0345, 0x1FF4},
+	{0x03D2, 0x0301, 0x03D3},
+	{0x03D2, 0x0308, 0x03D4},
+	{0x0406, 0x0308, 0x0407},
+	{0x0410, 0x0306, 0x04D0},
+	{0x0410, 0x0308, 0x04D2},
+	{0x0413, 0x0301, 0x0403},
+	{0x0415, 0x0300, 0x0400},
+	{0x0415, 0x0306, 0x04D6},
+	{0x0415, 0x0308, 0x0401},
+	{0x0416, 0x0306, 0x04C1},
+	{0x0416, 0x0308, 0x04DC},
+	{0x0417, 0x0308, 0x04DE},
+	{0x0418, 0x0300, 0x040D},
+	{0x0418, 0x0304, 0x04E2},
+	{0x0418, 0x0306, 0x0419},
+	{0x0418, 0x0308, 0x04E4},
+	{0x041A, 0x0301, 0x040C},
+	{0x041E, 0x0308, 0x04E6},
+	{0x0423, 0x0304, 0x04EE},
+	{0x0423, 0x0306, 0x040E},
+	{0x0423, 0x0308, 0x04F0},
+	{0x0423, 0x030B, 0x04F2},
+	{0x0427, 0x0308, 0x04F4},
+	{0x042B, 0x0308, 0x04F8},
+	{0x042D, 0x0308, 0x04EC},
+	{0x0430, 0x0306, 0x04D1},
+	{0x0430, 0x0308, 0x04D3},
+	{0x0433, 0x0301, 0x0453},
+	{0x0435, 0x0300, 0x0450},
+	{0x0435, 0x0306, 0x04D7},
+	{0x0435, 0x0308, 0x0451},
+	{0x0436, 0x0306, 0x04C2},
+	{0x0436, 0x0308, 0x04DD},
+	{0x0437, 0x0308, 0x04DF},
+	{0x0438, 0x0300, 0x045D},
+	{0x0438, 0x0304, 0x04E3},
+	{0x0438, 0x0306, 0x0439},
+	{0x0438, 0x0308, 0x04E5},
+	{0x043A, 0x0301, 0x045C},
+	{0x043E, 0x0308, 0x04E7},
+	{0x0443, 0x0304, 0x04EF},
+	{0x0443, 0x0306, 0x045E},
+	{0x0443, 0x0308, 0x04F1},
+	{0x0443, 0x030B, 0x04F3},
+	{0x0447, 0x0308, 0x04F5},
+	{0x044B, 0x0308, 0x04F9},
+	{0x044D, 0x0308, 0x04ED},
+	{0x0456, 0x0308, 0x0457},
+	{0x0474, 0x030F, 0x0476},
+	{0x0475, 0x030F, 0x0477},
+	{0x04D8, 0x0308, 0x04DA},
+	{0x04D9, 0x0308, 0x04DB},
+	{0x04E8, 0x0308, 0x04EA},
+	{0x04E9, 0x0308, 0x04EB},
+	{0x0627, 0x0653, 0x0622},
+	{0x0627, 0x0654, 0x0623},
+	{0x0627, 0x0655, 0x0625},
+	{0x0648, 0x0654, 0x0624},
+	{0x064A, 0x0654, 0x0626},
+	{0x06C1, 0x0654, 0x06C2},
+	{0x06D2, 0x0654, 0x06D3},
+	{0x06D5, 0x0654, 0x06C0},
+	{0x0928, 0x093C, 0x0929},
+	{0x0930, 0x093C, 0x0931},
+	{0x0933, 0x093C, 0x0934},
+	{0x09C7, 0x09BE, 0x09CB},
+	{0x09C7, 0x09D7, 0x09CC},
+	{0x0B47, 0x0B3E, 0x0B4B},
+	{0x0B47, 0x0B56, 0x0B48},
+	{0x0B47, 0x0B57, 0x0B4C},
+	{0x0B92, 0x0BD7, 0x0B94},
+	{0x0BC6, 0x0BBE, 0x0BCA},
+	{0x0BC6, 0x0BD7, 0x0BCC},
+	{0x0BC7, 0x0BBE, 0x0BCB},
+	{0x0C46, 0x0C56, 0x0C48},
+	{0x0CBF, 0x0CD5, 0x0CC0},
+	{0x0CC6, 0x0CC2, 0x0CCA},
+	{0x0CC6, 0x0CD5, 0x0CC7},
+	{0x0CC6, 0x0CD6, 0x0CC8},
+	{0x0CCA, 0x0CD5, 0x0CCB},
+	{0x0D46, 0x0D3E, 0x0D4A},
+	{0x0D46, 0x0D57, 0x0D4C},
+	{0x0D47, 0x0D3E, 0x0D4B},
+	{0x0DD9, 0x0DCA, 0x0DDA},
+	{0x0DD9, 0x0DCF, 0x0DDC},
+	{0x0DD9, 0x0DDF, 0x0DDE},
+	{0x0DDC, 0x0DCA, 0x0DDD},
+	{0x1025, 0x102E, 0x1026},
+	{0x1B05, 0x1B35, 0x1B06},
+	{0x1B07, 0x1B35, 0x1B08},
+	{0x1B09, 0x1B35, 0x1B0A},
+	{0x1B0B, 0x1B35, 0x1B0C},
+	{0x1B0D, 0x1B35, 0x1B0E},
+	{0x1B11, 0x1B35, 0x1B12},
+	{0x1B3A, 0x1B35, 0x1B3B},
+	{0x1B3C, 0x1B35, 0x1B3D},
+	{0x1B3E, 0x1B35, 0x1B40},
+	{0x1B3F, 0x1B35, 0x1B41},
+	{0x1B42, 0x1B35, 0x1B43},
+	{0x1E36, 0x0304, 0x1E38},
+	{0x1E37, 0x0304, 0x1E39},
+	{0x1E5A, 0x0304, 0x1E5C},
+	{0x1E5B, 0x0304, 0x1E5D},
+	{0x1E62, 0x0307, 0x1E68},
+	{0x1E63, 0x0307, 0x1E69},
+	{0x1EA0, 0x0302, 0x1EAC},
+	{0x1EA0, 0x0306, 0x1EB6},
+	{0x1EA1, 0x0302, 0x1EAD},
+	{0x1EA1, 0x0306, 0x1EB7},
+	{0x1EB8, 0x0302, 0x1EC6},
+	{0x1EB9, 0x0302, 0x1EC7},
+	{0x1ECC, 0x0302, 0x1ED8},
+	{0x1ECD, 0x0302, 0x1ED9},
+	{0x1F00, 0x0300, 0x1F02},
+	{0x1F00, 0x0301, 0x1F04},
+	{0x1F00, 0x0342, 0x1F06},
+	{0x1F00, 0x0345, 0x1F80},
+	{0x1F01, 0x0300, 0x1F03},
+	{0x1F01, 0x0301, 0x1F05},
+	{0x1F01, 0x0342, 0x1F07},
+	{0x1F01, 0x0345, 0x1F81},
+	{0x1F02, 0x0345, 0x1F82},
+	{0x1F03, 0x0345, 0x1F83},
+	{0x1F04, 0x0345, 0x1F84},
+	{0x1F05, 0x0345, 0x1F85},
+	{0x1F06, 0x0345, 0x1F86},
+	{0x1F07, 0x0345, 0x1F87},
+	{0x1F08, 0x0300, 0x1F0A},
+	{0x1F08, 0x0301, 0x1F0C},
+	{0x1F08, 0x0342, 0x1F0E},
+	{0x1F08, 0x0345, 0x1F88},
+	{0x1F09, 0x0300, 0x1F0B},
+	{0x1F09, 0x0301, 0x1F0D},
+	{0x1F09, 0x0342, 0x1F0F},
+	{0x1F09, 0x0345, 0x1F89},
+	{0x1F0A, 0x0345, 0x1F8A},
+	{0x1F0B, 0x0345, 0x1F8B},
+	{0x1F0C, 0x0345, 0x1F8C},
+	{0x1F0D, 0x0345, 0x1F8D},
+	{0x1F0E, 0x0345, 0x1F8E},
+	{0x1F0F, 0x0345, 0x1F8F},
+	{0x1F10, 0x0300, 0x1F12},
+	{0x1F10, 0x0301, 0x1F14},
+	{0x1F11, 0x0300, 0x1F13},
+	{0x1F11, 0x0301, 0x1F15},
+	{0x1F18, 0x0300, 0x1F1A},
+	{0x1F18, 0x0301, 0x1F1C},
+	{0x1F19, 0x0300, 0x1F1B},
+	{0x1F19, 0x0301, 0x1F1D},
+	{0x1F20, 0x0300, 0x1F22},
+	{0x1F20, 0x0301, 0x1F24},
+	{0x1F20, 0x0342, 0x1F26},
+	{0x1F20, 0x0345, 0x1F90},
+	{0x1F21, 0x0300, 0x1F23},
+	{0x1F21, 0x0301, 0x1F25},
+	{0x1F21, 0x0342, 0x1F27},
+	{0x1F21, 0x0345, 0x1F91},
+	{0x1F22, 0x0345, 0x1F92},
+	{0x1F23, 0x0345, 0x1F93},
+	{0x1F24, 0x0345, 0x1F94},
+	{0x1F25, 0x0345, 0x1F95},
+	{0x1F26, 0x0345, 0x1F96},
+	{0x1F27, 0x0345, 0x1F97},
+	{0x1F28, 0x0300, 0x1F2A},
+	{0x1F28, 0x0301, 0x1F2C},
+	{0x1F28, 0x0342, 0x1F2E},
+	{0x1F28, 0x0345, 0x1F98},
+	{0x1F29, 0x0300, 0x1F2B},
+	{0x1F29, 0x0301, 0x1F2D},
+	{0x1F29, 0x0342, 0x1F2F},
+	{0x1F29, 0x0345, 0x1F99},
+	{0x1F2A, 0x0345, 0x1F9A},
+	{0x1F2B, 0x0345, 0x1F9B},
+	{0x1F2C, 0x0345, 0x1F9C},
+	{0x1F2D, 0x0345, 0x1F9D},
+	{0x1F2E, 0x0345, 0x1F9E},
+	{0x1F2F, 0x0345, 0x1F9F},
+	{0x1F30, 0x0300, 0x1F32},
+	{0x1F30, 0x0301, 0x1F34},
+	{0x1F30, 0x0342, 0x1F36},
+	{0x1F31, 0x0300, 0x1F33},
+	{0x1F31, 0x0301, 0x1F35},
+	{0x1F31, 0x0342, 0x1F37},
+	{0x1F38, 0x0300, 0x1F3A},
+	{0x1F38, 0x0301, 0x1F3C},
+	{0x1F38, 0x0342, 0x1F3E},
+	{0x1F39, 0x0300, 0x1F3B},
+	{0x1F39, 0x0301, 0x1F3D},
+	{0x1F39, 0x0342, 0x1F3F},
+	{0x1F40, 0x0300, 0x1F42},
+	{0x1F40, 0x0301, 0x1F44},
+	{0x1F41, 0x0300, 0x1F43},
+	{0x1F41, 0x0301, 0x1F45},
+	{0x1F48, 0x0300, 0x1F4A},
+	{0x1F48, 0x0301, 0x1F4C},
+	{0x1F49, 0x0300, 0x1F4B},
+	{0x1F49, 0x0301, 0x1F4D},
+	{0x1F50, 0x0300, 0x1F52},
+	{0x1F50, 0x0301, 0x1F54},
+	{0x1F50, 0x0342, 0x1F56},
+	{0x1F51, 0x0300, 0x1F53},
+	{0x1F51, 0x0301, 0x1F55},
+	{0x1F51, 0x0342, 0x1F57},
+	{0x1F59, 0x0300, 0x1F5B},
+	{0x1F59, 0x0301, 0x1F5D},
+	{0x1F59, 0x0342, 0x1F5F},
+	{0x1F60, 0x0300, 0x1F62},
+	{0x1F60, 0x0301, 0x1F64},
+	{0x1F60, 0x0342, 0x1F66},
+	{0x1F60, 0x0345, 0x1FA0},
+	{0x1F61, 0x0300, 0x1F63},
+	{0x1F61, 0x0301, 0x1F65},
+	{0x1F61, 0x0342, 0x1F67},
+	{0x1F61, 0x0345, 0x1FA1},
+	{0x1F62, 0x0345, 0x1FA2},
+	{0x1F63, 0x0345, 0x1FA3},
+	{0x1F64, 0x0345, 0x1FA4},
+	{0x1F65, 0x0345, 0x1FA5},
+	{0x1F66, 0x0345, 0x1FA6},
+	{0x1F67, 0x0345, 0x1FA7},
+	{0x1F68, 0x0300, 0x1F6A},
+	{0x1F68, 0x0301, 0x1F6C},
+	{0x1F68, 0x0342, 0x1F6E},
+	{0x1F68, 0x0345, 0x1FA8},
+	{0x1F69, 0x0300, 0x1F6B},
+	{0x1F69, 0x0301, 0x1F6D},
+	{0x1F69, 0x0342, 0x1F6F},
+	{0x1F69, 0x0345, 0x1FA9},
+	{0x1F6A, 0x0345, 0x1FAA},
+	{0x1F6B, 0x0345, 0x1FAB},
+	{0x1F6C, 0x0345, 0x1FAC},
+	{0x1F6D, 0x0345, 0x1FAD},
+	{0x1F6E, 0x0345, 0x1FAE},
+	{0x1F6F, 0x0345, 0x1FAF},
+	{0x1F70, 0x0345, 0x1FB2},
+	{0x1F74, 0x0345, 0x1FC2},
+	{0x1F7C, 0x0345, 0x1FF2},
+	{0x1FB6, 0x0345, 0x1FB7},
+	{0x1FBF, 0x0300, 0x1FCD},
+	{0x1FBF, 0x0301, 0x1FCE},
+	{0x1FBF, 0x0342, 0x1FCF},
+	{0x1FC6, 0x0345, 0x1FC7},
+	{0x1FF6, 0x0345, 0x1FF7},
+	{0x1FFE, 0x0300, 0x1FDD},
+	{0x1FFE, 0x0301, 0x1FDE},
+	{0x1FFE, 0x0342, 0x1FDF},
+	{0x2190, 0x0338, 0x219A},
+	{0x2192, 0x0338, 0x219B},
+	{0x2194, 0x0338, 0x21AE},
+	{0x21D0, 0x0338, 0x21CD},
+	{0x21D2, 0x0338, 0x21CF},
+	{0x21D4, 0x0338, 0x21CE},
+	{0x2203, 0x0338, 0x2204},
+	{0x2208, 0x0338, 0x2209},
+	{0x220B, 0x0338, 0x220C},
+	{0x2223, 0x0338, 0x2224},
+	{0x2225, 0x0338, 0x2226},
+	{0x223C, 0x0338, 0x2241},
+	{0x2243, 0x0338, 0x2244},
+	{0x2245, 0x0338, 0x2247},
+	{0x2248, 0x0338, 0x2249},
+	{0x224D, 0x0338, 0x226D},
+	{0x2261, 0x0338, 0x2262},
+	{0x2264, 0x0338, 0x2270},
+	{0x2265, 0x0338, 0x2271},
+	{0x2272, 0x0338, 0x2274},
+	{0x2273, 0x0338, 0x2275},
+	{0x2276, 0x0338, 0x2278},
+	{0x2277, 0x0338, 0x2279},
+	{0x227A, 0x0338, 0x2280},
+	{0x227B, 0x0338, 0x2281},
+	{0x227C, 0x0338, 0x22E0},
+	{0x227D, 0x0338, 0x22E1},
+	{0x2282, 0x0338, 0x2284},
+	{0x2283, 0x0338, 0x2285},
+	{0x2286, 0x0338, 0x2288},
+	{0x2287, 0x0338, 0x2289},
+	{0x2291, 0x0338, 0x22E2},
+	{0x2292, 0x0338, 0x22E3},
+	{0x22A2, 0x0338, 0x22AC},
+	{0x22A8, 0x0338, 0x22AD},
+	{0x22A9, 0x0338, 0x22AE},
+	{0x22AB, 0x0338, 0x22AF},
+	{0x22B2, 0x0338, 0x22EA},
+	{0x22B3, 0x0338, 0x22EB},
+	{0x22B4, 0x0338, 0x22EC},
+	{0x22B5, 0x0338, 0x22ED},
+	{0x3046, 0x3099, 0x3094},
+	{0x304B, 0x3099, 0x304C},
+	{0x304D, 0x3099, 0x304E},
+	{0x304F, 0x3099, 0x3050},
+	{0x3051, 0x3099, 0x3052},
+	{0x3053, 0x3099, 0x3054},
+	{0x3055, 0x3099, 0x3056},
+	{0x3057, 0x3099, 0x3058},
+	{0x3059, 0x3099, 0x305A},
+	{0x305B, 0x3099, 0x305C},
+	{0x305D, 0x3099, 0x305E},
+	{0x305F, 0x3099, 0x3060},
+	{0x3061, 0x3099, 0x3062},
+	{0x3064, 0x3099, 0x3065},
+	{0x3066, 0x3099, 0x3067},
+	{0x3068, 0x3099, 0x3069},
+	{0x306F, 0x3099, 0x3070},
+	{0x306F, 0x309A, 0x3071},
+	{0x3072, 0x3099, 0x3073},
+	{0x3072, 0x309A, 0x3074},
+	{0x3075, 0x3099, 0x3076},
+	{0x3075, 0x309A, 0x3077},
+	{0x3078, 0x3099, 0x3079},
+	{0x3078, 0x309A, 0x307A},
+	{0x307B, 0x3099, 0x307C},
+	{0x307B, 0x309A, 0x307D},
+	{0x309D, 0x3099, 0x309E},
+	{0x30A6, 0x3099, 0x30F4},
+	{0x30AB, 0x3099, 0x30AC},
+	{0x30AD, 0x3099, 0x30AE},
+	{0x30AF, 0x3099, 0x30B0},
+	{0x30B1, 0x3099, 0x30B2},
+	{0x30B3, 0x3099, 0x30B4},
+	{0x30B5, 0x3099, 0x30B6},
+	{0x30B7, 0x3099, 0x30B8},
+	{0x30B9, 0x3099, 0x30BA},
+	{0x30BB, 0x3099, 0x30BC},
+	{0x30BD, 0x3099, 0x30BE},
+	{0x30BF, 0x3099, 0x30C0},
+	{0x30C1, 0x3099, 0x30C2},
+	{0x30C4, 0x3099, 0x30C5},
+	{0x30C6, 0x3099, 0x30C7},
+	{0x30C8, 0x3099, 0x30C9},
+	{0x30CF, 0x3099, 0x30D0},
+	{0x30CF, 0x309A, 0x30D1},
+	{0x30D2, 0x3099, 0x30D3},
+	{0x30D2, 0x309A, 0x30D4},
+	{0x30D5, 0x3099, 0x30D6},
+	{0x30D5, 0x309A, 0x30D7},
+	{0x30D8, 0x3099, 0x30D9},
+	{0x30D8, 0x309A, 0x30DA},
+	{0x30DB, 0x3099, 0x30DC},
+	{0x30DB, 0x309A, 0x30DD},
+	{0x30EF, 0x3099, 0x30F7},
+	{0x30F0, 0x3099, 0x30F8},
+	{0x30F1, 0x3099, 0x30F9},
+	{0x30F2, 0x3099, 0x30FA},
+	{0x30FD, 0x3099, 0x30FE},
+	{0x105D2, 0x0307, 0x105C9},
+	{0x105DA, 0x0307, 0x105E4},
+	{0x11099, 0x110BA, 0x1109A},
+	{0x1109B, 0x110BA, 0x1109C},
+	{0x110A5, 0x110BA, 0x110AB},
+	{0x11131, 0x11127, 0x1112E},
+	{0x11132, 0x11127, 0x1112F},
+	{0x11347, 0x1133E, 0x1134B},
+	{0x11347, 0x11357, 0x1134C},
+	{0x11382, 0x113C9, 0x11383},
+	{0x11384, 0x113BB, 0x11385},
+	{0x1138B, 0x113C2, 0x1138E},
+	{0x11390, 0x113C9, 0x11391},
+	{0x113C2, 0x113B8, 0x113C7},
+	{0x113C2, 0x113C2, 0x113C5},
+	{0x113C2, 0x113C9, 0x113C8},
+	{0x114B9, 0x114B0, 0x114BC},
+	{0x114B9, 0x114BA, 0x114BB},
+	{0x114B9, 0x114BD, 0x114BE},
+	{0x115B8, 0x115AF, 0x115BA},
+	{0x115B9, 0x115AF, 0x115BB},
+	{0x11935, 0x11930, 0x11938},
+	{0x1611E, 0x1611E, 0x16121},
+	{0x1611E, 0x1611F, 0x16123},
+	{0x1611E, 0x16120, 0x16125},
+	{0x1611E, 0x16129, 0x16122},
+	{0x16121, 0x1611F, 0x16126},
+	{0x16121, 0x16120, 0x16128},
+	{0x16122, 0x1611F, 0x16127},
+	{0x16129, 0x1611F, 0x16124},
+	{0x16D63, 0x16D67, 0x16D69},
+	{0x16D67, 0x16D67, 0x16D68},
+	{0x16D69, 0x16D67, 0x16D6A},
+}
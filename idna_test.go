@@ -0,0 +1,258 @@
+package gurl
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestToASCII(t *testing.T) {
+	tests := []struct {
+		domain string
+		want   string
+	}{
+		{"example.com", "example.com"},
+		{"EXAMPLE.com", "example.com"},
+		{"münchen.de", "xn--mnchen-3ya.de"},
+		{"MÜNCHEN.DE", "xn--mnchen-3ya.de"},
+		{"xn--mnchen-3ya.de", "xn--mnchen-3ya.de"},
+		{"faß.de", "xn--fa-hia.de"},
+		{"例え.テスト", "xn--r8jz45g.xn--zckzah"},
+		{"bücher。example", "xn--bcher-kva.example"},
+		{"under_score.example", "under_score.example"},
+		{"example.com.", "example.com."},
+	}
+
+	for _, test := range tests {
+		result, err := ToASCII(test.domain)
+		if err != nil {
+			t.Errorf("ToASCII(%q) returned an error: %v.", test.domain, err)
+			continue
+		}
+		if result != test.want {
+			t.Errorf("ToASCII was incorrect, got: %s, want: %s.", result, test.want)
+		}
+	}
+}
+
+func TestToUnicode(t *testing.T) {
+	tests := []struct {
+		domain string
+		want   string
+	}{
+		{"example.com", "example.com"},
+		{"xn--mnchen-3ya.de", "münchen.de"},
+		{"XN--MNCHEN-3YA.DE", "münchen.de"},
+		{"münchen.de", "münchen.de"},
+		{"xn--r8jz45g.xn--zckzah", "例え.テスト"},
+	}
+
+	for _, test := range tests {
+		result, err := ToUnicode(test.domain)
+		if err != nil {
+			t.Errorf("ToUnicode(%q) returned an error: %v.", test.domain, err)
+			continue
+		}
+		if result != test.want {
+			t.Errorf("ToUnicode was incorrect, got: %s, want: %s.", result, test.want)
+		}
+	}
+}
+
+func TestIDNAErrors(t *testing.T) {
+	tests := []struct {
+		profile IDNAProfile
+		domain  string
+		code    string
+	}{
+		{IDNALookup, "xn--a-.com", "P4"},
+		{IDNALookup, "xn--ü.com", "P4"},
+		{IDNALookup, "xn--a.com", "V7"},
+		{IDNALookup, "a\u200d.com", "C2"},
+		{IDNALookup, "\u0301a.com", "V6"},
+		{IDNALookup, "אa.com", "B2"},
+		{IDNALookup, "a\uffffb.com", "V7"},
+		{IDNAStrict, "-a.com", "V3"},
+		{IDNAStrict, "ab--c.com", "V2"},
+		{IDNAStrict, "under_score.com", "U1"},
+		{IDNAStrict, "a..com", "A4_2"},
+		{IDNAStrict, strings.Repeat("a", 64) + ".com", "A4_2"},
+	}
+
+	for _, test := range tests {
+		_, err := test.profile.ToASCII(test.domain)
+		var idnaErr *IDNAError
+		if !errors.As(err, &idnaErr) || idnaErr.Code != test.code {
+			t.Errorf("ToASCII(%q) was incorrect, got: %v, want: %s.", test.domain, err, test.code)
+		}
+		if !errors.Is(err, ErrInvalidHostname) {
+			t.Errorf("ToASCII(%q) was incorrect, got: %v, want: %v.", test.domain, err, ErrInvalidHostname)
+		}
+	}
+}
+
+func TestIDNATransitional(t *testing.T) {
+	profile := IDNAProfile{Transitional: true}
+	result, err := profile.ToASCII("Faß.de")
+	if err != nil {
+		t.Fatalf("ToASCII returned an error: %v.", err)
+	}
+	if result != "fass.de" {
+		t.Errorf("ToASCII was incorrect, got: %s, want: %s.", result, "fass.de")
+	}
+}
+
+// idnaIgnoredCodes are status codes in IdnaTestV2.txt that are not errors
+// in this package. X4_2 is a compatibility code for empty labels that
+// ToUnicode does not check.
+var idnaIgnoredCodes = map[string]bool{"X4_2": true}
+
+// idnaTestDataVersion is the Unicode version of testdata/IdnaTestV2.txt. It
+// must be updated along with the file.
+const idnaTestDataVersion = "16.0.0"
+
+// TestIdnaTestV2 runs the UTS #46 conformance tests in
+// testdata/IdnaTestV2.txt with every check turned on.
+func TestIdnaTestV2(t *testing.T) {
+	file, err := os.Open("testdata/IdnaTestV2.txt")
+	if err != nil {
+		t.Fatalf("Opening test data returned an error: %v.", err)
+	}
+	defer file.Close()
+
+	nontransitional := IDNAStrict
+	transitional := IDNAStrict
+	transitional.Transitional = true
+
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) != 7 {
+			t.Fatalf("Line %d has %d fields, want 7.", lineNumber, len(fields))
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		if hasSurrogate(fields[0]) {
+			// Go strings cannot hold unpaired surrogates.
+			continue
+		}
+		if idnaTestDataVersion != idnaUnicodeVersion && hasNewerRune(idnaTestString(fields[0], "")+idnaTestString(fields[1], "")) {
+			// The test data is older than the tables, and expects runes
+			// assigned since to be disallowed.
+			continue
+		}
+
+		source := idnaTestString(fields[0], "")
+		toUnicode := idnaTestString(fields[1], source)
+		toUnicodeStatus := fields[2]
+		toASCIIN := idnaTestString(fields[3], toUnicode)
+		toASCIINStatus := idnaTestStatus(fields[4], toUnicodeStatus)
+		toASCIIT := idnaTestString(fields[5], toASCIIN)
+		toASCIITStatus := idnaTestStatus(fields[6], toASCIINStatus)
+
+		result, err := nontransitional.ToUnicode(source)
+		checkIdnaTest(t, lineNumber, "ToUnicode", source, result, err, toUnicode, toUnicodeStatus)
+		result, err = nontransitional.ToASCII(source)
+		checkIdnaTest(t, lineNumber, "ToASCII", source, result, err, toASCIIN, toASCIINStatus)
+		result, err = transitional.ToASCII(source)
+		checkIdnaTest(t, lineNumber, "ToASCII (transitional)", source, result, err, toASCIIT, toASCIITStatus)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("Reading test data returned an error: %v.", err)
+	}
+}
+
+func checkIdnaTest(t *testing.T, lineNumber int, name, source, result string, err error, want, status string) {
+	t.Helper()
+	wantErr := false
+	for _, code := range strings.Split(strings.Trim(status, "[]"), ",") {
+		if code = strings.TrimSpace(code); code != "" && !idnaIgnoredCodes[code] {
+			wantErr = true
+		}
+	}
+	switch {
+	case wantErr && err == nil:
+		t.Errorf("Line %d: %s(%+q) was incorrect, got: %+q, want: error %s.", lineNumber, name, source, result, status)
+	case !wantErr && err != nil:
+		t.Errorf("Line %d: %s(%+q) was incorrect, got: %v, want: %+q.", lineNumber, name, source, err, want)
+	case !wantErr && result != want:
+		t.Errorf("Line %d: %s(%+q) was incorrect, got: %+q, want: %+q.", lineNumber, name, source, result, want)
+	}
+}
+
+// idnaTestString unescapes a string column of IdnaTestV2.txt. A blank column
+// means def, and "" means the empty string.
+func idnaTestString(s, def string) string {
+	switch s {
+	case "":
+		return def
+	case `""`:
+		return ""
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		var hex string
+		switch {
+		case strings.HasPrefix(s[i:], `\u`) && i+6 <= len(s):
+			hex = s[i+2 : i+6]
+			i += 5
+		case strings.HasPrefix(s[i:], `\x{`):
+			end := strings.IndexByte(s[i:], '}')
+			hex = s[i+3 : i+end]
+			i += end
+		default:
+			b.WriteByte(s[i])
+			continue
+		}
+		r, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			panic(err)
+		}
+		b.WriteRune(rune(r))
+	}
+	return b.String()
+}
+
+// idnaTestStatus returns a status column of IdnaTestV2.txt, or def if it is
+// blank.
+func idnaTestStatus(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+func hasSurrogate(s string) bool {
+	for _, prefix := range []string{`\uD8`, `\uD9`, `\uDA`, `\uDB`, `\uDC`, `\uDD`, `\uDE`, `\uDF`} {
+		if strings.Contains(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasNewerRune reports whether s has a rune of CJK Unified Ideographs
+// Extension J, which is assigned in the tables but not in the Unicode 16.0
+// test data. It is only used until the test data matches idnaUnicodeVersion,
+// and can be removed then.
+func hasNewerRune(s string) bool {
+	for _, r := range s {
+		if r >= 0x323B0 && r <= 0x3347F {
+			return true
+		}
+	}
+	return false
+}
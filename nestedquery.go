@@ -0,0 +1,595 @@
+package gurl
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Errors reported by nested queries.
+var (
+	// ErrNestedQueryTooDeep is returned when a key has more bracket
+	// segments than NestedQueryOptions.MaxDepth allows.
+	ErrNestedQueryTooDeep = errors.New("gurl: nested query too deep")
+	// ErrTooManyQueryParams is returned when a query has more parameters
+	// than NestedQueryOptions.MaxParams allows.
+	ErrTooManyQueryParams = errors.New("gurl: too many query parameters")
+	// ErrInvalidNestedQueryPath is returned when a path such as
+	// "filter.status" has an empty segment.
+	ErrInvalidNestedQueryPath = errors.New("gurl: invalid nested query path")
+	// ErrQueryArrayTooLong is returned when a value split on "," with
+	// ArrayComma has more items than NestedQueryOptions.ArrayLimit allows.
+	ErrQueryArrayTooLong = errors.New("gurl: query array too long")
+)
+
+// ArrayFormat selects how lists are written in a nested query.
+type ArrayFormat int
+
+const (
+	// ArrayBrackets writes a list as "a[]=1&a[]=2".
+	ArrayBrackets ArrayFormat = iota
+	// ArrayIndices writes a list as "a[0]=1&a[1]=2".
+	ArrayIndices
+	// ArrayRepeat writes a list as "a=1&a=2".
+	ArrayRepeat
+	// ArrayComma writes a list as "a=1,2", and splits values on "," when
+	// parsing.
+	ArrayComma
+)
+
+// Default limits of nested queries, as in the qs library.
+const (
+	DefaultNestedQueryDepth      = 5
+	DefaultNestedQueryParams     = 1000
+	DefaultNestedQueryArrayLimit = 20
+)
+
+// NestedQueryOptions configures how nested queries are parsed and built.
+// The zero value uses ArrayBrackets and the default limits.
+type NestedQueryOptions struct {
+	// ArrayFormat is how lists are written. When parsing, every format but
+	// ArrayComma is read: "a[]", "a[0]" and a repeated "a" all make lists.
+	ArrayFormat ArrayFormat
+	// MaxDepth is the most bracket segments a key can have, or 0 for
+	// DefaultNestedQueryDepth.
+	MaxDepth int
+	// MaxParams is the most parameters a query can have, or 0 for
+	// DefaultNestedQueryParams.
+	MaxParams int
+	// ArrayLimit is the highest list index, or 0 for
+	// DefaultNestedQueryArrayLimit. As in qs, a larger index such as
+	// "a[100]" is read as a map key, and so is "a[]" once the list is that
+	// long. A value split on "," may have at most ArrayLimit items.
+	ArrayLimit int
+}
+
+func nestedQueryOptions(opts []NestedQueryOptions) NestedQueryOptions {
+	var o NestedQueryOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	if o.MaxDepth <= 0 {
+		o.MaxDepth = DefaultNestedQueryDepth
+	}
+	if o.MaxParams <= 0 {
+		o.MaxParams = DefaultNestedQueryParams
+	}
+	if o.ArrayLimit <= 0 {
+		o.ArrayLimit = DefaultNestedQueryArrayLimit
+	}
+	return o
+}
+
+// splitNestedKey splits an unescaped key such as "filter[status][]" into its
+// segments "filter", "status" and "". A key that is not a name followed by
+// bracket segments is a single segment.
+func splitNestedKey(key string) []string {
+	i := strings.IndexByte(key, '[')
+	if i <= 0 {
+		return []string{key}
+	}
+	segments := []string{key[:i]}
+	for rest := key[i:]; rest != ""; {
+		end := strings.IndexByte(rest, ']')
+		if rest[0] != '[' || end < 0 {
+			return []string{key}
+		}
+		segments = append(segments, rest[1:end])
+		rest = rest[end+1:]
+	}
+	return segments
+}
+
+// splitNestedPath splits a path such as "filter.status" into its segments.
+func splitNestedPath(path string) ([]string, error) {
+	segments := strings.Split(path, ".")
+	for _, s := range segments {
+		if s == "" {
+			return nil, ErrInvalidNestedQueryPath
+		}
+	}
+	return segments, nil
+}
+
+// nestedKey returns the escaped key of the segments, such as
+// "filter%5Bstatus%5D".
+func nestedKey(segments []string) string {
+	key := url.QueryEscape(segments[0])
+	for _, s := range segments[1:] {
+		key += "%5B" + url.QueryEscape(s) + "%5D"
+	}
+	return key
+}
+
+// nestedArray is a list while it is parsed. Its items are kept by index, so
+// that sparse indices such as "a[5]" do not allocate and are compacted at
+// the end. next is the index after the highest one, where "a[]" appends.
+type nestedArray struct {
+	items map[int]interface{}
+	next  int
+}
+
+func newNestedArray(items ...interface{}) *nestedArray {
+	a := &nestedArray{items: make(map[int]interface{}, len(items))}
+	for _, item := range items {
+		a.set(a.next, item)
+	}
+	return a
+}
+
+func (a *nestedArray) set(i int, item interface{}) {
+	a.items[i] = item
+	if i >= a.next {
+		a.next = i + 1
+	}
+}
+
+// nestedIndex parses a list index, which has no sign and no leading zero.
+func nestedIndex(s string) (int, bool) {
+	if s == "" || len(s) > 9 || len(s) > 1 && s[0] == '0' || strings.Trim(s, "0123456789") != "" {
+		return 0, false
+	}
+	i, _ := strconv.Atoi(s)
+	return i, true
+}
+
+// insertNested adds value at the segments below node, and returns the new
+// node. Values met twice are combined into a list. Indices above arrayLimit
+// are map keys.
+func insertNested(node interface{}, segments []string, value string, arrayLimit int) interface{} {
+	if len(segments) == 0 {
+		switch n := node.(type) {
+		case nil:
+			return value
+		case *nestedArray:
+			n.set(n.next, value)
+			return n
+		}
+		return newNestedArray(node, value)
+	}
+	s := segments[0]
+	switch n := node.(type) {
+	case nil:
+		if i, ok := nestedIndex(s); ok && i <= arrayLimit || s == "" {
+			return insertNested(newNestedArray(), segments, value, arrayLimit)
+		}
+		return insertNested(make(map[string]interface{}), segments, value, arrayLimit)
+	case *nestedArray:
+		i, ok := nestedIndex(s)
+		if s == "" {
+			i, ok = n.next, true
+		}
+		if ok && i <= arrayLimit {
+			n.set(i, insertNested(n.items[i], segments[1:], value, arrayLimit))
+			return n
+		}
+		m := make(map[string]interface{}, len(n.items)+1)
+		for i, item := range n.items {
+			m[strconv.Itoa(i)] = item
+		}
+		return insertNested(m, segments, value, arrayLimit)
+	case map[string]interface{}:
+		if s == "" {
+			s = strconv.Itoa(len(n))
+		}
+		n[s] = insertNested(n[s], segments[1:], value, arrayLimit)
+		return n
+	}
+	return insertNested(newNestedArray(node), segments, value, arrayLimit)
+}
+
+// finishNested turns the nestedArrays below node into lists.
+func finishNested(node interface{}) interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for k, v := range n {
+			n[k] = finishNested(v)
+		}
+	case *nestedArray:
+		indices := make([]int, 0, len(n.items))
+		for i := range n.items {
+			indices = append(indices, i)
+		}
+		sort.Ints(indices)
+		list := make([]interface{}, len(indices))
+		for j, i := range indices {
+			list[j] = finishNested(n.items[i])
+		}
+		return list
+	}
+	return node
+}
+
+func parseNestedQuery(rawQuery string, opts NestedQueryOptions) (map[string]interface{}, error) {
+	root := make(map[string]interface{})
+	n := 0
+	for _, param := range strings.Split(rawQuery, "&") {
+		if param == "" {
+			continue
+		}
+		if n++; n > opts.MaxParams {
+			return nil, ErrTooManyQueryParams
+		}
+		rawKey, rawValue, _ := strings.Cut(param, "=")
+		segments := splitNestedKey(queryUnescape(rawKey))
+		if len(segments)-1 > opts.MaxDepth {
+			return nil, ErrNestedQueryTooDeep
+		}
+		values := []string{rawValue}
+		if opts.ArrayFormat == ArrayComma {
+			if strings.Count(rawValue, ",") >= opts.ArrayLimit {
+				return nil, ErrQueryArrayTooLong
+			}
+			values = strings.Split(rawValue, ",")
+		}
+		for _, value := range values {
+			root[segments[0]] = insertNested(root[segments[0]], segments[1:], queryUnescape(value), opts.ArrayLimit)
+		}
+	}
+	return finishNested(root).(map[string]interface{}), nil
+}
+
+// buildNested appends the parameters of value under the escaped key to
+// params. depth is the number of bracket segments of key.
+func buildNested(params []string, key string, value interface{}, depth int, opts NestedQueryOptions) ([]string, error) {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return params, nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return params, nil
+	}
+
+	var err error
+	switch {
+	case v.Kind() == reflect.Map && !isQueryScalar(v.Type()):
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, ErrUnsupportedQueryType
+		}
+		if depth >= opts.MaxDepth {
+			return nil, ErrNestedQueryTooDeep
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		for _, k := range keys {
+			if params, err = buildNested(params, key+"%5B"+url.QueryEscape(k.String())+"%5D", v.MapIndex(k).Interface(), depth+1, opts); err != nil {
+				return nil, err
+			}
+		}
+	case (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && !isQueryScalar(v.Type()):
+		// Lists of maps and lists are always written with indices, which
+		// keep the items apart.
+		format := opts.ArrayFormat
+		var items []string
+		for i := 0; i < v.Len() && format != ArrayIndices; i++ {
+			item, ok, err := nestedScalar(v.Index(i))
+			if err != nil {
+				format = ArrayIndices
+			} else if ok {
+				items = append(items, url.QueryEscape(item))
+			}
+		}
+		if format != ArrayRepeat && format != ArrayComma && depth >= opts.MaxDepth {
+			return nil, ErrNestedQueryTooDeep
+		}
+		switch format {
+		case ArrayBrackets:
+			for _, item := range items {
+				params = append(params, key+"%5B%5D="+item)
+			}
+		case ArrayRepeat:
+			for _, item := range items {
+				params = append(params, key+"="+item)
+			}
+		case ArrayComma:
+			if len(items) > 0 {
+				params = append(params, key+"="+strings.Join(items, ","))
+			}
+		default:
+			for i := 0; i < v.Len(); i++ {
+				if params, err = buildNested(params, key+"%5B"+strconv.Itoa(i)+"%5D", v.Index(i).Interface(), depth+1, opts); err != nil {
+					return nil, err
+				}
+			}
+		}
+	default:
+		item, err := encodeQueryValue(v, "")
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, key+"="+url.QueryEscape(item))
+	}
+	return params, nil
+}
+
+// nestedScalar formats a list item. ok is false for a nil item, and an error
+// is returned for a map or a list.
+func nestedScalar(v reflect.Value) (string, bool, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", false, nil
+		}
+		v = v.Elem()
+	}
+	if (v.Kind() == reflect.Map || v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && !isQueryScalar(v.Type()) {
+		return "", false, ErrUnsupportedQueryType
+	}
+	item, err := encodeQueryValue(v, "")
+	return item, err == nil, err
+}
+
+// GetNestedQuery parses the query of the URL into a tree. See
+// ParseNestedQuery.
+func (u *URL) GetNestedQuery(opts ...NestedQueryOptions) (map[string]interface{}, error) {
+	u.flush()
+	return parseNestedQuery(u.url.RawQuery, nestedQueryOptions(opts))
+}
+
+// GetNestedQueryParam returns the value at a path such as "filter.status"
+// in the tree of the query, or nil if there is none. A segment of the path
+// that is a number indexes a list.
+func (u *URL) GetNestedQueryParam(path string, opts ...NestedQueryOptions) (interface{}, error) {
+	segments, err := splitNestedPath(path)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := u.GetNestedQuery(opts...)
+	if err != nil {
+		return nil, err
+	}
+	var node interface{} = tree
+	for _, s := range segments {
+		switch n := node.(type) {
+		case map[string]interface{}:
+			node = n[s]
+		case []interface{}:
+			i, ok := nestedIndex(s)
+			if !ok || i >= len(n) {
+				return nil, nil
+			}
+			node = n[i]
+		default:
+			return nil, nil
+		}
+	}
+	return node, nil
+}
+
+// SetNestedQueryParam replaces the parameters under a path such as
+// "filter.status" with the parameters of value, which are appended to the
+// query. Other parameters are kept as they are. A nil value only deletes.
+func (u *URL) SetNestedQueryParam(path string, value interface{}, opts ...NestedQueryOptions) error {
+	segments, err := splitNestedPath(path)
+	if err != nil {
+		return err
+	}
+	o := nestedQueryOptions(opts)
+	if len(segments)-1 > o.MaxDepth {
+		return ErrNestedQueryTooDeep
+	}
+	u.flush()
+	tokens := parseQueryTokens(u.url.RawQuery).filter(func(t queryToken) bool {
+		key := splitNestedKey(t.key)
+		if len(key) < len(segments) {
+			return true
+		}
+		for i, s := range segments {
+			if key[i] != s {
+				return true
+			}
+		}
+		return false
+	})
+	params := strings.Split(tokens.encode(), "&")
+	if params[0] == "" {
+		params = nil
+	}
+	if params, err = buildNested(params, nestedKey(segments), value, len(segments)-1, o); err != nil {
+		return err
+	}
+	u.url.RawQuery = strings.Join(params, "&")
+	u.reset()
+	return nil
+}
+
+// DelNestedQueryParam deletes the parameters under a path such as
+// "filter.status".
+func (u *URL) DelNestedQueryParam(path string) error {
+	return u.SetNestedQueryParam(path, nil)
+}
+
+// ParseNestedQuery parses the query of a URL written in the bracket syntax of
+// Rails, PHP and the qs library, such as
+// "filter[status][]=open&filter[owner]=me&page[size]=10", into a tree.
+//
+// Each node of the tree is a map[string]interface{}, a list
+// ([]interface{}) or a string value. "a[]" appends to a list, "a[0]" sets an
+// index, and a repeated key makes a list. Sparse indices are compacted.
+//
+// Parameters:
+//
+//	u: The URL to parse the query of.
+//	opts: Optional options, such as the array format and the limits.
+//
+// Returns:
+//
+//	The tree, and an error if any occurred. ErrNestedQueryTooDeep,
+//	ErrTooManyQueryParams and ErrQueryArrayTooLong are returned when the
+//	query exceeds the limits.
+//
+// Example:
+//
+//	result, err := ParseNestedQuery("http://example.com/?filter[status][]=open&filter[owner]=me&page[size]=10")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: map[filter:map[owner:me status:[open]] page:map[size:10]]
+func ParseNestedQuery(u string, opts ...NestedQueryOptions) (map[string]interface{}, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return nil, err
+	}
+	return parsedURL.GetNestedQuery(opts...)
+}
+
+// BuildNestedQuery serializes a tree into a query in the bracket syntax,
+// without the leading "?". Keys are written in sorted order, with their
+// brackets escaped.
+//
+// The nodes of the tree are maps with string keys, slices, and values such
+// as strings, bools, numbers and types implementing
+// encoding.TextMarshaler. Lists of values are written in the array format of
+// opts, and lists of maps or lists always with indices.
+//
+// Parameters:
+//
+//	tree: The tree to serialize.
+//	opts: Optional options, such as the array format and the depth limit.
+//
+// Returns:
+//
+//	A string containing the query, and an error if any occurred.
+//
+// Example:
+//
+//	result, err := BuildNestedQuery(map[string]interface{}{
+//	  "filter": map[string]interface{}{"status": []string{"open"}, "owner": "me"},
+//	})
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "filter%5Bowner%5D=me&filter%5Bstatus%5D%5B%5D=open"
+func BuildNestedQuery(tree map[string]interface{}, opts ...NestedQueryOptions) (string, error) {
+	o := nestedQueryOptions(opts)
+	keys := make([]string, 0, len(tree))
+	for k := range tree {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var params []string
+	var err error
+	for _, k := range keys {
+		if params, err = buildNested(params, url.QueryEscape(k), tree[k], 0, o); err != nil {
+			return "", err
+		}
+	}
+	return strings.Join(params, "&"), nil
+}
+
+// GetNestedQueryParam returns the value at a path such as "filter.status" in
+// the tree of the query of a URL, as parsed by ParseNestedQuery.
+//
+// Parameters:
+//
+//	u: The URL to read.
+//	path: The dot-separated path. A segment that is a number indexes a list.
+//	opts: Optional options, such as the array format and the limits.
+//
+// Returns:
+//
+//	The value, a string, a list or a map, or nil if there is none, and an
+//	error if any occurred.
+//
+// Example:
+//
+//	result, err := GetNestedQueryParam("http://example.com/?filter[status][]=open&filter[owner]=me", "filter.owner")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: me
+func GetNestedQueryParam(u, path string, opts ...NestedQueryOptions) (interface{}, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return nil, err
+	}
+	return parsedURL.GetNestedQueryParam(path, opts...)
+}
+
+// SetNestedQueryParam replaces the parameters under a path such as
+// "filter.status" in the query of a URL, and returns the new URL. The new
+// parameters are appended, and the others are kept as they are.
+//
+// Parameters:
+//
+//	u: The URL in which to set the parameter.
+//	path: The dot-separated path.
+//	value: The new value, which can be a tree as in BuildNestedQuery.
+//	opts: Optional options, such as the array format and the limits.
+//
+// Returns:
+//
+//	A string containing the new URL, and an error if any occurred.
+//
+// Example:
+//
+//	result, err := SetNestedQueryParam("http://example.com/?filter[status][]=open&page=2", "filter.status", []string{"closed", "merged"})
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "http://example.com/?page=2&filter%5Bstatus%5D%5B%5D=closed&filter%5Bstatus%5D%5B%5D=merged"
+func SetNestedQueryParam(u, path string, value interface{}, opts ...NestedQueryOptions) (string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", err
+	}
+	if err := parsedURL.SetNestedQueryParam(path, value, opts...); err != nil {
+		return "", err
+	}
+	return parsedURL.String(), nil
+}
+
+// DelNestedQueryParam deletes the parameters under a path such as
+// "filter.status" in the query of a URL, and returns the new URL.
+//
+// Parameters:
+//
+//	u: The URL from which to delete the parameter.
+//	path: The dot-separated path.
+//
+// Returns:
+//
+//	A string containing the new URL, and an error if any occurred.
+//
+// Example:
+//
+//	result, err := DelNestedQueryParam("http://example.com/?filter[status][]=open&filter[owner]=me", "filter.status")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "http://example.com/?filter[owner]=me"
+func DelNestedQueryParam(u, path string) (string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", err
+	}
+	if err := parsedURL.DelNestedQueryParam(path); err != nil {
+		return "", err
+	}
+	return parsedURL.String(), nil
+}
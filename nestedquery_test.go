@@ -0,0 +1,210 @@
+package gurl
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseNestedQuery(t *testing.T) {
+	tests := []struct {
+		url    string
+		format ArrayFormat
+		result map[string]interface{}
+	}{
+		{"http://example.com/?filter[status][]=open&filter[status][]=closed&filter[owner]=me&page[size]=10", ArrayBrackets, map[string]interface{}{
+			"filter": map[string]interface{}{"status": []interface{}{"open", "closed"}, "owner": "me"},
+			"page":   map[string]interface{}{"size": "10"},
+		}},
+		{"http://example.com/?filter%5Bstatus%5D%5B%5D=open&q=a+b", ArrayBrackets, map[string]interface{}{
+			"filter": map[string]interface{}{"status": []interface{}{"open"}},
+			"q":      "a b",
+		}},
+		{"http://example.com/?a[1]=b&a[0]=a&a[9]=c", ArrayBrackets, map[string]interface{}{
+			"a": []interface{}{"a", "b", "c"},
+		}},
+		{"http://example.com/?a=1&a=2", ArrayRepeat, map[string]interface{}{
+			"a": []interface{}{"1", "2"},
+		}},
+		{"http://example.com/?a=1,2&b=3&c=x%2Cy", ArrayComma, map[string]interface{}{
+			"a": []interface{}{"1", "2"},
+			"b": "3",
+			"c": "x,y",
+		}},
+		{"http://example.com/?items[0][id]=1&items[0][qty]=2&items[1][id]=3", ArrayIndices, map[string]interface{}{
+			"items": []interface{}{
+				map[string]interface{}{"id": "1", "qty": "2"},
+				map[string]interface{}{"id": "3"},
+			},
+		}},
+		{"http://example.com/?a[0]=x&a[b]=y", ArrayBrackets, map[string]interface{}{
+			"a": map[string]interface{}{"0": "x", "b": "y"},
+		}},
+		{"http://example.com/?a[b=1&[c]=2&d[e]f=3", ArrayBrackets, map[string]interface{}{
+			"a[b": "1", "[c]": "2", "d[e]f": "3",
+		}},
+	}
+	for _, test := range tests {
+		result, err := ParseNestedQuery(test.url, NestedQueryOptions{ArrayFormat: test.format})
+		if err != nil {
+			t.Errorf("ParseNestedQuery(%q) returned an error: %v.", test.url, err)
+			continue
+		}
+		if !reflect.DeepEqual(result, test.result) {
+			t.Errorf("ParseNestedQuery(%q) was incorrect, got: %v, want: %v.", test.url, result, test.result)
+		}
+	}
+}
+
+func TestParseNestedQueryLimits(t *testing.T) {
+	if _, err := ParseNestedQuery("http://example.com/?a[b][c][d][e][f]=1"); err != nil {
+		t.Errorf("ParseNestedQuery was incorrect, got: %v, want: no error.", err)
+	}
+	if _, err := ParseNestedQuery("http://example.com/?a[b][c][d][e][f][g]=1"); !errors.Is(err, ErrNestedQueryTooDeep) {
+		t.Errorf("ParseNestedQuery was incorrect, got: %v, want: %v.", err, ErrNestedQueryTooDeep)
+	}
+	if _, err := ParseNestedQuery("http://example.com/?a[b][c]=1", NestedQueryOptions{MaxDepth: 1}); !errors.Is(err, ErrNestedQueryTooDeep) {
+		t.Errorf("ParseNestedQuery was incorrect, got: %v, want: %v.", err, ErrNestedQueryTooDeep)
+	}
+	query := strings.Repeat("a[]=1&", 1001)
+	if _, err := ParseNestedQuery("http://example.com/?" + query); !errors.Is(err, ErrTooManyQueryParams) {
+		t.Errorf("ParseNestedQuery was incorrect, got: %v, want: %v.", err, ErrTooManyQueryParams)
+	}
+	if _, err := ParseNestedQuery("http://example.com/?"+query, NestedQueryOptions{MaxParams: 2000}); err != nil {
+		t.Errorf("ParseNestedQuery was incorrect, got: %v, want: no error.", err)
+	}
+
+	arrayTests := []struct {
+		query  string
+		result interface{}
+	}{
+		{"a[20]=x", []interface{}{"x"}},
+		{"a[21]=x", map[string]interface{}{"21": "x"}},
+		{"a[0]=x&a[999999999]=y", map[string]interface{}{"0": "x", "999999999": "y"}},
+		{strings.Repeat("a[]=1&", 21), []interface{}{"1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1"}},
+		{"a[]=x&a[]=y&a[]=z", []interface{}{"x", "y", "z"}},
+	}
+	for _, test := range arrayTests {
+		result, err := ParseNestedQuery("http://example.com/?" + test.query)
+		if err != nil || !reflect.DeepEqual(result["a"], test.result) {
+			t.Errorf("ParseNestedQuery(%q) was incorrect, got: %v, %v, want: %v.", test.query, result["a"], err, test.result)
+		}
+	}
+	if result, _ := ParseNestedQuery("http://example.com/?" + strings.Repeat("a[]=1&", 22)); len(result["a"].(map[string]interface{})) != 22 {
+		t.Errorf("ParseNestedQuery was incorrect, got: %v, want: a map of 22 items.", result["a"])
+	}
+	if result, _ := ParseNestedQuery("http://example.com/?a[2]=x&a[21]=y", NestedQueryOptions{ArrayLimit: 30}); !reflect.DeepEqual(result["a"], []interface{}{"x", "y"}) {
+		t.Errorf("ParseNestedQuery was incorrect, got: %v, want: [x y].", result["a"])
+	}
+
+	comma := NestedQueryOptions{ArrayFormat: ArrayComma}
+	if _, err := ParseNestedQuery("http://example.com/?a="+strings.Repeat("1,", 19)+"1", comma); err != nil {
+		t.Errorf("ParseNestedQuery was incorrect, got: %v, want: no error.", err)
+	}
+	if _, err := ParseNestedQuery("http://example.com/?a="+strings.Repeat(",", 20), comma); !errors.Is(err, ErrQueryArrayTooLong) {
+		t.Errorf("ParseNestedQuery was incorrect, got: %v, want: %v.", err, ErrQueryArrayTooLong)
+	}
+	comma.ArrayLimit = 100
+	if _, err := ParseNestedQuery("http://example.com/?a="+strings.Repeat(",", 20), comma); err != nil {
+		t.Errorf("ParseNestedQuery was incorrect, got: %v, want: no error.", err)
+	}
+}
+
+func TestBuildNestedQuery(t *testing.T) {
+	tree := map[string]interface{}{
+		"filter": map[string]interface{}{"status": []string{"open", "a,b"}, "owner": "me"},
+		"page":   map[string]int{"size": 10},
+	}
+	tests := []struct {
+		format ArrayFormat
+		result string
+	}{
+		{ArrayBrackets, "filter%5Bowner%5D=me&filter%5Bstatus%5D%5B%5D=open&filter%5Bstatus%5D%5B%5D=a%2Cb&page%5Bsize%5D=10"},
+		{ArrayIndices, "filter%5Bowner%5D=me&filter%5Bstatus%5D%5B0%5D=open&filter%5Bstatus%5D%5B1%5D=a%2Cb&page%5Bsize%5D=10"},
+		{ArrayRepeat, "filter%5Bowner%5D=me&filter%5Bstatus%5D=open&filter%5Bstatus%5D=a%2Cb&page%5Bsize%5D=10"},
+		{ArrayComma, "filter%5Bowner%5D=me&filter%5Bstatus%5D=open,a%2Cb&page%5Bsize%5D=10"},
+	}
+	for _, test := range tests {
+		opts := NestedQueryOptions{ArrayFormat: test.format}
+		result, err := BuildNestedQuery(tree, opts)
+		if err != nil || result != test.result {
+			t.Errorf("BuildNestedQuery(%d) was incorrect, got: %s, %v, want: %s.", test.format, result, err, test.result)
+			continue
+		}
+		parsed, err := ParseNestedQuery("http://example.com/?"+result, opts)
+		want := map[string]interface{}{
+			"filter": map[string]interface{}{"status": []interface{}{"open", "a,b"}, "owner": "me"},
+			"page":   map[string]interface{}{"size": "10"},
+		}
+		if err != nil || !reflect.DeepEqual(parsed, want) {
+			t.Errorf("ParseNestedQuery(BuildNestedQuery(%d)) was incorrect, got: %v, want: %v.", test.format, parsed, want)
+		}
+	}
+
+	result, err := BuildNestedQuery(map[string]interface{}{
+		"items": []interface{}{map[string]string{"id": "1"}, map[string]string{"id": "2"}},
+	})
+	if want := "items%5B0%5D%5Bid%5D=1&items%5B1%5D%5Bid%5D=2"; err != nil || result != want {
+		t.Errorf("BuildNestedQuery was incorrect, got: %s, want: %s.", result, want)
+	}
+
+	cycle := map[string]interface{}{}
+	cycle["a"] = cycle
+	if _, err := BuildNestedQuery(cycle); !errors.Is(err, ErrNestedQueryTooDeep) {
+		t.Errorf("BuildNestedQuery was incorrect, got: %v, want: %v.", err, ErrNestedQueryTooDeep)
+	}
+	if _, err := BuildNestedQuery(map[string]interface{}{"a": struct{}{}}); !errors.Is(err, ErrUnsupportedQueryType) {
+		t.Errorf("BuildNestedQuery was incorrect, got: %v, want: %v.", err, ErrUnsupportedQueryType)
+	}
+}
+
+func TestGetNestedQueryParam(t *testing.T) {
+	u := "http://example.com/?filter[status][]=open&filter[status][]=closed&filter[owner]=me"
+	tests := []struct {
+		path   string
+		result interface{}
+	}{
+		{"filter.owner", "me"},
+		{"filter.status", []interface{}{"open", "closed"}},
+		{"filter.status.1", "closed"},
+		{"filter.status.2", nil},
+		{"filter.owner.x", nil},
+		{"page", nil},
+	}
+	for _, test := range tests {
+		result, err := GetNestedQueryParam(u, test.path)
+		if err != nil || !reflect.DeepEqual(result, test.result) {
+			t.Errorf("GetNestedQueryParam(%q) was incorrect, got: %v, want: %v.", test.path, result, test.result)
+		}
+	}
+	if _, err := GetNestedQueryParam(u, "filter..owner"); !errors.Is(err, ErrInvalidNestedQueryPath) {
+		t.Errorf("GetNestedQueryParam was incorrect, got: %v, want: %v.", err, ErrInvalidNestedQueryPath)
+	}
+}
+
+func TestSetNestedQueryParam(t *testing.T) {
+	u := "http://example.com/?z=%7e&filter[status][]=open&filter[status][]=closed&filter[owner]=me"
+	tests := []struct {
+		path   string
+		value  interface{}
+		result string
+	}{
+		{"filter.status", "merged", "http://example.com/?z=%7e&filter[owner]=me&filter%5Bstatus%5D=merged"},
+		{"filter.status", []string{"a", "b"}, "http://example.com/?z=%7e&filter[owner]=me&filter%5Bstatus%5D%5B%5D=a&filter%5Bstatus%5D%5B%5D=b"},
+		{"filter", map[string]string{"owner": "you"}, "http://example.com/?z=%7e&filter%5Bowner%5D=you"},
+		{"page.size", 10, "http://example.com/?z=%7e&filter[status][]=open&filter[status][]=closed&filter[owner]=me&page%5Bsize%5D=10"},
+		{"filter.status", nil, "http://example.com/?z=%7e&filter[owner]=me"},
+	}
+	for _, test := range tests {
+		result, err := SetNestedQueryParam(u, test.path, test.value)
+		if err != nil || result != test.result {
+			t.Errorf("SetNestedQueryParam(%q, %v) was incorrect, got: %s, %v, want: %s.", test.path, test.value, result, err, test.result)
+		}
+	}
+
+	result, err := DelNestedQueryParam(u, "filter")
+	if want := "http://example.com/?z=%7e"; err != nil || result != want {
+		t.Errorf("DelNestedQueryParam was incorrect, got: %s, want: %s.", result, want)
+	}
+}
@@ -0,0 +1,298 @@
+package gurl
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// NormalizeOptions selects the steps applied by Normalize.
+type NormalizeOptions struct {
+	// LowercaseScheme lowercases the scheme: "HTTP://" becomes "http://".
+	LowercaseScheme bool
+	// LowercaseHost lowercases the host: "Example.COM" becomes "example.com".
+	LowercaseHost bool
+	// RemoveDefaultPort removes the port if it is the default port of the
+	// scheme, such as ":443" for https, and an empty port such as "host:".
+	RemoveDefaultPort bool
+	// RemoveDotSegments resolves "." and ".." segments in the path, as in
+	// RFC 3986 section 5.2.4: "/a/./b/../c" becomes "/a/c".
+	RemoveDotSegments bool
+	// UppercaseEscapes uppercases the hex digits of percent-encodings in the
+	// path, query and fragment: "%2f" becomes "%2F".
+	UppercaseEscapes bool
+	// DecodeUnreserved decodes percent-encoded unreserved characters (letters,
+	// digits, "-", ".", "_" and "~") in the path, query and fragment: "%7E"
+	// becomes "~".
+	DecodeUnreserved bool
+	// AddRootPath sets an empty path to "/" when the URL has a host:
+	// "http://example.com" becomes "http://example.com/".
+	AddRootPath bool
+	// SortQuery sorts the query parameters by key. Values of the same key keep
+	// their order, and every parameter keeps its encoding.
+	SortQuery bool
+	// RemoveEmptyQuery removes a "?" with no query after it. RFC 3986
+	// section 6.2.3 leaves it to the scheme whether "?" and "?" with an empty
+	// query are the same, so it is not in NormalizeSafe. net/url never keeps
+	// an empty "#", so an empty fragment is always removed.
+	RemoveEmptyQuery bool
+	// RemoveFragment removes the fragment.
+	RemoveFragment bool
+	// RemoveWWW removes a leading "www." from the hostname.
+	RemoveWWW bool
+	// RemoveTrailingSlash removes a trailing "/" from the path, except from
+	// the root path "/".
+	RemoveTrailingSlash bool
+}
+
+// NormalizeSafe holds the normalization steps of RFC 3986 section 6 that never
+// change what a URL refers to.
+var NormalizeSafe = NormalizeOptions{
+	LowercaseScheme:   true,
+	LowercaseHost:     true,
+	RemoveDefaultPort: true,
+	RemoveDotSegments: true,
+	UppercaseEscapes:  true,
+	DecodeUnreserved:  true,
+	AddRootPath:       true,
+}
+
+// Normalize normalizes a URL so that equivalent URLs are written the same way.
+//
+// Parameters:
+//
+//	url: The URL to normalize.
+//	opts: The normalization steps to apply. NormalizeSafe holds the steps that never change what a URL refers to.
+//
+// Returns:
+//
+//	A string containing the normalized URL, and an error if any occurred.
+//
+// Example:
+//
+//	result, err := Normalize("HTTP://Example.COM:80/a/./b/../%7ec", NormalizeSafe)
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "http://example.com/a/~c"
+func Normalize(u string, opts NormalizeOptions) (string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", err
+	}
+	parsedURL.Normalize(opts)
+	return parsedURL.String(), nil
+}
+
+// Equivalent checks if two URLs are the same once normalized.
+//
+// Parameters:
+//
+//	a: The first URL.
+//	b: The second URL.
+//	opts: The normalization steps to apply to both URLs.
+//
+// Returns:
+//
+//	A boolean indicating whether the URLs are equivalent, and an error if any occurred.
+//
+// Example:
+//
+//	result, err := Equivalent("http://example.com/%7Efoo", "HTTP://EXAMPLE.com:80/~foo", NormalizeSafe)
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: true
+func Equivalent(a, b string, opts NormalizeOptions) (bool, error) {
+	normalizedA, err := Normalize(a, opts)
+	if err != nil {
+		return false, err
+	}
+	normalizedB, err := Normalize(b, opts)
+	if err != nil {
+		return false, err
+	}
+	return normalizedA == normalizedB, nil
+}
+
+// Normalize applies the normalization steps selected by opts to the URL.
+func (u *URL) Normalize(opts NormalizeOptions) {
+	u.flush()
+	parsedURL := u.url
+	if opts.LowercaseScheme {
+		parsedURL.Scheme = strings.ToLower(parsedURL.Scheme)
+	}
+	if opts.LowercaseHost {
+		parsedURL.Host = lowercaseHost(parsedURL.Host)
+	}
+	if opts.RemoveDefaultPort {
+		port := parsedURL.Port()
+		if port == "" || port == defaultPorts[strings.ToLower(parsedURL.Scheme)] {
+			parsedURL.Host = joinHostPort(parsedURL.Hostname(), "")
+		}
+	}
+	if opts.RemoveWWW {
+		if hostname := parsedURL.Hostname(); strings.HasPrefix(hostname, "www.") {
+			parsedURL.Host = joinHostPort(hostname[len("www."):], parsedURL.Port())
+		}
+	}
+
+	escapedPath := parsedURL.EscapedPath()
+	escapedPath = normalizeEscapes(escapedPath, opts)
+	if opts.RemoveDotSegments {
+		escapedPath = removeDotSegments(escapedPath)
+	}
+	if opts.AddRootPath && escapedPath == "" && parsedURL.Host != "" {
+		escapedPath = "/"
+	}
+	if opts.RemoveTrailingSlash && len(escapedPath) > 1 {
+		escapedPath = strings.TrimSuffix(escapedPath, "/")
+	}
+	setEscapedPath(parsedURL, escapedPath)
+
+	parsedURL.RawQuery = normalizeEscapes(parsedURL.RawQuery, opts)
+	if opts.SortQuery {
+		tokens := parseQueryTokens(parsedURL.RawQuery)
+		sort.SliceStable(tokens, func(i, j int) bool {
+			return tokens[i].key < tokens[j].key
+		})
+		parsedURL.RawQuery = tokens.encode()
+	}
+	if opts.RemoveEmptyQuery && parsedURL.RawQuery == "" {
+		parsedURL.ForceQuery = false
+	}
+
+	if opts.RemoveFragment {
+		parsedURL.Fragment = ""
+		parsedURL.RawFragment = ""
+	} else {
+		setEscapedFragment(parsedURL, normalizeEscapes(parsedURL.EscapedFragment(), opts))
+	}
+	u.reset()
+}
+
+// lowercaseHost lowercases host, except the zone ID of an IPv6 address, as
+// in "[fe80::1%en0]", which names a network interface and is case-sensitive.
+// The zone is unescaped in url.URL.Host.
+func lowercaseHost(host string) string {
+	if strings.HasPrefix(host, "[") {
+		zone := strings.Index(host, "%")
+		end := strings.LastIndex(host, "]")
+		if zone >= 0 && end > zone {
+			return strings.ToLower(host[:zone]) + host[zone:end] + strings.ToLower(host[end:])
+		}
+	}
+	return strings.ToLower(host)
+}
+
+// setEscapedPath sets the path of u from its escaped form, keeping that form
+// when String is called.
+func setEscapedPath(u *url.URL, escapedPath string) {
+	p, err := url.PathUnescape(escapedPath)
+	if err != nil {
+		return
+	}
+	u.Path = p
+	u.RawPath = escapedPath
+}
+
+// setEscapedFragment is like setEscapedPath, but for the fragment.
+func setEscapedFragment(u *url.URL, escapedFragment string) {
+	fragment, err := url.PathUnescape(escapedFragment)
+	if err != nil {
+		return
+	}
+	u.Fragment = fragment
+	u.RawFragment = escapedFragment
+}
+
+// isUnreserved reports whether c is an unreserved character of RFC 3986.
+func isUnreserved(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+func unhex(c byte) byte {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10
+	}
+	return 0
+}
+
+func isHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+// normalizeEscapes rewrites the percent-encodings of s as selected by
+// opts.UppercaseEscapes and opts.DecodeUnreserved.
+func normalizeEscapes(s string, opts NormalizeOptions) string {
+	if !opts.UppercaseEscapes && !opts.DecodeUnreserved {
+		return s
+	}
+	if !strings.Contains(s, "%") {
+		return s
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' || i+2 >= len(s) || !isHex(s[i+1]) || !isHex(s[i+2]) {
+			b.WriteByte(s[i])
+			continue
+		}
+		c := unhex(s[i+1])<<4 | unhex(s[i+2])
+		switch {
+		case opts.DecodeUnreserved && isUnreserved(c):
+			b.WriteByte(c)
+		case opts.UppercaseEscapes:
+			b.WriteString(strings.ToUpper(s[i : i+3]))
+		default:
+			b.WriteString(s[i : i+3])
+		}
+		i += 2
+	}
+	return b.String()
+}
+
+// removeDotSegments removes "." and ".." segments from an escaped path, as in
+// RFC 3986 section 5.2.4.
+func removeDotSegments(input string) string {
+	var output []string
+	for input != "" {
+		switch {
+		case strings.HasPrefix(input, "../"):
+			input = input[len("../"):]
+		case strings.HasPrefix(input, "./"):
+			input = input[len("./"):]
+		case strings.HasPrefix(input, "/./"):
+			input = input[len("/."):]
+		case input == "/.":
+			input = "/"
+		case strings.HasPrefix(input, "/../"):
+			input = input[len("/.."):]
+			if len(output) > 0 {
+				output = output[:len(output)-1]
+			}
+		case input == "/..":
+			input = "/"
+			if len(output) > 0 {
+				output = output[:len(output)-1]
+			}
+		case input == "." || input == "..":
+			input = ""
+		default:
+			// Move the first segment, with its leading "/" if any, to output.
+			end := strings.IndexByte(input[1:], '/') + 1
+			if end == 0 {
+				end = len(input)
+			}
+			output = append(output, input[:end])
+			input = input[end:]
+		}
+	}
+	return strings.Join(output, "")
+}
@@ -0,0 +1,79 @@
+package gurl
+
+import (
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	type NormalizeTest struct {
+		url    string
+		opts   NormalizeOptions
+		result string
+	}
+	tests := []NormalizeTest{
+		{"HTTP://Example.COM:80/a/./b/../%7ec?", NormalizeSafe, "http://example.com/a/~c?"},
+		{"HTTP://Example.COM:80/a/./b/../%7ec?", NormalizeOptions{LowercaseHost: true, RemoveDefaultPort: true, RemoveEmptyQuery: true}, "http://example.com/a/./b/../%7ec"},
+		{"https://example.com:443", NormalizeSafe, "https://example.com/"},
+		{"https://example.com:8443/", NormalizeSafe, "https://example.com:8443/"},
+		{"http://example.com:/a", NormalizeSafe, "http://example.com/a"},
+		{"http://example.com/a%2fb%c3%a9?q=%2a%41#%7e%2f", NormalizeSafe, "http://example.com/a%2Fb%C3%A9?q=%2AA#~%2F"},
+		{"http://example.com/../a/.././b/..", NormalizeSafe, "http://example.com/"},
+		{"http://example.com/a/b/", NormalizeSafe, "http://example.com/a/b/"},
+		{"http://[::1]:80/", NormalizeSafe, "http://[::1]/"},
+		{"http://[FE80::1%25EN0]:8080/", NormalizeSafe, "http://[fe80::1%25EN0]:8080/"},
+		{"http://[FE80::1%25EN0]:80/", NormalizeSafe, "http://[fe80::1%25EN0]/"},
+		{"mailto:User@Example.COM", NormalizeSafe, "mailto:User@Example.COM"},
+		{"http://example.com/?b=2&a=1&b=1&c", NormalizeOptions{SortQuery: true}, "http://example.com/?a=1&b=2&b=1&c"},
+		{"http://example.com/?z=%7e&a=1", NormalizeOptions{SortQuery: true}, "http://example.com/?a=1&z=%7e"},
+		{"http://www.example.com/a/", NormalizeOptions{RemoveWWW: true, RemoveTrailingSlash: true}, "http://example.com/a"},
+		{"http://www.example.com:8080/", NormalizeOptions{RemoveWWW: true, RemoveTrailingSlash: true}, "http://example.com:8080/"},
+		{"http://example.com/#section", NormalizeOptions{RemoveFragment: true}, "http://example.com/"},
+		{"http://example.com/?", NormalizeOptions{}, "http://example.com/?"},
+	}
+	for _, test := range tests {
+		result, err := Normalize(test.url, test.opts)
+		if err != nil || result != test.result {
+			t.Errorf("Normalize was incorrect, got: %s, want: %s.", result, test.result)
+		}
+	}
+}
+
+func TestRemoveDotSegments(t *testing.T) {
+	tests := map[string]string{
+		"/a/b/c/./../../g":   "/a/g",
+		"mid/content=5/../6": "mid/6",
+		"/./":                "/",
+		"/..":                "/",
+		"a/..":               "/",
+		"../a":               "a",
+		".":                  "",
+		"/a/b":               "/a/b",
+		"":                   "",
+	}
+	for input, want := range tests {
+		if result := removeDotSegments(input); result != want {
+			t.Errorf("removeDotSegments was incorrect, got: %s, want: %s, for: %s.", result, want, input)
+		}
+	}
+}
+
+func TestEquivalent(t *testing.T) {
+	type EquivalentTest struct {
+		a      string
+		b      string
+		result bool
+	}
+	tests := []EquivalentTest{
+		{"http://example.com/%7Efoo", "HTTP://EXAMPLE.com:80/~foo", true},
+		{"http://example.com", "http://example.com/", true},
+		{"http://example.com/?", "http://example.com/", false},
+		{"http://example.com/a", "http://example.com/b", false},
+		{"http://example.com/?a=1&b=2", "http://example.com/?b=2&a=1", false},
+	}
+	for _, test := range tests {
+		result, err := Equivalent(test.a, test.b, NormalizeSafe)
+		if err != nil || result != test.result {
+			t.Errorf("Equivalent was incorrect, got: %t, want: %t, for: %s, %s.", result, test.result, test.a, test.b)
+		}
+	}
+}
@@ -0,0 +1,339 @@
+package gurl
+
+import (
+	"net/url"
+	"strings"
+)
+
+// pathSegments is a path split on "/" into escaped segments. Segments that
+// are not edited keep their original escaping, so an escaped slash such as
+// "a%2Fb" stays inside its segment.
+type pathSegments struct {
+	segments []string
+	absolute bool
+	trailing bool
+}
+
+// pathSegments splits the escaped path of u. A path that ends in "/" has
+// trailing set instead of an empty last segment.
+func (u *URL) pathSegments() pathSegments {
+	escaped := u.url.EscapedPath()
+	p := pathSegments{absolute: strings.HasPrefix(escaped, "/") || u.url.Host != ""}
+	escaped = strings.TrimPrefix(escaped, "/")
+	if escaped == "" {
+		return p
+	}
+	if strings.HasSuffix(escaped, "/") {
+		p.trailing = true
+		escaped = escaped[:len(escaped)-1]
+	}
+	p.segments = strings.Split(escaped, "/")
+	return p
+}
+
+// setPathSegments joins p and sets it as the escaped path of u.
+func (u *URL) setPathSegments(p pathSegments) {
+	var b strings.Builder
+	if p.absolute {
+		b.WriteByte('/')
+	}
+	b.WriteString(strings.Join(p.segments, "/"))
+	if p.trailing && len(p.segments) > 0 {
+		b.WriteByte('/')
+	}
+	setEscapedPath(u.url, b.String())
+}
+
+// segmentIndex turns a negative index into one counted from the end, and
+// reports whether it is between 0 and n-1.
+func segmentIndex(i, n int) (int, bool) {
+	if i < 0 {
+		i += n
+	}
+	return i, i >= 0 && i < n
+}
+
+// GetPathSegments returns the unescaped segments of the path. A trailing
+// slash does not add an empty segment.
+func (u *URL) GetPathSegments() []string {
+	p := u.pathSegments()
+	segments := make([]string, len(p.segments))
+	for i, segment := range p.segments {
+		segments[i] = unescapeSegment(segment)
+	}
+	return segments
+}
+
+// SetPathSegment replaces the i-th path segment with value, escaping it so
+// that a "/" in value stays in the segment. A negative i counts from the
+// end. It returns ErrIndexOutOfRange if there is no i-th segment.
+func (u *URL) SetPathSegment(i int, value string) error {
+	p := u.pathSegments()
+	i, ok := segmentIndex(i, len(p.segments))
+	if !ok {
+		return ErrIndexOutOfRange
+	}
+	p.segments[i] = url.PathEscape(value)
+	u.setPathSegments(p)
+	return nil
+}
+
+// InsertPathSegment inserts value before the i-th path segment, or at the
+// end if i is the number of segments. A negative i counts from the end, so
+// -1 inserts before the last segment. It returns ErrIndexOutOfRange if i is
+// out of range.
+func (u *URL) InsertPathSegment(i int, value string) error {
+	p := u.pathSegments()
+	if i < 0 {
+		i += len(p.segments)
+	}
+	if i < 0 || i > len(p.segments) {
+		return ErrIndexOutOfRange
+	}
+	p.segments = append(p.segments, "")
+	copy(p.segments[i+1:], p.segments[i:])
+	p.segments[i] = url.PathEscape(value)
+	u.setPathSegments(p)
+	return nil
+}
+
+// DelPathSegment deletes the i-th path segment. A negative i counts from the
+// end. It returns ErrIndexOutOfRange if there is no i-th segment.
+func (u *URL) DelPathSegment(i int) error {
+	p := u.pathSegments()
+	i, ok := segmentIndex(i, len(p.segments))
+	if !ok {
+		return ErrIndexOutOfRange
+	}
+	p.segments = append(p.segments[:i], p.segments[i+1:]...)
+	u.setPathSegments(p)
+	return nil
+}
+
+// AppendPath joins elements onto the path. Elements are split on "/" and
+// each segment is escaped; empty segments are skipped, "." segments are
+// dropped and ".." segments remove the segment before them. The path ends in
+// a slash if the last element does.
+func (u *URL) AppendPath(elem ...string) {
+	if len(elem) == 0 {
+		return
+	}
+	p := u.pathSegments()
+	for _, e := range elem {
+		for _, segment := range strings.Split(e, "/") {
+			switch segment {
+			case "", ".":
+			case "..":
+				if len(p.segments) > 0 {
+					p.segments = p.segments[:len(p.segments)-1]
+				}
+			default:
+				p.segments = append(p.segments, url.PathEscape(segment))
+			}
+		}
+	}
+	p.trailing = strings.HasSuffix(elem[len(elem)-1], "/")
+	u.setPathSegments(p)
+}
+
+// PopPath removes the last path segment and returns it unescaped, or returns
+// "" if the path has no segments.
+func (u *URL) PopPath() string {
+	p := u.pathSegments()
+	if len(p.segments) == 0 {
+		return ""
+	}
+	last := p.segments[len(p.segments)-1]
+	p.segments = p.segments[:len(p.segments)-1]
+	u.setPathSegments(p)
+	return unescapeSegment(last)
+}
+
+// unescapeSegment unescapes a path segment, or returns it as it is if it is
+// not validly escaped.
+func unescapeSegment(segment string) string {
+	if unescaped, err := url.PathUnescape(segment); err == nil {
+		return unescaped
+	}
+	return segment
+}
+
+// GetPathSegments retrieves the unescaped segments of the path of a URL.
+//
+// Parameters:
+//
+//	url: The URL from which to retrieve the path segments.
+//
+// Returns:
+//
+//	A slice of strings containing the path segments, and an error if any
+//	occurred. A trailing slash does not add an empty segment.
+//
+// Example:
+//
+//	result, err := GetPathSegments("http://example.com/a%2Fb/c/")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: ["a/b" "c"]
+func GetPathSegments(u string) ([]string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return nil, err
+	}
+	return parsedURL.GetPathSegments(), nil
+}
+
+// SetPathSegment replaces a segment of the path of a URL and returns the new
+// URL.
+//
+// Parameters:
+//
+//	url: The URL in which to set the path segment.
+//	i: The index of the segment. A negative index counts from the end.
+//	value: The new segment. It is escaped, so a "/" stays in the segment.
+//
+// Returns:
+//
+//	A string containing the new URL, and an error if any occurred.
+//	ErrIndexOutOfRange is returned if the path has no such segment.
+//
+// Example:
+//
+//	result, err := SetPathSegment("http://example.com/users/42/", -1, "43")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "http://example.com/users/43/"
+func SetPathSegment(u string, i int, value string) (string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", err
+	}
+	if err := parsedURL.SetPathSegment(i, value); err != nil {
+		return "", err
+	}
+	return parsedURL.String(), nil
+}
+
+// InsertPathSegment inserts a segment into the path of a URL and returns the
+// new URL.
+//
+// Parameters:
+//
+//	url: The URL in which to insert the path segment.
+//	i: The index the segment is inserted at. The number of segments appends
+//	it, and a negative index counts from the end.
+//	value: The segment to insert. It is escaped, so a "/" stays in the
+//	segment.
+//
+// Returns:
+//
+//	A string containing the new URL, and an error if any occurred.
+//	ErrIndexOutOfRange is returned if the index is out of range.
+//
+// Example:
+//
+//	result, err := InsertPathSegment("http://example.com/users/42", 0, "v2")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "http://example.com/v2/users/42"
+func InsertPathSegment(u string, i int, value string) (string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", err
+	}
+	if err := parsedURL.InsertPathSegment(i, value); err != nil {
+		return "", err
+	}
+	return parsedURL.String(), nil
+}
+
+// DelPathSegment deletes a segment from the path of a URL and returns the
+// new URL.
+//
+// Parameters:
+//
+//	url: The URL from which to delete the path segment.
+//	i: The index of the segment. A negative index counts from the end.
+//
+// Returns:
+//
+//	A string containing the new URL, and an error if any occurred.
+//	ErrIndexOutOfRange is returned if the path has no such segment.
+//
+// Example:
+//
+//	result, err := DelPathSegment("http://example.com/v2/users/42", 0)
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "http://example.com/users/42"
+func DelPathSegment(u string, i int) (string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", err
+	}
+	if err := parsedURL.DelPathSegment(i); err != nil {
+		return "", err
+	}
+	return parsedURL.String(), nil
+}
+
+// AppendPath joins elements onto the path of a URL and returns the new URL.
+//
+// Parameters:
+//
+//	url: The URL to which to append the path.
+//	elem: The path elements to join. They are split on "/", empty, "." and
+//	".." segments are resolved, and each segment is escaped. The path ends
+//	in a slash if the last element does.
+//
+// Returns:
+//
+//	A string containing the new URL, and an error if any occurred.
+//
+// Example:
+//
+//	result, err := AppendPath("http://example.com/api/", "/users/", "42")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "http://example.com/api/users/42"
+func AppendPath(u string, elem ...string) (string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", err
+	}
+	parsedURL.AppendPath(elem...)
+	return parsedURL.String(), nil
+}
+
+// PopPath removes the last segment of the path of a URL and returns the new
+// URL.
+//
+// Parameters:
+//
+//	url: The URL from which to remove the last path segment.
+//
+// Returns:
+//
+//	A string containing the new URL, and an error if any occurred. A
+//	trailing slash is kept.
+//
+// Example:
+//
+//	result, err := PopPath("http://example.com/users/42/")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "http://example.com/users/"
+func PopPath(u string) (string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", err
+	}
+	parsedURL.PopPath()
+	return parsedURL.String(), nil
+}
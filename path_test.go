@@ -0,0 +1,139 @@
+package gurl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetPathSegments(t *testing.T) {
+	tests := []struct {
+		url    string
+		result []string
+	}{
+		{"http://example.com/a/b/c", []string{"a", "b", "c"}},
+		{"http://example.com/a%2Fb/c/", []string{"a/b", "c"}},
+		{"http://example.com/a%20b//c", []string{"a b", "", "c"}},
+		{"http://example.com/", []string{}},
+		{"http://example.com", []string{}},
+	}
+	for _, test := range tests {
+		result, err := GetPathSegments(test.url)
+		if err != nil || !reflect.DeepEqual(result, test.result) {
+			t.Errorf("GetPathSegments was incorrect, got: %q, want: %q.", result, test.result)
+		}
+	}
+}
+
+func TestSetPathSegment(t *testing.T) {
+	tests := []struct {
+		url    string
+		i      int
+		value  string
+		result string
+		err    error
+	}{
+		{"http://example.com/users/42", 1, "43", "http://example.com/users/43", nil},
+		{"http://example.com/users/42/", -1, "43", "http://example.com/users/43/", nil},
+		{"http://example.com/a%2Fb/c", 1, "d", "http://example.com/a%2Fb/d", nil},
+		{"http://example.com/a/c", 0, "x/y z", "http://example.com/x%2Fy%20z/c", nil},
+		{"http://example.com/a/c?q=1", -2, "b", "http://example.com/b/c?q=1", nil},
+		{"http://example.com/a", 1, "b", "", ErrIndexOutOfRange},
+		{"http://example.com/a", -2, "b", "", ErrIndexOutOfRange},
+	}
+	for _, test := range tests {
+		result, err := SetPathSegment(test.url, test.i, test.value)
+		if err != test.err || result != test.result {
+			t.Errorf("SetPathSegment was incorrect, got: %s, %v, want: %s, %v.", result, err, test.result, test.err)
+		}
+	}
+}
+
+func TestInsertPathSegment(t *testing.T) {
+	tests := []struct {
+		url    string
+		i      int
+		value  string
+		result string
+		err    error
+	}{
+		{"http://example.com/users/42", 0, "v2", "http://example.com/v2/users/42", nil},
+		{"http://example.com/users/42/", 2, "edit", "http://example.com/users/42/edit/", nil},
+		{"http://example.com/users/42", -1, "id", "http://example.com/users/id/42", nil},
+		{"http://example.com/a%2Fb", 0, "c", "http://example.com/c/a%2Fb", nil},
+		{"http://example.com", 0, "a", "http://example.com/a", nil},
+		{"http://example.com/a", 2, "b", "", ErrIndexOutOfRange},
+		{"http://example.com/a", -2, "b", "", ErrIndexOutOfRange},
+	}
+	for _, test := range tests {
+		result, err := InsertPathSegment(test.url, test.i, test.value)
+		if err != test.err || result != test.result {
+			t.Errorf("InsertPathSegment was incorrect, got: %s, %v, want: %s, %v.", result, err, test.result, test.err)
+		}
+	}
+}
+
+func TestDelPathSegment(t *testing.T) {
+	tests := []struct {
+		url    string
+		i      int
+		result string
+		err    error
+	}{
+		{"http://example.com/v2/users/42", 0, "http://example.com/users/42", nil},
+		{"http://example.com/users/42/", -1, "http://example.com/users/", nil},
+		{"http://example.com/a%2Fb/c", 1, "http://example.com/a%2Fb", nil},
+		{"http://example.com/a", 0, "http://example.com/", nil},
+		{"http://example.com/", 0, "", ErrIndexOutOfRange},
+	}
+	for _, test := range tests {
+		result, err := DelPathSegment(test.url, test.i)
+		if err != test.err || result != test.result {
+			t.Errorf("DelPathSegment was incorrect, got: %s, %v, want: %s, %v.", result, err, test.result, test.err)
+		}
+	}
+}
+
+func TestAppendPath(t *testing.T) {
+	tests := []struct {
+		url    string
+		elem   []string
+		result string
+	}{
+		{"http://example.com/api/", []string{"/users/", "42"}, "http://example.com/api/users/42"},
+		{"http://example.com/api", []string{"users/"}, "http://example.com/api/users/"},
+		{"http://example.com", []string{"a b", "c?d"}, "http://example.com/a%20b/c%3Fd"},
+		{"http://example.com/a%2Fb", []string{"c"}, "http://example.com/a%2Fb/c"},
+		{"http://example.com/a/b", []string{"../c", "./d"}, "http://example.com/a/c/d"},
+		{"http://example.com/a?q=1#top", []string{"b"}, "http://example.com/a/b?q=1#top"},
+	}
+	for _, test := range tests {
+		result, err := AppendPath(test.url, test.elem...)
+		if err != nil || result != test.result {
+			t.Errorf("AppendPath was incorrect, got: %s, want: %s.", result, test.result)
+		}
+	}
+}
+
+func TestPopPath(t *testing.T) {
+	tests := []struct {
+		url    string
+		result string
+	}{
+		{"http://example.com/users/42", "http://example.com/users"},
+		{"http://example.com/users/42/", "http://example.com/users/"},
+		{"http://example.com/a%2Fb/c", "http://example.com/a%2Fb"},
+		{"http://example.com/a", "http://example.com/"},
+		{"http://example.com/", "http://example.com/"},
+	}
+	for _, test := range tests {
+		result, err := PopPath(test.url)
+		if err != nil || result != test.result {
+			t.Errorf("PopPath was incorrect, got: %s, want: %s.", result, test.result)
+		}
+	}
+
+	parsedURL, _ := Parse("http://example.com/files/a%2Fb")
+	if segment := parsedURL.PopPath(); segment != "a/b" {
+		t.Errorf("URL.PopPath was incorrect, got: %s, want: %s.", segment, "a/b")
+	}
+}
@@ -0,0 +1,341 @@
+package gurl
+
+//go:generate go run gen_publicsuffix.go -input public_suffix_list.dat
+
+import (
+	_ "embed"
+	"errors"
+	"strings"
+	"sync"
+)
+
+// ErrNoRegistrableDomain is returned when a host has no registrable domain:
+// it is empty, an IP address, or itself a public suffix such as "co.uk".
+var ErrNoRegistrableDomain = errors.New("gurl: no registrable domain")
+
+// publicSuffixData is a snapshot of the Public Suffix List, written by
+// gen_publicsuffix.go.
+//
+//go:embed publicsuffix.dat
+var publicSuffixData string
+
+// The kinds of Public Suffix List rules, as bits of suffixRule.
+const (
+	ruleNormal uint8 = 1 << iota
+	ruleWildcard
+	ruleException
+)
+
+// suffixRule holds the kinds of rule listed for a name, and which of them
+// come from the private section.
+type suffixRule struct {
+	kinds   uint8
+	private uint8
+}
+
+var (
+	publicSuffixOnce  sync.Once
+	publicSuffixRules map[string]suffixRule
+)
+
+// loadPublicSuffixes parses the embedded list. Names are stored in ASCII
+// form, without the "*." or "!" of wildcard and exception rules.
+func loadPublicSuffixes() {
+	publicSuffixRules = make(map[string]suffixRule)
+	private := false
+	for _, line := range strings.Split(publicSuffixData, "\n") {
+		if line == "// ===BEGIN PRIVATE DOMAINS===" {
+			private = true
+		}
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		kind := ruleNormal
+		switch {
+		case strings.HasPrefix(line, "!"):
+			kind, line = ruleException, line[1:]
+		case strings.HasPrefix(line, "*."):
+			kind, line = ruleWildcard, line[2:]
+		}
+		if !isASCII(line) {
+			ascii, err := ToASCII(line)
+			if err != nil {
+				continue
+			}
+			line = ascii
+		}
+
+		rule := publicSuffixRules[line]
+		rule.kinds |= kind
+		if private {
+			rule.private |= kind
+		}
+		publicSuffixRules[line] = rule
+	}
+}
+
+// publicSuffixLength returns how many of the trailing labels form the
+// public suffix, and whether the matching rule is from the ICANN section.
+// The labels must be in lowercase ASCII form. A name that matches no rule
+// has its last label as public suffix.
+func publicSuffixLength(labels []string) (int, bool) {
+	publicSuffixOnce.Do(loadPublicSuffixes)
+	for i := range labels {
+		rule := publicSuffixRules[strings.Join(labels[i:], ".")]
+		if rule.kinds&ruleException != 0 {
+			return len(labels) - i - 1, rule.private&ruleException == 0
+		}
+		if rule.kinds&ruleNormal != 0 {
+			return len(labels) - i, rule.private&ruleNormal == 0
+		}
+		if i+1 < len(labels) {
+			parent := publicSuffixRules[strings.Join(labels[i+1:], ".")]
+			if parent.kinds&ruleWildcard != 0 {
+				return len(labels) - i, parent.private&ruleWildcard == 0
+			}
+		}
+	}
+	return 1, false
+}
+
+// splitDomain lowercases domain, drops a trailing dot and splits it into
+// labels. The labels keep the form of domain, ASCII or Unicode, and are
+// matched against the list in ASCII form. It returns false for an empty
+// domain, an IP address or an empty label.
+func splitDomain(domain string) (labels []string, n int, icann bool, ok bool) {
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	if domain == "" || isIPLiteral(domain) {
+		return nil, 0, false, false
+	}
+	labels = strings.Split(domain, ".")
+	ascii := labels
+	if !isASCII(domain) {
+		if converted, err := ToASCII(domain); err == nil {
+			ascii = strings.Split(converted, ".")
+		}
+		if len(ascii) != len(labels) {
+			labels = ascii
+		}
+	}
+	for _, label := range ascii {
+		if label == "" {
+			return nil, 0, false, false
+		}
+	}
+	n, icann = publicSuffixLength(ascii)
+	return labels, n, icann, true
+}
+
+// PublicSuffix returns the public suffix of a domain name according to the
+// embedded Public Suffix List, and whether it is from the ICANN section of
+// the list rather than the private section.
+//
+// Parameters:
+//
+//	domain: The domain name, in ASCII or Unicode form.
+//
+// Returns:
+//
+//	A string containing the public suffix, in the form of domain, or "" if
+//	domain is empty or an IP address, and true if the suffix is managed by
+//	ICANN. A top-level domain that is not listed is its own public suffix.
+//
+// Example:
+//
+//	result, icann := PublicSuffix("www.example.github.io")
+//	fmt.Println(result, icann) // Output: "github.io false"
+func PublicSuffix(domain string) (string, bool) {
+	labels, n, icann, ok := splitDomain(domain)
+	if !ok {
+		return "", false
+	}
+	return strings.Join(labels[len(labels)-n:], "."), icann
+}
+
+// RegistrableDomain returns the registrable domain of a domain name: its
+// public suffix and the label before it.
+//
+// Parameters:
+//
+//	domain: The domain name, in ASCII or Unicode form.
+//
+// Returns:
+//
+//	A string containing the registrable domain, in the form of domain, and
+//	ErrNoRegistrableDomain if domain has none.
+//
+// Example:
+//
+//	result, err := RegistrableDomain("a.b.example.co.uk")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "example.co.uk"
+func RegistrableDomain(domain string) (string, error) {
+	labels, n, _, ok := splitDomain(domain)
+	if !ok || n >= len(labels) {
+		return "", ErrNoRegistrableDomain
+	}
+	return strings.Join(labels[len(labels)-n-1:], "."), nil
+}
+
+// GetPublicSuffix returns the public suffix of the host, such as "co.uk".
+// It returns ErrNoRegistrableDomain if the host is empty or an IP address.
+func (u *URL) GetPublicSuffix() (string, error) {
+	suffix, _ := PublicSuffix(u.url.Hostname())
+	if suffix == "" {
+		return "", ErrNoRegistrableDomain
+	}
+	return suffix, nil
+}
+
+// GetRegistrableDomain returns the registrable domain of the host, such as
+// "example.co.uk".
+func (u *URL) GetRegistrableDomain() (string, error) {
+	return RegistrableDomain(u.url.Hostname())
+}
+
+// GetSubdomain returns the labels of the host before its registrable
+// domain, such as "a.b", or "" if there are none.
+func (u *URL) GetSubdomain() (string, error) {
+	labels, n, _, ok := splitDomain(u.url.Hostname())
+	if !ok || n >= len(labels) {
+		return "", ErrNoRegistrableDomain
+	}
+	return strings.Join(labels[:len(labels)-n-1], "."), nil
+}
+
+// SetSubdomain replaces the labels of the host before its registrable
+// domain, keeping the port. An empty subdomain removes them.
+func (u *URL) SetSubdomain(subdomain string) error {
+	hostname := u.url.Hostname()
+	labels, n, _, ok := splitDomain(hostname)
+	if !ok || n >= len(labels) {
+		return ErrNoRegistrableDomain
+	}
+	newHostname := strings.Join(labels[len(labels)-n-1:], ".")
+	if subdomain != "" {
+		newHostname = subdomain + "." + newHostname
+	}
+	if strings.HasSuffix(hostname, ".") {
+		newHostname += "."
+	}
+	u.SetHostname(newHostname)
+	return nil
+}
+
+// GetPublicSuffix retrieves the public suffix of the host of a URL, using
+// the embedded Public Suffix List with both its ICANN and private sections.
+//
+// Parameters:
+//
+//	url: The URL from which to retrieve the public suffix.
+//
+// Returns:
+//
+//	A string containing the public suffix, and an error if any occurred.
+//	ErrNoRegistrableDomain is returned if the host is empty or an IP address.
+//
+// Example:
+//
+//	result, err := GetPublicSuffix("https://a.b.example.co.uk/path")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "co.uk"
+func GetPublicSuffix(u string) (string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", err
+	}
+	return parsedURL.GetPublicSuffix()
+}
+
+// GetRegistrableDomain retrieves the registrable domain of the host of a
+// URL: its public suffix and the label before it.
+//
+// Parameters:
+//
+//	url: The URL from which to retrieve the registrable domain.
+//
+// Returns:
+//
+//	A string containing the registrable domain, and an error if any
+//	occurred. ErrNoRegistrableDomain is returned if the host is empty, an IP
+//	address or a public suffix.
+//
+// Example:
+//
+//	result, err := GetRegistrableDomain("https://a.b.example.co.uk/path")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "example.co.uk"
+func GetRegistrableDomain(u string) (string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", err
+	}
+	return parsedURL.GetRegistrableDomain()
+}
+
+// GetSubdomain retrieves the labels of the host of a URL before its
+// registrable domain.
+//
+// Parameters:
+//
+//	url: The URL from which to retrieve the subdomain.
+//
+// Returns:
+//
+//	A string containing the subdomain, or "" if there is none, and an error
+//	if any occurred. ErrNoRegistrableDomain is returned if the host is empty,
+//	an IP address or a public suffix.
+//
+// Example:
+//
+//	result, err := GetSubdomain("https://a.b.example.co.uk/path")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "a.b"
+func GetSubdomain(u string) (string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", err
+	}
+	return parsedURL.GetSubdomain()
+}
+
+// SetSubdomain replaces the labels of the host of a URL before its
+// registrable domain, and returns the new URL.
+//
+// Parameters:
+//
+//	url: The URL in which to set the subdomain.
+//	subdomain: The subdomain to set, or "" to remove it.
+//
+// Returns:
+//
+//	A string containing the new URL, and an error if any occurred.
+//	ErrNoRegistrableDomain is returned if the host is empty, an IP address
+//	or a public suffix.
+//
+// Example:
+//
+//	result, err := SetSubdomain("https://a.b.example.co.uk:8080/path", "www")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "https://www.example.co.uk:8080/path"
+func SetSubdomain(u, subdomain string) (string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", err
+	}
+	if err := parsedURL.SetSubdomain(subdomain); err != nil {
+		return "", err
+	}
+	return parsedURL.String(), nil
+}
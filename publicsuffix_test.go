@@ -0,0 +1,145 @@
+package gurl
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestPublicSuffix(t *testing.T) {
+	tests := []struct {
+		domain string
+		suffix string
+		icann  bool
+	}{
+		{"www.example.co.uk", "co.uk", true},
+		{"example.COM", "com", true},
+		{"foo.github.io", "github.io", false},
+		{"example.unlisted", "unlisted", false},
+		{"a.b.c.kobe.jp", "c.kobe.jp", true},
+		{"www.city.kobe.jp", "kobe.jp", true},
+		{"www.ck", "ck", true},
+		{"example.com.", "com", true},
+		{"xn--85x722f.xn--55qx5d.cn", "xn--55qx5d.cn", true},
+		{"食狮.公司.cn", "公司.cn", true},
+		{"192.168.0.1", "", false},
+		{"", "", false},
+	}
+
+	for _, test := range tests {
+		suffix, icann := PublicSuffix(test.domain)
+		if suffix != test.suffix || icann != test.icann {
+			t.Errorf("PublicSuffix(%q) was incorrect, got: %s, %t, want: %s, %t.", test.domain, suffix, icann, test.suffix, test.icann)
+		}
+	}
+}
+
+// TestPublicSuffixList runs the checkPublicSuffix tests of the Public Suffix
+// List in testdata/test_psl.txt.
+func TestPublicSuffixList(t *testing.T) {
+	file, err := os.Open("testdata/test_psl.txt")
+	if err != nil {
+		t.Fatalf("Opening test data returned an error: %v.", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "checkPublicSuffix(") {
+			continue
+		}
+		args := strings.Split(strings.TrimSuffix(strings.TrimPrefix(line, "checkPublicSuffix("), ");"), ",")
+		domain := strings.Trim(strings.TrimSpace(args[0]), "'")
+		want := strings.Trim(strings.TrimSpace(args[1]), "'")
+		if domain == "null" {
+			continue
+		}
+
+		result, err := RegistrableDomain(domain)
+		switch {
+		case want == "null" && err != ErrNoRegistrableDomain:
+			t.Errorf("RegistrableDomain(%q) was incorrect, got: %s, %v, want: %v.", domain, result, err, ErrNoRegistrableDomain)
+		case want != "null" && (err != nil || result != want):
+			t.Errorf("RegistrableDomain(%q) was incorrect, got: %s, %v, want: %s.", domain, result, err, want)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("Reading test data returned an error: %v.", err)
+	}
+}
+
+func TestGetRegistrableDomain(t *testing.T) {
+	tests := []struct {
+		url    string
+		result string
+		err    error
+	}{
+		{"https://a.b.example.co.uk/path", "example.co.uk", nil},
+		{"https://user@WWW.Example.com:8080/", "example.com", nil},
+		{"https://co.uk/", "", ErrNoRegistrableDomain},
+		{"https://127.0.0.1/", "", ErrNoRegistrableDomain},
+		{"https://[::1]/", "", ErrNoRegistrableDomain},
+		{"file:///etc/hosts", "", ErrNoRegistrableDomain},
+	}
+
+	for _, test := range tests {
+		result, err := GetRegistrableDomain(test.url)
+		if err != test.err || result != test.result {
+			t.Errorf("GetRegistrableDomain was incorrect, got: %s, %v, want: %s, %v.", result, err, test.result, test.err)
+		}
+	}
+}
+
+func TestGetSubdomain(t *testing.T) {
+	tests := []struct {
+		url    string
+		result string
+		err    error
+	}{
+		{"https://a.b.example.co.uk/path", "a.b", nil},
+		{"https://example.co.uk/path", "", nil},
+		{"https://user.github.io/", "", nil},
+		{"https://co.uk/", "", ErrNoRegistrableDomain},
+	}
+
+	for _, test := range tests {
+		result, err := GetSubdomain(test.url)
+		if err != test.err || result != test.result {
+			t.Errorf("GetSubdomain was incorrect, got: %s, %v, want: %s, %v.", result, err, test.result, test.err)
+		}
+	}
+}
+
+func TestGetPublicSuffix(t *testing.T) {
+	result, err := GetPublicSuffix("https://a.b.example.co.uk/path")
+	if err != nil || result != "co.uk" {
+		t.Errorf("GetPublicSuffix was incorrect, got: %s, %v, want: %s.", result, err, "co.uk")
+	}
+	if _, err := GetPublicSuffix("https://10.0.0.1/"); err != ErrNoRegistrableDomain {
+		t.Errorf("GetPublicSuffix was incorrect, got: %v, want: %v.", err, ErrNoRegistrableDomain)
+	}
+}
+
+func TestSetSubdomain(t *testing.T) {
+	tests := []struct {
+		url       string
+		subdomain string
+		result    string
+		err       error
+	}{
+		{"https://a.b.example.co.uk:8080/path", "www", "https://www.example.co.uk:8080/path", nil},
+		{"https://example.com/path", "api.v2", "https://api.v2.example.com/path", nil},
+		{"https://www.example.com/path", "", "https://example.com/path", nil},
+		{"https://www.example.com./path", "cdn", "https://cdn.example.com./path", nil},
+		{"https://co.uk/path", "www", "", ErrNoRegistrableDomain},
+	}
+
+	for _, test := range tests {
+		result, err := SetSubdomain(test.url, test.subdomain)
+		if err != test.err || result != test.result {
+			t.Errorf("SetSubdomain was incorrect, got: %s, %v, want: %s, %v.", result, err, test.result, test.err)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package gurl
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrInvalidPunycode is returned when a string is not valid Punycode, or
+// when encoding or decoding it would overflow.
+var ErrInvalidPunycode = errors.New("gurl: invalid punycode")
+
+// Punycode parameters from RFC 3492, section 5.
+const (
+	punyBase        = 36
+	punyTMin        = 1
+	punyTMax        = 26
+	punySkew        = 38
+	punyDamp        = 700
+	punyInitialBias = 72
+	punyInitialN    = 128
+	punyMaxInt      = math.MaxInt32
+)
+
+// EncodePunycode encodes a Unicode string with the Punycode algorithm of
+// RFC 3492. The "xn--" prefix used by IDNA is not added.
+//
+// Parameters:
+//
+//	s: The string to encode.
+//
+// Returns:
+//
+//	The Punycode string and an error if the string cannot be encoded.
+//
+// Example:
+//
+//	result, err := EncodePunycode("münchen")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "mnchen-3ya"
+func EncodePunycode(s string) (string, error) {
+	if !utf8.ValidString(s) {
+		return "", ErrInvalidPunycode
+	}
+	runes := []rune(s)
+
+	var b strings.Builder
+	for _, r := range runes {
+		if r < 0x80 {
+			b.WriteByte(byte(r))
+		}
+	}
+	basic := b.Len()
+	handled := basic
+	if basic > 0 {
+		b.WriteByte('-')
+	}
+
+	n, delta, bias := punyInitialN, 0, punyInitialBias
+	for handled < len(runes) {
+		m := punyMaxInt
+		for _, r := range runes {
+			if int(r) >= n && int(r) < m {
+				m = int(r)
+			}
+		}
+		if m-n > (punyMaxInt-delta)/(handled+1) {
+			return "", ErrInvalidPunycode
+		}
+		delta += (m - n) * (handled + 1)
+		n = m
+
+		for _, r := range runes {
+			if int(r) < n {
+				delta++
+				if delta == punyMaxInt {
+					return "", ErrInvalidPunycode
+				}
+			}
+			if int(r) != n {
+				continue
+			}
+			q := delta
+			for k := punyBase; ; k += punyBase {
+				t := punyThreshold(k, bias)
+				if q < t {
+					break
+				}
+				b.WriteByte(punyDigit(t + (q-t)%(punyBase-t)))
+				q = (q - t) / (punyBase - t)
+			}
+			b.WriteByte(punyDigit(q))
+			bias = punyAdapt(delta, handled+1, handled == basic)
+			delta = 0
+			handled++
+		}
+		delta++
+		n++
+	}
+	return b.String(), nil
+}
+
+// DecodePunycode decodes a string encoded with the Punycode algorithm of
+// RFC 3492. The string must not have the "xn--" prefix used by IDNA.
+//
+// Parameters:
+//
+//	s: The Punycode string to decode.
+//
+// Returns:
+//
+//	The decoded Unicode string and an error if s is not valid Punycode.
+//
+// Example:
+//
+//	result, err := DecodePunycode("mnchen-3ya")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "münchen"
+func DecodePunycode(s string) (string, error) {
+	var output []rune
+	pos := 0
+	if d := strings.LastIndexByte(s, '-'); d >= 0 {
+		for i := 0; i < d; i++ {
+			if s[i] >= 0x80 {
+				return "", ErrInvalidPunycode
+			}
+			output = append(output, rune(s[i]))
+		}
+		pos = d + 1
+	}
+
+	n, i, bias := punyInitialN, 0, punyInitialBias
+	for pos < len(s) {
+		oldi, w := i, 1
+		for k := punyBase; ; k += punyBase {
+			if pos >= len(s) {
+				return "", ErrInvalidPunycode
+			}
+			digit, ok := punyDigitValue(s[pos])
+			pos++
+			if !ok || digit > (punyMaxInt-i)/w {
+				return "", ErrInvalidPunycode
+			}
+			i += digit * w
+			t := punyThreshold(k, bias)
+			if digit < t {
+				break
+			}
+			if w > punyMaxInt/(punyBase-t) {
+				return "", ErrInvalidPunycode
+			}
+			w *= punyBase - t
+		}
+
+		length := len(output) + 1
+		bias = punyAdapt(i-oldi, length, oldi == 0)
+		if i/length > punyMaxInt-n {
+			return "", ErrInvalidPunycode
+		}
+		n += i / length
+		i %= length
+		if n > utf8.MaxRune || (n >= 0xD800 && n <= 0xDFFF) {
+			return "", ErrInvalidPunycode
+		}
+
+		output = append(output, 0)
+		copy(output[i+1:], output[i:])
+		output[i] = rune(n)
+		i++
+	}
+	return string(output), nil
+}
+
+// punyThreshold returns the threshold t for the digit at position k.
+func punyThreshold(k, bias int) int {
+	switch {
+	case k <= bias:
+		return punyTMin
+	case k >= bias+punyTMax:
+		return punyTMax
+	}
+	return k - bias
+}
+
+// punyAdapt is the bias adaptation function of RFC 3492, section 6.1.
+func punyAdapt(delta, numPoints int, firstTime bool) int {
+	if firstTime {
+		delta /= punyDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+	k := 0
+	for delta > ((punyBase-punyTMin)*punyTMax)/2 {
+		delta /= punyBase - punyTMin
+		k += punyBase
+	}
+	return k + (punyBase-punyTMin+1)*delta/(delta+punySkew)
+}
+
+func punyDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
+
+func punyDigitValue(c byte) (int, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return int(c-'0') + 26, true
+	case 'a' <= c && c <= 'z':
+		return int(c - 'a'), true
+	case 'A' <= c && c <= 'Z':
+		return int(c - 'A'), true
+	}
+	return 0, false
+}
@@ -0,0 +1,122 @@
+package gurl
+
+import (
+	"net/url"
+	"strings"
+)
+
+// queryToken is one "key=value" pair of a raw query, kept together with its
+// original bytes so that it can be written back unchanged.
+type queryToken struct {
+	key    string
+	value  string
+	rawKey string
+	raw    string
+}
+
+// queryTokens is a raw query split on "&", in its original order.
+type queryTokens []queryToken
+
+func parseQueryTokens(rawQuery string) queryTokens {
+	if rawQuery == "" {
+		return nil
+	}
+	parts := strings.Split(rawQuery, "&")
+	tokens := make(queryTokens, 0, len(parts))
+	for _, p := range parts {
+		rawKey, rawValue, _ := strings.Cut(p, "=")
+		tokens = append(tokens, queryToken{
+			key:    queryUnescape(rawKey),
+			value:  queryUnescape(rawValue),
+			rawKey: rawKey,
+			raw:    p,
+		})
+	}
+	return tokens
+}
+
+// queryUnescape decodes s, or returns it unchanged if it is not a valid
+// escaped query component.
+func queryUnescape(s string) string {
+	unescaped, err := url.QueryUnescape(s)
+	if err != nil {
+		return s
+	}
+	return unescaped
+}
+
+func newQueryToken(rawKey, key, value string) queryToken {
+	return queryToken{
+		key:    key,
+		value:  value,
+		rawKey: rawKey,
+		raw:    rawKey + "=" + url.QueryEscape(value),
+	}
+}
+
+func (tokens queryTokens) encode() string {
+	raws := make([]string, len(tokens))
+	for i, t := range tokens {
+		raws[i] = t.raw
+	}
+	return strings.Join(raws, "&")
+}
+
+func (tokens queryTokens) get(param string) []string {
+	var values []string
+	for _, t := range tokens {
+		if t.key == param {
+			values = append(values, t.value)
+		}
+	}
+	return values
+}
+
+// set replaces the first value of param where it sits and drops the others.
+// A missing param is appended.
+func (tokens queryTokens) set(param, value string) queryTokens {
+	var newTokens queryTokens
+	paramExists := false
+	for _, t := range tokens {
+		if t.key != param {
+			newTokens = append(newTokens, t)
+		} else if !paramExists {
+			newTokens = append(newTokens, newQueryToken(t.rawKey, param, value))
+			paramExists = true
+		}
+	}
+	if !paramExists {
+		newTokens = append(newTokens, newQueryToken(url.QueryEscape(param), param, value))
+	}
+	return newTokens
+}
+
+func (tokens queryTokens) add(param, value string) queryTokens {
+	return append(tokens, newQueryToken(url.QueryEscape(param), param, value))
+}
+
+// filter returns the tokens for which keep reports true.
+func (tokens queryTokens) filter(keep func(queryToken) bool) queryTokens {
+	var newTokens queryTokens
+	for _, t := range tokens {
+		if keep(t) {
+			newTokens = append(newTokens, t)
+		}
+	}
+	return newTokens
+}
+
+func (tokens queryTokens) setAt(param string, i int, value string) error {
+	n := 0
+	for j, t := range tokens {
+		if t.key != param {
+			continue
+		}
+		if n == i {
+			tokens[j] = newQueryToken(t.rawKey, param, value)
+			return nil
+		}
+		n++
+	}
+	return ErrIndexOutOfRange
+}
@@ -0,0 +1,576 @@
+package gurl
+
+import (
+	"encoding"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidQueryStruct is returned when DecodeQuery is not given a non-nil
+// pointer to a struct, or EncodeQuery is not given a struct.
+var ErrInvalidQueryStruct = errors.New("gurl: not a struct or a pointer to a struct")
+
+// ErrUnsupportedQueryType is returned when a struct field has a type that
+// cannot be converted to or from a query parameter. It is wrapped in a
+// *QueryFieldError.
+var ErrUnsupportedQueryType = errors.New("gurl: unsupported query field type")
+
+// QueryFieldError describes why a struct field could not be decoded from, or
+// encoded to, a query parameter.
+type QueryFieldError struct {
+	// Field is the name of the struct field, after the names of the
+	// embedded structs it is promoted from, such as "Paging.Page".
+	Field string
+	// Param is the query parameter of the field.
+	Param string
+	// Value is the parameter value that could not be decoded. It is empty
+	// when encoding.
+	Value string
+	// Err is the underlying error, such as a *strconv.NumError or
+	// ErrUnsupportedQueryType.
+	Err error
+}
+
+func (e *QueryFieldError) Error() string {
+	return "gurl: query parameter " + strconv.Quote(e.Param) + " of field " + e.Field + ": " + e.Err.Error()
+}
+
+func (e *QueryFieldError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// queryParams are the parameters that struct fields are decoded from and
+// encoded to: those of the query, or those of the fragment.
+type queryParams interface {
+	GetAll(param string) []string
+	Set(param, value string)
+	Add(param, value string)
+	Del(param string)
+}
+
+// urlQuery gives the query of a URL the methods of queryParams.
+type urlQuery struct {
+	u *URL
+}
+
+func (q urlQuery) GetAll(param string) []string { return q.u.GetQueryParams(param) }
+func (q urlQuery) Set(param, value string)      { q.u.SetQueryParam(param, value) }
+func (q urlQuery) Add(param, value string)      { q.u.AddQueryParam(param, value) }
+func (q urlQuery) Del(param string)             { q.u.DelQueryParam(param) }
+
+// queryField is a struct field that is a query parameter.
+type queryField struct {
+	name      string
+	field     string
+	index     []int
+	omitempty bool
+	comma     bool
+	layout    string
+	// tagged records that the name is from the "url" tag.
+	tagged bool
+}
+
+// queryFields returns the fields of the struct type t, with the fields of
+// embedded structs promoted. A field is named after its "url" tag:
+//
+//	Page   int       `url:"page"`              // the param "page"
+//	Tags   []string  `url:"tag,omitempty"`     // repeated "tag" params, left out when empty
+//	IDs    []int     `url:"ids,comma"`         // one "ids" param, comma-joined
+//	Since  time.Time `url:"since" layout:"2006-01-02"`
+//	Secret string    `url:"-"`                 // ignored
+//
+// A field without a name in its tag uses the field name. Fields of the same
+// name follow the rules of encoding/json: the shallowest one wins, or at the
+// same depth the only tagged one, and otherwise they all hide each other.
+func queryFields(t reflect.Type) []queryField {
+	var fields []queryField
+	// walking holds the embedded struct types being walked, so that a
+	// struct embedding a pointer to itself is walked once.
+	walking := map[reflect.Type]bool{}
+	var walk func(t reflect.Type, index []int, prefix string)
+	walk = func(t reflect.Type, index []int, prefix string) {
+		walking[t] = true
+		defer delete(walking, t)
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			tag := f.Tag.Get("url")
+			if tag == "-" {
+				continue
+			}
+			name, opts, _ := strings.Cut(tag, ",")
+			fieldIndex := append(append([]int(nil), index...), i)
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if f.Anonymous && name == "" && ft.Kind() == reflect.Struct && !isQueryScalar(ft) {
+				if (f.Type.Kind() != reflect.Ptr || f.IsExported()) && !walking[ft] {
+					walk(ft, fieldIndex, prefix+f.Name+".")
+				}
+				continue
+			}
+			if !f.IsExported() {
+				continue
+			}
+			field := queryField{name: name, field: prefix + f.Name, index: fieldIndex, layout: f.Tag.Get("layout"), tagged: name != ""}
+			if name == "" {
+				field.name = f.Name
+			}
+			for _, opt := range strings.Split(opts, ",") {
+				switch opt {
+				case "omitempty":
+					field.omitempty = true
+				case "comma":
+					field.comma = true
+				}
+			}
+			fields = append(fields, field)
+		}
+	}
+	walk(t, nil, "")
+
+	var visible []queryField
+	for i, f := range fields {
+		dominant := true
+		for j, g := range fields {
+			if i == j || g.name != f.name {
+				continue
+			}
+			if len(g.index) < len(f.index) || len(g.index) == len(f.index) && (g.tagged || !f.tagged) {
+				dominant = false
+				break
+			}
+		}
+		if dominant {
+			visible = append(visible, f)
+		}
+	}
+	return visible
+}
+
+// isQueryScalar reports whether a struct type is a single value rather than
+// a set of fields.
+func isQueryScalar(t reflect.Type) bool {
+	return t == timeType || reflect.PtrTo(t).Implements(textUnmarshalerType) || t.Implements(textMarshalerType)
+}
+
+// fieldByIndex is like reflect.Value.FieldByIndex, but allocates nil
+// embedded pointers if alloc is set. ok is false if it meets a nil pointer
+// it does not allocate.
+func fieldByIndex(v reflect.Value, index []int, alloc bool) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !alloc {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+func decodeQuery(params queryParams, dst interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return ErrInvalidQueryStruct
+	}
+	v = v.Elem()
+	for _, f := range queryFields(v.Type()) {
+		values := params.GetAll(f.name)
+		if len(values) == 0 {
+			continue
+		}
+		fv, _ := fieldByIndex(v, f.index, true)
+		if value, err := f.decode(fv, values); err != nil {
+			return &QueryFieldError{Field: f.field, Param: f.name, Value: value, Err: err}
+		}
+	}
+	return nil
+}
+
+// decode sets the field v from the values of its parameter. It returns the
+// value that could not be decoded with the error.
+func (f *queryField) decode(v reflect.Value, values []string) (string, error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice || isQueryScalar(v.Type()) {
+		return values[0], decodeQueryValue(v, values[0], f.layout)
+	}
+	if f.comma {
+		var split []string
+		for _, value := range values {
+			split = append(split, strings.Split(value, ",")...)
+		}
+		values = split
+	}
+	slice := reflect.MakeSlice(v.Type(), len(values), len(values))
+	for i, value := range values {
+		elem := slice.Index(i)
+		if elem.Kind() == reflect.Ptr {
+			elem.Set(reflect.New(elem.Type().Elem()))
+			elem = elem.Elem()
+		}
+		if err := decodeQueryValue(elem, value, f.layout); err != nil {
+			return value, err
+		}
+	}
+	v.Set(slice)
+	return "", nil
+}
+
+// decodeQueryValue sets v from a single value. An empty value sets the zero
+// value.
+func decodeQueryValue(v reflect.Value, value string, layout string) error {
+	if v.Type() == timeType {
+		if value == "" {
+			v.Set(reflect.Zero(timeType))
+			return nil
+		}
+		if layout == "" {
+			layout = time.RFC3339
+		}
+		t, err := time.Parse(layout, value)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value))
+	}
+	if value == "" && v.Kind() != reflect.String {
+		switch v.Kind() {
+		case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(value, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+	default:
+		return ErrUnsupportedQueryType
+	}
+	return nil
+}
+
+func encodeQuery(params queryParams, src interface{}) error {
+	v := reflect.ValueOf(src)
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return ErrInvalidQueryStruct
+	}
+	for _, f := range queryFields(v.Type()) {
+		var values []string
+		if fv, ok := fieldByIndex(v, f.index, false); ok && !(f.omitempty && fv.IsZero()) {
+			var err error
+			if values, err = f.encode(fv); err != nil {
+				return &QueryFieldError{Field: f.field, Param: f.name, Err: err}
+			}
+		}
+		if len(values) == 0 {
+			params.Del(f.name)
+			continue
+		}
+		params.Set(f.name, values[0])
+		for _, value := range values[1:] {
+			params.Add(f.name, value)
+		}
+	}
+	return nil
+}
+
+// encode returns the values of the parameter of the field v. A nil pointer
+// or an empty slice has none.
+func (f *queryField) encode(v reflect.Value) ([]string, error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice || isQueryScalar(v.Type()) {
+		value, err := encodeQueryValue(v, f.layout)
+		if err != nil {
+			return nil, err
+		}
+		return []string{value}, nil
+	}
+	var values []string
+	for i := 0; i < v.Len(); i++ {
+		elem := v.Index(i)
+		if elem.Kind() == reflect.Ptr {
+			if elem.IsNil() {
+				continue
+			}
+			elem = elem.Elem()
+		}
+		value, err := encodeQueryValue(elem, f.layout)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	if f.comma && len(values) > 0 {
+		values = []string{strings.Join(values, ",")}
+	}
+	return values, nil
+}
+
+func encodeQueryValue(v reflect.Value, layout string) (string, error) {
+	if v.Type() == timeType {
+		if layout == "" {
+			layout = time.RFC3339
+		}
+		return v.Interface().(time.Time).Format(layout), nil
+	}
+	if v.Type().Implements(textMarshalerType) {
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(textMarshalerType) {
+		text, err := v.Addr().Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), nil
+	}
+	return "", ErrUnsupportedQueryType
+}
+
+// DecodeQuery decodes the query of the URL into the struct dst points to.
+// See DecodeQuery for the fields and tags.
+func (u *URL) DecodeQuery(dst interface{}) error {
+	return decodeQuery(urlQuery{u}, dst)
+}
+
+// EncodeQuery sets the query parameters of the fields of the struct src.
+// See EncodeQuery for the fields and tags. The query is left unchanged if a
+// field cannot be encoded.
+func (u *URL) EncodeQuery(src interface{}) error {
+	c := u.Clone()
+	if err := encodeQuery(urlQuery{c}, src); err != nil {
+		return err
+	}
+	u.url.RawQuery = c.url.RawQuery
+	u.query, u.queryDirty, u.tokens = c.query, c.queryDirty, c.tokens
+	return nil
+}
+
+// DecodeHashQuery decodes the hash parameters of the URL into the struct
+// dst points to. See DecodeQuery for the fields and tags.
+func (u *URL) DecodeHashQuery(dst interface{}) error {
+	return decodeQuery(&u.fragment, dst)
+}
+
+// EncodeHashQuery sets the hash parameters of the fields of the struct src.
+// See EncodeQuery for the fields and tags. The fragment is left unchanged if
+// a field cannot be encoded.
+func (u *URL) EncodeHashQuery(src interface{}) error {
+	fra := u.fragment.clone()
+	if err := encodeQuery(&fra, src); err != nil {
+		return err
+	}
+	u.fragment = fra
+	u.syncFragment()
+	return nil
+}
+
+// DecodeQuery decodes the query parameters of a URL into the struct that dst
+// points to.
+//
+// Each exported field is the parameter named in its "url" tag, or after
+// the field if the tag has no name, and a field tagged "-" is ignored. The
+// fields of embedded structs are promoted. A field can be a string, bool,
+// int, uint or float; a time.Time, parsed with the layout of its "layout"
+// tag or else time.RFC3339; a type implementing encoding.TextUnmarshaler; a
+// pointer to one of them; or a slice of them, which takes every value of a
+// repeated parameter, or splits them on "," if the tag has the "comma"
+// option. Fields of missing parameters are left unchanged, and an empty
+// value sets the zero value.
+//
+// Parameters:
+//
+//	u: The URL to decode the query of.
+//	dst: A pointer to the struct to decode into.
+//
+// Returns:
+//
+//	An error if any occurred. A value that cannot be decoded is reported as
+//	a *QueryFieldError naming the field.
+//
+// Example:
+//
+//	var params struct {
+//	  Page int      `url:"page"`
+//	  Tags []string `url:"tag"`
+//	}
+//	err := DecodeQuery("http://example.com/?page=2&tag=a&tag=b", &params)
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(params.Page, params.Tags) // Output: 2 [a b]
+func DecodeQuery(u string, dst interface{}) error {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return err
+	}
+	return parsedURL.DecodeQuery(dst)
+}
+
+// EncodeQuery sets the query parameters of a URL from the fields of a struct,
+// and returns the new URL.
+//
+// The fields and tags are those of DecodeQuery. A field tagged
+// "omitempty" that holds its zero value, a nil pointer and an empty slice
+// delete their parameter. Other parameters of the URL are kept.
+//
+// Parameters:
+//
+//	u: The URL in which to set the query parameters.
+//	src: The struct, or a pointer to it, to encode.
+//
+// Returns:
+//
+//	A string containing the new URL, and an error if any occurred.
+//
+// Example:
+//
+//	params := struct {
+//	  Page int    `url:"page"`
+//	  IDs  []int  `url:"ids,comma"`
+//	  Sort string `url:"sort,omitempty"`
+//	}{Page: 2, IDs: []int{1, 2}}
+//	result, err := EncodeQuery("http://example.com/?sort=name", params)
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "http://example.com/?ids=1%2C2&page=2"
+func EncodeQuery(u string, src interface{}) (string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", err
+	}
+	if err := parsedURL.EncodeQuery(src); err != nil {
+		return "", err
+	}
+	return parsedURL.String(), nil
+}
+
+// DecodeHashQuery decodes the query parameters of the URL fragment into the
+// struct that dst points to, as DecodeQuery does for the query.
+//
+// Parameters:
+//
+//	u: The URL to decode the hash parameters of.
+//	dst: A pointer to the struct to decode into.
+//
+// Returns:
+//
+//	An error if any occurred.
+//
+// Example:
+//
+//	var params struct {
+//	  Tab string `url:"tab"`
+//	}
+//	err := DecodeHashQuery("http://example.com/#/users?tab=posts", &params)
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(params.Tab) // Output: posts
+func DecodeHashQuery(u string, dst interface{}) error {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return err
+	}
+	return parsedURL.DecodeHashQuery(dst)
+}
+
+// EncodeHashQuery sets the query parameters of the URL fragment from the
+// fields of a struct, as EncodeQuery does for the query, and returns the new
+// URL.
+//
+// Parameters:
+//
+//	u: The URL in which to set the hash parameters.
+//	src: The struct, or a pointer to it, to encode.
+//
+// Returns:
+//
+//	A string containing the new URL, and an error if any occurred.
+//
+// Example:
+//
+//	params := struct {
+//	  Tab string `url:"tab"`
+//	}{Tab: "posts"}
+//	result, err := EncodeHashQuery("http://example.com/#/users", params)
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "http://example.com/#/users?tab=posts"
+func EncodeHashQuery(u string, src interface{}) (string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", err
+	}
+	if err := parsedURL.EncodeHashQuery(src); err != nil {
+		return "", err
+	}
+	return parsedURL.String(), nil
+}
@@ -0,0 +1,217 @@
+package gurl
+
+import (
+	"errors"
+	"net"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type queryPaging struct {
+	Page  int `url:"page"`
+	Limit int `url:"limit,omitempty"`
+}
+
+type queryFilter struct {
+	queryPaging
+	Search  string    `url:"q"`
+	Tags    []string  `url:"tag,omitempty"`
+	IDs     []int     `url:"ids,comma,omitempty"`
+	Ratio   float64   `url:"ratio,omitempty"`
+	Active  *bool     `url:"active"`
+	Since   time.Time `url:"since,omitempty" layout:"2006-01-02"`
+	IP      net.IP    `url:"ip,omitempty"`
+	Secret  string    `url:"-"`
+	Default string
+	hidden  string
+}
+
+func TestDecodeQuery(t *testing.T) {
+	var filter queryFilter
+	err := DecodeQuery("http://example.com/?page=2&q=go+url&tag=a&tag=b&ids=1,2&ids=3&ratio=0.5&active=true&since=2024-05-01&ip=10.0.0.1&Secret=x&Default=d&hidden=h", &filter)
+	if err != nil {
+		t.Fatalf("DecodeQuery returned an error: %v.", err)
+	}
+	active := true
+	want := queryFilter{
+		queryPaging: queryPaging{Page: 2},
+		Search:      "go url",
+		Tags:        []string{"a", "b"},
+		IDs:         []int{1, 2, 3},
+		Ratio:       0.5,
+		Active:      &active,
+		Since:       time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		IP:          net.ParseIP("10.0.0.1"),
+		Default:     "d",
+	}
+	if !reflect.DeepEqual(filter, want) {
+		t.Errorf("DecodeQuery was incorrect, got: %+v, want: %+v.", filter, want)
+	}
+
+	// Missing parameters leave their fields unchanged, and empty values set
+	// the zero value.
+	filter = queryFilter{Search: "keep", Ratio: 1}
+	if err := DecodeQuery("http://example.com/?ratio=", &filter); err != nil || filter.Search != "keep" || filter.Ratio != 0 {
+		t.Errorf("DecodeQuery was incorrect, got: %+v, %v.", filter, err)
+	}
+}
+
+func TestDecodeQueryError(t *testing.T) {
+	var filter queryFilter
+	err := DecodeQuery("http://example.com/?page=two", &filter)
+	var fieldErr *QueryFieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Field != "queryPaging.Page" || fieldErr.Param != "page" || fieldErr.Value != "two" {
+		t.Errorf("DecodeQuery was incorrect, got: %v, want: an error about field queryPaging.Page.", err)
+	}
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) {
+		t.Errorf("DecodeQuery was incorrect, got: %v, want: a *strconv.NumError.", err)
+	}
+
+	err = DecodeQuery("http://example.com/?ids=1,x", &filter)
+	if !errors.As(err, &fieldErr) || fieldErr.Field != "IDs" || fieldErr.Value != "x" {
+		t.Errorf("DecodeQuery was incorrect, got: %v, want: an error about field IDs.", err)
+	}
+
+	var unsupported struct {
+		Values map[string]string `url:"values"`
+	}
+	if err := DecodeQuery("http://example.com/?values=a", &unsupported); !errors.Is(err, ErrUnsupportedQueryType) {
+		t.Errorf("DecodeQuery was incorrect, got: %v, want: %v.", err, ErrUnsupportedQueryType)
+	}
+	if err := DecodeQuery("http://example.com/", filter); !errors.Is(err, ErrInvalidQueryStruct) {
+		t.Errorf("DecodeQuery was incorrect, got: %v, want: %v.", err, ErrInvalidQueryStruct)
+	}
+}
+
+func TestEncodeQuery(t *testing.T) {
+	active := false
+	tests := []struct {
+		url    string
+		src    interface{}
+		result string
+	}{
+		{"http://example.com/?q=old&keep=1", queryFilter{
+			queryPaging: queryPaging{Page: 3},
+			Search:      "go url",
+			Tags:        []string{"a", "b"},
+			IDs:         []int{1, 2},
+			Active:      &active,
+			Since:       time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			IP:          net.ParseIP("10.0.0.1"),
+		}, "http://example.com/?Default=&active=false&ids=1%2C2&ip=10.0.0.1&keep=1&page=3&q=go+url&since=2024-05-01&tag=a&tag=b"},
+		{"http://example.com/?page=9&tag=x", &queryFilter{Search: "a"}, "http://example.com/?Default=&page=0&q=a"},
+	}
+	for _, test := range tests {
+		result, err := EncodeQuery(test.url, test.src)
+		if err != nil {
+			t.Errorf("EncodeQuery(%q) returned an error: %v.", test.url, err)
+			continue
+		}
+		if result != test.result {
+			t.Errorf("EncodeQuery(%q) was incorrect, got: %s, want: %s.", test.url, result, test.result)
+		}
+	}
+
+	if _, err := EncodeQuery("http://example.com/", 42); !errors.Is(err, ErrInvalidQueryStruct) {
+		t.Errorf("EncodeQuery was incorrect, got: %v, want: %v.", err, ErrInvalidQueryStruct)
+	}
+}
+
+type queryNode struct {
+	*queryNode
+	Name string `url:"name"`
+}
+
+type queryA struct {
+	ID   string `url:"id"`
+	Name string
+}
+
+type queryB struct {
+	ID   string `url:"id"`
+	Name string `url:"Name"`
+}
+
+type queryConflict struct {
+	queryA
+	queryB
+}
+
+func TestEncodeQueryAtomic(t *testing.T) {
+	u, _ := Parse("http://example.com/?page=1&limit=20&q=old")
+	invalid := struct {
+		Page int        `url:"page"`
+		Bad  complex128 `url:"bad"`
+	}{Page: 2}
+	if err := u.EncodeQuery(invalid); !errors.Is(err, ErrUnsupportedQueryType) {
+		t.Errorf("EncodeQuery was incorrect, got: %v, want: %v.", err, ErrUnsupportedQueryType)
+	}
+	if result, want := u.String(), "http://example.com/?page=1&limit=20&q=old"; result != want {
+		t.Errorf("EncodeQuery changed the URL on error, got: %s, want: %s.", result, want)
+	}
+	if result := u.GetQueryParam("page"); result != "1" {
+		t.Errorf("EncodeQuery changed the query on error, got: %s, want: 1.", result)
+	}
+	u.PreserveQuery(true)
+	if err := u.EncodeQuery(invalid); err == nil {
+		t.Errorf("EncodeQuery was incorrect, got: nil, want: an error.")
+	}
+	if err := u.EncodeQuery(queryPaging{Page: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if result, want := u.String(), "http://example.com/?page=3&q=old"; result != want {
+		t.Errorf("EncodeQuery was incorrect, got: %s, want: %s.", result, want)
+	}
+}
+
+func TestQueryFields(t *testing.T) {
+	result, err := EncodeQuery("http://example.com/", queryNode{Name: "root"})
+	if want := "http://example.com/?name=root"; err != nil || result != want {
+		t.Errorf("EncodeQuery of a self-referential struct was incorrect, got: %s, %v, want: %s.", result, err, want)
+	}
+	// Both "id" fields are tagged at the same depth and hide each other,
+	// while the tagged "Name" of queryB wins over the untagged one.
+	result, err = EncodeQuery("http://example.com/", queryConflict{queryA{"a", "x"}, queryB{"b", "y"}})
+	if want := "http://example.com/?Name=y"; err != nil || result != want {
+		t.Errorf("EncodeQuery of conflicting fields was incorrect, got: %s, %v, want: %s.", result, err, want)
+	}
+}
+
+func TestEncodeQueryPreserve(t *testing.T) {
+	u, _ := Parse("http://example.com/?z=1&page=1&a=2")
+	u.PreserveQuery(true)
+	if err := u.EncodeQuery(queryPaging{Page: 5}); err != nil {
+		t.Fatal(err)
+	}
+	if result := u.String(); result != "http://example.com/?z=1&page=5&a=2" {
+		t.Errorf("EncodeQuery was incorrect, got: %s, want: %s.", result, "http://example.com/?z=1&page=5&a=2")
+	}
+}
+
+func TestHashQuery(t *testing.T) {
+	var paging queryPaging
+	if err := DecodeHashQuery("http://example.com/?page=1#/list?page=4&limit=20", &paging); err != nil || paging != (queryPaging{Page: 4, Limit: 20}) {
+		t.Errorf("DecodeHashQuery was incorrect, got: %+v, %v.", paging, err)
+	}
+	result, err := EncodeHashQuery("http://example.com/?page=1#/list?limit=20", queryPaging{Page: 2})
+	if want := "http://example.com/?page=1#/list?page=2"; err != nil || result != want {
+		t.Errorf("EncodeHashQuery was incorrect, got: %s, want: %s.", result, want)
+	}
+	u, _ := Parse("http://example.com/#/list?limit=20")
+	invalid := struct {
+		Page int        `url:"page"`
+		Bad  complex128 `url:"bad"`
+	}{Page: 2}
+	if err := u.EncodeHashQuery(invalid); !errors.Is(err, ErrUnsupportedQueryType) {
+		t.Errorf("EncodeHashQuery was incorrect, got: %v, want: %v.", err, ErrUnsupportedQueryType)
+	}
+	if result, want := u.String(), "http://example.com/#/list?limit=20"; result != want {
+		t.Errorf("EncodeHashQuery changed the URL on error, got: %s, want: %s.", result, want)
+	}
+	if result := u.GetHashParam("page"); result != "" {
+		t.Errorf("EncodeHashQuery changed the hash params on error, got: %s, want: \"\".", result)
+	}
+}
@@ -0,0 +1,36 @@
+//go:build go1.21
+
+package gurl
+
+import "log/slog"
+
+// LogValue implements slog.LogValuer, so that a *URL is logged redacted with
+// the zero RedactPolicy. The URL itself is not changed. A nil *URL is logged
+// as DefaultRedactMask.
+func (u *URL) LogValue() slog.Value {
+	if u == nil {
+		return slog.StringValue(DefaultRedactMask)
+	}
+	c := u.Clone()
+	c.Redact(RedactPolicy{})
+	return slog.StringValue(c.String())
+}
+
+// RedactedURL is a URL string that log/slog logs redacted with Policy. A URL
+// that cannot be parsed is logged as the mask, as it may hold secrets.
+type RedactedURL struct {
+	URL    string
+	Policy RedactPolicy
+}
+
+// LogValue implements slog.LogValuer.
+func (r RedactedURL) LogValue() slog.Value {
+	redacted, err := Redact(r.URL, r.Policy)
+	if err != nil {
+		if r.Policy.Mask != "" {
+			return slog.StringValue(r.Policy.Mask)
+		}
+		return slog.StringValue(DefaultRedactMask)
+	}
+	return slog.StringValue(redacted)
+}
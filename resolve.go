@@ -0,0 +1,233 @@
+package gurl
+
+import (
+	"strings"
+)
+
+// reference is a URI reference split into the five components of RFC 3986,
+// appendix B. The components keep their escaping, and the has fields tell an
+// empty component from a missing one, as in "http://a/b?" and "http://a/b".
+type reference struct {
+	scheme, authority, path, query, fragment       string
+	hasScheme, hasAuthority, hasQuery, hasFragment bool
+}
+
+// splitReference splits s into its components.
+func splitReference(s string) reference {
+	var r reference
+	if i := strings.IndexByte(s, '#'); i >= 0 {
+		r.fragment, r.hasFragment = s[i+1:], true
+		s = s[:i]
+	}
+	if i := strings.IndexByte(s, '?'); i >= 0 {
+		r.query, r.hasQuery = s[i+1:], true
+		s = s[:i]
+	}
+	if i := strings.IndexAny(s, ":/"); i > 0 && s[i] == ':' {
+		r.scheme, r.hasScheme = s[:i], true
+		s = s[i+1:]
+	}
+	if strings.HasPrefix(s, "//") {
+		s = s[2:]
+		i := strings.IndexByte(s, '/')
+		if i < 0 {
+			i = len(s)
+		}
+		r.authority, r.hasAuthority = s[:i], true
+		s = s[i:]
+	}
+	r.path = s
+	return r
+}
+
+// String recomposes the components, as in RFC 3986 section 5.3.
+func (r reference) String() string {
+	var b strings.Builder
+	if r.hasScheme {
+		b.WriteString(r.scheme + ":")
+	}
+	if r.hasAuthority {
+		b.WriteString("//" + r.authority)
+	}
+	b.WriteString(r.path)
+	if r.hasQuery {
+		b.WriteString("?" + r.query)
+	}
+	if r.hasFragment {
+		b.WriteString("#" + r.fragment)
+	}
+	return b.String()
+}
+
+// resolveReference resolves ref against base with the strict algorithm of
+// RFC 3986 section 5.2.2.
+func resolveReference(base, ref reference) reference {
+	var t reference
+	switch {
+	case ref.hasScheme:
+		t = ref
+		t.path = removeDotSegments(ref.path)
+	case ref.hasAuthority:
+		t = ref
+		t.path = removeDotSegments(ref.path)
+		t.scheme, t.hasScheme = base.scheme, base.hasScheme
+	default:
+		switch {
+		case ref.path == "":
+			t.path = base.path
+			t.query, t.hasQuery = base.query, base.hasQuery
+			if ref.hasQuery {
+				t.query, t.hasQuery = ref.query, true
+			}
+		case strings.HasPrefix(ref.path, "/"):
+			t.path = removeDotSegments(ref.path)
+			t.query, t.hasQuery = ref.query, ref.hasQuery
+		default:
+			t.path = removeDotSegments(mergePaths(base, ref.path))
+			t.query, t.hasQuery = ref.query, ref.hasQuery
+		}
+		t.authority, t.hasAuthority = base.authority, base.hasAuthority
+		t.scheme, t.hasScheme = base.scheme, base.hasScheme
+	}
+	t.fragment, t.hasFragment = ref.fragment, ref.hasFragment
+	return t
+}
+
+// mergePaths merges a relative-path reference with the path of base, as in
+// RFC 3986 section 5.2.3.
+func mergePaths(base reference, refPath string) string {
+	if base.hasAuthority && base.path == "" {
+		return "/" + refPath
+	}
+	return base.path[:strings.LastIndexByte(base.path, '/')+1] + refPath
+}
+
+// relativeReference returns the shortest reference that resolves to target
+// against base, using a relative path rather than an absolute one.
+func relativeReference(base, target reference) reference {
+	if !strings.EqualFold(base.scheme, target.scheme) || base.hasScheme != target.hasScheme ||
+		!strings.EqualFold(base.authority, target.authority) || base.hasAuthority != target.hasAuthority {
+		return target
+	}
+	r := reference{
+		query:       target.query,
+		hasQuery:    target.hasQuery,
+		fragment:    target.fragment,
+		hasFragment: target.hasFragment,
+	}
+
+	basePath := removeDotSegments(base.path)
+	targetPath := removeDotSegments(target.path)
+	if basePath == targetPath && (target.hasQuery || !base.hasQuery) {
+		// Only the query or the fragment differ.
+		if target.hasQuery && base.hasQuery && target.query == base.query {
+			r.query, r.hasQuery = "", false
+		}
+		return r
+	}
+	if base.hasAuthority && basePath == "" {
+		basePath = "/"
+	}
+	if !strings.HasPrefix(basePath, "/") || !strings.HasPrefix(targetPath, "/") {
+		return target
+	}
+
+	// Drop the directories that both paths start with, then go up from the
+	// rest of the base directory and down to the target.
+	baseDirs := strings.Split(basePath[:strings.LastIndexByte(basePath, '/')], "/")
+	targetSegments := strings.Split(targetPath, "/")
+	common := 0
+	for common < len(baseDirs) && common < len(targetSegments)-1 && baseDirs[common] == targetSegments[common] {
+		common++
+	}
+	ups := len(baseDirs) - common
+	rest := targetSegments[common:]
+	r.path = strings.Repeat("../", ups) + strings.Join(rest, "/")
+	switch {
+	case r.path == "":
+		r.path = "./"
+	case ups == 0 && (rest[0] == "" || strings.Contains(rest[0], ":")):
+		// Keep an empty first segment from being read as an authority, and
+		// one with a colon from being read as a scheme.
+		r.path = "./" + r.path
+	}
+	return r
+}
+
+// Resolve resolves a reference against the URL, as a browser does for a link
+// on the page at the URL, and returns the result as a new URL.
+func (u *URL) Resolve(ref string) (*URL, error) {
+	if _, err := Parse(ref); err != nil {
+		return nil, err
+	}
+	return Parse(resolveReference(splitReference(u.String()), splitReference(ref)).String())
+}
+
+// Relativize returns the shortest reference that resolves to target against
+// the URL. target is returned whole if its scheme or host differ.
+func (u *URL) Relativize(target *URL) string {
+	return relativeReference(splitReference(u.String()), splitReference(target.String())).String()
+}
+
+// Resolve resolves a relative reference against a base URL, following
+// RFC 3986 section 5.2, as a browser does for a link on the page at the base
+// URL.
+//
+// Parameters:
+//
+//	base: The URL the reference is relative to.
+//	ref: The reference to resolve, such as "../img/a.png", "?page=2" or
+//	"#top". An absolute URL is returned with its dot segments removed.
+//
+// Returns:
+//
+//	A string containing the resolved URL, and an error if any occurred.
+//
+// Example:
+//
+//	result, err := Resolve("http://example.com/blog/post/index.html", "../img/a.png")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "http://example.com/blog/img/a.png"
+func Resolve(base, ref string) (string, error) {
+	if _, err := Parse(base); err != nil {
+		return "", err
+	}
+	if _, err := Parse(ref); err != nil {
+		return "", err
+	}
+	return resolveReference(splitReference(base), splitReference(ref)).String(), nil
+}
+
+// Relativize computes the shortest relative reference from a base URL to a
+// target URL, the inverse of Resolve.
+//
+// Parameters:
+//
+//	base: The URL the reference will be relative to.
+//	target: The URL the reference must resolve to.
+//
+// Returns:
+//
+//	A string containing the reference, and an error if any occurred. Paths
+//	are made relative with "../" rather than starting with "/", so that the
+//	links keep working when a site is moved. The target is returned whole
+//	if its scheme or host differ from those of the base.
+//
+// Example:
+//
+//	result, err := Relativize("http://example.com/blog/post/index.html", "http://example.com/blog/img/a.png")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "../img/a.png"
+func Relativize(base, target string) (string, error) {
+	if _, err := Parse(base); err != nil {
+		return "", err
+	}
+	if _, err := Parse(target); err != nil {
+		return "", err
+	}
+	return relativeReference(splitReference(base), splitReference(target)).String(), nil
+}
@@ -0,0 +1,152 @@
+package gurl
+
+import (
+	"testing"
+)
+
+// rfc3986Base is the base URI of the examples in RFC 3986 section 5.4.
+const rfc3986Base = "http://a/b/c/d;p?q"
+
+// rfc3986Examples are the normal and abnormal examples of RFC 3986 sections
+// 5.4.1 and 5.4.2.
+var rfc3986Examples = []struct {
+	ref    string
+	result string
+}{
+	// Normal examples.
+	{"g:h", "g:h"},
+	{"g", "http://a/b/c/g"},
+	{"./g", "http://a/b/c/g"},
+	{"g/", "http://a/b/c/g/"},
+	{"/g", "http://a/g"},
+	{"//g", "http://g"},
+	{"?y", "http://a/b/c/d;p?y"},
+	{"g?y", "http://a/b/c/g?y"},
+	{"#s", "http://a/b/c/d;p?q#s"},
+	{"g#s", "http://a/b/c/g#s"},
+	{"g?y#s", "http://a/b/c/g?y#s"},
+	{";x", "http://a/b/c/;x"},
+	{"g;x", "http://a/b/c/g;x"},
+	{"g;x?y#s", "http://a/b/c/g;x?y#s"},
+	{"", "http://a/b/c/d;p?q"},
+	{".", "http://a/b/c/"},
+	{"./", "http://a/b/c/"},
+	{"..", "http://a/b/"},
+	{"../", "http://a/b/"},
+	{"../g", "http://a/b/g"},
+	{"../..", "http://a/"},
+	{"../../", "http://a/"},
+	{"../../g", "http://a/g"},
+
+	// Abnormal examples.
+	{"../../../g", "http://a/g"},
+	{"../../../../g", "http://a/g"},
+	{"/./g", "http://a/g"},
+	{"/../g", "http://a/g"},
+	{"g.", "http://a/b/c/g."},
+	{".g", "http://a/b/c/.g"},
+	{"g..", "http://a/b/c/g.."},
+	{"..g", "http://a/b/c/..g"},
+	{"./../g", "http://a/b/g"},
+	{"./g/.", "http://a/b/c/g/"},
+	{"g/./h", "http://a/b/c/g/h"},
+	{"g/../h", "http://a/b/c/h"},
+	{"g;x=1/./y", "http://a/b/c/g;x=1/y"},
+	{"g;x=1/../y", "http://a/b/c/y"},
+	{"g?y/./x", "http://a/b/c/g?y/./x"},
+	{"g?y/../x", "http://a/b/c/g?y/../x"},
+	{"g#s/./x", "http://a/b/c/g#s/./x"},
+	{"g#s/../x", "http://a/b/c/g#s/../x"},
+	{"http:g", "http:g"},
+}
+
+func TestResolve(t *testing.T) {
+	for _, test := range rfc3986Examples {
+		result, err := Resolve(rfc3986Base, test.ref)
+		if err != nil || result != test.result {
+			t.Errorf("Resolve(%q) was incorrect, got: %s, %v, want: %s.", test.ref, result, err, test.result)
+		}
+	}
+}
+
+func TestResolveEmptyComponents(t *testing.T) {
+	tests := []struct {
+		base   string
+		ref    string
+		result string
+	}{
+		{"http://example.com", "a", "http://example.com/a"},
+		{"http://example.com/a?x=1#top", "?", "http://example.com/a?"},
+		{"http://example.com/a?x=1", "#", "http://example.com/a?x=1#"},
+		{"http://example.com/blog/post/index.html", "../img/a.png", "http://example.com/blog/img/a.png"},
+	}
+	for _, test := range tests {
+		result, err := Resolve(test.base, test.ref)
+		if err != nil || result != test.result {
+			t.Errorf("Resolve(%q, %q) was incorrect, got: %s, %v, want: %s.", test.base, test.ref, result, err, test.result)
+		}
+	}
+
+	if _, err := Resolve("http://[::1", "a"); err == nil {
+		t.Errorf("Resolve was incorrect, got: nil, want: error.")
+	}
+}
+
+func TestRelativize(t *testing.T) {
+	tests := []struct {
+		base   string
+		target string
+		result string
+	}{
+		{"http://example.com/blog/post/index.html", "http://example.com/blog/img/a.png", "../img/a.png"},
+		{"http://example.com/a/b", "http://example.com/a/c", "c"},
+		{"http://example.com/a/b", "http://example.com/a/", "./"},
+		{"http://example.com/a/b/", "http://example.com/a/b/c/d", "c/d"},
+		{"http://example.com/a/b/c", "http://example.com/", "../../"},
+		{"http://example.com/a/b", "http://example.com/a/b?page=2", "?page=2"},
+		{"http://example.com/a/b?page=2", "http://example.com/a/b", "b"},
+		{"http://example.com/a/b?page=2", "http://example.com/a/b?page=2#top", "#top"},
+		{"http://example.com/a/b#top", "http://example.com/a/b", ""},
+		{"http://example.com/a/b", "http://example.com/a/c:d", "./c:d"},
+		{"http://example.com/a/b", "http://example.com/a//c", ".//c"},
+		{"HTTP://EXAMPLE.com/a/b", "http://example.com/a/c", "c"},
+		{"http://example.com", "http://example.com/a", "a"},
+		{"http://example.com/a/b", "https://example.com/a/b", "https://example.com/a/b"},
+		{"http://example.com/a/b", "http://other.com/a/b", "http://other.com/a/b"},
+	}
+	for _, test := range tests {
+		result, err := Relativize(test.base, test.target)
+		if err != nil || result != test.result {
+			t.Errorf("Relativize(%q, %q) was incorrect, got: %s, %v, want: %s.", test.base, test.target, result, err, test.result)
+		}
+	}
+}
+
+// TestRelativizeInverse checks that the reference from Relativize resolves
+// back to the target of every RFC 3986 example.
+func TestRelativizeInverse(t *testing.T) {
+	for _, test := range rfc3986Examples {
+		ref, err := Relativize(rfc3986Base, test.result)
+		if err != nil {
+			t.Errorf("Relativize(%q) returned an error: %v.", test.result, err)
+			continue
+		}
+		if result, _ := Resolve(rfc3986Base, ref); result != test.result {
+			t.Errorf("Relativize(%q) was incorrect, got: %s, which resolves to %s.", test.result, ref, result)
+		}
+	}
+}
+
+func TestURLResolve(t *testing.T) {
+	base, _ := Parse("http://example.com/docs/guide/")
+	resolved, err := base.Resolve("../api/?v=2#intro")
+	if err != nil {
+		t.Fatalf("URL.Resolve returned an error: %v.", err)
+	}
+	if result := resolved.String(); result != "http://example.com/docs/api/?v=2#intro" {
+		t.Errorf("URL.Resolve was incorrect, got: %s, want: %s.", result, "http://example.com/docs/api/?v=2#intro")
+	}
+	if result := base.Relativize(resolved); result != "../api/?v=2#intro" {
+		t.Errorf("URL.Relativize was incorrect, got: %s, want: %s.", result, "../api/?v=2#intro")
+	}
+}
@@ -0,0 +1,344 @@
+package gurl
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Errors reported by routes. They are wrapped in a *RouteError and can be
+// checked with errors.Is.
+var (
+	ErrInvalidRoute      = errors.New("gurl: invalid route pattern")
+	ErrMissingRouteParam = errors.New("gurl: missing route parameter")
+	ErrInvalidRouteParam = errors.New("gurl: invalid route parameter")
+)
+
+// RouteError describes why a route pattern could not be compiled, or why a
+// path could not be built from it.
+type RouteError struct {
+	// Pattern is the route pattern.
+	Pattern string
+	// Name is the parameter, or the pattern segment, the error is about.
+	Name string
+	// Err is one of the errors reported by routes, such as
+	// ErrMissingRouteParam.
+	Err error
+}
+
+func (e *RouteError) Error() string {
+	return e.Err.Error() + " " + strconv.Quote(e.Name) + " in " + strconv.Quote(e.Pattern)
+}
+
+func (e *RouteError) Unwrap() error {
+	return e.Err
+}
+
+// routeTypes are the constraints a parameter can have, as in "{id:int}".
+var routeTypes = map[string]func(string) bool{
+	"int": func(s string) bool {
+		_, err := strconv.ParseInt(s, 10, 64)
+		return err == nil
+	},
+	"uint": func(s string) bool {
+		_, err := strconv.ParseUint(s, 10, 64)
+		return err == nil
+	},
+	"float": func(s string) bool {
+		_, err := strconv.ParseFloat(s, 64)
+		return err == nil
+	},
+	"alpha": func(s string) bool {
+		return s != "" && strings.IndexFunc(s, func(r rune) bool { return !unicode.IsLetter(r) }) < 0
+	},
+	"alnum": func(s string) bool {
+		return s != "" && strings.IndexFunc(s, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }) < 0
+	},
+	"hex": func(s string) bool {
+		return s != "" && strings.IndexFunc(s, func(r rune) bool { return r > 0x7f || !isHex(byte(r)) }) < 0
+	},
+	"uuid": func(s string) bool {
+		if len(s) != 36 {
+			return false
+		}
+		for i := 0; i < len(s); i++ {
+			if i == 8 || i == 13 || i == 18 || i == 23 {
+				if s[i] != '-' {
+					return false
+				}
+			} else if !isHex(s[i]) {
+				return false
+			}
+		}
+		return true
+	},
+}
+
+type routeSegmentKind int
+
+const (
+	routeLiteral routeSegmentKind = iota
+	routeParam
+	routeWildcard
+)
+
+// routeSegment is one "/"-separated segment of a route pattern.
+type routeSegment struct {
+	kind     routeSegmentKind
+	literal  string
+	name     string
+	optional bool
+	accept   func(string) bool
+}
+
+// Route is a compiled route pattern, such as "/users/:id/posts/{slug}". It
+// matches paths and builds them back from parameters.
+//
+// Each segment of a pattern is one of:
+//
+//	users      a literal segment
+//	:id        a parameter matching one segment
+//	{id}       the same, in braces
+//	{id:int}   a parameter that must be an int; the types are int, uint,
+//	           float, alpha, alnum, hex and uuid
+//	*rest      a wildcard matching the remaining segments, which must be last
+//
+// A parameter followed by "?", as in ":page?" or "{page:int}?", is optional.
+// A Route is safe for concurrent use.
+type Route struct {
+	pattern  string
+	segments []routeSegment
+	trailing bool
+}
+
+// splitRoutePath splits a path into its segments, ignoring a leading and a
+// trailing slash.
+func splitRoutePath(p string) ([]string, bool) {
+	p = strings.TrimPrefix(p, "/")
+	if p == "" {
+		return nil, false
+	}
+	trailing := strings.HasSuffix(p, "/")
+	if trailing {
+		p = p[:len(p)-1]
+	}
+	return strings.Split(p, "/"), trailing
+}
+
+func isRouteName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// CompileRoute parses a route pattern.
+//
+// Parameters:
+//
+//	pattern: The route pattern, such as "/users/{id:int}/posts/:slug?".
+//
+// Returns:
+//
+//	The compiled route, and a *RouteError wrapping ErrInvalidRoute if the
+//	pattern is not valid.
+//
+// Example:
+//
+//	route, err := CompileRoute("/users/{id:int}/posts/:slug")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	params, ok := route.Match("/users/42/posts/hello")
+//	fmt.Println(params, ok) // Output: map[id:42 slug:hello] true
+func CompileRoute(pattern string) (*Route, error) {
+	parts, trailing := splitRoutePath(pattern)
+	r := &Route{pattern: pattern, trailing: trailing}
+	seen := make(map[string]bool)
+	for i, part := range parts {
+		invalid := &RouteError{Pattern: pattern, Name: part, Err: ErrInvalidRoute}
+		s := routeSegment{kind: routeParam}
+		switch {
+		case strings.HasPrefix(part, "*"):
+			s.kind, s.name = routeWildcard, part[1:]
+			if s.name == "" {
+				s.name = "*"
+			} else if !isRouteName(s.name) {
+				return nil, invalid
+			}
+			if i != len(parts)-1 {
+				return nil, invalid
+			}
+		case strings.HasPrefix(part, ":"):
+			s.name = part[1:]
+			s.name, s.optional = strings.TrimSuffix(s.name, "?"), strings.HasSuffix(s.name, "?")
+		case strings.HasPrefix(part, "{"):
+			body := part[1:]
+			if strings.HasSuffix(body, "}?") {
+				body, s.optional = body[:len(body)-2], true
+			} else if strings.HasSuffix(body, "}") {
+				body = body[:len(body)-1]
+			} else {
+				return nil, invalid
+			}
+			if strings.HasSuffix(body, "?") {
+				body, s.optional = body[:len(body)-1], true
+			}
+			name, typ, hasType := strings.Cut(body, ":")
+			s.name = name
+			if hasType {
+				s.accept = routeTypes[typ]
+				if s.accept == nil {
+					return nil, invalid
+				}
+			}
+		default:
+			s.kind, s.literal = routeLiteral, part
+		}
+		if s.kind != routeLiteral {
+			if s.kind == routeParam && !isRouteName(s.name) || seen[s.name] {
+				return nil, invalid
+			}
+			seen[s.name] = true
+		}
+		r.segments = append(r.segments, s)
+	}
+	return r, nil
+}
+
+// MustCompileRoute is like CompileRoute but panics if the pattern is not
+// valid. It is meant for patterns in package-level variables.
+func MustCompileRoute(pattern string) *Route {
+	r, err := CompileRoute(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// String returns the pattern the route was compiled from.
+func (r *Route) String() string {
+	return r.pattern
+}
+
+// Match matches an unescaped path, as returned by GetPath, against the
+// route, and returns the parameters. A trailing slash in the path is
+// ignored.
+func (r *Route) Match(path string) (map[string]string, bool) {
+	segments, _ := splitRoutePath(path)
+	params := make(map[string]string)
+	if !r.match(r.segments, segments, params) {
+		return nil, false
+	}
+	return params, true
+}
+
+// MatchURL matches the path of u against the route, and returns the
+// parameters. Unlike Match, an escaped slash such as "a%2Fb" is kept inside
+// its segment.
+func (r *Route) MatchURL(u *URL) (map[string]string, bool) {
+	segments := u.GetPathSegments()
+	params := make(map[string]string)
+	if !r.match(r.segments, segments, params) {
+		return nil, false
+	}
+	return params, true
+}
+
+// match matches the path segments against the pattern segments, trying
+// both with and without each optional parameter.
+func (r *Route) match(pattern []routeSegment, segments []string, params map[string]string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+	s := pattern[0]
+	switch s.kind {
+	case routeWildcard:
+		params[s.name] = strings.Join(segments, "/")
+		return true
+	case routeLiteral:
+		return len(segments) > 0 && segments[0] == s.literal && r.match(pattern[1:], segments[1:], params)
+	}
+	if len(segments) > 0 && segments[0] != "" && (s.accept == nil || s.accept(segments[0])) {
+		params[s.name] = segments[0]
+		if r.match(pattern[1:], segments[1:], params) {
+			return true
+		}
+		delete(params, s.name)
+	}
+	return s.optional && r.match(pattern[1:], segments, params)
+}
+
+// Path builds a path from the route and params. Optional parameters that are
+// missing or empty are left out.
+//
+// It returns a *RouteError wrapping ErrMissingRouteParam if a required
+// parameter is missing, or ErrInvalidRouteParam if a value does not match its
+// type or, outside a wildcard, contains a "/".
+func (r *Route) Path(params map[string]string) (string, error) {
+	var segments []string
+	for _, s := range r.segments {
+		if s.kind == routeLiteral {
+			segments = append(segments, s.literal)
+			continue
+		}
+		value := params[s.name]
+		switch {
+		case s.kind == routeWildcard:
+			if value = strings.Trim(value, "/"); value == "" {
+				continue
+			}
+		case value == "" && s.optional:
+			continue
+		case value == "":
+			return "", &RouteError{Pattern: r.pattern, Name: s.name, Err: ErrMissingRouteParam}
+		case strings.Contains(value, "/") || s.accept != nil && !s.accept(value):
+			return "", &RouteError{Pattern: r.pattern, Name: s.name, Err: ErrInvalidRouteParam}
+		}
+		segments = append(segments, value)
+	}
+	path := "/" + strings.Join(segments, "/")
+	if r.trailing && len(segments) > 0 {
+		path += "/"
+	}
+	return path, nil
+}
+
+// Build builds a path from the route and params, as Path does, and sets it
+// as the path of the URL u with SetPath.
+//
+// Parameters:
+//
+//	u: The URL in which to set the path.
+//	params: The parameter values.
+//
+// Returns:
+//
+//	A string containing the new URL, and an error if any occurred.
+//
+// Example:
+//
+//	route := MustCompileRoute("/users/{id:int}/posts/:slug")
+//	result, err := route.Build("https://example.com/?ref=home", map[string]string{"id": "42", "slug": "hello"})
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "https://example.com/users/42/posts/hello?ref=home"
+func (r *Route) Build(u string, params map[string]string) (string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", err
+	}
+	path, err := r.Path(params)
+	if err != nil {
+		return "", err
+	}
+	parsedURL.SetPath(path)
+	return parsedURL.String(), nil
+}
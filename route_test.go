@@ -0,0 +1,128 @@
+package gurl
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCompileRouteError(t *testing.T) {
+	tests := []string{
+		"/users/:",
+		"/users/{id",
+		"/users/{id:bool}",
+		"/users/:id/:id",
+		"/files/*rest/edit",
+		"/files/*a-b",
+		"/users/:a.b",
+	}
+	for _, pattern := range tests {
+		_, err := CompileRoute(pattern)
+		var routeErr *RouteError
+		if !errors.Is(err, ErrInvalidRoute) || !errors.As(err, &routeErr) || routeErr.Pattern != pattern {
+			t.Errorf("CompileRoute(%q) was incorrect, got: %v, want: %v.", pattern, err, ErrInvalidRoute)
+		}
+	}
+}
+
+func TestRouteMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		params  map[string]string
+	}{
+		{"/", "/", map[string]string{}},
+		{"/users/:id", "/users/42", map[string]string{"id": "42"}},
+		{"/users/:id", "/users/42/", map[string]string{"id": "42"}},
+		{"/users/{id}/posts/{slug}", "/users/42/posts/hello", map[string]string{"id": "42", "slug": "hello"}},
+		{"/users/{id:int}", "/users/-7", map[string]string{"id": "-7"}},
+		{"/users/{id:int}", "/users/me", nil},
+		{"/users/{id:uint}", "/users/-7", nil},
+		{"/prices/{p:float}", "/prices/1.5", map[string]string{"p": "1.5"}},
+		{"/tags/{t:alpha}", "/tags/go1", nil},
+		{"/tags/{t:alnum}", "/tags/go1", map[string]string{"t": "go1"}},
+		{"/commits/{sha:hex}", "/commits/a1B2", map[string]string{"sha": "a1B2"}},
+		{"/commits/{sha:hex}", "/commits/xyz", nil},
+		{"/items/{id:uuid}", "/items/123e4567-e89b-12d3-a456-426614174000", map[string]string{"id": "123e4567-e89b-12d3-a456-426614174000"}},
+		{"/items/{id:uuid}", "/items/123e4567e89b12d3a456426614174000", nil},
+		{"/posts/:page?", "/posts", map[string]string{}},
+		{"/posts/:page?", "/posts/2", map[string]string{"page": "2"}},
+		{"/posts/{page:int}?/edit", "/posts/edit", map[string]string{}},
+		{"/posts/{page:int?}/edit", "/posts/3/edit", map[string]string{"page": "3"}},
+		{"/:lang?/docs", "/docs", map[string]string{}},
+		{"/:lang?/docs", "/en/docs", map[string]string{"lang": "en"}},
+		{"/files/*rest", "/files/a/b.txt", map[string]string{"rest": "a/b.txt"}},
+		{"/files/*rest", "/files", map[string]string{"rest": ""}},
+		{"/files/*", "/files/a", map[string]string{"*": "a"}},
+		{"/users/:id", "/users", nil},
+		{"/users/:id", "/users/42/posts", nil},
+		{"/users/:id", "/accounts/42", nil},
+	}
+	for _, test := range tests {
+		params, ok := MustCompileRoute(test.pattern).Match(test.path)
+		if ok != (test.params != nil) || !reflect.DeepEqual(params, test.params) {
+			t.Errorf("Match(%q, %q) was incorrect, got: %v, want: %v.", test.pattern, test.path, params, test.params)
+		}
+	}
+}
+
+func TestRouteMatchURL(t *testing.T) {
+	u, _ := Parse("http://example.com/files/a%2Fb/c%20d?x=1")
+	params, ok := MustCompileRoute("/files/:dir/:name").MatchURL(u)
+	want := map[string]string{"dir": "a/b", "name": "c d"}
+	if !ok || !reflect.DeepEqual(params, want) {
+		t.Errorf("MatchURL was incorrect, got: %v, want: %v.", params, want)
+	}
+}
+
+func TestRoutePath(t *testing.T) {
+	tests := []struct {
+		pattern string
+		params  map[string]string
+		result  string
+		err     error
+	}{
+		{"/users/:id", map[string]string{"id": "42"}, "/users/42", nil},
+		{"/users/:id/", map[string]string{"id": "42"}, "/users/42/", nil},
+		{"/posts/:page?", nil, "/posts", nil},
+		{"/:lang?/docs", map[string]string{"lang": "en"}, "/en/docs", nil},
+		{"/files/*rest", map[string]string{"rest": "/a/b.txt"}, "/files/a/b.txt", nil},
+		{"/files/*rest", nil, "/files", nil},
+		{"/users/:id", nil, "", ErrMissingRouteParam},
+		{"/users/{id:int}", map[string]string{"id": "me"}, "", ErrInvalidRouteParam},
+		{"/users/:id", map[string]string{"id": "a/b"}, "", ErrInvalidRouteParam},
+	}
+	for _, test := range tests {
+		result, err := MustCompileRoute(test.pattern).Path(test.params)
+		if !errors.Is(err, test.err) || result != test.result {
+			t.Errorf("Path(%q) was incorrect, got: %s, %v, want: %s, %v.", test.pattern, result, err, test.result, test.err)
+		}
+	}
+}
+
+func TestRouteBuild(t *testing.T) {
+	route := MustCompileRoute("/users/{id:int}/files/*rest")
+	result, err := route.Build("https://example.com/?v=1", map[string]string{"id": "7", "rest": "a b/c"})
+	want := "https://example.com/users/7/files/a%20b/c?v=1"
+	if err != nil || result != want {
+		t.Errorf("Build was incorrect, got: %s, want: %s.", result, want)
+	}
+	if _, err := route.Build("http://[::1", nil); err == nil {
+		t.Errorf("Build was incorrect, got: nil, want: error.")
+	}
+}
+
+func TestRouteRoundTrip(t *testing.T) {
+	route := MustCompileRoute("/:lang?/users/{id:int}/*rest")
+	for _, path := range []string{"/en/users/1/a/b", "/users/2", "/users/3/x"} {
+		params, ok := route.Match(path)
+		if !ok {
+			t.Errorf("Match(%q) was incorrect, got: false, want: true.", path)
+			continue
+		}
+		result, err := route.Path(params)
+		if err != nil || result != path {
+			t.Errorf("Path was incorrect, got: %s, want: %s.", result, path)
+		}
+	}
+}
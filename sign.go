@@ -0,0 +1,323 @@
+package gurl
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"hash"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Errors reported by Verify. They are wrapped in a *SignatureError and can be
+// checked with errors.Is.
+var (
+	ErrMissingSignature  = errors.New("gurl: missing signature")
+	ErrInvalidSignature  = errors.New("gurl: invalid signature")
+	ErrSignatureExpired  = errors.New("gurl: signature expired")
+	ErrUnknownSigningKey = errors.New("gurl: unknown signing key")
+)
+
+// ErrInvalidSigningKey is returned by Sign and Verify for a key with an empty
+// secret or an unknown algorithm.
+var ErrInvalidSigningKey = errors.New("gurl: invalid signing key")
+
+// SignatureError describes why Verify rejected a URL.
+type SignatureError struct {
+	// Err is one of the errors reported by Verify, such as
+	// ErrSignatureExpired.
+	Err error
+	// KeyID is the key ID of the URL.
+	KeyID string
+	// Expires is when the signature expired, for ErrSignatureExpired.
+	Expires time.Time
+}
+
+func (e *SignatureError) Error() string {
+	switch e.Err {
+	case ErrUnknownSigningKey:
+		return e.Err.Error() + " " + strconv.Quote(e.KeyID)
+	case ErrSignatureExpired:
+		return e.Err.Error() + " at " + e.Expires.UTC().Format(time.RFC3339)
+	}
+	return e.Err.Error()
+}
+
+func (e *SignatureError) Unwrap() error {
+	return e.Err
+}
+
+// SignatureAlgorithm selects the HMAC hash of a SigningKey.
+type SignatureAlgorithm int
+
+const (
+	// HMACSHA256 signs with HMAC-SHA256.
+	HMACSHA256 SignatureAlgorithm = iota
+	// HMACSHA512 signs with HMAC-SHA512.
+	HMACSHA512
+)
+
+// SigningKey is a secret that Sign and Verify use.
+type SigningKey struct {
+	// ID names the key. Sign writes it to the "kid" parameter, so that Verify
+	// can pick the key among several while keys are rotated. It may be empty
+	// if only one key is in use.
+	ID string
+	// Secret is the HMAC secret.
+	Secret []byte
+	// Algorithm is the HMAC hash. The zero value is HMACSHA256.
+	Algorithm SignatureAlgorithm
+}
+
+func (k SigningKey) hash() (func() hash.Hash, error) {
+	if len(k.Secret) == 0 {
+		return nil, ErrInvalidSigningKey
+	}
+	switch k.Algorithm {
+	case HMACSHA256:
+		return sha256.New, nil
+	case HMACSHA512:
+		return sha512.New, nil
+	}
+	return nil, ErrInvalidSigningKey
+}
+
+// SignOptions configures Sign. The zero value signs the scheme, host, path
+// and every query parameter, and never expires.
+type SignOptions struct {
+	// Expires is when the signature expires. Sign writes it to the "expires"
+	// parameter as a Unix time. The signature never expires if it is zero.
+	Expires time.Time
+	// PathOnly signs the path, leaving the scheme, host and query out of the
+	// signature, so that the URL stays valid behind another host and with
+	// other query parameters.
+	PathOnly bool
+	// QueryKeys lists the query parameters signed along with the path when
+	// PathOnly is set.
+	QueryKeys []string
+}
+
+// The parameters that Sign adds. The signature covers all but the last.
+const (
+	signatureExpiresParam = "expires"
+	signatureKeyIDParam   = "kid"
+	signatureScopeParam   = "scope"
+	signatureParam        = "signature"
+)
+
+// signatureScope is the scope of a signature: the whole URL if it is nil, or
+// the path and the listed query parameters.
+type signatureScope []string
+
+// encode writes the scope as the "scope" parameter: "path", followed by the
+// escaped query parameters, separated by ",".
+func (s signatureScope) encode() string {
+	parts := []string{"path"}
+	for _, key := range s {
+		parts = append(parts, url.QueryEscape(key))
+	}
+	return strings.Join(parts, ",")
+}
+
+func parseSignatureScope(s string) (signatureScope, bool) {
+	if s == "" {
+		return nil, true
+	}
+	parts := strings.Split(s, ",")
+	if parts[0] != "path" {
+		return nil, false
+	}
+	scope := signatureScope{}
+	for _, part := range parts[1:] {
+		scope = append(scope, queryUnescape(part))
+	}
+	return scope, true
+}
+
+func (s signatureScope) covers(key string) bool {
+	switch key {
+	case signatureParam:
+		return false
+	case signatureExpiresParam, signatureKeyIDParam, signatureScopeParam:
+		return true
+	}
+	if s == nil {
+		return true
+	}
+	for _, k := range s {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// signingString returns the canonical form of the URL that is signed, so
+// that the signature survives edits that do not change what the URL refers
+// to: the URL is normalized with NormalizeSafe, and the signed query
+// parameters are decoded, escaped again and sorted by key, values of the same
+// key keeping their order.
+func (u *URL) signingString(scope signatureScope) string {
+	c := u.Clone()
+	c.Normalize(NormalizeSafe)
+	tokens := parseQueryTokens(c.url.RawQuery).filter(func(t queryToken) bool {
+		return scope.covers(t.key)
+	})
+	sort.SliceStable(tokens, func(i, j int) bool {
+		return tokens[i].key < tokens[j].key
+	})
+	pairs := make([]string, len(tokens))
+	for i, t := range tokens {
+		pairs[i] = url.QueryEscape(t.key) + "=" + url.QueryEscape(t.value)
+	}
+	lines := []string{c.url.Scheme, c.url.Host, c.url.EscapedPath(), strings.Join(pairs, "&")}
+	if scope != nil {
+		lines[0], lines[1] = "", ""
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (u *URL) signature(h func() hash.Hash, secret []byte, scope signatureScope) []byte {
+	mac := hmac.New(h, secret)
+	mac.Write([]byte(u.signingString(scope)))
+	return mac.Sum(nil)
+}
+
+// Sign signs the URL with key, replacing any previous signature. It adds the
+// "expires", "kid" and "scope" parameters as needed, then the "signature"
+// parameter, keeping the order and encoding of the other parameters. The
+// fragment is never signed.
+func (u *URL) Sign(key SigningKey, opts SignOptions) error {
+	h, err := key.hash()
+	if err != nil {
+		return err
+	}
+	preserve := u.preserveQuery
+	u.PreserveQuery(true)
+	defer u.PreserveQuery(preserve)
+	for _, param := range []string{signatureExpiresParam, signatureKeyIDParam, signatureScopeParam, signatureParam} {
+		u.DelQueryParam(param)
+	}
+	if !opts.Expires.IsZero() {
+		u.SetQueryParam(signatureExpiresParam, strconv.FormatInt(opts.Expires.Unix(), 10))
+	}
+	if key.ID != "" {
+		u.SetQueryParam(signatureKeyIDParam, key.ID)
+	}
+	var scope signatureScope
+	if opts.PathOnly {
+		scope = append(signatureScope{}, opts.QueryKeys...)
+		u.SetQueryParam(signatureScopeParam, scope.encode())
+	}
+	u.SetQueryParam(signatureParam, base64.RawURLEncoding.EncodeToString(u.signature(h, key.Secret, scope)))
+	return nil
+}
+
+// Verify checks the signature of the URL against the key of keys whose ID
+// matches its "kid" parameter, and checks that it has not expired at now.
+// It returns a *SignatureError wrapping ErrMissingSignature,
+// ErrUnknownSigningKey, ErrInvalidSignature or ErrSignatureExpired.
+func (u *URL) Verify(keys []SigningKey, now time.Time) error {
+	signatures := u.GetQueryParams(signatureParam)
+	if len(signatures) == 0 {
+		return &SignatureError{Err: ErrMissingSignature}
+	}
+	keyID := u.GetQueryParam(signatureKeyIDParam)
+	var key *SigningKey
+	for i := range keys {
+		if keys[i].ID == keyID {
+			key = &keys[i]
+			break
+		}
+	}
+	if key == nil {
+		return &SignatureError{Err: ErrUnknownSigningKey, KeyID: keyID}
+	}
+	h, err := key.hash()
+	if err != nil {
+		return err
+	}
+
+	scope, ok := parseSignatureScope(u.GetQueryParam(signatureScopeParam))
+	got, err := base64.RawURLEncoding.DecodeString(signatures[0])
+	if !ok || err != nil || len(signatures) > 1 || !hmac.Equal(got, u.signature(h, key.Secret, scope)) {
+		return &SignatureError{Err: ErrInvalidSignature, KeyID: keyID}
+	}
+
+	if expires := u.GetQueryParam(signatureExpiresParam); expires != "" {
+		unix, err := strconv.ParseInt(expires, 10, 64)
+		if err != nil {
+			return &SignatureError{Err: ErrInvalidSignature, KeyID: keyID}
+		}
+		if t := time.Unix(unix, 0); !now.Before(t) {
+			return &SignatureError{Err: ErrSignatureExpired, KeyID: keyID, Expires: t}
+		}
+	}
+	return nil
+}
+
+// Sign signs a URL with an HMAC, for temporary links such as downloads. The
+// URL is canonicalized before it is signed, so the signature still verifies
+// after its query parameters are reordered or escaped differently.
+//
+// Parameters:
+//
+//	u: The URL to sign.
+//	key: The key, with an optional ID written to the "kid" parameter.
+//	opts: The expiry, and the components to sign.
+//
+// Returns:
+//
+//	A string containing the URL with the "expires", "kid", "scope" and
+//	"signature" parameters as needed, and an error if any occurred.
+//
+// Example:
+//
+//	key := SigningKey{ID: "2024-05", Secret: []byte("secret")}
+//	result, err := Sign("https://cdn.example.com/files/report.pdf", key, SignOptions{Expires: time.Unix(1717200000, 0)})
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "https://cdn.example.com/files/report.pdf?expires=1717200000&kid=2024-05&signature=3o4EmGTesNDTME8A8URtKKMzcYx15d__o7SS1IlFi-8"
+func Sign(u string, key SigningKey, opts SignOptions) (string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", err
+	}
+	if err := parsedURL.Sign(key, opts); err != nil {
+		return "", err
+	}
+	return parsedURL.String(), nil
+}
+
+// Verify checks the signature that Sign added to a URL.
+//
+// Parameters:
+//
+//	u: The signed URL.
+//	keys: The keys that may have signed the URL, picked by their ID.
+//	now: The current time, checked against the expiry.
+//
+// Returns:
+//
+//	An error if the URL is not validly signed: a *SignatureError wrapping
+//	ErrMissingSignature, ErrUnknownSigningKey, ErrInvalidSignature if the
+//	URL was tampered with, or ErrSignatureExpired.
+//
+// Example:
+//
+//	keys := []SigningKey{{ID: "2024-05", Secret: []byte("secret")}}
+//	err := Verify("https://cdn.example.com/files/report.pdf?expires=1717200000&kid=2024-05&signature=3o4EmGTesNDTME8A8URtKKMzcYx15d__o7SS1IlFi-8", keys, time.Unix(1717300000, 0))
+//	fmt.Println(errors.Is(err, ErrSignatureExpired)) // Output: true
+func Verify(u string, keys []SigningKey, now time.Time) error {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return err
+	}
+	return parsedURL.Verify(keys, now)
+}
@@ -0,0 +1,125 @@
+package gurl
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	signKey     = SigningKey{ID: "k1", Secret: []byte("secret")}
+	signKeys    = []SigningKey{{ID: "k0", Secret: []byte("old")}, signKey, {ID: "k2", Secret: []byte("new"), Algorithm: HMACSHA512}}
+	signExpires = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	signNow     = signExpires.Add(-time.Hour)
+)
+
+func TestSignVerify(t *testing.T) {
+	tests := []struct {
+		url  string
+		key  SigningKey
+		opts SignOptions
+	}{
+		{"https://cdn.example.com/files/report.pdf", signKey, SignOptions{Expires: signExpires}},
+		{"https://cdn.example.com/files/a%20b.pdf?v=2&dl=1#top", signKeys[2], SignOptions{Expires: signExpires}},
+		{"https://cdn.example.com/files/report.pdf?v=2", SigningKey{Secret: []byte("single")}, SignOptions{}},
+		{"https://cdn.example.com/files/report.pdf?v=2&x=1", signKey, SignOptions{PathOnly: true, QueryKeys: []string{"v"}}},
+	}
+	keys := append([]SigningKey{{Secret: []byte("single")}}, signKeys...)
+	for _, test := range tests {
+		signed, err := Sign(test.url, test.key, test.opts)
+		if err != nil {
+			t.Errorf("Sign(%q) returned an error: %v.", test.url, err)
+			continue
+		}
+		if err := Verify(signed, keys, signNow); err != nil {
+			t.Errorf("Verify(%q) was incorrect, got: %v, want: no error.", signed, err)
+		}
+		// Signing again replaces the signature.
+		resigned, err := Sign(signed, test.key, test.opts)
+		if err != nil || resigned != signed {
+			t.Errorf("Sign(%q) was incorrect, got: %s, want: %s.", signed, resigned, signed)
+		}
+	}
+}
+
+func TestSignCanonical(t *testing.T) {
+	signed, err := Sign("https://cdn.example.com/files/report.pdf?b=1&a=x+y", signKey, SignOptions{Expires: signExpires})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "https://cdn.example.com/files/report.pdf?b=1&a=x+y&expires=1717200000&kid=k1&signature="; !strings.HasPrefix(signed, want) {
+		t.Errorf("Sign was incorrect, got: %s, want: %s...", signed, want)
+	}
+
+	// Reordering the query, as SetQueryParam does, and escaping it differently
+	// keep the signature valid.
+	u, _ := Parse(signed)
+	u.SetQueryParam("a", "x y")
+	if err := u.Verify(signKeys, signNow); err != nil {
+		t.Errorf("Verify(%q) was incorrect, got: %v, want: no error.", u.String(), err)
+	}
+	equivalent := strings.Replace(signed, "https://cdn.example.com/files/", "HTTPS://CDN.Example.COM:443/files/./", 1)
+	equivalent = strings.Replace(equivalent, "a=x+y", "a=x%20y", 1)
+	if err := Verify(equivalent, signKeys, signNow); err != nil {
+		t.Errorf("Verify(%q) was incorrect, got: %v, want: no error.", equivalent, err)
+	}
+}
+
+func TestVerifyError(t *testing.T) {
+	signed, _ := Sign("https://cdn.example.com/files/report.pdf?v=2", signKey, SignOptions{Expires: signExpires})
+	pathOnly, _ := Sign("https://cdn.example.com/files/report.pdf?v=2", signKey, SignOptions{Expires: signExpires, PathOnly: true, QueryKeys: []string{"v"}})
+	tests := []struct {
+		url string
+		now time.Time
+		err error
+	}{
+		{"https://cdn.example.com/files/report.pdf?v=2", signNow, ErrMissingSignature},
+		{signed, signExpires, ErrSignatureExpired},
+		{strings.Replace(signed, "v=2", "v=3", 1), signNow, ErrInvalidSignature},
+		{strings.Replace(signed, "report", "secret", 1), signNow, ErrInvalidSignature},
+		{strings.Replace(signed, "example.com", "example.org", 1), signNow, ErrInvalidSignature},
+		{strings.Replace(signed, "expires=1717200000", "expires=1917200000", 1), signNow, ErrInvalidSignature},
+		{signed + "&extra=1", signNow, ErrInvalidSignature},
+		{signed + "&expires=1917200000", signNow, ErrInvalidSignature},
+		{signed + "&signature=x", signNow, ErrInvalidSignature},
+		{strings.Replace(signed, "kid=k1", "kid=k0", 1), signNow, ErrInvalidSignature},
+		{strings.Replace(signed, "kid=k1", "kid=k9", 1), signNow, ErrUnknownSigningKey},
+		{strings.Replace(pathOnly, "v=2", "v=3", 1), signNow, ErrInvalidSignature},
+		{strings.Replace(pathOnly, "scope=path%2Cv", "scope=path", 1), signNow, ErrInvalidSignature},
+	}
+	for _, test := range tests {
+		err := Verify(test.url, signKeys, test.now)
+		var sigErr *SignatureError
+		if !errors.Is(err, test.err) || !errors.As(err, &sigErr) {
+			t.Errorf("Verify(%q) was incorrect, got: %v, want: %v.", test.url, err, test.err)
+		}
+	}
+
+	// A path-only signature ignores the host and the unsigned parameters.
+	moved := strings.Replace(pathOnly, "cdn.example.com", "mirror.example.net", 1) + "&token=abc"
+	if err := Verify(moved, signKeys, signNow); err != nil {
+		t.Errorf("Verify(%q) was incorrect, got: %v, want: no error.", moved, err)
+	}
+
+	err := Verify(signed, signKeys, signExpires.Add(time.Second))
+	if want := "gurl: signature expired at 2024-06-01T00:00:00Z"; err == nil || err.Error() != want {
+		t.Errorf("Verify was incorrect, got: %v, want: %s.", err, want)
+	}
+	if _, err := Sign("https://example.com/", SigningKey{}, SignOptions{}); !errors.Is(err, ErrInvalidSigningKey) {
+		t.Errorf("Sign was incorrect, got: %v, want: %v.", err, ErrInvalidSigningKey)
+	}
+}
+
+func TestSignBuilder(t *testing.T) {
+	signed, err := From("https://cdn.example.com/files/report.pdf").
+		Query("dl", "1").
+		Sign(signKey, SignOptions{Expires: signExpires}).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Verify(signed, signKeys, signNow); err != nil {
+		t.Errorf("Verify(%q) was incorrect, got: %v, want: no error.", signed, err)
+	}
+}
@@ -0,0 +1,367 @@
+package gurl
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// ErrUnsafeAddress is reported, wrapped in a *ValidationError, when a URL
+// points at an address that a RemotePolicy does not allow.
+var ErrUnsafeAddress = errors.New("gurl: unsafe address")
+
+// NetworkClass is the kind of network an IP address belongs to.
+type NetworkClass int
+
+const (
+	// NetworkPublic is any address not in one of the other classes.
+	NetworkPublic NetworkClass = iota
+	// NetworkUnspecified is 0.0.0.0/8 and ::.
+	NetworkUnspecified
+	// NetworkLoopback is 127.0.0.0/8 and ::1.
+	NetworkLoopback
+	// NetworkPrivate is 10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16, fc00::/7
+	// and the deprecated site-local fec0::/10.
+	NetworkPrivate
+	// NetworkLinkLocal is 169.254.0.0/16 and fe80::/10.
+	NetworkLinkLocal
+	// NetworkCGNAT is the shared address space 100.64.0.0/10 of RFC 6598.
+	NetworkCGNAT
+	// NetworkMulticast is 224.0.0.0/4 and ff00::/8.
+	NetworkMulticast
+	// NetworkMetadata is a cloud instance metadata service, such as
+	// 169.254.169.254.
+	NetworkMetadata
+	// NetworkReserved is any other special-purpose range, such as
+	// documentation, benchmarking, 240.0.0.0/4 and broadcast.
+	NetworkReserved
+)
+
+var networkClassNames = map[NetworkClass]string{
+	NetworkPublic:      "public",
+	NetworkUnspecified: "unspecified",
+	NetworkLoopback:    "loopback",
+	NetworkPrivate:     "private",
+	NetworkLinkLocal:   "link-local",
+	NetworkCGNAT:       "CGNAT",
+	NetworkMulticast:   "multicast",
+	NetworkMetadata:    "metadata",
+	NetworkReserved:    "reserved",
+}
+
+func (c NetworkClass) String() string {
+	if name, ok := networkClassNames[c]; ok {
+		return name
+	}
+	return "NetworkClass(" + strconv.Itoa(int(c)) + ")"
+}
+
+type classifiedNetwork struct {
+	network *net.IPNet
+	class   NetworkClass
+}
+
+// classifiedNetworks is searched in order, so metadata addresses come
+// before the ranges that contain them.
+var classifiedNetworks = func() []classifiedNetwork {
+	ranges := []struct {
+		cidr  string
+		class NetworkClass
+	}{
+		{"169.254.169.254/32", NetworkMetadata},
+		{"169.254.170.2/32", NetworkMetadata},
+		{"100.100.100.200/32", NetworkMetadata},
+		{"fd00:ec2::254/128", NetworkMetadata},
+		{"0.0.0.0/8", NetworkUnspecified},
+		{"10.0.0.0/8", NetworkPrivate},
+		{"100.64.0.0/10", NetworkCGNAT},
+		{"127.0.0.0/8", NetworkLoopback},
+		{"169.254.0.0/16", NetworkLinkLocal},
+		{"172.16.0.0/12", NetworkPrivate},
+		{"192.0.0.0/24", NetworkReserved},
+		{"192.0.2.0/24", NetworkReserved},
+		{"192.88.99.0/24", NetworkReserved},
+		{"192.168.0.0/16", NetworkPrivate},
+		{"198.18.0.0/15", NetworkReserved},
+		{"198.51.100.0/24", NetworkReserved},
+		{"203.0.113.0/24", NetworkReserved},
+		{"224.0.0.0/4", NetworkMulticast},
+		{"240.0.0.0/4", NetworkReserved},
+		{"::/128", NetworkUnspecified},
+		{"::1/128", NetworkLoopback},
+		{"::/96", NetworkReserved},
+		{"100::/64", NetworkReserved},
+		{"2001:db8::/32", NetworkReserved},
+		{"fc00::/7", NetworkPrivate},
+		{"fe80::/10", NetworkLinkLocal},
+		{"fec0::/10", NetworkPrivate},
+		{"ff00::/8", NetworkMulticast},
+	}
+	networks := make([]classifiedNetwork, len(ranges))
+	for i, r := range ranges {
+		_, network, err := net.ParseCIDR(r.cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks[i] = classifiedNetwork{network: network, class: r.class}
+	}
+	return networks
+}()
+
+// nat64Prefix is the well-known NAT64 prefix 64:ff9b::/96 of RFC 6052, which
+// embeds an IPv4 address in its last four bytes.
+var nat64Prefix = net.IP{0, 0x64, 0xff, 0x9b, 0, 0, 0, 0, 0, 0, 0, 0}
+
+// embeddedIPv4 returns the IPv4 address that the IPv6 address ip carries, or
+// nil. A 6to4 address (2002::/16, RFC 3056) carries it in bytes 2 to 5, and a
+// Teredo address (2001::/32, RFC 4380) carries the client address with every
+// bit flipped in its last four bytes.
+func embeddedIPv4(ip net.IP) net.IP {
+	if len(ip) != net.IPv6len {
+		return nil
+	}
+	switch {
+	case ip[:12].Equal(nat64Prefix):
+		return ip[12:]
+	case ip[0] == 0x20 && ip[1] == 0x02:
+		return net.IP{ip[2], ip[3], ip[4], ip[5]}
+	case ip[0] == 0x20 && ip[1] == 0x01 && ip[2] == 0 && ip[3] == 0:
+		return net.IP{^ip[12], ^ip[13], ^ip[14], ^ip[15]}
+	}
+	return nil
+}
+
+// ClassifyIP returns the kind of network ip belongs to. An IPv4 address
+// embedded in an IPv6 address, either IPv4-mapped (::ffff:127.0.0.1), NAT64
+// (64:ff9b::127.0.0.1), 6to4 (2002:7f00:1::) or Teredo, is classified as the
+// IPv4 address.
+//
+// Parameters:
+//
+//	ip: The address to classify.
+//
+// Returns:
+//
+//	The NetworkClass of the address.
+//
+// Example:
+//
+//	result := ClassifyIP(net.ParseIP("::ffff:169.254.169.254"))
+//	fmt.Println(result) // Output: metadata
+func ClassifyIP(ip net.IP) NetworkClass {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	} else if ip4 := embeddedIPv4(ip); ip4 != nil {
+		ip = ip4
+	}
+	if ip.Equal(net.IPv4bcast) {
+		return NetworkReserved
+	}
+	for _, n := range classifiedNetworks {
+		if n.network.Contains(ip) {
+			return n.class
+		}
+	}
+	return NetworkPublic
+}
+
+// parseIPv4 parses an IPv4 address in any of the forms accepted by browsers
+// and inet_aton: one to four dot-separated parts, each decimal, octal with a
+// leading "0", or hexadecimal with a leading "0x". For example "2130706433",
+// "0177.1" and "0x7f.0.0.1" are all 127.0.0.1.
+func parseIPv4(s string) (net.IP, bool) {
+	s = strings.TrimSuffix(s, ".")
+	parts := strings.Split(s, ".")
+	if s == "" || len(parts) > 4 {
+		return nil, false
+	}
+	var n uint64
+	for i, p := range parts {
+		base := 10
+		switch {
+		case len(p) >= 2 && (p[:2] == "0x" || p[:2] == "0X"):
+			base = 16
+			p = p[2:]
+			if p == "" {
+				p = "0"
+			}
+		case len(p) >= 2 && p[0] == '0':
+			base = 8
+			p = p[1:]
+		}
+		if p == "" || p[0] == '+' || p[0] == '-' {
+			return nil, false
+		}
+		v, err := strconv.ParseUint(p, base, 32)
+		if err != nil {
+			return nil, false
+		}
+		if i < len(parts)-1 {
+			if v > 255 {
+				return nil, false
+			}
+			n = n<<8 | v
+			continue
+		}
+		// The last part fills the remaining bytes.
+		bits := uint(8 * (5 - len(parts)))
+		if v >= 1<<bits {
+			return nil, false
+		}
+		n = n<<bits | v
+	}
+	return net.IPv4(byte(n>>24), byte(n>>16), byte(n>>8), byte(n)), true
+}
+
+// hostnameIP returns the IP address written in hostname, in any IPv4 form
+// accepted by parseIPv4 or as IPv6 with an optional zone.
+func hostnameIP(hostname string) (net.IP, bool) {
+	if strings.Contains(hostname, ":") {
+		if i := strings.IndexByte(hostname, '%'); i >= 0 {
+			hostname = hostname[:i]
+		}
+		ip := net.ParseIP(hostname)
+		return ip, ip != nil
+	}
+	return parseIPv4(hostname)
+}
+
+// hostnameClasses maps host names that always point at a special network
+// to its class. Subdomains of "localhost" are loopback too.
+var hostnameClasses = map[string]NetworkClass{
+	"localhost":                NetworkLoopback,
+	"localhost.localdomain":    NetworkLoopback,
+	"ip6-localhost":            NetworkLoopback,
+	"ip6-loopback":             NetworkLoopback,
+	"metadata":                 NetworkMetadata,
+	"metadata.google.internal": NetworkMetadata,
+	"instance-data":            NetworkMetadata,
+}
+
+func classifyHostname(hostname string) (NetworkClass, bool) {
+	hostname = strings.ToLower(strings.TrimSuffix(hostname, "."))
+	if class, ok := hostnameClasses[hostname]; ok {
+		return class, true
+	}
+	if strings.HasSuffix(hostname, ".localhost") {
+		return NetworkLoopback, true
+	}
+	return NetworkPublic, false
+}
+
+// Resolver looks up the IP addresses of a host. *net.Resolver implements it.
+type Resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// RemotePolicy decides whether a URL is safe to fetch from a server, such as
+// a user-supplied webhook URL, without reaching internal services.
+// The zero value allows http and https URLs pointing at public addresses.
+type RemotePolicy struct {
+	// AllowedSchemes lists the accepted schemes. It defaults to http and https.
+	AllowedSchemes []string
+	// AllowedClasses lists the network classes accepted besides NetworkPublic.
+	AllowedClasses []NetworkClass
+	// Resolver, if set, is used to resolve host names, and every address it
+	// returns must be allowed. Without it, only IP literals and well-known
+	// host names such as "localhost" are checked. See CheckContext for the
+	// limits of checking resolved addresses.
+	Resolver Resolver
+}
+
+// Check checks that u only points at addresses allowed by the policy.
+func (p *RemotePolicy) Check(u string) error {
+	return p.CheckContext(context.Background(), u)
+}
+
+// CheckContext is like Check, but passes ctx to the Resolver. A host name
+// that resolves to no address is rejected.
+//
+// The check does not pin the addresses it resolved: when the URL is fetched,
+// the name is resolved again and may then point at an internal address, as in
+// DNS rebinding. Unless the caller connects to the vetted address, it should
+// check the address again when connecting, for example with ClassifyIP in the
+// Control function of a net.Dialer.
+func (p *RemotePolicy) CheckContext(ctx context.Context, u string) error {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return err
+	}
+	schemes := p.AllowedSchemes
+	if len(schemes) == 0 {
+		schemes = []string{"http", "https"}
+	}
+	hostname := parsedURL.GetHostname()
+	ip, isIP := hostnameIP(hostname)
+	if isIP {
+		// Validate does not know the IPv4 forms of parseIPv4.
+		parsedURL.SetHostname(ip.String())
+	}
+	rules := ValidationRules{
+		AllowedSchemes:  schemes,
+		AllowIPLiterals: true,
+		AllowUserinfo:   true,
+	}
+	if err := parsedURL.validate(rules); err != nil {
+		return err
+	}
+	if isIP {
+		return p.checkClass(hostname, ClassifyIP(ip))
+	}
+	if class, ok := classifyHostname(hostname); ok {
+		return p.checkClass(hostname, class)
+	}
+	if p.Resolver == nil {
+		return nil
+	}
+	addrs, err := p.Resolver.LookupIPAddr(ctx, hostname)
+	if err != nil {
+		return err
+	}
+	if len(addrs) == 0 {
+		return &ValidationError{Err: ErrUnsafeAddress, Detail: strconv.Quote(hostname) + " has no addresses"}
+	}
+	for _, addr := range addrs {
+		if err := p.checkClass(hostname+" ("+addr.IP.String()+")", ClassifyIP(addr.IP)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *RemotePolicy) checkClass(host string, class NetworkClass) error {
+	if class == NetworkPublic {
+		return nil
+	}
+	for _, allowed := range p.AllowedClasses {
+		if class == allowed {
+			return nil
+		}
+	}
+	return &ValidationError{Err: ErrUnsafeAddress, Detail: strconv.Quote(host) + " is " + class.String()}
+}
+
+// CheckSafeRemoteURL checks that a URL is an HTTP or HTTPS URL that does not point at a loopback,
+// private, link-local, CGNAT, multicast, metadata or other special address. IPv4 addresses written
+// in decimal, octal or hexadecimal, IPv4-mapped IPv6 addresses and "localhost" aliases are detected.
+// Host names are not resolved; use a RemotePolicy with a Resolver to check their addresses.
+//
+// Parameters:
+//
+//	url: The URL to check.
+//
+// Returns:
+//
+//	nil if the URL is safe, or an error. A *ValidationError wrapping ErrUnsafeAddress is returned
+//	for a disallowed address.
+//
+// Example:
+//
+//	err := CheckSafeRemoteURL("http://0x7f.1/admin")
+//	fmt.Println(errors.Is(err, ErrUnsafeAddress)) // Output: true
+func CheckSafeRemoteURL(u string) error {
+	var policy RemotePolicy
+	return policy.Check(u)
+}
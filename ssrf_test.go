@@ -0,0 +1,177 @@
+package gurl
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+)
+
+func TestClassifyIP(t *testing.T) {
+	tests := map[string]NetworkClass{
+		"8.8.8.8":                              NetworkPublic,
+		"2606:4700::1111":                      NetworkPublic,
+		"0.0.0.0":                              NetworkUnspecified,
+		"::":                                   NetworkUnspecified,
+		"127.0.0.1":                            NetworkLoopback,
+		"127.255.0.1":                          NetworkLoopback,
+		"::1":                                  NetworkLoopback,
+		"10.1.2.3":                             NetworkPrivate,
+		"172.31.0.1":                           NetworkPrivate,
+		"172.32.0.1":                           NetworkPublic,
+		"192.168.1.1":                          NetworkPrivate,
+		"fd12:3456::1":                         NetworkPrivate,
+		"169.254.1.1":                          NetworkLinkLocal,
+		"fe80::1":                              NetworkLinkLocal,
+		"100.64.0.1":                           NetworkCGNAT,
+		"100.127.255.255":                      NetworkCGNAT,
+		"224.0.0.1":                            NetworkMulticast,
+		"ff02::1":                              NetworkMulticast,
+		"169.254.169.254":                      NetworkMetadata,
+		"fd00:ec2::254":                        NetworkMetadata,
+		"100.100.100.200":                      NetworkMetadata,
+		"255.255.255.255":                      NetworkReserved,
+		"192.0.2.1":                            NetworkReserved,
+		"::ffff:127.0.0.1":                     NetworkLoopback,
+		"::ffff:169.254.169.254":               NetworkMetadata,
+		"64:ff9b::10.0.0.1":                    NetworkPrivate,
+		"2002:7f00:1::":                        NetworkLoopback,
+		"2002:a9fe:a9fe::":                     NetworkMetadata,
+		"2002:c0a8:101::1":                     NetworkPrivate,
+		"2002:5db8:d822::":                     NetworkPublic,
+		"2001:0:4136:e378:8000:63bf:80ff:fffe": NetworkLoopback,
+		"2001:0:4136:e378:8000:63bf:f5ff:fffe": NetworkPrivate,
+		"2001:0:4136:e378:8000:63bf:a247:27dd": NetworkPublic,
+		"2001:db8::1":                          NetworkReserved,
+	}
+	for addr, want := range tests {
+		if result := ClassifyIP(net.ParseIP(addr)); result != want {
+			t.Errorf("ClassifyIP was incorrect, got: %s, want: %s, for: %s.", result, want, addr)
+		}
+	}
+}
+
+func TestParseIPv4(t *testing.T) {
+	tests := map[string]string{
+		"127.0.0.1":     "127.0.0.1",
+		"2130706433":    "127.0.0.1",
+		"0x7f.1":        "127.0.0.1",
+		"0177.0.0.01":   "127.0.0.1",
+		"0x7F000001":    "127.0.0.1",
+		"169.254.43518": "169.254.169.254",
+		"0xa9fea9fe":    "169.254.169.254",
+		"127.1.":        "127.0.0.1",
+		"0":             "0.0.0.0",
+		"256.0.0.1":     "",
+		"1.2.3.4.5":     "",
+		"4294967296":    "",
+		"08.0.0.1":      "",
+		"example.com":   "",
+		"1.2.3.-4":      "",
+	}
+	for input, want := range tests {
+		ip, ok := parseIPv4(input)
+		if result := ip.String(); ok && result != want || !ok && want != "" {
+			t.Errorf("parseIPv4 was incorrect, got: %s, want: %s, for: %s.", result, want, input)
+		}
+	}
+}
+
+func TestCheckSafeRemoteURL(t *testing.T) {
+	type SafeTest struct {
+		url string
+		err error
+	}
+	tests := []SafeTest{
+		{"https://example.com/hook", nil},
+		{"http://93.184.216.34/hook", nil},
+		{"ftp://example.com/hook", ErrDisallowedScheme},
+		{"http://169.254.169.254/latest/meta-data/", ErrUnsafeAddress},
+		{"http://0x7f.1/", ErrUnsafeAddress},
+		{"http://2130706433/", ErrUnsafeAddress},
+		{"http://0177.0.0.1:8080/", ErrUnsafeAddress},
+		{"http://[::ffff:127.0.0.1]/", ErrUnsafeAddress},
+		{"http://[::ffff:a9fe:a9fe]/", ErrUnsafeAddress},
+		{"http://[2002:7f00:1::]/", ErrUnsafeAddress},
+		{"http://[2001:0:4136:e378:8000:63bf:80ff:fffe]/", ErrUnsafeAddress},
+		{"http://[fe80::1%25eth0]/", ErrUnsafeAddress},
+		{"http://[::]/", ErrUnsafeAddress},
+		{"http://0/", ErrUnsafeAddress},
+		{"http://LOCALHOST./", ErrUnsafeAddress},
+		{"http://api.localhost/", ErrUnsafeAddress},
+		{"http://metadata.google.internal/", ErrUnsafeAddress},
+		{"http://user@10.0.0.1/", ErrUnsafeAddress},
+		{"http://100.64.1.1/", ErrUnsafeAddress},
+		{"http://239.1.1.1/", ErrUnsafeAddress},
+	}
+	for _, test := range tests {
+		err := CheckSafeRemoteURL(test.url)
+		if !errors.Is(err, test.err) || (err == nil) != (test.err == nil) {
+			t.Errorf("CheckSafeRemoteURL was incorrect, got: %v, want: %v, for: %s.", err, test.err, test.url)
+		}
+	}
+}
+
+type fakeResolver map[string][]string
+
+func (r fakeResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	addrs, ok := r[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	var ipAddrs []net.IPAddr
+	for _, addr := range addrs {
+		ipAddrs = append(ipAddrs, net.IPAddr{IP: net.ParseIP(addr)})
+	}
+	return ipAddrs, nil
+}
+
+func TestRemotePolicy(t *testing.T) {
+	policy := RemotePolicy{
+		Resolver: fakeResolver{
+			"example.com":       {"93.184.216.34"},
+			"rebind.example":    {"93.184.216.34", "127.0.0.1"},
+			"internal.example":  {"10.0.0.5"},
+			"metadata.example":  {"::ffff:169.254.169.254"},
+			"cgnat.example":     {"100.64.0.1"},
+			"ipv6only.example":  {"2606:4700::1111"},
+			"linklocal.example": {"fe80::1"},
+			"empty.example":     {},
+		},
+	}
+	type PolicyTest struct {
+		url string
+		err error
+	}
+	tests := []PolicyTest{
+		{"https://example.com/", nil},
+		{"https://ipv6only.example/", nil},
+		{"https://rebind.example/", ErrUnsafeAddress},
+		{"https://internal.example/", ErrUnsafeAddress},
+		{"https://metadata.example/", ErrUnsafeAddress},
+		{"https://cgnat.example/", ErrUnsafeAddress},
+		{"https://linklocal.example/", ErrUnsafeAddress},
+		{"https://empty.example/", ErrUnsafeAddress},
+	}
+	for _, test := range tests {
+		err := policy.Check(test.url)
+		if !errors.Is(err, test.err) || (err == nil) != (test.err == nil) {
+			t.Errorf("RemotePolicy.Check was incorrect, got: %v, want: %v, for: %s.", err, test.err, test.url)
+		}
+	}
+	var dnsErr *net.DNSError
+	if err := policy.Check("https://missing.example/"); !errors.As(err, &dnsErr) {
+		t.Errorf("RemotePolicy.Check was incorrect, got: %v, want: *net.DNSError.", err)
+	}
+
+	policy.AllowedClasses = []NetworkClass{NetworkPrivate}
+	if err := policy.Check("https://internal.example/"); err != nil {
+		t.Errorf("RemotePolicy.Check was incorrect, got: %v, want: nil.", err)
+	}
+	if err := policy.Check("http://192.168.0.1/"); err != nil {
+		t.Errorf("RemotePolicy.Check was incorrect, got: %v, want: nil.", err)
+	}
+	if err := policy.Check("http://127.0.0.1/"); !errors.Is(err, ErrUnsafeAddress) {
+		t.Errorf("RemotePolicy.Check was incorrect, got: %v, want: %v.", err, ErrUnsafeAddress)
+	}
+}
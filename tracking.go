@@ -0,0 +1,214 @@
+package gurl
+
+import (
+	_ "embed"
+	"strings"
+)
+
+// trackingData is the rule list that DefaultTrackingRules is parsed from.
+//
+//go:embed tracking.dat
+var trackingData string
+
+// TrackingRule is a tracking parameter that StripTracking removes.
+type TrackingRule struct {
+	// Param is the name of the parameter, as a glob in which "*" matches any
+	// run of characters, such as "utm_*". It is matched without regard to
+	// case.
+	Param string
+	// Domains limits the rule to URLs whose host matches one of these globs,
+	// or is a subdomain of a match, such as "amazon.*". The rule applies to
+	// every URL if Domains is empty.
+	Domains []string
+}
+
+// DefaultTrackingRules are the rules of the embedded list, which covers the
+// parameters of analytics, ad and mailing tools such as utm_*, fbclid, gclid
+// and mc_eid, and site-specific ones such as the ref of Amazon and the si of
+// YouTube. It can be extended with TrackingOptions.ExtraRules.
+var DefaultTrackingRules = ParseTrackingRules(trackingData)
+
+// ParseTrackingRules parses a rule list in the format of the embedded list:
+// one rule per line, the parameter followed by the domains it applies to,
+// separated by spaces. Empty lines and lines starting with "//" are skipped.
+//
+// Parameters:
+//
+//	text: The rule list.
+//
+// Returns:
+//
+//	The rules.
+//
+// Example:
+//
+//	rules := ParseTrackingRules("// Our newsletter\nnl_id\nsrc shop.example.com")
+//	fmt.Println(rules) // Output: [{nl_id []} {src [shop.example.com]}]
+func ParseTrackingRules(text string) []TrackingRule {
+	var rules []TrackingRule
+	for _, line := range strings.Split(text, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "//") {
+			continue
+		}
+		rules = append(rules, TrackingRule{Param: fields[0], Domains: fields[1:]})
+	}
+	return rules
+}
+
+// TrackingOptions configures StripTracking. The zero value removes the
+// parameters of DefaultTrackingRules from the query and the fragment.
+type TrackingOptions struct {
+	// Rules replaces DefaultTrackingRules if it is not nil.
+	Rules []TrackingRule
+	// ExtraRules are applied in addition to Rules.
+	ExtraRules []TrackingRule
+	// Keep lists globs of parameters that are never removed, even if a rule
+	// matches them.
+	Keep []string
+	// SkipFragment leaves the hash parameters of the fragment untouched.
+	SkipFragment bool
+}
+
+// matchGlob reports whether s matches pattern, in which "*" matches any run
+// of characters.
+func matchGlob(pattern, s string) bool {
+	px, sx := 0, 0
+	starPx, starSx := -1, -1
+	for px < len(pattern) || sx < len(s) {
+		if px < len(pattern) {
+			if pattern[px] == '*' {
+				starPx, starSx = px, sx
+				px++
+				continue
+			}
+			if sx < len(s) && pattern[px] == s[sx] {
+				px++
+				sx++
+				continue
+			}
+		}
+		// Let the last "*" match one more character and try again.
+		if starPx >= 0 && starSx < len(s) {
+			starSx++
+			px, sx = starPx+1, starSx
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// appliesTo reports whether the rule applies to the lowercase host.
+func (r TrackingRule) appliesTo(host string) bool {
+	if len(r.Domains) == 0 {
+		return true
+	}
+	for _, domain := range r.Domains {
+		domain = strings.ToLower(domain)
+		for h := host; ; {
+			if matchGlob(domain, h) {
+				return true
+			}
+			i := strings.IndexByte(h, '.')
+			if i < 0 {
+				break
+			}
+			h = h[i+1:]
+		}
+	}
+	return false
+}
+
+// StripTracking removes the tracking parameters of the rules from the query
+// and, unless opts.SkipFragment is set, from the hash parameters. The other
+// parameters keep their order and encoding. It returns the names of the
+// removed parameters, each once, with those of hash parameters prefixed
+// with "#".
+func (u *URL) StripTracking(opts TrackingOptions) []string {
+	rules := opts.Rules
+	if rules == nil {
+		rules = DefaultTrackingRules
+	}
+	host := strings.ToLower(u.GetHostname())
+	var params []string
+	for _, r := range append(rules[:len(rules):len(rules)], opts.ExtraRules...) {
+		if r.appliesTo(host) {
+			params = append(params, strings.ToLower(r.Param))
+		}
+	}
+	isTracking := func(key string) bool {
+		key = strings.ToLower(key)
+		for _, keep := range opts.Keep {
+			if matchGlob(strings.ToLower(keep), key) {
+				return false
+			}
+		}
+		for _, param := range params {
+			if matchGlob(param, key) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var removed []string
+	seen := make(map[string]bool)
+	preserve := u.preserveQuery
+	u.PreserveQuery(true)
+	for _, t := range u.tokens {
+		if !seen[t.key] && isTracking(t.key) {
+			seen[t.key] = true
+			removed = append(removed, t.key)
+		}
+	}
+	for _, key := range removed {
+		u.DelQueryParam(key)
+	}
+	u.PreserveQuery(preserve)
+
+	if !opts.SkipFragment {
+		n := len(removed)
+		for _, p := range u.fragment.Params {
+			if !seen["#"+p.Key] && isTracking(p.Key) {
+				seen["#"+p.Key] = true
+				removed = append(removed, "#"+p.Key)
+			}
+		}
+		for _, key := range removed[n:] {
+			u.DelHashParam(key[1:])
+		}
+	}
+	return removed
+}
+
+// StripTracking removes tracking parameters such as utm_*, fbclid, gclid
+// and mc_eid from the query and the fragment of a URL, keeping the order and
+// encoding of the other parameters.
+//
+// Parameters:
+//
+//	u: The URL to strip.
+//	opts: The rules to apply, and the parameters to keep.
+//
+// Returns:
+//
+//	A string containing the new URL, the names of the removed parameters,
+//	with those of hash parameters prefixed with "#", and an error if any
+//	occurred.
+//
+// Example:
+//
+//	result, removed, err := StripTracking("https://www.amazon.com/dp/B0?ref=nav&th=1&utm_source=x#?fbclid=y", TrackingOptions{})
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result, removed) // Output: https://www.amazon.com/dp/B0?th=1 [ref utm_source #fbclid]
+func StripTracking(u string, opts TrackingOptions) (string, []string, error) {
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return "", nil, err
+	}
+	removed := parsedURL.StripTracking(opts)
+	return parsedURL.String(), removed, nil
+}
@@ -0,0 +1,73 @@
+package gurl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStripTracking(t *testing.T) {
+	tests := []struct {
+		url     string
+		opts    TrackingOptions
+		result  string
+		removed []string
+	}{
+		{"https://example.com/a?z=1&utm_source=news&utm_medium=email&fbclid=abc&a=%7e", TrackingOptions{}, "https://example.com/a?z=1&a=%7e", []string{"utm_source", "utm_medium", "fbclid"}},
+		{"https://example.com/?UTM_Campaign=x&gclid=1&gclid=2&mc_eid=3", TrackingOptions{}, "https://example.com/", []string{"UTM_Campaign", "gclid", "mc_eid"}},
+		{"https://www.amazon.co.uk/dp/B0?ref=nav&th=1&pf_rd_p=2", TrackingOptions{}, "https://www.amazon.co.uk/dp/B0?th=1", []string{"ref", "pf_rd_p"}},
+		{"https://example.com/?ref=home&si=1", TrackingOptions{}, "https://example.com/?ref=home&si=1", nil},
+		{"https://youtu.be/abc?si=xyz&t=42", TrackingOptions{}, "https://youtu.be/abc?t=42", []string{"si"}},
+		{"https://example.com/#/page?utm_source=x&tab=1", TrackingOptions{}, "https://example.com/#/page?tab=1", []string{"#utm_source"}},
+		{"https://example.com/?utm_source=a#?utm_source=b", TrackingOptions{SkipFragment: true}, "https://example.com/#?utm_source=b", []string{"utm_source"}},
+		{"https://example.com/?utm_source=a&utm_id=7", TrackingOptions{Keep: []string{"utm_id"}}, "https://example.com/?utm_id=7", []string{"utm_source"}},
+		{"https://shop.example.com/?src=mail&utm_source=a", TrackingOptions{ExtraRules: ParseTrackingRules("src example.com")}, "https://shop.example.com/", []string{"src", "utm_source"}},
+		{"https://example.com/?src=mail&utm_source=a", TrackingOptions{Rules: []TrackingRule{{Param: "src"}}}, "https://example.com/?utm_source=a", []string{"src"}},
+	}
+	for _, test := range tests {
+		result, removed, err := StripTracking(test.url, test.opts)
+		if err != nil {
+			t.Errorf("StripTracking(%q) returned an error: %v.", test.url, err)
+			continue
+		}
+		if result != test.result {
+			t.Errorf("StripTracking(%q) was incorrect, got: %s, want: %s.", test.url, result, test.result)
+		}
+		if !reflect.DeepEqual(removed, test.removed) {
+			t.Errorf("StripTracking(%q) removed keys were incorrect, got: %v, want: %v.", test.url, removed, test.removed)
+		}
+	}
+}
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		s       string
+		result  bool
+	}{
+		{"utm_*", "utm_source", true},
+		{"utm_*", "utm_", true},
+		{"utm_*", "xutm_source", false},
+		{"*_id", "campaign_id", true},
+		{"a*b*c", "axxbyyc", true},
+		{"a*b*c", "axxbyy", false},
+		{"amazon.*", "amazon.co.uk", true},
+		{"ref", "ref_", false},
+		{"*", "", true},
+	}
+	for _, test := range tests {
+		if result := matchGlob(test.pattern, test.s); result != test.result {
+			t.Errorf("matchGlob(%q, %q) was incorrect, got: %v, want: %v.", test.pattern, test.s, result, test.result)
+		}
+	}
+}
+
+func TestParseTrackingRules(t *testing.T) {
+	rules := ParseTrackingRules("// Our newsletter\nnl_id\n\nsrc  shop.example.com  example.org\n")
+	want := []TrackingRule{{Param: "nl_id", Domains: []string{}}, {Param: "src", Domains: []string{"shop.example.com", "example.org"}}}
+	if !reflect.DeepEqual(rules, want) {
+		t.Errorf("ParseTrackingRules was incorrect, got: %v, want: %v.", rules, want)
+	}
+	if len(DefaultTrackingRules) == 0 {
+		t.Errorf("DefaultTrackingRules is empty.")
+	}
+}
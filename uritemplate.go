@@ -0,0 +1,669 @@
+package gurl
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Errors reported by URI templates. They are wrapped in a *URITemplateError
+// and can be checked with errors.Is.
+var (
+	ErrInvalidURITemplate      = errors.New("gurl: invalid URI template")
+	ErrInvalidURITemplateValue = errors.New("gurl: invalid URI template value")
+)
+
+// URITemplateError describes why a URI template could not be compiled, or
+// why a variable could not be expanded.
+type URITemplateError struct {
+	// Template is the URI template.
+	Template string
+	// Name is the variable, or the part of the template, the error is about.
+	Name string
+	// Err is ErrInvalidURITemplate or ErrInvalidURITemplateValue.
+	Err error
+}
+
+func (e *URITemplateError) Error() string {
+	return e.Err.Error() + " " + strconv.Quote(e.Name) + " in " + strconv.Quote(e.Template)
+}
+
+func (e *URITemplateError) Unwrap() error {
+	return e.Err
+}
+
+// uriTemplateOperator is how an expression operator expands its variables,
+// as in the table of RFC 6570 appendix A.
+type uriTemplateOperator struct {
+	first         string
+	sep           string
+	named         bool
+	ifemp         string
+	allowReserved bool
+}
+
+var uriTemplateOperators = map[byte]uriTemplateOperator{
+	0:   {first: "", sep: ","},
+	'+': {first: "", sep: ",", allowReserved: true},
+	'#': {first: "#", sep: ",", allowReserved: true},
+	'.': {first: ".", sep: "."},
+	'/': {first: "/", sep: "/"},
+	';': {first: ";", sep: ";", named: true},
+	'?': {first: "?", sep: "&", named: true, ifemp: "="},
+	'&': {first: "&", sep: "&", named: true, ifemp: "="},
+}
+
+// uriTemplateVar is a variable of an expression, with its modifier.
+type uriTemplateVar struct {
+	name    string
+	prefix  int
+	explode bool
+}
+
+// uriTemplatePart is a literal, already percent-encoded, or an expression
+// if vars is not empty.
+type uriTemplatePart struct {
+	literal string
+	op      uriTemplateOperator
+	vars    []uriTemplateVar
+}
+
+// URITemplate is a compiled RFC 6570 URI template, such as
+// "https://api.example.com/users/{id}{?fields,limit}{#section}". All four
+// levels of the RFC are supported: the operators "+", "#", ".", "/", ";",
+// "?" and "&", the prefix modifier ":n" and the explode modifier "*".
+//
+// A URITemplate is safe for concurrent use.
+type URITemplate struct {
+	template string
+	parts    []uriTemplatePart
+	re       *regexp.Regexp
+}
+
+// CompileURITemplate parses a URI template.
+//
+// Parameters:
+//
+//	template: The URI template, such as "/users/{id}{?fields*}".
+//
+// Returns:
+//
+//	The compiled template, and a *URITemplateError wrapping
+//	ErrInvalidURITemplate if the template is not valid.
+//
+// Example:
+//
+//	t, err := CompileURITemplate("https://api.example.com/users/{id}{?fields,limit}")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	result, err := t.Expand(map[string]interface{}{"id": 42, "fields": []string{"name", "email"}})
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "https://api.example.com/users/42?fields=name,email"
+func CompileURITemplate(template string) (*URITemplate, error) {
+	t := &URITemplate{template: template}
+	literal := ""
+	for i := 0; i < len(template); {
+		switch template[i] {
+		case '{':
+			end := strings.IndexByte(template[i:], '}')
+			if end < 0 {
+				return nil, t.error(template[i:], ErrInvalidURITemplate)
+			}
+			expr := template[i : i+end+1]
+			part, ok := parseURITemplateExpression(expr[1 : len(expr)-1])
+			if !ok {
+				return nil, t.error(expr, ErrInvalidURITemplate)
+			}
+			if literal != "" {
+				t.parts = append(t.parts, uriTemplatePart{literal: literal})
+				literal = ""
+			}
+			t.parts = append(t.parts, part)
+			i += end + 1
+		case '}':
+			return nil, t.error(template[:i+1], ErrInvalidURITemplate)
+		default:
+			end := strings.IndexAny(template[i:], "{}")
+			if end < 0 {
+				end = len(template) - i
+			}
+			literal += encodeURITemplate(template[i:i+end], true)
+			i += end
+		}
+	}
+	if literal != "" {
+		t.parts = append(t.parts, uriTemplatePart{literal: literal})
+	}
+	t.re = regexp.MustCompile(t.regexp())
+	return t, nil
+}
+
+// MustCompileURITemplate is like CompileURITemplate but panics if the
+// template is not valid. It is meant for templates in package-level
+// variables.
+func MustCompileURITemplate(template string) *URITemplate {
+	t, err := CompileURITemplate(template)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func (t *URITemplate) error(name string, err error) *URITemplateError {
+	return &URITemplateError{Template: t.template, Name: name, Err: err}
+}
+
+// parseURITemplateExpression parses the text between the braces of an
+// expression.
+func parseURITemplateExpression(expr string) (uriTemplatePart, bool) {
+	var part uriTemplatePart
+	if expr == "" {
+		return part, false
+	}
+	op := expr[0]
+	if strings.IndexByte("=,!@|", op) >= 0 {
+		// Reserved by the RFC for future extensions.
+		return part, false
+	}
+	if _, ok := uriTemplateOperators[op]; ok {
+		expr = expr[1:]
+	} else {
+		op = 0
+	}
+	part.op = uriTemplateOperators[op]
+	for _, spec := range strings.Split(expr, ",") {
+		var v uriTemplateVar
+		if strings.HasSuffix(spec, "*") {
+			spec, v.explode = spec[:len(spec)-1], true
+		} else if name, length, ok := strings.Cut(spec, ":"); ok {
+			if length == "" || len(length) > 4 || length[0] == '0' || strings.Trim(length, "0123456789") != "" {
+				return part, false
+			}
+			spec = name
+			v.prefix, _ = strconv.Atoi(length)
+		}
+		if !isURITemplateVarname(spec) {
+			return part, false
+		}
+		v.name = spec
+		part.vars = append(part.vars, v)
+	}
+	return part, true
+}
+
+// isURITemplateVarname reports whether name is made of letters, digits,
+// "_" and percent-encoded bytes, with single dots between them.
+func isURITemplateVarname(name string) bool {
+	if name == "" || name[0] == '.' || name[len(name)-1] == '.' || strings.Contains(name, "..") {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '%':
+			if i+2 >= len(name) || !isHex(name[i+1]) || !isHex(name[i+2]) {
+				return false
+			}
+			i += 2
+		case c != '_' && c != '.' && !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'):
+			return false
+		}
+	}
+	return true
+}
+
+// encodeURITemplate percent-encodes s, keeping unreserved characters and,
+// if allowReserved is set, reserved characters and percent-encoded bytes.
+func encodeURITemplate(s string, allowReserved bool) string {
+	const upperhex = "0123456789ABCDEF"
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || strings.IndexByte("-._~", c) >= 0:
+			b.WriteByte(c)
+		case allowReserved && strings.IndexByte(":/?#[]@!$&'()*+,;=", c) >= 0:
+			b.WriteByte(c)
+		case allowReserved && c == '%' && i+2 < len(s) && isHex(s[i+1]) && isHex(s[i+2]):
+			b.WriteString(s[i : i+3])
+			i += 2
+		default:
+			b.WriteByte('%')
+			b.WriteByte(upperhex[c>>4])
+			b.WriteByte(upperhex[c&15])
+		}
+	}
+	return b.String()
+}
+
+// String returns the template it was compiled from.
+func (t *URITemplate) String() string {
+	return t.template
+}
+
+// Variables returns the names of the variables of the template, in the
+// order they first appear.
+func (t *URITemplate) Variables() []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, part := range t.parts {
+		for _, v := range part.vars {
+			if !seen[v.name] {
+				seen[v.name] = true
+				names = append(names, v.name)
+			}
+		}
+	}
+	return names
+}
+
+// The kinds of values a variable can have.
+const (
+	uriTemplateUndefined = iota
+	uriTemplateString
+	uriTemplateList
+	uriTemplateMap
+)
+
+// uriTemplateValue converts the value of a variable. A list is returned as
+// its items, and an associative array as its keys and values alternately,
+// sorted by key. Undefined items and values are left out.
+func uriTemplateValue(v interface{}) (int, string, []string, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return uriTemplateUndefined, "", nil, nil
+		}
+		rv = rv.Elem()
+	}
+	var items []string
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return uriTemplateUndefined, "", nil, nil
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			item, ok, err := uriTemplateScalar(rv.Index(i))
+			if err != nil {
+				return 0, "", nil, err
+			}
+			if ok {
+				items = append(items, item)
+			}
+		}
+		if len(items) == 0 {
+			return uriTemplateUndefined, "", nil, nil
+		}
+		return uriTemplateList, "", items, nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return 0, "", nil, ErrInvalidURITemplateValue
+		}
+		keys := rv.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		for _, key := range keys {
+			value, ok, err := uriTemplateScalar(rv.MapIndex(key))
+			if err != nil {
+				return 0, "", nil, err
+			}
+			if ok {
+				items = append(items, key.String(), value)
+			}
+		}
+		if len(items) == 0 {
+			return uriTemplateUndefined, "", nil, nil
+		}
+		return uriTemplateMap, "", items, nil
+	}
+	value, _, err := uriTemplateScalar(rv)
+	if err != nil {
+		return 0, "", nil, err
+	}
+	return uriTemplateString, value, nil, nil
+}
+
+// uriTemplateScalar formats a string, bool or number. ok is false if the
+// value is nil.
+func uriTemplateScalar(rv reflect.Value) (value string, ok bool, err error) {
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return "", false, nil
+		}
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), true, nil
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), true, nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, rv.Type().Bits()), true, nil
+	}
+	return "", false, ErrInvalidURITemplateValue
+}
+
+// Expand expands the template with values. A value is a string, a bool or
+// a number; a slice, which is a list; or a map with string keys, which is
+// an associative array and is expanded in key order. A variable that is
+// missing or nil, or an empty list or map, is undefined and is left out.
+//
+// It returns a *URITemplateError wrapping ErrInvalidURITemplateValue if a
+// value has another type, or if a prefix modifier is applied to a list or
+// map.
+func (t *URITemplate) Expand(values map[string]interface{}) (string, error) {
+	var b strings.Builder
+	for _, part := range t.parts {
+		if part.vars == nil {
+			b.WriteString(part.literal)
+			continue
+		}
+		op := part.op
+		first := true
+		for _, v := range part.vars {
+			kind, value, items, err := uriTemplateValue(values[v.name])
+			if err != nil || v.prefix > 0 && (kind == uriTemplateList || kind == uriTemplateMap) {
+				return "", t.error(v.name, ErrInvalidURITemplateValue)
+			}
+			if kind == uriTemplateUndefined {
+				continue
+			}
+			if first {
+				b.WriteString(op.first)
+				first = false
+			} else {
+				b.WriteString(op.sep)
+			}
+
+			switch {
+			case kind == uriTemplateString:
+				if op.named {
+					b.WriteString(v.name)
+					if value == "" {
+						b.WriteString(op.ifemp)
+						continue
+					}
+					b.WriteByte('=')
+				}
+				if v.prefix > 0 {
+					n := 0
+					for i := range value {
+						if n == v.prefix {
+							value = value[:i]
+							break
+						}
+						n++
+					}
+				}
+				b.WriteString(encodeURITemplate(value, op.allowReserved))
+			case !v.explode:
+				if op.named {
+					b.WriteString(v.name + "=")
+				}
+				for i, item := range items {
+					if i > 0 {
+						b.WriteByte(',')
+					}
+					b.WriteString(encodeURITemplate(item, op.allowReserved))
+				}
+			case kind == uriTemplateList:
+				for i, item := range items {
+					if i > 0 {
+						b.WriteString(op.sep)
+					}
+					if op.named {
+						b.WriteString(v.name)
+						if item == "" {
+							b.WriteString(op.ifemp)
+							continue
+						}
+						b.WriteByte('=')
+					}
+					b.WriteString(encodeURITemplate(item, op.allowReserved))
+				}
+			default:
+				for i := 0; i < len(items); i += 2 {
+					if i > 0 {
+						b.WriteString(op.sep)
+					}
+					b.WriteString(encodeURITemplate(items[i], op.allowReserved))
+					if op.named && items[i+1] == "" {
+						b.WriteString(op.ifemp)
+						continue
+					}
+					b.WriteByte('=')
+					b.WriteString(encodeURITemplate(items[i+1], op.allowReserved))
+				}
+			}
+		}
+	}
+	return b.String(), nil
+}
+
+// ExpandURITemplate expands an RFC 6570 URI template with values, as
+// URITemplate.Expand does.
+//
+// Parameters:
+//
+//	template: The URI template, such as "/users/{id}{?fields*}".
+//	values: The values of the variables.
+//
+// Returns:
+//
+//	A string containing the expanded URL, and an error if any occurred.
+//
+// Example:
+//
+//	result, err := ExpandURITemplate("https://api.example.com/users/{id}{?fields,limit}{#section}", map[string]interface{}{
+//	  "id":      42,
+//	  "fields":  []string{"name", "email"},
+//	  "section": "profile",
+//	})
+//	if err != nil {
+//	  panic(err)
+//	}
+//	fmt.Println(result) // Output: "https://api.example.com/users/42?fields=name,email#profile"
+func ExpandURITemplate(template string, values map[string]interface{}) (string, error) {
+	t, err := CompileURITemplate(template)
+	if err != nil {
+		return "", err
+	}
+	return t.Expand(values)
+}
+
+// regexp returns the regular expression that matches the expansions of the
+// template, with a group around the variables of each expression.
+func (t *URITemplate) regexp() string {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, part := range t.parts {
+		if part.vars == nil {
+			b.WriteString(regexp.QuoteMeta(part.literal))
+			continue
+		}
+		// Expansions without reserved characters are made of unreserved
+		// characters, percent-encoded bytes and the separators that the
+		// operator and the modifiers can add.
+		class := `A-Za-z0-9\-._~%,`
+		explode := false
+		for _, v := range part.vars {
+			explode = explode || v.explode
+		}
+		if part.op.named || explode {
+			class += "="
+		}
+		if len(part.vars) > 1 || explode {
+			class += regexp.QuoteMeta(part.op.sep)
+		}
+		expr := "([" + class + "]*)"
+		if part.op.allowReserved {
+			expr = "([^#]*?)"
+			if part.op.first == "#" {
+				expr = "(.*?)"
+			}
+		}
+		if part.op.first != "" {
+			expr = "(?:" + regexp.QuoteMeta(part.op.first) + expr + ")?"
+		}
+		b.WriteString(expr)
+	}
+	b.WriteString("$")
+	return b.String()
+}
+
+// Match extracts the values of the variables from a URL that the template
+// expands to. A string value is returned as a string, a list as a
+// []string and an exploded associative array as a map[string]string.
+// Variables that the URL leaves out are missing from the result.
+//
+// Matching is best effort: a URL may be the expansion of several sets of
+// values, such as "{a}{b}" of "xy", and only one of them is returned. A
+// variable with a prefix modifier gets the prefix, and a list that is not
+// exploded cannot be told apart from an associative array. ok is false if
+// the template cannot expand to the URL.
+func (t *URITemplate) Match(u string) (map[string]interface{}, bool) {
+	m := t.re.FindStringSubmatchIndex(u)
+	if m == nil {
+		return nil, false
+	}
+	values := make(map[string]interface{})
+	group := 1
+	for _, part := range t.parts {
+		if part.vars == nil {
+			continue
+		}
+		start, end := m[2*group], m[2*group+1]
+		group++
+		if start < 0 || start == end && part.op.first == "" {
+			continue
+		}
+		if !part.extract(u[start:end], values) {
+			return nil, false
+		}
+	}
+	return values, true
+}
+
+// extract sets the values of the variables of an expression from its
+// expansion, without the first character of the operator.
+func (part *uriTemplatePart) extract(text string, values map[string]interface{}) bool {
+	op := part.op
+	items := strings.Split(text, op.sep)
+	if op.named {
+		for _, item := range items {
+			name, value, _ := strings.Cut(item, "=")
+			v := part.lookup(name)
+			if v == nil {
+				// A key of an exploded associative array.
+				for i := range part.vars {
+					if part.vars[i].explode {
+						v = &part.vars[i]
+						break
+					}
+				}
+				if v == nil {
+					continue
+				}
+				if !setURITemplatePair(values, v.name, name, value) {
+					return false
+				}
+				continue
+			}
+			if !part.set(values, v, value) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if len(part.vars) == 1 && !part.vars[0].explode {
+		return part.set(values, &part.vars[0], text)
+	}
+	for i, v := range part.vars {
+		if len(items) == 0 {
+			break
+		}
+		if !v.explode {
+			if !part.set(values, &part.vars[i], items[0]) {
+				return false
+			}
+			items = items[1:]
+			continue
+		}
+		// An exploded variable takes the items that the variables after it
+		// do not need.
+		n := len(items) - (len(part.vars) - i - 1)
+		if n < 1 {
+			n = 1
+		}
+		for _, item := range items[:n] {
+			if !strings.Contains(item, "=") {
+				if !part.set(values, &part.vars[i], item) {
+					return false
+				}
+			} else if key, value, _ := strings.Cut(item, "="); !setURITemplatePair(values, v.name, key, value) {
+				return false
+			}
+		}
+		items = items[n:]
+	}
+	return true
+}
+
+func (part *uriTemplatePart) lookup(name string) *uriTemplateVar {
+	for i := range part.vars {
+		if part.vars[i].name == name {
+			return &part.vars[i]
+		}
+	}
+	return nil
+}
+
+// set sets the value of v. An exploded variable collects its values in a
+// list, and a comma outside reserved expansion separates list items.
+func (part *uriTemplatePart) set(values map[string]interface{}, v *uriTemplateVar, value string) bool {
+	if v.explode || !part.op.allowReserved && strings.Contains(value, ",") {
+		list, _ := values[v.name].([]string)
+		for _, item := range strings.Split(value, ",") {
+			item, err := url.PathUnescape(item)
+			if err != nil {
+				return false
+			}
+			list = append(list, item)
+		}
+		values[v.name] = list
+		return true
+	}
+	value, err := url.PathUnescape(value)
+	if err != nil {
+		return false
+	}
+	values[v.name] = value
+	return true
+}
+
+func setURITemplatePair(values map[string]interface{}, name, key, value string) bool {
+	key, err := url.PathUnescape(key)
+	if err != nil {
+		return false
+	}
+	if value, err = url.PathUnescape(value); err != nil {
+		return false
+	}
+	m, ok := values[name].(map[string]string)
+	if !ok {
+		m = make(map[string]string)
+		values[name] = m
+	}
+	m[key] = value
+	return true
+}
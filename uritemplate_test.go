@@ -0,0 +1,213 @@
+package gurl
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// uriTemplateGroup is a group of a uritemplate-test file: the variables of
+// the group, and the test cases as a template and its expansion, a list of
+// the acceptable expansions, or false if the template must be rejected.
+type uriTemplateGroup struct {
+	Level     int                    `json:"level"`
+	Variables map[string]interface{} `json:"variables"`
+	Testcases [][]interface{}        `json:"testcases"`
+}
+
+// uriTemplateKnownFailures lists the cases of the uritemplate-test files that
+// the package is known to fail, as the file name and the template separated
+// by a space, with the reason. Every other case must pass.
+var uriTemplateKnownFailures = map[string]string{}
+
+// TestURITemplateSuite runs the files of the uritemplate-test project,
+// vendored under testdata/uritemplate at the commit recorded in
+// testdata/README.md.
+func TestURITemplateSuite(t *testing.T) {
+	files := []string{"spec-examples.json", "spec-examples-by-section.json", "negative-tests.json", "extended-tests.json"}
+	suite := map[string][]byte{}
+	for _, file := range files {
+		data, err := os.ReadFile("testdata/uritemplate/" + file)
+		if err != nil && !os.IsNotExist(err) {
+			t.Fatal(err)
+		}
+		if err == nil {
+			suite[file] = data
+		}
+	}
+	if len(suite) == 0 {
+		t.Skip("testdata/uritemplate is not vendored; see testdata/README.md")
+	}
+	seen := map[string]bool{}
+	for _, file := range files {
+		data, ok := suite[file]
+		if !ok {
+			t.Errorf("testdata/uritemplate/%s is missing; the files must be vendored together.", file)
+			continue
+		}
+		for name := range testURITemplateData(t, file, data, uriTemplateKnownFailures) {
+			seen[name] = true
+		}
+	}
+	for name := range uriTemplateKnownFailures {
+		if !seen[name] {
+			t.Errorf("Known failure %q is not a case of the test data.", name)
+		}
+	}
+}
+
+// TestURITemplateData runs the local cases of testdata/uritemplate, which
+// use the layout of the uritemplate-test files.
+func TestURITemplateData(t *testing.T) {
+	for _, file := range []string{"spec-examples-local.json", "spec-examples-by-section-local.json", "negative-tests-local.json"} {
+		data, err := os.ReadFile("testdata/uritemplate/" + file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testURITemplateData(t, file, data, nil)
+	}
+}
+
+// testURITemplateData runs the cases of a uritemplate-test file, skipping
+// those of knownFailures, and returns the names of the cases it read.
+func testURITemplateData(t *testing.T, file string, data []byte, knownFailures map[string]string) map[string]bool {
+	var groups map[string]uriTemplateGroup
+	if err := json.Unmarshal(data, &groups); err != nil {
+		t.Fatal(err)
+	}
+	seen := map[string]bool{}
+	for name, group := range groups {
+		for _, test := range group.Testcases {
+			template := test[0].(string)
+			seen[file+" "+template] = true
+			if reason, ok := knownFailures[file+" "+template]; ok {
+				t.Logf("Skipping %s %q: %s.", file, template, reason)
+				continue
+			}
+			result, err := ExpandURITemplate(template, group.Variables)
+			var want []interface{}
+			switch expected := test[1].(type) {
+			case bool:
+				var templateErr *URITemplateError
+				if !errors.As(err, &templateErr) {
+					t.Errorf("%s: %s: ExpandURITemplate(%q) was incorrect, got: %q, want: an error.", file, name, template, result)
+				}
+				continue
+			case string:
+				want = []interface{}{expected}
+			case []interface{}:
+				want = expected
+			}
+			if err != nil {
+				t.Errorf("%s: %s: ExpandURITemplate(%q) returned an error: %v.", file, name, template, err)
+				continue
+			}
+			ok := false
+			for _, w := range want {
+				ok = ok || result == w
+			}
+			if !ok {
+				t.Errorf("%s: %s: ExpandURITemplate(%q) was incorrect, got: %s, want: %v.", file, name, template, result, want)
+			}
+		}
+	}
+	return seen
+}
+
+func TestExpandURITemplate(t *testing.T) {
+	values := map[string]interface{}{
+		"id":      42,
+		"fields":  []string{"name", "email"},
+		"limit":   nil,
+		"section": "profile",
+		"ratio":   0.5,
+		"active":  true,
+		"name":    "Ťěšť",
+		"tags":    map[string]int{"b": 2, "a": 1},
+		"ptr":     (*string)(nil),
+	}
+	tests := []struct {
+		template string
+		result   string
+	}{
+		{"https://api.x.com/users/{id}{?fields,limit}{#section}", "https://api.x.com/users/42?fields=name,email#profile"},
+		{"/users/{id}{?fields*}", "/users/42?fields=name&fields=email"},
+		{"{?ratio,active}", "?ratio=0.5&active=true"},
+		{"{name:2}", "%C5%A4%C4%9B"},
+		{"{?tags*}", "?a=1&b=2"},
+		{"/a{?ptr}", "/a"},
+		{"/search name/{id}", "/search%20name/42"},
+		{"/already%20encoded/{id}", "/already%20encoded/42"},
+	}
+	for _, test := range tests {
+		result, err := ExpandURITemplate(test.template, values)
+		if err != nil {
+			t.Errorf("ExpandURITemplate(%q) returned an error: %v.", test.template, err)
+			continue
+		}
+		if result != test.result {
+			t.Errorf("ExpandURITemplate(%q) was incorrect, got: %s, want: %s.", test.template, result, test.result)
+		}
+	}
+}
+
+func TestURITemplateError(t *testing.T) {
+	_, err := CompileURITemplate("/users/{id")
+	var templateErr *URITemplateError
+	if !errors.As(err, &templateErr) || !errors.Is(err, ErrInvalidURITemplate) || templateErr.Name != "{id" {
+		t.Errorf("CompileURITemplate was incorrect, got: %v, want: an error about %q.", err, "{id")
+	}
+	_, err = ExpandURITemplate("/users/{id}", map[string]interface{}{"id": struct{}{}})
+	if !errors.Is(err, ErrInvalidURITemplateValue) {
+		t.Errorf("ExpandURITemplate was incorrect, got: %v, want: %v.", err, ErrInvalidURITemplateValue)
+	}
+}
+
+func TestURITemplateVariables(t *testing.T) {
+	template := MustCompileURITemplate("/{a}/{b*}{?a,c:3}")
+	if names := template.Variables(); !reflect.DeepEqual(names, []string{"a", "b", "c"}) {
+		t.Errorf("Variables was incorrect, got: %v, want: %v.", names, []string{"a", "b", "c"})
+	}
+}
+
+func TestURITemplateMatch(t *testing.T) {
+	tests := []struct {
+		template string
+		url      string
+		values   map[string]interface{}
+	}{
+		{"https://api.x.com/users/{id}{?fields,limit}{#section}", "https://api.x.com/users/42?fields=name,email&limit=10#profile", map[string]interface{}{
+			"id": "42", "fields": []string{"name", "email"}, "limit": "10", "section": "profile",
+		}},
+		{"https://api.x.com/users/{id}{?fields,limit}{#section}", "https://api.x.com/users/42", map[string]interface{}{"id": "42"}},
+		{"/search{?q,page}", "/search?q=Hello%20World%21", map[string]interface{}{"q": "Hello World!"}},
+		{"{/path*}{?keys*}", "/a/b%2Fc?x=1&y=", map[string]interface{}{
+			"path": []string{"a", "b/c"}, "keys": map[string]string{"x": "1", "y": ""},
+		}},
+		{"{/who,dub}", "/fred/me%2Ftoo", map[string]interface{}{"who": "fred", "dub": "me/too"}},
+		{"{+base}index{?q}", "http://example.com/home/index?q=go", map[string]interface{}{"base": "http://example.com/home/", "q": "go"}},
+		{"X{.var}", "X.", map[string]interface{}{"var": ""}},
+		{"{;x,y,empty}", ";x=1024;y=768;empty", map[string]interface{}{"x": "1024", "y": "768", "empty": ""}},
+		{"www{.dom*}", "www.example.com", map[string]interface{}{"dom": []string{"example", "com"}}},
+		{"{x,y}", "1024,768", map[string]interface{}{"x": "1024", "y": "768"}},
+	}
+	for _, test := range tests {
+		values, ok := MustCompileURITemplate(test.template).Match(test.url)
+		if !ok {
+			t.Errorf("Match(%q) on %q was incorrect, got: no match, want: %v.", test.url, test.template, test.values)
+			continue
+		}
+		if !reflect.DeepEqual(values, test.values) {
+			t.Errorf("Match(%q) on %q was incorrect, got: %v, want: %v.", test.url, test.template, values, test.values)
+		}
+	}
+
+	for _, u := range []string{"https://api.x.com/posts/42", "/search?q=a#b", "/users/4%2"} {
+		template := MustCompileURITemplate("https://api.x.com/users/{id}")
+		if values, ok := template.Match(u); ok {
+			t.Errorf("Match(%q) was incorrect, got: %v, want: no match.", u, values)
+		}
+	}
+}
@@ -0,0 +1,527 @@
+package gurl
+
+import (
+	"errors"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidPort is returned when a port is not a number between 1 and 65535
+// written without leading zeros.
+var ErrInvalidPort = errors.New("gurl: invalid port")
+
+// ErrIndexOutOfRange is returned when a parameter value is addressed by an
+// index that does not exist.
+var ErrIndexOutOfRange = errors.New("gurl: index out of range")
+
+// defaultPorts maps a scheme to the port used when a URL does not give one.
+var defaultPorts = map[string]string{
+	"ftp":   "21",
+	"http":  "80",
+	"https": "443",
+	"ws":    "80",
+	"wss":   "443",
+}
+
+// URL is a parsed URL that can be read and edited many times without being
+// re-parsed. The query and the hash route/query split are cached, and the URL
+// is only serialized again when String is called.
+//
+// A URL is not safe for concurrent use.
+type URL struct {
+	url *url.URL
+
+	// query caches the parsed RawQuery. It is nil until first used, and
+	// queryDirty records that it must be encoded back on String.
+	query      url.Values
+	queryDirty bool
+
+	// preserveQuery switches query edits to work on tokens, the raw query
+	// split in its original order, instead of query.
+	preserveQuery bool
+	tokens        queryTokens
+
+	// fragment caches url.Fragment split into route path and params.
+	fragment Fragment
+}
+
+// Parse parses a URL string into a URL.
+//
+// Parameters:
+//
+//	u: The URL to parse.
+//
+// Returns:
+//
+//	A pointer to the parsed URL, and an error if any occurred.
+//
+// Example:
+//
+//	parsedURL, err := Parse("http://example.com/?p1=1#path?t1=1")
+//	if err != nil {
+//	  panic(err)
+//	}
+//	parsedURL.SetQueryParam("p1", "2")
+//	parsedURL.SetHashParam("t1", "2")
+//	fmt.Println(parsedURL.String()) // Output: "http://example.com/?p1=2#path?t1=2"
+func Parse(u string) (*URL, error) {
+	parsedURL, err := url.Parse(u)
+	if err != nil {
+		return nil, err
+	}
+	return newURL(parsedURL), nil
+}
+
+func newURL(parsedURL *url.URL) *URL {
+	return &URL{
+		url:      parsedURL,
+		fragment: ParseFragment(parsedURL.EscapedFragment()),
+	}
+}
+
+// Clone returns a deep copy of u. Edits to the copy do not affect u.
+func (u *URL) Clone() *URL {
+	u.flush()
+	parsedURL := *u.url
+	if u.url.User != nil {
+		user := *u.url.User
+		parsedURL.User = &user
+	}
+	clone := *u
+	clone.url = &parsedURL
+	clone.query = nil
+	clone.tokens = append(queryTokens(nil), u.tokens...)
+	clone.fragment = u.fragment.clone()
+	return &clone
+}
+
+// String reassembles the URL into a valid URL string.
+func (u *URL) String() string {
+	u.flush()
+	return u.url.String()
+}
+
+// flush writes the cached query back to the underlying url.URL.
+func (u *URL) flush() {
+	if u.queryDirty {
+		if u.preserveQuery {
+			u.url.RawQuery = u.tokens.encode()
+		} else {
+			u.url.RawQuery = u.query.Encode()
+		}
+		u.queryDirty = false
+	}
+}
+
+// PreserveQuery switches the query edit mode.
+//
+// By default, query edits re-encode the whole query with url.Values.Encode,
+// which sorts the parameters and re-escapes every value. With preserve set,
+// edits work on the raw query instead: parameters keep their original order
+// and encoding, a parameter that is set is updated where it already sits, and
+// new parameters are appended at the end.
+func (u *URL) PreserveQuery(preserve bool) {
+	if u.preserveQuery == preserve {
+		return
+	}
+	u.flush()
+	u.preserveQuery = preserve
+	u.reset()
+}
+
+// reset rebuilds the cached query and fragment from the underlying url.URL,
+// after it has been changed directly. Pending query edits must be flushed
+// first.
+func (u *URL) reset() {
+	u.query = nil
+	u.queryDirty = false
+	u.tokens = nil
+	if u.preserveQuery {
+		u.tokens = parseQueryTokens(u.url.RawQuery)
+	}
+	u.fragment = ParseFragmentEncoding(u.url.EscapedFragment(), u.fragment.Encoding)
+}
+
+func (u *URL) queryValues() url.Values {
+	if u.query == nil {
+		u.query = u.url.Query()
+	}
+	return u.query
+}
+
+// joinHostPort combines hostname and port into a host, putting an IPv6
+// address in square brackets. The port is left out if it is "".
+func joinHostPort(hostname, port string) string {
+	if strings.Contains(hostname, ":") && !strings.HasPrefix(hostname, "[") {
+		hostname = "[" + hostname + "]"
+	}
+	if port == "" {
+		return hostname
+	}
+	return hostname + ":" + port
+}
+
+func validPort(port string) bool {
+	if port == "" || len(port) > 5 {
+		return false
+	}
+	n, err := strconv.Atoi(port)
+	if err != nil || port[0] == '+' || port[0] == '-' || port[0] == '0' {
+		return false
+	}
+	return n >= 1 && n <= 65535
+}
+
+// syncFragment writes the cached fragment back to the underlying url.URL.
+func (u *URL) syncFragment() {
+	raw := u.fragment.String()
+	fragment, err := url.PathUnescape(raw)
+	if err != nil {
+		// Only a Path set to an invalid escaping can get here. Keep it as
+		// text, so that String escapes its "%".
+		u.url.Fragment = raw
+		u.url.RawFragment = ""
+		return
+	}
+	u.url.Fragment = fragment
+	u.url.RawFragment = raw
+}
+
+// SetHashEncoding selects how hash parameters are escaped and unescaped.
+// The default is EncodingForm, the same rules as url.Values.
+func (u *URL) SetHashEncoding(enc Encoding) {
+	u.fragment = ParseFragmentEncoding(u.fragment.String(), enc)
+}
+
+// GetQueryParam returns the value of the query parameter param.
+func (u *URL) GetQueryParam(param string) string {
+	if u.preserveQuery {
+		if values := u.tokens.get(param); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	return u.queryValues().Get(param)
+}
+
+// SetQueryParam sets the query parameter param to value.
+func (u *URL) SetQueryParam(param, value string) {
+	if u.preserveQuery {
+		u.tokens = u.tokens.set(param, value)
+	} else {
+		u.queryValues().Set(param, value)
+	}
+	u.queryDirty = true
+}
+
+// DelQueryParam deletes the query parameter param.
+func (u *URL) DelQueryParam(param string) {
+	if u.preserveQuery {
+		u.tokens = u.tokens.filter(func(t queryToken) bool {
+			return t.key != param
+		})
+	} else {
+		u.queryValues().Del(param)
+	}
+	u.queryDirty = true
+}
+
+// GetQueryParams returns all values of the query parameter param, in order.
+func (u *URL) GetQueryParams(param string) []string {
+	if u.preserveQuery {
+		return u.tokens.get(param)
+	}
+	return append([]string(nil), u.queryValues()[param]...)
+}
+
+// AddQueryParam appends value to the query parameter param, keeping the
+// existing values.
+func (u *URL) AddQueryParam(param, value string) {
+	if u.preserveQuery {
+		u.tokens = u.tokens.add(param, value)
+	} else {
+		u.queryValues().Add(param, value)
+	}
+	u.queryDirty = true
+}
+
+// DelQueryParamValue removes every occurrence of value from the query
+// parameter param. The parameter is deleted once it has no values left.
+func (u *URL) DelQueryParamValue(param, value string) {
+	if u.preserveQuery {
+		u.tokens = u.tokens.filter(func(t queryToken) bool {
+			return t.key != param || t.value != value
+		})
+		u.queryDirty = true
+		return
+	}
+	values := u.queryValues()
+	var newValues []string
+	for _, v := range values[param] {
+		if v != value {
+			newValues = append(newValues, v)
+		}
+	}
+	if len(newValues) == 0 {
+		values.Del(param)
+	} else {
+		values[param] = newValues
+	}
+	u.queryDirty = true
+}
+
+// SetQueryParamAt replaces the i-th value of the query parameter param.
+// It returns ErrIndexOutOfRange if the parameter has no i-th value.
+func (u *URL) SetQueryParamAt(param string, i int, value string) error {
+	if u.preserveQuery {
+		if err := u.tokens.setAt(param, i, value); err != nil {
+			return err
+		}
+		u.queryDirty = true
+		return nil
+	}
+	values := u.queryValues()[param]
+	if i < 0 || i >= len(values) {
+		return ErrIndexOutOfRange
+	}
+	values[i] = value
+	u.queryDirty = true
+	return nil
+}
+
+// GetHashParam returns the unescaped value of the hash parameter param.
+func (u *URL) GetHashParam(param string) string {
+	return u.fragment.Get(param)
+}
+
+// SetHashParam sets the hash parameter param to value, escaping it with the
+// selected hash encoding.
+func (u *URL) SetHashParam(param, value string) {
+	u.fragment.Set(param, value)
+	u.syncFragment()
+}
+
+// DelHashParam deletes the hash parameter param.
+func (u *URL) DelHashParam(param string) {
+	u.fragment.Del(param)
+	u.syncFragment()
+}
+
+// GetHashParams returns all values of the hash parameter param, in order.
+func (u *URL) GetHashParams(param string) []string {
+	return u.fragment.GetAll(param)
+}
+
+// AddHashParam appends value to the hash parameter param, keeping the
+// existing values.
+func (u *URL) AddHashParam(param, value string) {
+	u.fragment.Add(param, value)
+	u.syncFragment()
+}
+
+// DelHashParamValue removes every occurrence of value from the hash
+// parameter param.
+func (u *URL) DelHashParamValue(param, value string) {
+	u.fragment.DelValue(param, value)
+	u.syncFragment()
+}
+
+// SetHashParamAt replaces the i-th value of the hash parameter param.
+// It returns ErrIndexOutOfRange if the parameter has no i-th value.
+func (u *URL) SetHashParamAt(param string, i int, value string) error {
+	if err := u.fragment.SetAt(param, i, value); err != nil {
+		return err
+	}
+	u.syncFragment()
+	return nil
+}
+
+// GetFragment returns the fragment split into hashbang, route path and params.
+func (u *URL) GetFragment() Fragment {
+	return u.fragment.clone()
+}
+
+// SetFragment replaces the fragment.
+func (u *URL) SetFragment(fra Fragment) {
+	u.fragment = fra.clone()
+	u.syncFragment()
+}
+
+// GetHashPath returns the route path of the fragment.
+func (u *URL) GetHashPath() string {
+	return u.fragment.Path
+}
+
+// SetHashPath sets the route path of the fragment, keeping its params.
+func (u *URL) SetHashPath(newPath string) {
+	u.fragment.Path = newPath
+	u.syncFragment()
+}
+
+// JoinHashPath joins the elements onto the route path of the fragment.
+func (u *URL) JoinHashPath(elem ...string) {
+	u.fragment.JoinPath(elem...)
+	u.syncFragment()
+}
+
+// GetPath returns the path.
+func (u *URL) GetPath() string {
+	return u.url.Path
+}
+
+// SetPath sets the path.
+func (u *URL) SetPath(newPath string) {
+	u.url.Path = newPath
+}
+
+// GetHost returns the host, including the port if any.
+func (u *URL) GetHost() string {
+	return u.url.Host
+}
+
+// SetHost sets the host, including the port if any.
+func (u *URL) SetHost(newHost string) {
+	u.url.Host = newHost
+}
+
+// GetHostname returns the host without the port. Square brackets around an
+// IPv6 address are removed.
+func (u *URL) GetHostname() string {
+	return u.url.Hostname()
+}
+
+// SetHostname sets the host while keeping the port. An IPv6 address is put in
+// square brackets.
+func (u *URL) SetHostname(newHostname string) {
+	u.url.Host = joinHostPort(newHostname, u.url.Port())
+}
+
+// GetHostnameAs returns the hostname converted to form. IP addresses are
+// returned as they are.
+func (u *URL) GetHostnameAs(form HostnameForm) (string, error) {
+	return convertHostname(u.url.Hostname(), form)
+}
+
+// SetHostnameAs converts newHostname to form and sets it while keeping the
+// port. The URL is left unchanged if the conversion fails. HostnameUnicode
+// returns ErrUnicodeHostnameForm.
+func (u *URL) SetHostnameAs(newHostname string, form HostnameForm) error {
+	if form == HostnameUnicode {
+		return ErrUnicodeHostnameForm
+	}
+	hostname, err := convertHostname(newHostname, form)
+	if err != nil {
+		return err
+	}
+	u.SetHostname(hostname)
+	return nil
+}
+
+// GetPort returns the port, or "" if the URL has none.
+func (u *URL) GetPort() string {
+	return u.url.Port()
+}
+
+// SetPort sets the port while keeping the hostname. It returns ErrInvalidPort
+// if port is not a number between 1 and 65535.
+func (u *URL) SetPort(port string) error {
+	if !validPort(port) {
+		return ErrInvalidPort
+	}
+	u.url.Host = joinHostPort(u.url.Hostname(), port)
+	return nil
+}
+
+// DelPort removes the port.
+func (u *URL) DelPort() {
+	u.url.Host = joinHostPort(u.url.Hostname(), "")
+}
+
+// GetUsername returns the unescaped username of the userinfo, or "" if there
+// is none.
+func (u *URL) GetUsername() string {
+	return u.url.User.Username()
+}
+
+// GetPassword returns the unescaped password of the userinfo, and whether it
+// is set.
+func (u *URL) GetPassword() (string, bool) {
+	return u.url.User.Password()
+}
+
+// SetUserinfo sets the username and password. They are escaped as needed, so
+// they may hold reserved characters such as "@", ":" and "/". An empty
+// password leaves the password out, and an empty username and password
+// remove the userinfo, as DelUserinfo does. Use SetPassword to set an empty
+// password.
+func (u *URL) SetUserinfo(username, password string) {
+	if username == "" && password == "" {
+		u.DelUserinfo()
+		return
+	}
+	if password == "" {
+		u.url.User = url.User(username)
+		return
+	}
+	u.url.User = url.UserPassword(username, password)
+}
+
+// SetPassword sets the password, escaped as needed, and keeps the username.
+// An empty password is kept as such, as in "user:@host".
+func (u *URL) SetPassword(password string) {
+	u.url.User = url.UserPassword(u.url.User.Username(), password)
+}
+
+// DelUserinfo removes the username and password.
+func (u *URL) DelUserinfo() {
+	u.url.User = nil
+}
+
+// GetEffectivePort returns the port, or the default port of the scheme if the
+// URL has none, such as "443" for https. It returns "" if the scheme has no
+// known default port.
+func (u *URL) GetEffectivePort() string {
+	if port := u.url.Port(); port != "" {
+		return port
+	}
+	return defaultPorts[strings.ToLower(u.url.Scheme)]
+}
+
+// GetProtocol returns the protocol (scheme).
+func (u *URL) GetProtocol() string {
+	return strings.Split(u.url.Scheme, ":")[0]
+}
+
+// SetProtocol sets the protocol (scheme).
+func (u *URL) SetProtocol(newProtocol string) {
+	u.url.Scheme = newProtocol
+}
+
+// CheckValid reports whether the URL has both a scheme and a valid host.
+func (u *URL) CheckValid() bool {
+	return u.validate(checkValidRules) == nil
+}
+
+// CheckValidHTTPURL reports whether the URL is valid and uses the HTTP or
+// HTTPS scheme.
+func (u *URL) CheckValidHTTPURL() bool {
+	return u.validate(checkValidHTTPURLRules) == nil
+}
+
+// GetURLFileType returns the file extension of the path without the dot.
+func (u *URL) GetURLFileType() string {
+	return strings.TrimPrefix(filepath.Ext(u.url.Path), ".")
+}
+
+// GetBaseURL returns the URL without query parameters and fragment.
+func (u *URL) GetBaseURL() string {
+	u.flush()
+	baseURL := *u.url
+	// Clear query parameters and fragment
+	baseURL.RawQuery = ""
+	baseURL.Fragment = ""
+	baseURL.RawFragment = ""
+	return baseURL.String()
+}
@@ -0,0 +1,889 @@
+package gurl
+
+import (
+	"errors"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidURLPattern is returned when a URL pattern cannot be compiled. It
+// is wrapped in a *URLPatternError.
+var ErrInvalidURLPattern = errors.New("gurl: invalid URL pattern")
+
+// URLPatternError describes why a URL pattern could not be compiled.
+type URLPatternError struct {
+	// Component is the component the error is in, such as "pathname", or
+	// empty if the error is in the pattern as a whole.
+	Component string
+	// Detail explains the error.
+	Detail string
+}
+
+func (e *URLPatternError) Error() string {
+	if e.Component == "" {
+		return ErrInvalidURLPattern.Error() + ": " + e.Detail
+	}
+	return ErrInvalidURLPattern.Error() + ": " + e.Component + ": " + e.Detail
+}
+
+func (e *URLPatternError) Unwrap() error {
+	return ErrInvalidURLPattern
+}
+
+// URLPatternInit gives the components of a URL pattern, or of a URL to
+// match, one by one. A nil field is not given: in a pattern it matches
+// anything, unless BaseURL gives it; in a URL to match it is empty. The JSON
+// field names are those of the JavaScript URLPatternInit dictionary.
+type URLPatternInit struct {
+	Protocol *string `json:"protocol,omitempty"`
+	Username *string `json:"username,omitempty"`
+	Password *string `json:"password,omitempty"`
+	Hostname *string `json:"hostname,omitempty"`
+	Port     *string `json:"port,omitempty"`
+	Pathname *string `json:"pathname,omitempty"`
+	Search   *string `json:"search,omitempty"`
+	Hash     *string `json:"hash,omitempty"`
+	BaseURL  *string `json:"baseURL,omitempty"`
+}
+
+// URLPatternOptions configures a URL pattern.
+type URLPatternOptions struct {
+	// IgnoreCase matches the pathname, search and hash without regard to
+	// case. Protocols and hostnames are always lowercased.
+	IgnoreCase bool
+}
+
+// URLPattern is a URL pattern of the WHATWG URL Pattern Standard, the
+// pattern syntax of the URLPattern API of browsers, such as
+// "https://*.example.com/books/:id(\\d+)". Each component of a URL is
+// matched against its own pattern, and named and numbered groups are
+// returned.
+//
+// Regular expression groups use Go regexp syntax, which is close to that of
+// JavaScript but has no lookarounds or backreferences. A URLPattern is safe
+// for concurrent use.
+type URLPattern struct {
+	protocol, username, password, hostname, port, pathname, search, hash *patternComponent
+}
+
+// URLPatternComponentResult is the match of one component.
+type URLPatternComponentResult struct {
+	// Input is the canonicalized component that was matched.
+	Input string
+	// Groups maps the name of each group, or its number if it has no name,
+	// to the text it matched. Optional groups that did not match are left
+	// out.
+	Groups map[string]string
+}
+
+// URLPatternResult is the match of a URL against a URLPattern.
+type URLPatternResult struct {
+	Protocol URLPatternComponentResult
+	Username URLPatternComponentResult
+	Password URLPatternComponentResult
+	Hostname URLPatternComponentResult
+	Port     URLPatternComponentResult
+	Pathname URLPatternComponentResult
+	Search   URLPatternComponentResult
+	Hash     URLPatternComponentResult
+}
+
+// specialSchemes are the schemes the WHATWG URL Standard parses with a host
+// and a hierarchical path.
+var specialSchemes = []string{"ftp", "file", "http", "https", "ws", "wss"}
+
+func isSpecialScheme(scheme string) bool {
+	for _, s := range specialSchemes {
+		if s == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// patternComponent is the compiled pattern of one component.
+type patternComponent struct {
+	pattern         string
+	re              *regexp.Regexp
+	names           []string
+	hasRegexpGroups bool
+}
+
+// compileComponent parses the pattern of a component, canonicalizing its
+// fixed text with encode.
+func compileComponent(input string, encode func(string) (string, error), opts patternOptions) (*patternComponent, error) {
+	parts, err := parsePattern(input, opts, encode)
+	if err != nil {
+		return nil, err
+	}
+	expr, names := generateRegexp(parts, opts)
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, &URLPatternError{Detail: err.Error()}
+	}
+	c := &patternComponent{pattern: generatePatternString(parts, opts), re: re, names: names}
+	for _, part := range parts {
+		if part.typ == partRegexp {
+			c.hasRegexpGroups = true
+		}
+	}
+	return c, nil
+}
+
+func (c *patternComponent) matchesSpecialScheme() bool {
+	for _, scheme := range specialSchemes {
+		if c.re.MatchString(scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *patternComponent) match(input string) (URLPatternComponentResult, bool) {
+	m := c.re.FindStringSubmatchIndex(input)
+	if m == nil {
+		return URLPatternComponentResult{}, false
+	}
+	result := URLPatternComponentResult{Input: input, Groups: make(map[string]string)}
+	for i, name := range c.names {
+		if start := m[2*i+2]; start >= 0 {
+			result.Groups[name] = input[start:m[2*i+3]]
+		}
+	}
+	return result, true
+}
+
+// CompileURLPattern compiles a URL pattern from a constructor string, as
+// the JavaScript URLPattern constructor does.
+//
+// Parameters:
+//
+//	input: The pattern, such as "https://*.example.com/books/:id". A
+//	relative pattern such as "/books/:id" needs a base URL.
+//	baseURL: The URL that relative patterns and missing components are
+//	taken from, or "" for none.
+//	options: The options of the pattern.
+//
+// Returns:
+//
+//	The compiled pattern, and a *URLPatternError wrapping
+//	ErrInvalidURLPattern if the pattern is not valid.
+//
+// Example:
+//
+//	pattern, err := CompileURLPattern("https://*.example.com/books/:id", "", URLPatternOptions{})
+//	if err != nil {
+//	  panic(err)
+//	}
+//	result, ok := pattern.Exec("https://store.example.com/books/123")
+//	fmt.Println(result.Pathname.Groups["id"], ok) // Output: 123 true
+func CompileURLPattern(input, baseURL string, options URLPatternOptions) (*URLPattern, error) {
+	components, err := parseConstructorString(input)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := components[stateProtocol]; !ok && baseURL == "" {
+		return nil, &URLPatternError{Detail: "relative pattern without a base URL"}
+	}
+	var init URLPatternInit
+	for name, field := range init.fields() {
+		if value, ok := components[name]; ok {
+			*field = &value
+		}
+	}
+	if baseURL != "" {
+		init.BaseURL = &baseURL
+	}
+	return CompileURLPatternInit(init, options)
+}
+
+// CompileURLPatternInit compiles a URL pattern from its components, as the
+// JavaScript URLPattern constructor does for a URLPatternInit.
+func CompileURLPatternInit(init URLPatternInit, options URLPatternOptions) (*URLPattern, error) {
+	processed, err := processURLPatternInit(init, true)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string)
+	for name, field := range processed.fields() {
+		values[name] = "*"
+		if *field != nil {
+			values[name] = **field
+		}
+	}
+	if defaultPorts[values[stateProtocol]] == values[statePort] && isSpecialScheme(values[stateProtocol]) {
+		values[statePort] = ""
+	}
+
+	p := &URLPattern{}
+	compile := func(name string, encode func(string) (string, error), opts patternOptions) *patternComponent {
+		if err != nil {
+			return nil
+		}
+		var c *patternComponent
+		c, err = compileComponent(values[name], encode, opts)
+		if e, ok := err.(*URLPatternError); ok && e.Component == "" {
+			e.Component = name
+		}
+		return c
+	}
+	p.protocol = compile(stateProtocol, canonicalizeProtocol, defaultPatternOptions)
+	p.username = compile(stateUsername, canonicalizeUserinfo, defaultPatternOptions)
+	p.password = compile(statePassword, canonicalizeUserinfo, defaultPatternOptions)
+	if isIPv6Pattern(values[stateHostname]) {
+		p.hostname = compile(stateHostname, canonicalizeIPv6Hostname, hostnamePatternOptions)
+	} else {
+		p.hostname = compile(stateHostname, func(s string) (string, error) {
+			return canonicalizeHostname(s, "")
+		}, hostnamePatternOptions)
+	}
+	p.port = compile(statePort, func(s string) (string, error) {
+		return canonicalizePort(s, "")
+	}, defaultPatternOptions)
+	if err != nil {
+		return nil, err
+	}
+	opts := patternOptions{ignoreCase: options.IgnoreCase}
+	if p.protocol.matchesSpecialScheme() {
+		pathOpts := pathnamePatternOptions
+		pathOpts.ignoreCase = options.IgnoreCase
+		p.pathname = compile(statePathname, canonicalizePathname, pathOpts)
+	} else {
+		p.pathname = compile(statePathname, canonicalizeOpaquePathname, opts)
+	}
+	p.search = compile(stateSearch, canonicalizeSearch, opts)
+	p.hash = compile(stateHash, canonicalizeHash, opts)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// MustCompileURLPattern is like CompileURLPattern but panics if the pattern
+// is not valid. It is meant for patterns in package-level variables.
+func MustCompileURLPattern(input, baseURL string, options URLPatternOptions) *URLPattern {
+	p, err := CompileURLPattern(input, baseURL, options)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// isIPv6Pattern reports whether a hostname pattern is an IPv6 address, as
+// in "[::1]" or "{[::1]}".
+func isIPv6Pattern(hostname string) bool {
+	if len(hostname) < 2 {
+		return false
+	}
+	return hostname[0] == '[' || (hostname[0] == '{' || hostname[0] == '\\') && hostname[1] == '['
+}
+
+// fields returns the component fields of init by component name.
+func (init *URLPatternInit) fields() map[string]**string {
+	return map[string]**string{
+		stateProtocol: &init.Protocol,
+		stateUsername: &init.Username,
+		statePassword: &init.Password,
+		stateHostname: &init.Hostname,
+		statePort:     &init.Port,
+		statePathname: &init.Pathname,
+		stateSearch:   &init.Search,
+		stateHash:     &init.Hash,
+	}
+}
+
+// Protocol returns the normalized pattern of the protocol.
+func (p *URLPattern) Protocol() string { return p.protocol.pattern }
+
+// Username returns the normalized pattern of the username.
+func (p *URLPattern) Username() string { return p.username.pattern }
+
+// Password returns the normalized pattern of the password.
+func (p *URLPattern) Password() string { return p.password.pattern }
+
+// Hostname returns the normalized pattern of the hostname.
+func (p *URLPattern) Hostname() string { return p.hostname.pattern }
+
+// Port returns the normalized pattern of the port.
+func (p *URLPattern) Port() string { return p.port.pattern }
+
+// Pathname returns the normalized pattern of the pathname.
+func (p *URLPattern) Pathname() string { return p.pathname.pattern }
+
+// Search returns the normalized pattern of the search, without the "?".
+func (p *URLPattern) Search() string { return p.search.pattern }
+
+// Hash returns the normalized pattern of the hash, without the "#".
+func (p *URLPattern) Hash() string { return p.hash.pattern }
+
+// HasRegExpGroups reports whether the pattern has regular expression groups,
+// such as ":id(\\d+)".
+func (p *URLPattern) HasRegExpGroups() bool {
+	for _, c := range p.components() {
+		if c.hasRegexpGroups {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *URLPattern) components() []*patternComponent {
+	return []*patternComponent{p.protocol, p.username, p.password, p.hostname, p.port, p.pathname, p.search, p.hash}
+}
+
+// Test reports whether a URL matches the pattern. A relative URL is
+// resolved against baseURL if one is given.
+func (p *URLPattern) Test(input string, baseURL ...string) bool {
+	_, ok := p.Exec(input, baseURL...)
+	return ok
+}
+
+// Exec matches a URL against the pattern and returns the groups of each
+// component. A relative URL is resolved against baseURL if one is given.
+func (p *URLPattern) Exec(input string, baseURL ...string) (*URLPatternResult, bool) {
+	u, err := Parse(input)
+	if err != nil {
+		return nil, false
+	}
+	if len(baseURL) > 0 {
+		base, err := Parse(baseURL[0])
+		if err != nil || base.url.Scheme == "" {
+			return nil, false
+		}
+		if u, err = base.Resolve(input); err != nil {
+			return nil, false
+		}
+	}
+	return p.ExecURL(u)
+}
+
+// ExecURL matches u against the pattern and returns the groups of each
+// component. It reports false if u is not absolute.
+func (p *URLPattern) ExecURL(u *URL) (*URLPatternResult, bool) {
+	values, err := urlPatternValues(u)
+	if err != nil {
+		return nil, false
+	}
+	return p.match(values)
+}
+
+// ExecInit matches the components of a URL given one by one against the
+// pattern, and returns the groups of each component.
+func (p *URLPattern) ExecInit(init URLPatternInit) (*URLPatternResult, bool) {
+	processed, err := processURLPatternInit(init, false)
+	if err != nil {
+		return nil, false
+	}
+	values := make(map[string]string)
+	for name, field := range processed.fields() {
+		values[name] = **field
+	}
+	return p.match(values)
+}
+
+func (p *URLPattern) match(values map[string]string) (*URLPatternResult, bool) {
+	var result URLPatternResult
+	var ok bool
+	steps := []struct {
+		name      string
+		component *patternComponent
+		result    *URLPatternComponentResult
+	}{
+		{stateProtocol, p.protocol, &result.Protocol},
+		{stateUsername, p.username, &result.Username},
+		{statePassword, p.password, &result.Password},
+		{stateHostname, p.hostname, &result.Hostname},
+		{statePort, p.port, &result.Port},
+		{statePathname, p.pathname, &result.Pathname},
+		{stateSearch, p.search, &result.Search},
+		{stateHash, p.hash, &result.Hash},
+	}
+	for _, step := range steps {
+		if *step.result, ok = step.component.match(values[step.name]); !ok {
+			return nil, false
+		}
+	}
+	return &result, true
+}
+
+// urlPatternValues returns the components of an absolute URL as the WHATWG
+// URL Standard serializes them, which is what URL patterns match.
+func urlPatternValues(u *URL) (map[string]string, error) {
+	u.flush()
+	if u.url.Scheme == "" {
+		return nil, &URLPatternError{Detail: "relative URL"}
+	}
+	protocol, err := canonicalizeProtocol(u.GetProtocol())
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]string{stateProtocol: protocol}
+	if u.url.User != nil {
+		username, password, _ := strings.Cut(u.url.User.String(), ":")
+		if values[stateUsername], err = canonicalizeUserinfo(username); err != nil {
+			return nil, err
+		}
+		if values[statePassword], err = canonicalizeUserinfo(password); err != nil {
+			return nil, err
+		}
+	}
+	hostname := u.GetHostname()
+	if strings.Contains(hostname, ":") {
+		hostname = "[" + hostname + "]"
+	}
+	if values[stateHostname], err = canonicalizeHostname(hostname, protocol); err != nil {
+		return nil, err
+	}
+	if values[statePort], err = canonicalizePort(u.GetPort(), protocol); err != nil {
+		return nil, err
+	}
+	switch {
+	case u.url.Opaque != "":
+		values[statePathname], err = canonicalizeOpaquePathname(u.url.Opaque)
+	case isSpecialScheme(protocol) && u.url.EscapedPath() == "":
+		values[statePathname] = "/"
+	case isSpecialScheme(protocol):
+		values[statePathname], err = canonicalizePathname(u.url.EscapedPath())
+	case strings.HasPrefix(u.url.EscapedPath(), "/"):
+		values[statePathname] = parsePath(stripTabNewline(u.url.EscapedPath()), false)
+	default:
+		values[statePathname], err = canonicalizeOpaquePathname(u.url.EscapedPath())
+	}
+	if err != nil {
+		return nil, err
+	}
+	values[stateSearch], _ = canonicalizeSearch(u.url.RawQuery)
+	values[stateHash], _ = canonicalizeHash(u.url.EscapedFragment())
+	return values, nil
+}
+
+// processURLPatternInit fills in the components of init from its base URL
+// and, for a URL to match rather than a pattern, canonicalizes them and
+// sets the missing ones to "".
+func processURLPatternInit(init URLPatternInit, isPattern bool) (URLPatternInit, error) {
+	var result URLPatternInit
+	set := func(field **string, value string) {
+		*field = &value
+	}
+	if !isPattern {
+		for _, field := range result.fields() {
+			set(field, "")
+		}
+	}
+	// fromBase escapes a component of the base URL if it becomes part of a
+	// pattern.
+	fromBase := func(value string) string {
+		if isPattern {
+			return escapePatternString(value)
+		}
+		return value
+	}
+
+	var base map[string]string
+	var baseOpaque bool
+	if init.BaseURL != nil {
+		u, err := Parse(*init.BaseURL)
+		if err == nil {
+			base, err = urlPatternValues(u)
+		}
+		if err != nil {
+			return result, &URLPatternError{Detail: "invalid base URL"}
+		}
+		baseOpaque = u.url.Opaque != ""
+
+		given := func(fields ...*string) bool {
+			for _, field := range fields {
+				if field != nil {
+					return true
+				}
+			}
+			return false
+		}
+		if init.Protocol == nil {
+			set(&result.Protocol, fromBase(base[stateProtocol]))
+		}
+		if !isPattern && !given(init.Protocol, init.Hostname, init.Port, init.Username) {
+			set(&result.Username, fromBase(base[stateUsername]))
+		}
+		if !isPattern && !given(init.Protocol, init.Hostname, init.Port, init.Username, init.Password) {
+			set(&result.Password, fromBase(base[statePassword]))
+		}
+		if !given(init.Protocol, init.Hostname) {
+			set(&result.Hostname, fromBase(base[stateHostname]))
+		}
+		if !given(init.Protocol, init.Hostname, init.Port) {
+			set(&result.Port, fromBase(base[statePort]))
+		}
+		if !given(init.Protocol, init.Hostname, init.Port, init.Pathname) {
+			set(&result.Pathname, fromBase(base[statePathname]))
+		}
+		if !given(init.Protocol, init.Hostname, init.Port, init.Pathname, init.Search) {
+			set(&result.Search, fromBase(base[stateSearch]))
+		}
+		if !given(init.Protocol, init.Hostname, init.Port, init.Pathname, init.Search, init.Hash) {
+			set(&result.Hash, fromBase(base[stateHash]))
+		}
+	}
+
+	// canonicalize is skipped for patterns, whose fixed text is
+	// canonicalized when they are compiled.
+	canonicalize := func(field **string, value string, f func(string) (string, error)) error {
+		if !isPattern {
+			var err error
+			if value, err = f(value); err != nil {
+				return err
+			}
+		}
+		set(field, value)
+		return nil
+	}
+	if init.Protocol != nil {
+		if err := canonicalize(&result.Protocol, strings.TrimSuffix(*init.Protocol, ":"), canonicalizeProtocol); err != nil {
+			return result, err
+		}
+	}
+	protocol := ""
+	if result.Protocol != nil {
+		protocol = *result.Protocol
+	}
+	if init.Username != nil {
+		if err := canonicalize(&result.Username, *init.Username, canonicalizeUserinfo); err != nil {
+			return result, err
+		}
+	}
+	if init.Password != nil {
+		if err := canonicalize(&result.Password, *init.Password, canonicalizeUserinfo); err != nil {
+			return result, err
+		}
+	}
+	if init.Hostname != nil {
+		err := canonicalize(&result.Hostname, *init.Hostname, func(s string) (string, error) {
+			return canonicalizeHostname(s, protocol)
+		})
+		if err != nil {
+			return result, err
+		}
+	}
+	if init.Port != nil {
+		err := canonicalize(&result.Port, *init.Port, func(s string) (string, error) {
+			return canonicalizePort(s, protocol)
+		})
+		if err != nil {
+			return result, err
+		}
+	}
+	if init.Pathname != nil {
+		pathname := *init.Pathname
+		if base != nil && !baseOpaque && !isAbsolutePathname(pathname, isPattern) {
+			// A relative pathname is resolved against the directory of the
+			// base URL.
+			basePath := fromBase(base[statePathname])
+			if i := strings.LastIndexByte(basePath, '/'); i >= 0 {
+				pathname = basePath[:i+1] + pathname
+			}
+		}
+		encode := canonicalizeOpaquePathname
+		if protocol == "" || isSpecialScheme(protocol) {
+			encode = canonicalizePathname
+		}
+		if err := canonicalize(&result.Pathname, pathname, encode); err != nil {
+			return result, err
+		}
+	}
+	if init.Search != nil {
+		if err := canonicalize(&result.Search, strings.TrimPrefix(*init.Search, "?"), canonicalizeSearch); err != nil {
+			return result, err
+		}
+	}
+	if init.Hash != nil {
+		if err := canonicalize(&result.Hash, strings.TrimPrefix(*init.Hash, "#"), canonicalizeHash); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// isAbsolutePathname reports whether a pathname starts with "/". In a
+// pattern, an escaped "\/" or a group "{/" starts one too.
+func isAbsolutePathname(pathname string, isPattern bool) bool {
+	if strings.HasPrefix(pathname, "/") {
+		return true
+	}
+	if !isPattern || len(pathname) < 2 {
+		return false
+	}
+	return (pathname[0] == '\\' || pathname[0] == '{') && pathname[1] == '/'
+}
+
+// Percent-encode sets of the WHATWG URL Standard.
+func inC0ControlSet(c byte) bool {
+	return c < 0x20 || c > 0x7e
+}
+
+func inFragmentSet(c byte) bool {
+	return inC0ControlSet(c) || strings.IndexByte(" \"<>`", c) >= 0
+}
+
+func inSpecialQuerySet(c byte) bool {
+	return inC0ControlSet(c) || strings.IndexByte(" \"#<>'", c) >= 0
+}
+
+func inPathSet(c byte) bool {
+	return inC0ControlSet(c) || strings.IndexByte(" \"#<>?`{}", c) >= 0
+}
+
+func inUserinfoSet(c byte) bool {
+	return inPathSet(c) || strings.IndexByte("/:;=@[\\]^|", c) >= 0
+}
+
+// percentEncodeSet percent-encodes the bytes of s in a set.
+func percentEncodeSet(s string, inSet func(byte) bool) string {
+	const upperhex = "0123456789ABCDEF"
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if inSet(c) {
+			b.WriteByte('%')
+			b.WriteByte(upperhex[c>>4])
+			b.WriteByte(upperhex[c&15])
+		} else {
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// stripTabNewline removes tabs and newlines, which URL parsers ignore.
+func stripTabNewline(s string) string {
+	if strings.IndexAny(s, "\t\n\r") < 0 {
+		return s
+	}
+	return strings.NewReplacer("\t", "", "\n", "", "\r", "").Replace(s)
+}
+
+func canonicalizeProtocol(value string) (string, error) {
+	value = stripTabNewline(value)
+	if value == "" {
+		return "", nil
+	}
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		isAlpha := 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+		if !isAlpha && (i == 0 || !isASCIIDigit(c) && c != '+' && c != '-' && c != '.') {
+			return "", &URLPatternError{Component: stateProtocol, Detail: "invalid protocol " + strconv.Quote(value)}
+		}
+	}
+	return strings.ToLower(value), nil
+}
+
+func canonicalizeUserinfo(value string) (string, error) {
+	return percentEncodeSet(stripTabNewline(value), inUserinfoSet), nil
+}
+
+// canonicalizeHostname serializes a hostname as the WHATWG URL Standard
+// does: domains of special schemes, and of patterns, are converted with
+// ToASCII and IPv4 addresses are written in dotted decimal form.
+func canonicalizeHostname(value, protocol string) (string, error) {
+	value = stripTabNewline(value)
+	if value == "" {
+		return "", nil
+	}
+	invalid := &URLPatternError{Component: stateHostname, Detail: "invalid hostname " + strconv.Quote(value)}
+	if strings.HasPrefix(value, "[") {
+		if !strings.HasSuffix(value, "]") {
+			return "", invalid
+		}
+		ip := net.ParseIP(value[1 : len(value)-1])
+		if ip == nil || !strings.Contains(value, ":") {
+			return "", invalid
+		}
+		return "[" + serializeIPv6(ip) + "]", nil
+	}
+	if protocol != "" && !isSpecialScheme(protocol) {
+		if strings.ContainsAny(value, "\x00 #/:<>?@[\\]^|") {
+			return "", invalid
+		}
+		return percentEncodeSet(value, inC0ControlSet), nil
+	}
+
+	decoded := unescapeSegment(value)
+	ascii, err := ToASCII(decoded)
+	if err != nil || ascii == "" {
+		return "", invalid
+	}
+	for i := 0; i < len(ascii); i++ {
+		if c := ascii[i]; c <= 0x20 || c == 0x7f || strings.IndexByte("#%/:<>?@[\\]^|", c) >= 0 {
+			return "", invalid
+		}
+	}
+	if endsInNumber(ascii) {
+		ip, ok := parseIPv4(ascii)
+		if !ok {
+			return "", invalid
+		}
+		return ip.To4().String(), nil
+	}
+	return ascii, nil
+}
+
+// endsInNumber reports whether the last label of a domain is a number, in
+// which case the domain must be an IPv4 address.
+func endsInNumber(domain string) bool {
+	labels := strings.Split(domain, ".")
+	if labels[len(labels)-1] == "" {
+		if len(labels) == 1 {
+			return false
+		}
+		labels = labels[:len(labels)-1]
+	}
+	last := labels[len(labels)-1]
+	if last != "" && strings.Trim(last, "0123456789") == "" {
+		return true
+	}
+	if len(last) < 2 || last[0] != '0' || last[1] != 'x' && last[1] != 'X' {
+		return false
+	}
+	for i := 2; i < len(last); i++ {
+		if !isHex(last[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// serializeIPv6 writes an IPv6 address with the longest run of zero groups
+// compressed, and never in the IPv4 form net.IP uses for mapped addresses.
+func serializeIPv6(ip net.IP) string {
+	ip = ip.To16()
+	var groups [8]uint16
+	for i := range groups {
+		groups[i] = uint16(ip[2*i])<<8 | uint16(ip[2*i+1])
+	}
+	bestStart, bestLen := -1, 1
+	for i := 0; i < 8; {
+		if groups[i] != 0 {
+			i++
+			continue
+		}
+		j := i
+		for j < 8 && groups[j] == 0 {
+			j++
+		}
+		if j-i > bestLen {
+			bestStart, bestLen = i, j-i
+		}
+		i = j
+	}
+	var b strings.Builder
+	for i := 0; i < 8; i++ {
+		if i == bestStart {
+			b.WriteString("::")
+			i += bestLen - 1
+			continue
+		}
+		if i > 0 && i != bestStart+bestLen {
+			b.WriteByte(':')
+		}
+		b.WriteString(strconv.FormatUint(uint64(groups[i]), 16))
+	}
+	return b.String()
+}
+
+// canonicalizeIPv6Hostname lowercases the fixed text of an IPv6 hostname
+// pattern, which may only hold hex digits, colons and brackets.
+func canonicalizeIPv6Hostname(value string) (string, error) {
+	for i := 0; i < len(value); i++ {
+		if c := value[i]; !isHex(c) && c != '[' && c != ']' && c != ':' {
+			return "", &URLPatternError{Component: stateHostname, Detail: "invalid IPv6 hostname " + strconv.Quote(value)}
+		}
+	}
+	return strings.ToLower(value), nil
+}
+
+// canonicalizePort removes leading zeros from a port, and removes it if it
+// is the default port of protocol.
+func canonicalizePort(value, protocol string) (string, error) {
+	value = stripTabNewline(value)
+	if value == "" {
+		return "", nil
+	}
+	n, err := strconv.ParseUint(value, 10, 16)
+	if err != nil || value[0] == '+' {
+		return "", &URLPatternError{Component: statePort, Detail: "invalid port " + strconv.Quote(value)}
+	}
+	port := strconv.FormatUint(n, 10)
+	if isSpecialScheme(protocol) && defaultPorts[protocol] == port {
+		return "", nil
+	}
+	return port, nil
+}
+
+// canonicalizePathname percent-encodes a hierarchical path and resolves its
+// "." and ".." segments. A pathname that does not start with "/", such as
+// the fixed text after a group, is resolved as if it followed "/-".
+func canonicalizePathname(value string) (string, error) {
+	value = stripTabNewline(value)
+	if value == "" {
+		return "", nil
+	}
+	leadingSlash := value[0] == '/'
+	if !leadingSlash {
+		value = "/-" + value
+	}
+	result := parsePath(value, true)
+	if !leadingSlash {
+		if len(result) < 2 {
+			return "", nil
+		}
+		result = result[2:]
+	}
+	return result, nil
+}
+
+// parsePath parses a path starting with "/" as the WHATWG URL Standard
+// does, resolving "." and ".." segments. For special schemes "\" is a
+// separator too.
+func parsePath(path string, special bool) string {
+	var segments []string
+	var buffer strings.Builder
+	rest := path[1:]
+	for i := 0; i <= len(rest); i++ {
+		if i < len(rest) && rest[i] != '/' && (!special || rest[i] != '\\') {
+			buffer.WriteString(percentEncodeSet(rest[i:i+1], inPathSet))
+			continue
+		}
+		segment := buffer.String()
+		buffer.Reset()
+		last := i == len(rest)
+		switch strings.ToLower(segment) {
+		case "..", ".%2e", "%2e.", "%2e%2e":
+			if len(segments) > 0 {
+				segments = segments[:len(segments)-1]
+			}
+			if last {
+				segments = append(segments, "")
+			}
+		case ".", "%2e":
+			if last {
+				segments = append(segments, "")
+			}
+		default:
+			segments = append(segments, segment)
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+func canonicalizeOpaquePathname(value string) (string, error) {
+	return percentEncodeSet(stripTabNewline(value), inC0ControlSet), nil
+}
+
+func canonicalizeSearch(value string) (string, error) {
+	return percentEncodeSet(stripTabNewline(value), inSpecialQuerySet), nil
+}
+
+func canonicalizeHash(value string) (string, error) {
+	return percentEncodeSet(stripTabNewline(value), inFragmentSet), nil
+}
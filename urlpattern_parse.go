@@ -0,0 +1,884 @@
+package gurl
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// This file holds the pattern string machinery of the WHATWG URL Pattern
+// Standard: the tokenizer, the parser that turns a component pattern into
+// parts, the generation of a regular expression and of a normalized pattern
+// string from the parts, and the parser of whole constructor strings such as
+// "https://*.example.com/books/:id".
+
+type patternTokenType int
+
+const (
+	tokenOpen patternTokenType = iota
+	tokenClose
+	tokenRegexp
+	tokenName
+	tokenChar
+	tokenEscapedChar
+	tokenOtherModifier
+	tokenAsterisk
+	tokenEnd
+	tokenInvalidChar
+)
+
+// patternToken is a token of a pattern string. index is the position of the
+// token in the input, in runes.
+type patternToken struct {
+	typ   patternTokenType
+	index int
+	value string
+}
+
+// patternTokenizer splits a pattern string into tokens. In strict mode an
+// invalid character is an error; otherwise it becomes an invalid-char token,
+// which is how constructor strings are first split into components.
+type patternTokenizer struct {
+	input  []rune
+	strict bool
+	index  int
+	tokens []patternToken
+}
+
+func (t *patternTokenizer) add(typ patternTokenType, next, valueStart, valueEnd int) {
+	t.tokens = append(t.tokens, patternToken{typ: typ, index: t.index, value: string(t.input[valueStart:valueEnd])})
+	t.index = next
+}
+
+func (t *patternTokenizer) fail(next, valueStart int, detail string) error {
+	if t.strict {
+		return &URLPatternError{Detail: detail}
+	}
+	t.add(tokenInvalidChar, next, valueStart, next)
+	return nil
+}
+
+// tokenizePattern splits input into tokens, ending with an end token.
+func tokenizePattern(input string, strict bool) ([]patternToken, error) {
+	t := &patternTokenizer{input: []rune(input), strict: strict}
+	for t.index < len(t.input) {
+		i := t.index
+		var err error
+		switch t.input[i] {
+		case '*':
+			t.add(tokenAsterisk, i+1, i, i+1)
+		case '+', '?':
+			t.add(tokenOtherModifier, i+1, i, i+1)
+		case '\\':
+			if i == len(t.input)-1 {
+				err = t.fail(i+1, i, "trailing backslash")
+				break
+			}
+			t.add(tokenEscapedChar, i+2, i+1, i+2)
+		case '{':
+			t.add(tokenOpen, i+1, i, i+1)
+		case '}':
+			t.add(tokenClose, i+1, i, i+1)
+		case ':':
+			end := i + 1
+			for end < len(t.input) && isPatternNameRune(t.input[end], end == i+1) {
+				end++
+			}
+			if end == i+1 {
+				err = t.fail(end, i, "missing parameter name")
+				break
+			}
+			t.add(tokenName, end, i+1, end)
+		case '(':
+			err = t.regexp()
+		default:
+			t.add(tokenChar, i+1, i, i+1)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	t.add(tokenEnd, t.index, t.index, t.index)
+	return t.tokens, nil
+}
+
+// regexp reads a regular expression group such as "(\d+)". Nested groups
+// must not capture, so each "(" inside must be followed by "?".
+func (t *patternTokenizer) regexp() error {
+	open := t.index
+	start := open + 1
+	depth := 1
+	pos := start
+	for pos < len(t.input) {
+		c := t.input[pos]
+		if c > unicode.MaxASCII {
+			return t.fail(start, open, "non-ASCII character in regular expression")
+		}
+		if pos == start && c == '?' {
+			return t.fail(start, open, "regular expression starts with \"?\"")
+		}
+		if c == '\\' {
+			if pos == len(t.input)-1 || t.input[pos+1] > unicode.MaxASCII {
+				return t.fail(start, open, "invalid escape in regular expression")
+			}
+			pos += 2
+			continue
+		}
+		if c == ')' {
+			depth--
+			if depth == 0 {
+				pos++
+				break
+			}
+		} else if c == '(' {
+			depth++
+			if pos == len(t.input)-1 || t.input[pos+1] != '?' {
+				return t.fail(start, open, "capturing group in regular expression")
+			}
+		}
+		pos++
+	}
+	if depth != 0 {
+		return t.fail(start, open, "unbalanced regular expression")
+	}
+	if pos-start-1 == 0 {
+		return t.fail(start, open, "empty regular expression")
+	}
+	t.add(tokenRegexp, pos, start, pos-1)
+	return nil
+}
+
+// isPatternNameRune reports whether r can be part of a parameter name, as
+// in JavaScript identifiers.
+func isPatternNameRune(r rune, first bool) bool {
+	if r == '$' || r == '_' {
+		return true
+	}
+	if unicode.In(r, unicode.Pattern_Syntax, unicode.Pattern_White_Space) {
+		return false
+	}
+	if unicode.In(r, unicode.L, unicode.Nl, unicode.Other_ID_Start) {
+		return true
+	}
+	if first {
+		return false
+	}
+	return r == '\u200c' || r == '\u200d' ||
+		unicode.In(r, unicode.Mn, unicode.Mc, unicode.Nd, unicode.Pc, unicode.Other_ID_Continue)
+}
+
+type patternPartType int
+
+const (
+	partFixedText patternPartType = iota
+	partRegexp
+	partSegmentWildcard
+	partFullWildcard
+)
+
+type patternModifier int
+
+const (
+	modifierNone patternModifier = iota
+	modifierOptional
+	modifierZeroOrMore
+	modifierOneOrMore
+)
+
+func (m patternModifier) String() string {
+	switch m {
+	case modifierOptional:
+		return "?"
+	case modifierZeroOrMore:
+		return "*"
+	case modifierOneOrMore:
+		return "+"
+	}
+	return ""
+}
+
+// patternPart is a fixed text or a group of a parsed pattern. Groups have a
+// name, which is a number for unnamed ones, and may have a prefix and suffix
+// that are only matched with the group.
+type patternPart struct {
+	typ      patternPartType
+	value    string
+	modifier patternModifier
+	name     string
+	prefix   string
+	suffix   string
+}
+
+// patternOptions are the delimiter of segment wildcards and the prefix that
+// is made optional with them: "/" for paths and "." (delimiter only) for
+// hostnames.
+type patternOptions struct {
+	delimiter  string
+	prefix     string
+	ignoreCase bool
+}
+
+var (
+	defaultPatternOptions  = patternOptions{}
+	hostnamePatternOptions = patternOptions{delimiter: "."}
+	pathnamePatternOptions = patternOptions{delimiter: "/", prefix: "/"}
+)
+
+// fullWildcardRegexp is the regular expression of "*".
+const fullWildcardRegexp = ".*"
+
+// segmentWildcardRegexp returns the regular expression of a parameter
+// without one, as written by the standard. For the empty delimiter it is
+// "[^]+?", which Go does not accept; see goSegmentWildcardRegexp.
+func segmentWildcardRegexp(opts patternOptions) string {
+	return "[^" + escapeRegexpString(opts.delimiter) + "]+?"
+}
+
+func goSegmentWildcardRegexp(opts patternOptions) string {
+	if opts.delimiter == "" {
+		return `(?s:.)+?`
+	}
+	return segmentWildcardRegexp(opts)
+}
+
+func escapeRegexpString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(`.+*?^${}()[]|/\`, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func escapePatternString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(`+*?:{}()\`, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// patternParser turns tokens into parts. encode canonicalizes fixed text,
+// prefixes and suffixes for the component being parsed.
+type patternParser struct {
+	tokens          []patternToken
+	encode          func(string) (string, error)
+	segmentWildcard string
+	parts           []patternPart
+	pending         string
+	index           int
+	nextNumericName int
+}
+
+// parsePattern parses the pattern of one component.
+func parsePattern(input string, opts patternOptions, encode func(string) (string, error)) ([]patternPart, error) {
+	tokens, err := tokenizePattern(input, true)
+	if err != nil {
+		return nil, err
+	}
+	p := &patternParser{tokens: tokens, encode: encode, segmentWildcard: segmentWildcardRegexp(opts)}
+	for p.index < len(p.tokens) {
+		charToken := p.tryConsume(tokenChar)
+		nameToken := p.tryConsume(tokenName)
+		regexpOrWildcardToken := p.tryConsumeRegexpOrWildcard(nameToken)
+		if nameToken != nil || regexpOrWildcardToken != nil {
+			prefix := ""
+			if charToken != nil {
+				prefix = charToken.value
+			}
+			if prefix != "" && prefix != opts.prefix {
+				p.pending += prefix
+				prefix = ""
+			}
+			if err := p.addPendingPart(); err != nil {
+				return nil, err
+			}
+			modifierToken := p.tryConsumeModifier()
+			if err := p.addPart(prefix, nameToken, regexpOrWildcardToken, "", modifierToken); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		fixedToken := charToken
+		if fixedToken == nil {
+			fixedToken = p.tryConsume(tokenEscapedChar)
+		}
+		if fixedToken != nil {
+			p.pending += fixedToken.value
+			continue
+		}
+
+		if p.tryConsume(tokenOpen) != nil {
+			prefix := p.consumeText()
+			nameToken := p.tryConsume(tokenName)
+			regexpOrWildcardToken := p.tryConsumeRegexpOrWildcard(nameToken)
+			suffix := p.consumeText()
+			if err := p.consumeRequired(tokenClose); err != nil {
+				return nil, err
+			}
+			modifierToken := p.tryConsumeModifier()
+			if err := p.addPart(prefix, nameToken, regexpOrWildcardToken, suffix, modifierToken); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := p.addPendingPart(); err != nil {
+			return nil, err
+		}
+		if err := p.consumeRequired(tokenEnd); err != nil {
+			return nil, err
+		}
+	}
+	return p.parts, nil
+}
+
+func (p *patternParser) tryConsume(typ patternTokenType) *patternToken {
+	if p.tokens[p.index].typ != typ {
+		return nil
+	}
+	p.index++
+	return &p.tokens[p.index-1]
+}
+
+func (p *patternParser) tryConsumeModifier() *patternToken {
+	if token := p.tryConsume(tokenOtherModifier); token != nil {
+		return token
+	}
+	return p.tryConsume(tokenAsterisk)
+}
+
+// tryConsumeRegexpOrWildcard consumes a regular expression, or a "*" if
+// there is no name before it: in ":name*" the "*" is a modifier.
+func (p *patternParser) tryConsumeRegexpOrWildcard(nameToken *patternToken) *patternToken {
+	token := p.tryConsume(tokenRegexp)
+	if nameToken == nil && token == nil {
+		token = p.tryConsume(tokenAsterisk)
+	}
+	return token
+}
+
+func (p *patternParser) consumeRequired(typ patternTokenType) error {
+	if p.tryConsume(typ) == nil {
+		token := p.tokens[p.index]
+		if token.typ == tokenEnd {
+			return &URLPatternError{Detail: "unexpected end of pattern"}
+		}
+		return &URLPatternError{Detail: "unexpected " + strconv.Quote(token.value)}
+	}
+	return nil
+}
+
+func (p *patternParser) consumeText() string {
+	var b strings.Builder
+	for {
+		token := p.tryConsume(tokenChar)
+		if token == nil {
+			token = p.tryConsume(tokenEscapedChar)
+		}
+		if token == nil {
+			return b.String()
+		}
+		b.WriteString(token.value)
+	}
+}
+
+func (p *patternParser) addPendingPart() error {
+	if p.pending == "" {
+		return nil
+	}
+	value, err := p.encode(p.pending)
+	if err != nil {
+		return err
+	}
+	p.pending = ""
+	p.parts = append(p.parts, patternPart{typ: partFixedText, value: value})
+	return nil
+}
+
+func (p *patternParser) addPart(prefix string, nameToken, regexpOrWildcardToken *patternToken, suffix string, modifierToken *patternToken) error {
+	modifier := modifierNone
+	if modifierToken != nil {
+		switch modifierToken.value {
+		case "?":
+			modifier = modifierOptional
+		case "*":
+			modifier = modifierZeroOrMore
+		case "+":
+			modifier = modifierOneOrMore
+		}
+	}
+	if nameToken == nil && regexpOrWildcardToken == nil && modifier == modifierNone {
+		p.pending += prefix
+		return nil
+	}
+	if err := p.addPendingPart(); err != nil {
+		return err
+	}
+	if nameToken == nil && regexpOrWildcardToken == nil {
+		// A group of fixed text with a modifier, such as "{www.}?".
+		if prefix == "" {
+			return nil
+		}
+		value, err := p.encode(prefix)
+		if err != nil {
+			return err
+		}
+		p.parts = append(p.parts, patternPart{typ: partFixedText, value: value, modifier: modifier})
+		return nil
+	}
+
+	regexpValue := p.segmentWildcard
+	if regexpOrWildcardToken != nil {
+		if regexpOrWildcardToken.typ == tokenAsterisk {
+			regexpValue = fullWildcardRegexp
+		} else {
+			regexpValue = regexpOrWildcardToken.value
+		}
+	}
+	typ := partRegexp
+	switch regexpValue {
+	case p.segmentWildcard:
+		typ, regexpValue = partSegmentWildcard, ""
+	case fullWildcardRegexp:
+		typ, regexpValue = partFullWildcard, ""
+	}
+
+	var name string
+	if nameToken != nil {
+		name = nameToken.value
+	} else {
+		name = strconv.Itoa(p.nextNumericName)
+		p.nextNumericName++
+	}
+	for _, part := range p.parts {
+		if part.name == name {
+			return &URLPatternError{Detail: "duplicate name " + strconv.Quote(name)}
+		}
+	}
+
+	encodedPrefix, err := p.encode(prefix)
+	if err != nil {
+		return err
+	}
+	encodedSuffix, err := p.encode(suffix)
+	if err != nil {
+		return err
+	}
+	p.parts = append(p.parts, patternPart{
+		typ:      typ,
+		value:    regexpValue,
+		modifier: modifier,
+		name:     name,
+		prefix:   encodedPrefix,
+		suffix:   encodedSuffix,
+	})
+	return nil
+}
+
+// generateRegexp returns the regular expression matching a component, with
+// one capturing group per named or numbered group, and the group names.
+func generateRegexp(parts []patternPart, opts patternOptions) (string, []string) {
+	var b strings.Builder
+	if opts.ignoreCase {
+		b.WriteString("(?i)")
+	}
+	b.WriteString("^")
+	var names []string
+	for _, part := range parts {
+		if part.typ == partFixedText {
+			if part.modifier == modifierNone {
+				b.WriteString(escapeRegexpString(part.value))
+			} else {
+				b.WriteString("(?:" + escapeRegexpString(part.value) + ")" + part.modifier.String())
+			}
+			continue
+		}
+
+		names = append(names, part.name)
+		regexpValue := part.value
+		switch part.typ {
+		case partSegmentWildcard:
+			regexpValue = goSegmentWildcardRegexp(opts)
+		case partFullWildcard:
+			regexpValue = fullWildcardRegexp
+		}
+		prefix, suffix := escapeRegexpString(part.prefix), escapeRegexpString(part.suffix)
+		single := part.modifier == modifierNone || part.modifier == modifierOptional
+		switch {
+		case prefix == "" && suffix == "" && single:
+			b.WriteString("(" + regexpValue + ")" + part.modifier.String())
+		case prefix == "" && suffix == "":
+			b.WriteString("((?:" + regexpValue + ")" + part.modifier.String() + ")")
+		case single:
+			b.WriteString("(?:" + prefix + "(" + regexpValue + ")" + suffix + ")" + part.modifier.String())
+		default:
+			// Repeat the group with the suffix and prefix between the
+			// repetitions, as in "/:id+" matching "/a/b/c".
+			b.WriteString("(?:" + prefix + "((?:" + regexpValue + ")(?:" + suffix + prefix +
+				"(?:" + regexpValue + "))*)" + suffix + ")")
+			if part.modifier == modifierZeroOrMore {
+				b.WriteString("?")
+			}
+		}
+	}
+	b.WriteString("$")
+	return b.String(), names
+}
+
+// generatePatternString writes parts back as a normalized pattern string.
+func generatePatternString(parts []patternPart, opts patternOptions) string {
+	var b strings.Builder
+	for i, part := range parts {
+		var previous, next *patternPart
+		if i > 0 {
+			previous = &parts[i-1]
+		}
+		if i < len(parts)-1 {
+			next = &parts[i+1]
+		}
+
+		if part.typ == partFixedText {
+			if part.modifier == modifierNone {
+				b.WriteString(escapePatternString(part.value))
+			} else {
+				b.WriteString("{" + escapePatternString(part.value) + "}" + part.modifier.String())
+			}
+			continue
+		}
+
+		customName := !isASCIIDigit(part.name[0])
+		needsGrouping := part.suffix != "" || part.prefix != "" && part.prefix != opts.prefix
+		if !needsGrouping && customName && part.typ == partSegmentWildcard && part.modifier == modifierNone &&
+			next != nil && next.prefix == "" && next.suffix == "" {
+			if next.typ == partFixedText {
+				needsGrouping = startsWithNameRune(next.value)
+			} else {
+				needsGrouping = isASCIIDigit(next.name[0])
+			}
+		}
+		if !needsGrouping && part.prefix == "" && previous != nil && previous.typ == partFixedText &&
+			opts.prefix != "" && strings.HasSuffix(previous.value, opts.prefix) {
+			needsGrouping = true
+		}
+
+		if needsGrouping {
+			b.WriteString("{")
+		}
+		b.WriteString(escapePatternString(part.prefix))
+		if customName {
+			b.WriteString(":" + part.name)
+		}
+		switch part.typ {
+		case partRegexp:
+			b.WriteString("(" + part.value + ")")
+		case partSegmentWildcard:
+			if !customName {
+				b.WriteString("(" + segmentWildcardRegexp(opts) + ")")
+			}
+		case partFullWildcard:
+			if !customName && (previous == nil || previous.typ == partFixedText || previous.modifier != modifierNone ||
+				needsGrouping || part.prefix != "") {
+				b.WriteString("*")
+			} else {
+				b.WriteString("(" + fullWildcardRegexp + ")")
+			}
+		}
+		if part.typ == partSegmentWildcard && customName && startsWithNameRune(part.suffix) {
+			b.WriteString("\\")
+		}
+		b.WriteString(escapePatternString(part.suffix))
+		if needsGrouping {
+			b.WriteString("}")
+		}
+		b.WriteString(part.modifier.String())
+	}
+	return b.String()
+}
+
+func isASCIIDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func startsWithNameRune(s string) bool {
+	for _, r := range s {
+		return isPatternNameRune(r, false)
+	}
+	return false
+}
+
+// The states of constructorParser, named after the component being read.
+const (
+	stateInit      = "init"
+	stateProtocol  = "protocol"
+	stateAuthority = "authority"
+	stateUsername  = "username"
+	statePassword  = "password"
+	stateHostname  = "hostname"
+	statePort      = "port"
+	statePathname  = "pathname"
+	stateSearch    = "search"
+	stateHash      = "hash"
+	stateDone      = "done"
+)
+
+// constructorParser splits a constructor string such as
+// "https://example.com/:id?q=*" into the patterns of its components.
+type constructorParser struct {
+	input                []rune
+	tokens               []patternToken
+	result               map[string]string
+	componentStart       int
+	index                int
+	increment            int
+	groupDepth           int
+	ipv6Depth            int
+	protocolMatchSpecial bool
+	state                string
+}
+
+// parseConstructorString returns the pattern of each component found in
+// input, keyed by component name.
+func parseConstructorString(input string) (map[string]string, error) {
+	tokens, err := tokenizePattern(input, false)
+	if err != nil {
+		return nil, err
+	}
+	p := &constructorParser{
+		input:  []rune(input),
+		tokens: tokens,
+		result: make(map[string]string),
+		state:  stateInit,
+	}
+	for p.index < len(p.tokens) {
+		p.increment = 1
+		if p.tokens[p.index].typ == tokenEnd {
+			if p.state == stateInit {
+				// There is no protocol: the string is a relative pattern.
+				p.rewind()
+				switch {
+				case p.isHashPrefix():
+					p.changeState(stateHash, 1)
+				case p.isSearchPrefix():
+					p.changeState(stateSearch, 1)
+				default:
+					p.changeState(statePathname, 0)
+				}
+				p.index += p.increment
+				continue
+			}
+			if p.state == stateAuthority {
+				// There is no "@": the authority is a host.
+				p.rewind()
+				p.state = stateHostname
+				p.index += p.increment
+				continue
+			}
+			p.changeState(stateDone, 0)
+			break
+		}
+
+		if p.tokens[p.index].typ == tokenOpen {
+			p.groupDepth++
+			p.index += p.increment
+			continue
+		}
+		if p.groupDepth > 0 {
+			if p.tokens[p.index].typ == tokenClose {
+				p.groupDepth--
+			} else {
+				p.index += p.increment
+				continue
+			}
+		}
+
+		switch p.state {
+		case stateInit:
+			if p.isNonSpecialPatternChar(p.index, ":") {
+				p.rewind()
+				p.state = stateProtocol
+			}
+		case stateProtocol:
+			if p.isNonSpecialPatternChar(p.index, ":") {
+				if err := p.computeProtocolMatchSpecial(); err != nil {
+					return nil, err
+				}
+				next, skip := statePathname, 1
+				if p.isNonSpecialPatternChar(p.index+1, "/") && p.isNonSpecialPatternChar(p.index+2, "/") {
+					next, skip = stateAuthority, 3
+				} else if p.protocolMatchSpecial {
+					next = stateAuthority
+				}
+				p.changeState(next, skip)
+			}
+		case stateAuthority:
+			if p.isNonSpecialPatternChar(p.index, "@") {
+				p.rewind()
+				p.state = stateUsername
+			} else if p.isNonSpecialPatternChar(p.index, "/") || p.isSearchPrefix() || p.isHashPrefix() {
+				p.rewind()
+				p.state = stateHostname
+			}
+		case stateUsername:
+			if p.isNonSpecialPatternChar(p.index, ":") {
+				p.changeState(statePassword, 1)
+			} else if p.isNonSpecialPatternChar(p.index, "@") {
+				p.changeState(stateHostname, 1)
+			}
+		case statePassword:
+			if p.isNonSpecialPatternChar(p.index, "@") {
+				p.changeState(stateHostname, 1)
+			}
+		case stateHostname:
+			switch {
+			case p.isNonSpecialPatternChar(p.index, "["):
+				p.ipv6Depth++
+			case p.isNonSpecialPatternChar(p.index, "]"):
+				p.ipv6Depth--
+			case p.isNonSpecialPatternChar(p.index, ":") && p.ipv6Depth == 0:
+				p.changeState(statePort, 1)
+			case p.isNonSpecialPatternChar(p.index, "/"):
+				p.changeState(statePathname, 0)
+			case p.isSearchPrefix():
+				p.changeState(stateSearch, 1)
+			case p.isHashPrefix():
+				p.changeState(stateHash, 1)
+			}
+		case statePort:
+			switch {
+			case p.isNonSpecialPatternChar(p.index, "/"):
+				p.changeState(statePathname, 0)
+			case p.isSearchPrefix():
+				p.changeState(stateSearch, 1)
+			case p.isHashPrefix():
+				p.changeState(stateHash, 1)
+			}
+		case statePathname:
+			if p.isSearchPrefix() {
+				p.changeState(stateSearch, 1)
+			} else if p.isHashPrefix() {
+				p.changeState(stateHash, 1)
+			}
+		case stateSearch:
+			if p.isHashPrefix() {
+				p.changeState(stateHash, 1)
+			}
+		}
+		p.index += p.increment
+	}
+	if _, ok := p.result[stateHostname]; ok {
+		if _, ok := p.result[statePort]; !ok {
+			p.result[statePort] = ""
+		}
+	}
+	return p.result, nil
+}
+
+func (p *constructorParser) rewind() {
+	p.index = p.componentStart
+	p.increment = 0
+}
+
+// changeState records the component just read and moves to the next one.
+// Components skipped on the way get an empty pattern, so that
+// "https://example.com?q" has an empty port and a "/" pathname.
+func (p *constructorParser) changeState(state string, skip int) {
+	switch p.state {
+	case stateInit, stateAuthority, stateDone:
+	default:
+		p.result[p.state] = p.componentString()
+	}
+	if p.state != stateInit && state != stateDone {
+		before := func(states ...string) bool {
+			for _, s := range states {
+				if p.state == s {
+					return true
+				}
+			}
+			return false
+		}
+		_, hasHostname := p.result[stateHostname]
+		_, hasPathname := p.result[statePathname]
+		_, hasSearch := p.result[stateSearch]
+		if before(stateProtocol, stateAuthority, stateUsername, statePassword) &&
+			(state == statePort || state == statePathname || state == stateSearch || state == stateHash) && !hasHostname {
+			p.result[stateHostname] = ""
+		}
+		if before(stateProtocol, stateAuthority, stateUsername, statePassword, stateHostname, statePort) &&
+			(state == stateSearch || state == stateHash) && !hasPathname {
+			if p.protocolMatchSpecial {
+				p.result[statePathname] = "/"
+			} else {
+				p.result[statePathname] = ""
+			}
+		}
+		if before(stateProtocol, stateAuthority, stateUsername, statePassword, stateHostname, statePort, statePathname) &&
+			state == stateHash && !hasSearch {
+			p.result[stateSearch] = ""
+		}
+	}
+	p.state = state
+	p.index += skip
+	p.componentStart = p.index
+	p.increment = 0
+}
+
+func (p *constructorParser) safeToken(i int) patternToken {
+	if i < len(p.tokens) {
+		return p.tokens[i]
+	}
+	return p.tokens[len(p.tokens)-1]
+}
+
+// isNonSpecialPatternChar reports whether the token at i is the character
+// value, not part of a group, name or regular expression.
+func (p *constructorParser) isNonSpecialPatternChar(i int, value string) bool {
+	token := p.safeToken(i)
+	if token.value != value {
+		return false
+	}
+	return token.typ == tokenChar || token.typ == tokenEscapedChar || token.typ == tokenInvalidChar
+}
+
+func (p *constructorParser) isHashPrefix() bool {
+	return p.isNonSpecialPatternChar(p.index, "#")
+}
+
+// isSearchPrefix reports whether the token is a "?" starting the search,
+// rather than a modifier after a group, as in "/:id?".
+func (p *constructorParser) isSearchPrefix() bool {
+	if p.isNonSpecialPatternChar(p.index, "?") {
+		return true
+	}
+	if p.tokens[p.index].value != "?" {
+		return false
+	}
+	if p.index == 0 {
+		return true
+	}
+	switch p.safeToken(p.index - 1).typ {
+	case tokenName, tokenRegexp, tokenClose, tokenAsterisk:
+		return false
+	}
+	return true
+}
+
+func (p *constructorParser) componentString() string {
+	start := p.safeToken(p.componentStart).index
+	return string(p.input[start:p.tokens[p.index].index])
+}
+
+func (p *constructorParser) computeProtocolMatchSpecial() error {
+	c, err := compileComponent(p.componentString(), canonicalizeProtocol, defaultPatternOptions)
+	if err != nil {
+		return err
+	}
+	p.protocolMatchSpecial = c.matchesSpecialScheme()
+	return nil
+}
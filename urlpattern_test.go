@@ -0,0 +1,321 @@
+package gurl
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+)
+
+// urlPatternCase is a case of urlpatterntestdata.json from the
+// web-platform-tests: the arguments of the URLPattern constructor, the
+// arguments of exec, and the expected components and matches. Only the
+// components a case lists, and those of exactly_empty_components, are
+// checked, and a null group is one that must not take part in the match.
+type urlPatternCase struct {
+	Pattern                []json.RawMessage `json:"pattern"`
+	Inputs                 []json.RawMessage `json:"inputs"`
+	ExactlyEmptyComponents []string          `json:"exactly_empty_components"`
+	ExpectedObj            json.RawMessage   `json:"expected_obj"`
+	ExpectedMatch          json.RawMessage   `json:"expected_match"`
+}
+
+type urlPatternExpectedComponent struct {
+	Input  *string            `json:"input"`
+	Groups map[string]*string `json:"groups"`
+}
+
+// name identifies the case by its compacted pattern and inputs, as the keys
+// of urlPatternKnownFailures do.
+func (c *urlPatternCase) name() string {
+	data, _ := json.Marshal([]interface{}{c.Pattern, c.Inputs})
+	return string(data)
+}
+
+// compile runs the constructor with the pattern arguments of the case: an
+// input string or URLPatternInit, then an optional base URL and options. A
+// base URL after a URLPatternInit is a TypeError in the constructor.
+func (c *urlPatternCase) compile() (*URLPattern, error) {
+	if len(c.Pattern) == 0 {
+		return CompileURLPatternInit(URLPatternInit{}, URLPatternOptions{})
+	}
+	var options URLPatternOptions
+	var baseURL *string
+	for _, arg := range c.Pattern[1:] {
+		var s string
+		if json.Unmarshal(arg, &s) == nil {
+			baseURL = &s
+		} else if err := json.Unmarshal(arg, &options); err != nil {
+			return nil, err
+		}
+	}
+	var input string
+	if json.Unmarshal(c.Pattern[0], &input) == nil {
+		if baseURL == nil {
+			return CompileURLPattern(input, "", options)
+		}
+		return CompileURLPattern(input, *baseURL, options)
+	}
+	if baseURL != nil {
+		return nil, ErrInvalidURLPattern
+	}
+	var init URLPatternInit
+	if err := json.Unmarshal(c.Pattern[0], &init); err != nil {
+		return nil, err
+	}
+	return CompileURLPatternInit(init, options)
+}
+
+// errURLPatternExec stands for the TypeError that exec throws for a base URL
+// after a URLPatternInit.
+var errURLPatternExec = errors.New("exec: base URL after a URLPatternInit")
+
+func (c *urlPatternCase) exec(p *URLPattern) (*URLPatternResult, bool, error) {
+	if len(c.Inputs) == 0 {
+		result, ok := p.ExecInit(URLPatternInit{})
+		return result, ok, nil
+	}
+	var input string
+	if json.Unmarshal(c.Inputs[0], &input) == nil {
+		var baseURL []string
+		if len(c.Inputs) > 1 {
+			baseURL = make([]string, 1)
+			if err := json.Unmarshal(c.Inputs[1], &baseURL[0]); err != nil {
+				return nil, false, err
+			}
+		}
+		result, ok := p.Exec(input, baseURL...)
+		return result, ok, nil
+	}
+	if len(c.Inputs) > 1 {
+		return nil, false, errURLPatternExec
+	}
+	var init URLPatternInit
+	if err := json.Unmarshal(c.Inputs[0], &init); err != nil {
+		return nil, false, err
+	}
+	result, ok := p.ExecInit(init)
+	return result, ok, nil
+}
+
+// urlPatternKnownFailures lists the cases of testdata/urlpatterntestdata.json
+// that the package is known to fail, by urlPatternCase.name, with the reason.
+// Regular expression groups are compiled with package regexp, so cases that
+// need JavaScript-only features, such as lookaround assertions and
+// backreferences, belong here. Every other case must pass.
+var urlPatternKnownFailures = map[string]string{}
+
+// TestURLPatternWPT runs urlpatterntestdata.json from the web-platform-tests,
+// vendored at the commit recorded in testdata/README.md.
+func TestURLPatternWPT(t *testing.T) {
+	data, err := os.ReadFile("testdata/urlpatterntestdata.json")
+	if os.IsNotExist(err) {
+		t.Skip("testdata/urlpatterntestdata.json is not vendored; see testdata/README.md")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	testURLPatternData(t, data, urlPatternKnownFailures)
+}
+
+// TestURLPatternData runs the local cases of testdata/urlpattern-local.json,
+// which use the layout of urlpatterntestdata.json.
+func TestURLPatternData(t *testing.T) {
+	data, err := os.ReadFile("testdata/urlpattern-local.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testURLPatternData(t, data, nil)
+}
+
+func testURLPatternData(t *testing.T, data []byte, knownFailures map[string]string) {
+	var cases []urlPatternCase
+	if err := json.Unmarshal(data, &cases); err != nil {
+		t.Fatal(err)
+	}
+	seen := map[string]bool{}
+	for _, c := range cases {
+		name := c.name()
+		seen[name] = true
+		if reason, ok := knownFailures[name]; ok {
+			t.Logf("Skipping %s: %s.", name, reason)
+			continue
+		}
+		testURLPatternCase(t, c, name)
+	}
+	for name := range knownFailures {
+		if !seen[name] {
+			t.Errorf("Known failure %s is not a case of the test data.", name)
+		}
+	}
+}
+
+func testURLPatternCase(t *testing.T, c urlPatternCase, name string) {
+	p, err := c.compile()
+	if string(c.ExpectedObj) == `"error"` {
+		if !errors.Is(err, ErrInvalidURLPattern) {
+			t.Errorf("CompileURLPattern(%s) was incorrect, got: %v, want: %v.", name, err, ErrInvalidURLPattern)
+		}
+		return
+	}
+	if err != nil {
+		t.Errorf("CompileURLPattern(%s) returned an error: %v.", name, err)
+		return
+	}
+
+	components := map[string]string{
+		"protocol": p.Protocol(),
+		"username": p.Username(),
+		"password": p.Password(),
+		"hostname": p.Hostname(),
+		"port":     p.Port(),
+		"pathname": p.Pathname(),
+		"search":   p.Search(),
+		"hash":     p.Hash(),
+	}
+	expectedObj := map[string]string{}
+	for _, component := range c.ExactlyEmptyComponents {
+		expectedObj[component] = ""
+	}
+	if c.ExpectedObj != nil {
+		if err := json.Unmarshal(c.ExpectedObj, &expectedObj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for component, want := range expectedObj {
+		if got := components[component]; got != want {
+			t.Errorf("CompileURLPattern(%s) %s was incorrect, got: %s, want: %s.", name, component, got, want)
+		}
+	}
+
+	if c.ExpectedMatch == nil {
+		return
+	}
+	result, ok, err := c.exec(p)
+	if string(c.ExpectedMatch) == `"error"` {
+		if err != errURLPatternExec {
+			t.Errorf("Exec on %s was incorrect, got: %v, want: %v.", name, err, errURLPatternExec)
+		}
+		return
+	}
+	if err != nil {
+		t.Errorf("Exec on %s returned an error: %v.", name, err)
+		return
+	}
+	if string(c.ExpectedMatch) == "null" {
+		if ok {
+			t.Errorf("Exec on %s was incorrect, got: a match, want: none.", name)
+		}
+		return
+	}
+	if !ok {
+		t.Errorf("Exec on %s was incorrect, got: no match, want: a match.", name)
+		return
+	}
+	empty := ""
+	expected := map[string]urlPatternExpectedComponent{}
+	for _, component := range c.ExactlyEmptyComponents {
+		expected[component] = urlPatternExpectedComponent{Input: &empty, Groups: map[string]*string{}}
+	}
+	if err := json.Unmarshal(c.ExpectedMatch, &expected); err != nil {
+		t.Fatal(err)
+	}
+	results := map[string]URLPatternComponentResult{
+		"protocol": result.Protocol,
+		"username": result.Username,
+		"password": result.Password,
+		"hostname": result.Hostname,
+		"port":     result.Port,
+		"pathname": result.Pathname,
+		"search":   result.Search,
+		"hash":     result.Hash,
+	}
+	for component, want := range expected {
+		got := results[component]
+		if want.Input != nil && got.Input != *want.Input {
+			t.Errorf("Exec on %s %s input was incorrect, got: %s, want: %s.", name, component, got.Input, *want.Input)
+		}
+		if len(got.Groups) != countPresent(want.Groups) {
+			t.Errorf("Exec on %s %s groups were incorrect, got: %v, want: %d groups.", name, component, got.Groups, countPresent(want.Groups))
+		}
+		for group, value := range want.Groups {
+			gotValue, present := got.Groups[group]
+			if value == nil && present || value != nil && (!present || gotValue != *value) {
+				t.Errorf("Exec on %s %s group %s was incorrect, got: %q, want: %v.", name, component, group, gotValue, value)
+			}
+		}
+	}
+}
+
+func countPresent(groups map[string]*string) int {
+	n := 0
+	for _, value := range groups {
+		if value != nil {
+			n++
+		}
+	}
+	return n
+}
+
+func TestURLPatternTest(t *testing.T) {
+	pattern := MustCompileURLPattern("/books/:id(\\d+)", "https://example.com", URLPatternOptions{})
+	tests := []struct {
+		url    string
+		result bool
+	}{
+		{"https://example.com/books/123", true},
+		{"https://example.com/books/abc", false},
+		{"https://example.com:443/books/1?x#y", true},
+		{"https://EXAMPLE.com/books/1", true},
+		{"http://example.com/books/1", false},
+		{"https://example.com/books/1/", false},
+		{"/books/1", false},
+	}
+	for _, test := range tests {
+		if result := pattern.Test(test.url); result != test.result {
+			t.Errorf("Test(%q) was incorrect, got: %v, want: %v.", test.url, result, test.result)
+		}
+	}
+	if !pattern.Test("../books/7", "https://example.com/shelf/x") {
+		t.Errorf("Test with a base URL was incorrect, got: false, want: true.")
+	}
+	if !pattern.HasRegExpGroups() {
+		t.Errorf("HasRegExpGroups was incorrect, got: false, want: true.")
+	}
+}
+
+func TestURLPatternExecURL(t *testing.T) {
+	pattern := MustCompileURLPattern("https://:sub.example.com/*", "", URLPatternOptions{})
+	u, _ := Parse("https://api.example.com/v1/users?page=2")
+	result, ok := pattern.ExecURL(u)
+	if !ok || result.Hostname.Groups["sub"] != "api" || result.Pathname.Groups["0"] != "v1/users" {
+		t.Errorf("ExecURL was incorrect, got: %v, %v.", result, ok)
+	}
+	if pattern.HasRegExpGroups() {
+		t.Errorf("HasRegExpGroups was incorrect, got: true, want: false.")
+	}
+
+	// Edits of the query are matched before the URL is serialized.
+	search := MustCompileURLPattern("https://example.com/search?q=:q", "", URLPatternOptions{})
+	u, _ = Parse("https://example.com/search?q=old")
+	u.SetQueryParam("q", "new")
+	result, ok = search.ExecURL(u)
+	if !ok || result.Search.Groups["q"] != "new" {
+		t.Errorf("ExecURL was incorrect, got: %v, %v, want: q=new.", result, ok)
+	}
+	u.DelQueryParam("q")
+	if _, ok := search.ExecURL(u); ok {
+		t.Errorf("ExecURL was incorrect, got: a match, want: none.")
+	}
+}
+
+func TestURLPatternError(t *testing.T) {
+	_, err := CompileURLPattern("https://example.com/:id/:id", "", URLPatternOptions{})
+	var patternErr *URLPatternError
+	if !errors.As(err, &patternErr) || patternErr.Component != "pathname" {
+		t.Errorf("CompileURLPattern was incorrect, got: %v, want: a pathname error.", err)
+	}
+	if _, err := CompileURLPattern("/(a(?=b))", "https://example.com", URLPatternOptions{}); !errors.Is(err, ErrInvalidURLPattern) {
+		t.Errorf("CompileURLPattern was incorrect, got: %v, want: %v.", err, ErrInvalidURLPattern)
+	}
+}
@@ -0,0 +1,230 @@
+package gurl
+
+import (
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Errors reported by Validate. They are wrapped in a *ValidationError and can
+// be checked with errors.Is.
+var (
+	ErrMissingScheme      = errors.New("gurl: missing scheme")
+	ErrMissingHost        = errors.New("gurl: missing host")
+	ErrDisallowedScheme   = errors.New("gurl: scheme not allowed")
+	ErrInvalidHostname    = errors.New("gurl: invalid hostname")
+	ErrDisallowedUserinfo = errors.New("gurl: userinfo not allowed")
+	ErrTooLong            = errors.New("gurl: URL too long")
+)
+
+// ValidationError describes why Validate rejected a URL.
+type ValidationError struct {
+	// Err is one of the errors reported by Validate, such as ErrMissingHost.
+	Err error
+	// Detail explains the error, and may be empty.
+	Detail string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Detail == "" {
+		return e.Err.Error()
+	}
+	return e.Err.Error() + ": " + e.Detail
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationRules configures Validate. The zero value requires a scheme and
+// a host name, and rejects IP literals and userinfo.
+type ValidationRules struct {
+	// AllowedSchemes lists the schemes that are accepted, compared without
+	// regard to case. Any scheme is accepted if it is empty.
+	AllowedSchemes []string
+	// RequireTLD requires the host name to have at least two labels, such as
+	// "example.com", and a top-level label that is not numeric. It also
+	// rejects "_", which DNS allows, as in "_dmarc.example.com", but host
+	// names do not.
+	RequireTLD bool
+	// MaxLength is the maximum length of the URL in bytes, or 0 for no limit.
+	MaxLength int
+	// AllowIPLiterals accepts an IPv4 or IPv6 address as the host.
+	AllowIPLiterals bool
+	// AllowUserinfo accepts a username and password before the host.
+	AllowUserinfo bool
+}
+
+// checkValidRules and checkValidHTTPURLRules are the rules of CheckValid and
+// CheckValidHTTPURL.
+var (
+	checkValidRules = ValidationRules{
+		AllowIPLiterals: true,
+		AllowUserinfo:   true,
+	}
+	checkValidHTTPURLRules = ValidationRules{
+		AllowedSchemes:  []string{"http", "https"},
+		AllowIPLiterals: true,
+		AllowUserinfo:   true,
+	}
+)
+
+// Validate checks a URL against a set of rules and explains why it was rejected.
+//
+// Parameters:
+//
+//	url: The URL to check.
+//	rules: The rules to check the URL against.
+//
+// Returns:
+//
+//	nil if the URL is valid, the error from parsing the URL, or a *ValidationError
+//	wrapping one of ErrMissingScheme, ErrMissingHost, ErrInvalidPort,
+//	ErrDisallowedScheme, ErrInvalidHostname, ErrDisallowedUserinfo and ErrTooLong.
+//	A port that is not a number, which fails parsing, is reported as
+//	ErrInvalidPort too.
+//
+// Example:
+//
+//	err := Validate("ftp://example.com/", ValidationRules{AllowedSchemes: []string{"http", "https"}})
+//	fmt.Println(errors.Is(err, ErrDisallowedScheme)) // Output: true
+func Validate(u string, rules ValidationRules) error {
+	if rules.MaxLength > 0 && len(u) > rules.MaxLength {
+		return &ValidationError{Err: ErrTooLong, Detail: strconv.Itoa(len(u)) + " > " + strconv.Itoa(rules.MaxLength) + " bytes"}
+	}
+	// A port that is not a number fails parsing, so it is checked first.
+	if port, ok := rawPort(u); ok && !validPort(port) {
+		return &ValidationError{Err: ErrInvalidPort, Detail: strconv.Quote(port)}
+	}
+	parsedURL, err := Parse(u)
+	if err != nil {
+		return err
+	}
+	return parsedURL.validate(rules)
+}
+
+// rawPort returns the port of the authority of the URL string u, and whether
+// the authority has a port. An empty port, as in "http://example.com:/", is
+// none.
+func rawPort(u string) (string, bool) {
+	i := strings.Index(u, "//")
+	if i < 0 || strings.ContainsAny(u[:i], "/?#") {
+		return "", false
+	}
+	rest := u[i+2:]
+	if i := strings.IndexAny(rest, "/?#"); i >= 0 {
+		rest = rest[:i]
+	}
+	if i := strings.LastIndexByte(rest, '@'); i >= 0 {
+		rest = rest[i+1:]
+	}
+	if i := strings.LastIndexByte(rest, ']'); i >= 0 {
+		rest = rest[i+1:]
+	}
+	i = strings.LastIndexByte(rest, ':')
+	if i < 0 || i == len(rest)-1 {
+		return "", false
+	}
+	return rest[i+1:], true
+}
+
+// Validate checks the URL against a set of rules. See the Validate function.
+func (u *URL) Validate(rules ValidationRules) error {
+	if rules.MaxLength > 0 {
+		if n := len(u.String()); n > rules.MaxLength {
+			return &ValidationError{Err: ErrTooLong, Detail: strconv.Itoa(n) + " > " + strconv.Itoa(rules.MaxLength) + " bytes"}
+		}
+	}
+	return u.validate(rules)
+}
+
+func (u *URL) validate(rules ValidationRules) error {
+	scheme := u.url.Scheme
+	if scheme == "" {
+		return &ValidationError{Err: ErrMissingScheme}
+	}
+	if len(rules.AllowedSchemes) > 0 {
+		allowed := false
+		for _, s := range rules.AllowedSchemes {
+			if strings.EqualFold(s, scheme) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return &ValidationError{Err: ErrDisallowedScheme, Detail: strconv.Quote(scheme)}
+		}
+	}
+	if u.url.User != nil && !rules.AllowUserinfo {
+		return &ValidationError{Err: ErrDisallowedUserinfo}
+	}
+	hostname := u.url.Hostname()
+	if hostname == "" {
+		return &ValidationError{Err: ErrMissingHost}
+	}
+	if port := u.url.Port(); port != "" && !validPort(port) {
+		return &ValidationError{Err: ErrInvalidPort, Detail: strconv.Quote(port)}
+	}
+	if isIPLiteral(hostname) {
+		if !rules.AllowIPLiterals {
+			return &ValidationError{Err: ErrInvalidHostname, Detail: "IP literal " + strconv.Quote(hostname) + " not allowed"}
+		}
+		return nil
+	}
+	if detail := checkHostname(hostname, rules.RequireTLD); detail != "" {
+		return &ValidationError{Err: ErrInvalidHostname, Detail: strconv.Quote(hostname) + ": " + detail}
+	}
+	return nil
+}
+
+// isIPLiteral reports whether hostname is an IPv4 or IPv6 address. An IPv6
+// address may carry a zone, as in "fe80::1%en0".
+func isIPLiteral(hostname string) bool {
+	if i := strings.IndexByte(hostname, '%'); i >= 0 && strings.Contains(hostname, ":") {
+		hostname = hostname[:i]
+	}
+	return net.ParseIP(hostname) != nil
+}
+
+// checkHostname checks that hostname is made of dot-separated labels of
+// letters, digits, "-" and, unless requireTLD is set, "_", and returns why it
+// is not, or "". Non-ASCII letters are accepted for internationalized names.
+// A single trailing dot, as in "example.com.", is allowed.
+func checkHostname(hostname string, requireTLD bool) string {
+	hostname = strings.TrimSuffix(hostname, ".")
+	if len(hostname) > 253 {
+		return "longer than 253 bytes"
+	}
+	labels := strings.Split(hostname, ".")
+	for _, label := range labels {
+		if label == "" {
+			return "empty label"
+		}
+		if len(label) > 63 {
+			return "label longer than 63 bytes"
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return "label starts or ends with a hyphen"
+		}
+		for _, r := range label {
+			if r < 0x80 && !isHostnameChar(byte(r)) || r == '_' && requireTLD {
+				return "invalid character " + strconv.QuoteRune(r)
+			}
+		}
+	}
+	if requireTLD {
+		tld := labels[len(labels)-1]
+		if len(labels) < 2 {
+			return "missing top-level domain"
+		}
+		if _, err := strconv.Atoi(tld); err == nil {
+			return "numeric top-level domain"
+		}
+	}
+	return ""
+}
+
+func isHostnameChar(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-' || c == '_'
+}